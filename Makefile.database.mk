@@ -30,7 +30,10 @@ db/drop: ensure-migrate-installed ## Drop local database elements
 	@migrate -path ./infra/database/migrations -database $(DATABASE_CONNECTION_STRING) drop
 
 db/seed: ## Populate the local database with seed data using the application
-	@go run main.go api.go migration.go seed.go -e seed
+	@go run main.go api.go migration.go seed.go purge.go -e seed
+
+db/purge: ## Delete the teams and people archived past the retention period using the application
+	@go run main.go api.go migration.go seed.go purge.go -e purge
 
 ensure-migrate-installed:
 	@command -v migrate >/dev/null 2>&1 || { echo >&2 "migrate is necessary to run this command. please run 'brew install golang-migrate' and try again"; exit 1; }
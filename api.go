@@ -17,7 +17,8 @@ func runAPI(applicationConfig *config.Application) {
 	applicationLogger.Infof("configuring the %s...", AppName)
 	databaseClient := getDatabase(applicationConfig, applicationLogger)
 	repositories := getRepositories(applicationConfig, databaseClient)
-	apiApp := api.NewApp(applicationLogger, applicationConfig, repositories)
+	mediaStorage := getStorage(applicationConfig, applicationLogger)
+	apiApp := api.NewApp(applicationLogger, applicationConfig, repositories, mediaStorage)
 	applicationLogger.Infof("the %s was configured successfully", AppName)
 
 	// Start API
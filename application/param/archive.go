@@ -0,0 +1,14 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type PurgeArchivedRecords struct {
+	ArchivedBefore time.Time
+
+	TeamRepository   repository.Team
+	PersonRepository repository.Person
+}
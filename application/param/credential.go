@@ -0,0 +1,62 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetPersonCredentials struct {
+	PersonUserName string
+
+	PersonRepository     repository.Person
+	CredentialRepository repository.Credential
+}
+
+type CreateWFDFAccreditation struct {
+	PersonUserName string
+	Level          entity.WFDFAccreditationLevel
+	IssueDate      time.Time
+	ExpiryDate     time.Time
+	CreatedBy      string
+
+	PersonRepository     repository.Person
+	CredentialRepository repository.Credential
+}
+
+type CreateFederationMembership struct {
+	PersonUserName string
+	FederationSlug string
+	Season         string
+	ValidFrom      time.Time
+	ValidUntil     time.Time
+	CreatedBy      string
+
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+	CredentialRepository  repository.Credential
+}
+
+type CreateCoachCertification struct {
+	PersonUserName string
+	Name           string
+	IssuedBy       string
+	IssueDate      time.Time
+	ExpiryDate     time.Time
+	CreatedBy      string
+
+	PersonRepository     repository.Person
+	CredentialRepository repository.Credential
+}
+
+type GetTeamExpiringWFDFAccreditations struct {
+	TeamName string
+	Date     time.Time
+	Roster   []string
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	CredentialRepository repository.Credential
+}
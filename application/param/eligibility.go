@@ -0,0 +1,23 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type CheckRosterEligibility struct {
+	TeamName       string
+	Roster         []string
+	EventStartDate time.Time
+	EventEndDate   time.Time
+	RuleNames      []eligibility.RuleName
+	Settings       eligibility.Settings
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	CredentialRepository repository.Credential
+	GuardianRepository   repository.Guardian
+}
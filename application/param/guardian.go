@@ -0,0 +1,63 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetGuardians struct {
+	DependentUserName string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type AddGuardian struct {
+	DependentUserName string
+	GuardianUserName  string
+	Relationship      string
+	CreatedBy         string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type RemoveGuardian struct {
+	DependentUserName string
+	GuardianUserName  string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type GetConsents struct {
+	PersonUserName string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type GrantConsent struct {
+	PersonUserName    string
+	Kind              entity.ConsentKind
+	GrantedByUserName string
+	GrantDate         time.Time
+	ExpiryDate        time.Time
+	CreatedBy         string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type GetDependentsSchedule struct {
+	GuardianUserName string
+	From             time.Time
+	To               time.Time
+
+	PersonRepository     repository.Person
+	GuardianRepository   repository.Guardian
+	MembershipRepository repository.Membership
+	TeamEventRepository  repository.TeamEvent
+}
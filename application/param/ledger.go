@@ -0,0 +1,59 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetTeamLedger struct {
+	TeamName string
+
+	TeamRepository   repository.Team
+	LedgerRepository repository.Ledger
+}
+
+type GetTeamLedgerBalances struct {
+	TeamName string
+
+	TeamRepository   repository.Team
+	LedgerRepository repository.Ledger
+}
+
+type ChargeTeamDues struct {
+	TeamName      string
+	AmountInCents int64
+	Description   string
+	EffectiveDate time.Time
+	CreatedBy     string
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
+
+type ChargeTeamTournamentFee struct {
+	TeamName           string
+	PersonUserNames    []string
+	TotalAmountInCents int64
+	Description        string
+	EffectiveDate      time.Time
+	CreatedBy          string
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
+
+type RecordTeamPayment struct {
+	TeamName       string
+	PersonUserName string
+	AmountInCents  int64
+	Description    string
+	EffectiveDate  time.Time
+	CreatedBy      string
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
@@ -0,0 +1,55 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetLegalEntityAffiliations struct {
+	LegalEntitySlug string
+
+	LegalEntityRepository repository.LegalEntity
+}
+
+type GetTeamLegalEntityAffiliations struct {
+	TeamName string
+
+	TeamRepository        repository.Team
+	LegalEntityRepository repository.LegalEntity
+}
+
+type GetPersonLegalEntityAffiliations struct {
+	PersonUserName string
+
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+}
+
+// AffiliateToLegalEntity affiliates the team with TeamName or, when it is empty, the person with PersonUserName.
+type AffiliateToLegalEntity struct {
+	LegalEntitySlug string
+	TeamName        string
+	PersonUserName  string
+	StartDate       time.Time
+	EndDate         time.Time
+	CreatedBy       string
+
+	TeamRepository        repository.Team
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+}
+
+// EndLegalEntityAffiliation ends the affiliation of the team with TeamName or, when it is empty, of the person with
+// PersonUserName.
+type EndLegalEntityAffiliation struct {
+	LegalEntitySlug string
+	TeamName        string
+	PersonUserName  string
+	EndDate         time.Time
+	UpdatedBy       string
+
+	TeamRepository        repository.Team
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+}
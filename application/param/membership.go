@@ -0,0 +1,63 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetTeamMemberships struct {
+	TeamName string
+	AsOf     time.Time
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+}
+
+type GetPersonMemberships struct {
+	PersonUserName string
+	AsOf           time.Time
+
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+}
+
+type TransferMembership struct {
+	PersonUserName  string
+	FromTeamName    string
+	ToTeamName      string
+	Role            string
+	TransferDate    time.Time
+	UpdatedBy       string
+	TransferWindows []entity.TransferWindow
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	GuardianRepository   repository.Guardian
+	TransactionManager   repository.TransactionManager
+}
+
+type AssignJerseyNumber struct {
+	TeamName       string
+	PersonUserName string
+	JerseyNumber   string
+	Date           time.Time
+	UpdatedBy      string
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	TransactionManager   repository.TransactionManager
+}
+
+type GetTeamMemberByJerseyNumber struct {
+	TeamName     string
+	JerseyNumber string
+	Date         time.Time
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+}
@@ -0,0 +1,67 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetTeamEvents struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+
+	TeamRepository      repository.Team
+	TeamEventRepository repository.TeamEvent
+}
+
+type CreateTeamEvent struct {
+	TeamName string
+	Event    *entity.TeamEvent
+
+	TeamRepository      repository.Team
+	TeamEventRepository repository.TeamEvent
+}
+
+type RespondToTeamEvent struct {
+	TeamName       string
+	EventID        string
+	PersonUserName string
+	RSVP           entity.RSVPStatus
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type RecordTeamEventAttendance struct {
+	TeamName   string
+	EventID    string
+	Attendance map[string]entity.AttendanceStatus
+	RecordedBy string
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type GetTeamAttendanceReport struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type GetTeamAvailability struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
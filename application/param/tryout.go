@@ -0,0 +1,83 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetTeamTryouts struct {
+	TeamName string
+
+	TeamRepository   repository.Team
+	TryoutRepository repository.Tryout
+}
+
+type CreateTeamTryout struct {
+	TeamName string
+	Tryout   *entity.Tryout
+
+	TeamRepository   repository.Team
+	TryoutRepository repository.Tryout
+}
+
+type SignUpForTeamTryout struct {
+	TeamName       string
+	TryoutID       string
+	PersonUserName string
+	NewPerson      *entity.Person
+
+	TeamRepository     repository.Team
+	PersonRepository   repository.Person
+	TryoutRepository   repository.Tryout
+	CountryRepository  repository.Country
+	TransactionManager repository.TransactionManager
+}
+
+type GetTeamTryoutCandidates struct {
+	TeamName string
+	TryoutID string
+
+	TeamRepository   repository.Team
+	TryoutRepository repository.Tryout
+}
+
+type EvaluateTeamTryoutCandidate struct {
+	TeamName          string
+	TryoutID          string
+	CandidateUserName string
+	EvaluatorUserName string
+	Scores            map[string]int
+
+	TeamRepository       repository.Team
+	TryoutRepository     repository.Tryout
+	MembershipRepository repository.Membership
+}
+
+type GetTeamTryoutRankings struct {
+	TeamName    string
+	TryoutID    string
+	RequestedBy string
+
+	TeamRepository       repository.Team
+	TryoutRepository     repository.Tryout
+	MembershipRepository repository.Membership
+}
+
+type DecideTeamTryoutCandidate struct {
+	TeamName            string
+	TryoutID            string
+	CandidateUserName   string
+	Decision            entity.TryoutCandidateStatus
+	Role                string
+	MembershipStartDate time.Time
+	DecidedBy           string
+
+	TeamRepository       repository.Team
+	TryoutRepository     repository.Tryout
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	GuardianRepository   repository.Guardian
+	TransactionManager   repository.TransactionManager
+}
@@ -0,0 +1,12 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type PurgeArchivedRecords struct {
+	PurgedTeams  []*entity.Team
+	KeptTeams    []*entity.Team
+	PurgedPeople []*entity.Person
+	KeptPeople   []*entity.Person
+}
@@ -0,0 +1,33 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetPersonCredentials struct {
+	Person                *entity.Person
+	WFDFAccreditations    []*entity.WFDFAccreditation
+	FederationMemberships []*entity.FederationMembership
+	CoachCertifications   []*entity.CoachCertification
+}
+
+type CreateWFDFAccreditation struct {
+	Person        *entity.Person
+	Accreditation *entity.WFDFAccreditation
+}
+
+type CreateFederationMembership struct {
+	Person     *entity.Person
+	Federation *entity.LegalEntity
+	Membership *entity.FederationMembership
+}
+
+type CreateCoachCertification struct {
+	Person        *entity.Person
+	Certification *entity.CoachCertification
+}
+
+type GetTeamExpiringWFDFAccreditations struct {
+	Team     *entity.Team
+	Statuses []*entity.WFDFAccreditationStatus
+}
@@ -0,0 +1,12 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type CheckRosterEligibility struct {
+	Team       *entity.Team
+	Eligible   bool
+	Violations []eligibility.Violation
+}
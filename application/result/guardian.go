@@ -0,0 +1,36 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetGuardians struct {
+	Dependent     *entity.Person
+	Guardianships []*entity.Guardianship
+}
+
+type AddGuardian struct {
+	Dependent    *entity.Person
+	Guardian     *entity.Person
+	Guardianship *entity.Guardianship
+}
+
+type RemoveGuardian struct {
+	Dependent    *entity.Person
+	Guardianship *entity.Guardianship
+}
+
+type GetConsents struct {
+	Person   *entity.Person
+	Consents []*entity.Consent
+}
+
+type GrantConsent struct {
+	Person  *entity.Person
+	Consent *entity.Consent
+}
+
+type GetDependentsSchedule struct {
+	Guardian *entity.Person
+	Events   []*entity.DependentTeamEvent
+}
@@ -0,0 +1,31 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetTeamLedger struct {
+	Team         *entity.Team
+	Transactions []*entity.LedgerTransaction
+}
+
+type GetTeamLedgerBalances struct {
+	Team                    *entity.Team
+	Balances                []*entity.LedgerBalance
+	TotalOutstandingInCents int64
+}
+
+type ChargeTeamDues struct {
+	Team        *entity.Team
+	Transaction *entity.LedgerTransaction
+}
+
+type ChargeTeamTournamentFee struct {
+	Team        *entity.Team
+	Transaction *entity.LedgerTransaction
+}
+
+type RecordTeamPayment struct {
+	Team        *entity.Team
+	Transaction *entity.LedgerTransaction
+}
@@ -0,0 +1,34 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetLegalEntityAffiliations struct {
+	LegalEntity  *entity.LegalEntity
+	Affiliations []*entity.LegalEntityAffiliation
+}
+
+type GetTeamLegalEntityAffiliations struct {
+	Team         *entity.Team
+	Affiliations []*entity.LegalEntityAffiliation
+}
+
+type GetPersonLegalEntityAffiliations struct {
+	Person       *entity.Person
+	Affiliations []*entity.LegalEntityAffiliation
+}
+
+type AffiliateToLegalEntity struct {
+	LegalEntity *entity.LegalEntity
+	Team        *entity.Team
+	Person      *entity.Person
+	Affiliation *entity.LegalEntityAffiliation
+}
+
+type EndLegalEntityAffiliation struct {
+	LegalEntity *entity.LegalEntity
+	Team        *entity.Team
+	Person      *entity.Person
+	Affiliation *entity.LegalEntityAffiliation
+}
@@ -0,0 +1,35 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetTeamMemberships struct {
+	Team        *entity.Team
+	Memberships []entity.Membership
+}
+
+type GetPersonMemberships struct {
+	Person      *entity.Person
+	Memberships []entity.Membership
+}
+
+type TransferMembership struct {
+	Person          *entity.Person
+	FromTeam        *entity.Team
+	ToTeam          *entity.Team
+	EndedMembership *entity.Membership
+	Membership      *entity.Membership
+}
+
+type AssignJerseyNumber struct {
+	Team        *entity.Team
+	Person      *entity.Person
+	Memberships []*entity.Membership
+}
+
+type GetTeamMemberByJerseyNumber struct {
+	Team       *entity.Team
+	Membership *entity.Membership
+	Person     *entity.Person
+}
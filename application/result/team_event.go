@@ -0,0 +1,37 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetTeamEvents struct {
+	Team   *entity.Team
+	Events []*entity.TeamEvent
+}
+
+type CreateTeamEvent struct {
+	Team  *entity.Team
+	Event *entity.TeamEvent
+}
+
+type RespondToTeamEvent struct {
+	Team          *entity.Team
+	Event         *entity.TeamEvent
+	Participation *entity.TeamEventParticipation
+}
+
+type RecordTeamEventAttendance struct {
+	Team           *entity.Team
+	Event          *entity.TeamEvent
+	Participations []*entity.TeamEventParticipation
+}
+
+type GetTeamAttendanceReport struct {
+	Team  *entity.Team
+	Rates []*entity.TeamAttendanceRate
+}
+
+type GetTeamAvailability struct {
+	Team           *entity.Team
+	Availabilities []*entity.TeamEventAvailability
+}
@@ -0,0 +1,48 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetTeamTryouts struct {
+	Team    *entity.Team
+	Tryouts []*entity.Tryout
+}
+
+type CreateTeamTryout struct {
+	Team   *entity.Team
+	Tryout *entity.Tryout
+}
+
+type SignUpForTeamTryout struct {
+	Team      *entity.Team
+	Tryout    *entity.Tryout
+	Person    *entity.Person
+	Candidate *entity.TryoutCandidate
+}
+
+type GetTeamTryoutCandidates struct {
+	Team       *entity.Team
+	Tryout     *entity.Tryout
+	Candidates []*entity.TryoutCandidate
+}
+
+type EvaluateTeamTryoutCandidate struct {
+	Team        *entity.Team
+	Tryout      *entity.Tryout
+	Candidate   *entity.TryoutCandidate
+	Evaluations []*entity.TryoutEvaluation
+}
+
+type GetTeamTryoutRankings struct {
+	Team     *entity.Team
+	Tryout   *entity.Tryout
+	Rankings []*entity.TryoutRanking
+}
+
+type DecideTeamTryoutCandidate struct {
+	Team       *entity.Team
+	Tryout     *entity.Tryout
+	Candidate  *entity.TryoutCandidate
+	Membership *entity.Membership
+}
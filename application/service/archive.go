@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// PurgeArchivedRecords permanently deletes the teams and the people archived before the given date. Teams are purged
+// first, since the records that reference both (eg. memberships) keep people from being purged.
+func PurgeArchivedRecords(
+	context context.Context,
+	param serviceParam.PurgeArchivedRecords,
+) (serviceResult.PurgeArchivedRecords, error) {
+	teamsResult, err := domainService.PurgeArchivedTeams(context, domainServiceParam.PurgeArchivedTeams{
+		ArchivedBefore: param.ArchivedBefore,
+
+		Repository: param.TeamRepository,
+	})
+	if err != nil {
+		return serviceResult.PurgeArchivedRecords{
+			PurgedTeams: teamsResult.PurgedTeams,
+		}, fmt.Errorf("failed to purge archived teams through domain service: %w", err)
+	}
+
+	peopleResult, err := domainService.PurgeArchivedPeople(context, domainServiceParam.PurgeArchivedPeople{
+		ArchivedBefore: param.ArchivedBefore,
+
+		Repository: param.PersonRepository,
+	})
+	if err != nil {
+		return serviceResult.PurgeArchivedRecords{
+			PurgedTeams:  teamsResult.PurgedTeams,
+			KeptTeams:    teamsResult.KeptTeams,
+			PurgedPeople: peopleResult.PurgedPeople,
+		}, fmt.Errorf("failed to purge archived people through domain service: %w", err)
+	}
+
+	return serviceResult.PurgeArchivedRecords{
+		PurgedTeams:  teamsResult.PurgedTeams,
+		KeptTeams:    teamsResult.KeptTeams,
+		PurgedPeople: peopleResult.PurgedPeople,
+		KeptPeople:   peopleResult.KeptPeople,
+	}, nil
+}
@@ -0,0 +1,194 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// Credentials are addressed by the username of the person and, for the expiring accreditations, by the team name
+// used in the API routes. When the person, the team or the federation does not exist, the corresponding entity of
+// the result is nil and no error is returned.
+
+func GetPersonCredentials(
+	context context.Context,
+	param serviceParam.GetPersonCredentials,
+) (serviceResult.GetPersonCredentials, error) {
+	emptyResult := serviceResult.GetPersonCredentials{
+		WFDFAccreditations:    []*entity.WFDFAccreditation{},
+		FederationMemberships: []*entity.FederationMembership{},
+		CoachCertifications:   []*entity.CoachCertification{},
+	}
+
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return emptyResult, err
+	}
+
+	result, err := domainService.GetPersonCredentials(context, domainServiceParam.GetPersonCredentials{
+		PersonUserName: person.UserName,
+
+		Repository: param.CredentialRepository,
+	})
+	if err != nil {
+		emptyResult.Person = person
+
+		return emptyResult, fmt.Errorf("failed to list credentials through domain service: %w", err)
+	}
+
+	return serviceResult.GetPersonCredentials{
+		Person:                person,
+		WFDFAccreditations:    result.WFDFAccreditations,
+		FederationMemberships: result.FederationMemberships,
+		CoachCertifications:   result.CoachCertifications,
+	}, nil
+}
+
+func CreateWFDFAccreditation(
+	context context.Context,
+	param serviceParam.CreateWFDFAccreditation,
+) (serviceResult.CreateWFDFAccreditation, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.CreateWFDFAccreditation{}, err
+	}
+
+	result, err := domainService.CreateWFDFAccreditation(context, domainServiceParam.CreateWFDFAccreditation{
+		Accreditation: &entity.WFDFAccreditation{
+			Person:     person,
+			Level:      param.Level,
+			IssueDate:  param.IssueDate,
+			ExpiryDate: param.ExpiryDate,
+			CreatedBy:  param.CreatedBy,
+			UpdatedBy:  param.CreatedBy,
+		},
+
+		Repository: param.CredentialRepository,
+	})
+	if err != nil {
+		return serviceResult.CreateWFDFAccreditation{
+			Person: person,
+		}, fmt.Errorf("failed to create WFDF accreditation through domain service: %w", err)
+	}
+
+	return serviceResult.CreateWFDFAccreditation{
+		Person:        person,
+		Accreditation: result.Accreditation,
+	}, nil
+}
+
+func CreateFederationMembership(
+	context context.Context,
+	param serviceParam.CreateFederationMembership,
+) (serviceResult.CreateFederationMembership, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.CreateFederationMembership{}, err
+	}
+
+	federation, err := findLegalEntityBySlug(context, param.FederationSlug, param.LegalEntityRepository)
+	if err != nil || federation == nil {
+		return serviceResult.CreateFederationMembership{
+			Person: person,
+		}, err
+	}
+
+	result, err := domainService.CreateFederationMembership(context, domainServiceParam.CreateFederationMembership{
+		Membership: &entity.FederationMembership{
+			Person:     person,
+			Federation: federation,
+			Season:     param.Season,
+			ValidFrom:  param.ValidFrom,
+			ValidUntil: param.ValidUntil,
+			CreatedBy:  param.CreatedBy,
+			UpdatedBy:  param.CreatedBy,
+		},
+
+		Repository: param.CredentialRepository,
+	})
+	if err != nil {
+		return serviceResult.CreateFederationMembership{
+			Person:     person,
+			Federation: federation,
+		}, fmt.Errorf("failed to create federation membership through domain service: %w", err)
+	}
+
+	return serviceResult.CreateFederationMembership{
+		Person:     person,
+		Federation: federation,
+		Membership: result.Membership,
+	}, nil
+}
+
+func CreateCoachCertification(
+	context context.Context,
+	param serviceParam.CreateCoachCertification,
+) (serviceResult.CreateCoachCertification, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.CreateCoachCertification{}, err
+	}
+
+	result, err := domainService.CreateCoachCertification(context, domainServiceParam.CreateCoachCertification{
+		Certification: &entity.CoachCertification{
+			Person:     person,
+			Name:       param.Name,
+			IssuedBy:   param.IssuedBy,
+			IssueDate:  param.IssueDate,
+			ExpiryDate: param.ExpiryDate,
+			CreatedBy:  param.CreatedBy,
+			UpdatedBy:  param.CreatedBy,
+		},
+
+		Repository: param.CredentialRepository,
+	})
+	if err != nil {
+		return serviceResult.CreateCoachCertification{
+			Person: person,
+		}, fmt.Errorf("failed to create coach certification through domain service: %w", err)
+	}
+
+	return serviceResult.CreateCoachCertification{
+		Person:        person,
+		Certification: result.Certification,
+	}, nil
+}
+
+func GetTeamExpiringWFDFAccreditations(
+	context context.Context,
+	param serviceParam.GetTeamExpiringWFDFAccreditations,
+) (serviceResult.GetTeamExpiringWFDFAccreditations, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamExpiringWFDFAccreditations{
+			Statuses: []*entity.WFDFAccreditationStatus{},
+		}, err
+	}
+
+	result, err := domainService.GetTeamExpiringWFDFAccreditations(context, domainServiceParam.GetTeamExpiringWFDFAccreditations{
+		TeamSlug: team.Slug,
+		Date:     param.Date,
+		Roster:   param.Roster,
+
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		CredentialRepository: param.CredentialRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamExpiringWFDFAccreditations{
+			Team:     team,
+			Statuses: []*entity.WFDFAccreditationStatus{},
+		}, fmt.Errorf("failed to list expiring WFDF accreditations through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamExpiringWFDFAccreditations{
+		Team:     team,
+		Statuses: result.Statuses,
+	}, nil
+}
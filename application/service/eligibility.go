@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// CheckRosterEligibility checks the roster of a team against the rules of an event, built from their names. When the
+// team does not exist, the Team of the result is nil and no error is returned.
+func CheckRosterEligibility(
+	context context.Context,
+	param serviceParam.CheckRosterEligibility,
+) (serviceResult.CheckRosterEligibility, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.CheckRosterEligibility{
+			Violations: []eligibility.Violation{},
+		}, err
+	}
+
+	rules, err := eligibility.BuildRules(param.RuleNames, param.Settings)
+	if err != nil {
+		return serviceResult.CheckRosterEligibility{
+			Team:       team,
+			Violations: []eligibility.Violation{},
+		}, fmt.Errorf("failed to build the eligibility rules: %w", err)
+	}
+
+	result, err := domainService.CheckRosterEligibility(context, domainServiceParam.CheckRosterEligibility{
+		TeamSlug:       team.Slug,
+		Roster:         param.Roster,
+		EventStartDate: param.EventStartDate,
+		EventEndDate:   param.EventEndDate,
+		Rules:          rules,
+
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		CredentialRepository: param.CredentialRepository,
+		GuardianRepository:   param.GuardianRepository,
+	})
+	if err != nil {
+		return serviceResult.CheckRosterEligibility{
+			Team:       team,
+			Violations: []eligibility.Violation{},
+		}, fmt.Errorf("failed to check roster eligibility through domain service: %w", err)
+	}
+
+	return serviceResult.CheckRosterEligibility{
+		Team:       team,
+		Eligible:   result.Eligible,
+		Violations: result.Violations,
+	}, nil
+}
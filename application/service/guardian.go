@@ -0,0 +1,192 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// Guardians and consents are addressed by the usernames of the dependent and of the guardian. When one of them does
+// not exist, the corresponding entity of the result is nil and no error is returned.
+
+func GetGuardians(context context.Context, param serviceParam.GetGuardians) (serviceResult.GetGuardians, error) {
+	dependent, err := findPersonByUserName(context, param.DependentUserName, param.PersonRepository)
+	if err != nil || dependent == nil {
+		return serviceResult.GetGuardians{
+			Guardianships: []*entity.Guardianship{},
+		}, err
+	}
+
+	result, err := domainService.GetGuardians(context, domainServiceParam.GetGuardians{
+		DependentUserName: dependent.UserName,
+
+		Repository: param.GuardianRepository,
+	})
+	if err != nil {
+		return serviceResult.GetGuardians{
+			Dependent:     dependent,
+			Guardianships: []*entity.Guardianship{},
+		}, fmt.Errorf("failed to list guardians through domain service: %w", err)
+	}
+
+	return serviceResult.GetGuardians{
+		Dependent:     dependent,
+		Guardianships: result.Guardianships,
+	}, nil
+}
+
+func AddGuardian(context context.Context, param serviceParam.AddGuardian) (serviceResult.AddGuardian, error) {
+	dependent, err := findPersonByUserName(context, param.DependentUserName, param.PersonRepository)
+	if err != nil || dependent == nil {
+		return serviceResult.AddGuardian{}, err
+	}
+
+	guardian, err := findPersonByUserName(context, param.GuardianUserName, param.PersonRepository)
+	if err != nil || guardian == nil {
+		return serviceResult.AddGuardian{
+			Dependent: dependent,
+		}, err
+	}
+
+	result, err := domainService.AddGuardian(context, domainServiceParam.AddGuardian{
+		Guardianship: &entity.Guardianship{
+			Guardian:     guardian,
+			Dependent:    dependent,
+			Relationship: param.Relationship,
+			CreatedBy:    param.CreatedBy,
+		},
+
+		Repository: param.GuardianRepository,
+	})
+	if err != nil {
+		return serviceResult.AddGuardian{
+			Dependent: dependent,
+			Guardian:  guardian,
+		}, fmt.Errorf("failed to add guardian through domain service: %w", err)
+	}
+
+	return serviceResult.AddGuardian{
+		Dependent:    dependent,
+		Guardian:     guardian,
+		Guardianship: result.Guardianship,
+	}, nil
+}
+
+func RemoveGuardian(context context.Context, param serviceParam.RemoveGuardian) (serviceResult.RemoveGuardian, error) {
+	dependent, err := findPersonByUserName(context, param.DependentUserName, param.PersonRepository)
+	if err != nil || dependent == nil {
+		return serviceResult.RemoveGuardian{}, err
+	}
+
+	result, err := domainService.RemoveGuardian(context, domainServiceParam.RemoveGuardian{
+		GuardianUserName:  param.GuardianUserName,
+		DependentUserName: dependent.UserName,
+
+		Repository: param.GuardianRepository,
+	})
+	if err != nil {
+		return serviceResult.RemoveGuardian{
+			Dependent: dependent,
+		}, fmt.Errorf("failed to remove guardian through domain service: %w", err)
+	}
+
+	return serviceResult.RemoveGuardian{
+		Dependent:    dependent,
+		Guardianship: result.Guardianship,
+	}, nil
+}
+
+func GetConsents(context context.Context, param serviceParam.GetConsents) (serviceResult.GetConsents, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.GetConsents{
+			Consents: []*entity.Consent{},
+		}, err
+	}
+
+	result, err := domainService.GetConsents(context, domainServiceParam.GetConsents{
+		PersonUserName: person.UserName,
+
+		Repository: param.GuardianRepository,
+	})
+	if err != nil {
+		return serviceResult.GetConsents{
+			Person:   person,
+			Consents: []*entity.Consent{},
+		}, fmt.Errorf("failed to list consents through domain service: %w", err)
+	}
+
+	return serviceResult.GetConsents{
+		Person:   person,
+		Consents: result.Consents,
+	}, nil
+}
+
+func GrantConsent(context context.Context, param serviceParam.GrantConsent) (serviceResult.GrantConsent, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.GrantConsent{}, err
+	}
+
+	result, err := domainService.GrantConsent(context, domainServiceParam.GrantConsent{
+		Consent: &entity.Consent{
+			Person:     person,
+			Kind:       param.Kind,
+			GrantedBy:  &entity.Person{UserName: param.GrantedByUserName},
+			GrantDate:  param.GrantDate,
+			ExpiryDate: param.ExpiryDate,
+			CreatedBy:  param.CreatedBy,
+		},
+
+		Repository: param.GuardianRepository,
+	})
+	if err != nil {
+		return serviceResult.GrantConsent{
+			Person: person,
+		}, fmt.Errorf("failed to grant consent through domain service: %w", err)
+	}
+
+	return serviceResult.GrantConsent{
+		Person:  person,
+		Consent: result.Consent,
+	}, nil
+}
+
+func GetDependentsSchedule(
+	context context.Context,
+	param serviceParam.GetDependentsSchedule,
+) (serviceResult.GetDependentsSchedule, error) {
+	guardian, err := findPersonByUserName(context, param.GuardianUserName, param.PersonRepository)
+	if err != nil || guardian == nil {
+		return serviceResult.GetDependentsSchedule{
+			Events: []*entity.DependentTeamEvent{},
+		}, err
+	}
+
+	result, err := domainService.GetDependentsSchedule(context, domainServiceParam.GetDependentsSchedule{
+		GuardianUserName: guardian.UserName,
+		From:             param.From,
+		To:               param.To,
+
+		GuardianRepository:   param.GuardianRepository,
+		MembershipRepository: param.MembershipRepository,
+		TeamEventRepository:  param.TeamEventRepository,
+	})
+	if err != nil {
+		return serviceResult.GetDependentsSchedule{
+			Guardian: guardian,
+			Events:   []*entity.DependentTeamEvent{},
+		}, fmt.Errorf("failed to list the schedule of the dependents through domain service: %w", err)
+	}
+
+	return serviceResult.GetDependentsSchedule{
+		Guardian: guardian,
+		Events:   result.Events,
+	}, nil
+}
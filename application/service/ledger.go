@@ -0,0 +1,161 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// Every ledger operation is addressed by the team name used in the API routes, while the ledger itself is keyed by
+// the team slug. When the team does not exist, the result holds a nil Team and no error.
+
+func GetTeamLedger(context context.Context, param serviceParam.GetTeamLedger) (serviceResult.GetTeamLedger, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamLedger{
+			Transactions: []*entity.LedgerTransaction{},
+		}, err
+	}
+
+	result, err := domainService.GetTeamLedgerTransactions(context, domainServiceParam.GetTeamLedgerTransactions{
+		TeamSlug: team.Slug,
+
+		Repository: param.LedgerRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamLedger{
+			Team:         team,
+			Transactions: []*entity.LedgerTransaction{},
+		}, fmt.Errorf("failed to list ledger transactions through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamLedger{
+		Team:         team,
+		Transactions: result.Transactions,
+	}, nil
+}
+
+func GetTeamLedgerBalances(
+	context context.Context,
+	param serviceParam.GetTeamLedgerBalances,
+) (serviceResult.GetTeamLedgerBalances, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamLedgerBalances{
+			Balances: []*entity.LedgerBalance{},
+		}, err
+	}
+
+	result, err := domainService.GetTeamLedgerBalances(context, domainServiceParam.GetTeamLedgerBalances{
+		TeamSlug: team.Slug,
+
+		Repository: param.LedgerRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamLedgerBalances{
+			Team:     team,
+			Balances: []*entity.LedgerBalance{},
+		}, fmt.Errorf("failed to list ledger balances through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamLedgerBalances{
+		Team:                    team,
+		Balances:                result.Balances,
+		TotalOutstandingInCents: result.TotalOutstandingInCents,
+	}, nil
+}
+
+func ChargeTeamDues(context context.Context, param serviceParam.ChargeTeamDues) (serviceResult.ChargeTeamDues, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.ChargeTeamDues{}, err
+	}
+
+	result, err := domainService.ChargeMembershipDues(context, domainServiceParam.ChargeMembershipDues{
+		TeamSlug:      team.Slug,
+		AmountInCents: param.AmountInCents,
+		Description:   param.Description,
+		EffectiveDate: param.EffectiveDate,
+		CreatedBy:     param.CreatedBy,
+
+		MembershipRepository: param.MembershipRepository,
+		LedgerRepository:     param.LedgerRepository,
+	})
+	if err != nil {
+		return serviceResult.ChargeTeamDues{
+			Team: team,
+		}, fmt.Errorf("failed to charge dues through domain service: %w", err)
+	}
+
+	return serviceResult.ChargeTeamDues{
+		Team:        team,
+		Transaction: result.Transaction,
+	}, nil
+}
+
+func ChargeTeamTournamentFee(
+	context context.Context,
+	param serviceParam.ChargeTeamTournamentFee,
+) (serviceResult.ChargeTeamTournamentFee, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.ChargeTeamTournamentFee{}, err
+	}
+
+	result, err := domainService.ChargeTournamentFee(context, domainServiceParam.ChargeTournamentFee{
+		TeamSlug:           team.Slug,
+		PersonUserNames:    param.PersonUserNames,
+		TotalAmountInCents: param.TotalAmountInCents,
+		Description:        param.Description,
+		EffectiveDate:      param.EffectiveDate,
+		CreatedBy:          param.CreatedBy,
+
+		MembershipRepository: param.MembershipRepository,
+		LedgerRepository:     param.LedgerRepository,
+	})
+	if err != nil {
+		return serviceResult.ChargeTeamTournamentFee{
+			Team: team,
+		}, fmt.Errorf("failed to charge tournament fee through domain service: %w", err)
+	}
+
+	return serviceResult.ChargeTeamTournamentFee{
+		Team:        team,
+		Transaction: result.Transaction,
+	}, nil
+}
+
+func RecordTeamPayment(context context.Context, param serviceParam.RecordTeamPayment) (serviceResult.RecordTeamPayment, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.RecordTeamPayment{}, err
+	}
+
+	result, err := domainService.RecordLedgerPayment(context, domainServiceParam.RecordLedgerPayment{
+		TeamSlug:       team.Slug,
+		PersonUserName: param.PersonUserName,
+		AmountInCents:  param.AmountInCents,
+		Description:    param.Description,
+		EffectiveDate:  param.EffectiveDate,
+		CreatedBy:      param.CreatedBy,
+
+		Repository:           param.LedgerRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.RecordTeamPayment{
+			Team: team,
+		}, fmt.Errorf("failed to record payment through domain service: %w", err)
+	}
+
+	return serviceResult.RecordTeamPayment{
+		Team:        team,
+		Transaction: result.Transaction,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// Legal entity affiliations are addressed by the legal entity slug, the team name and the person username used in
+// the API routes. When any of them does not exist, the corresponding entity of the result is nil and no error is
+// returned.
+
+func GetLegalEntityAffiliations(
+	context context.Context,
+	param serviceParam.GetLegalEntityAffiliations,
+) (serviceResult.GetLegalEntityAffiliations, error) {
+	legalEntity, err := findLegalEntityBySlug(context, param.LegalEntitySlug, param.LegalEntityRepository)
+	if err != nil || legalEntity == nil {
+		return serviceResult.GetLegalEntityAffiliations{
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, err
+	}
+
+	result, err := domainService.GetLegalEntityAffiliations(context, domainServiceParam.GetLegalEntityAffiliations{
+		LegalEntitySlug: legalEntity.Slug,
+
+		Repository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return serviceResult.GetLegalEntityAffiliations{
+			LegalEntity:  legalEntity,
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, fmt.Errorf("failed to list legal entity affiliations through domain service: %w", err)
+	}
+
+	return serviceResult.GetLegalEntityAffiliations{
+		LegalEntity:  legalEntity,
+		Affiliations: result.Affiliations,
+	}, nil
+}
+
+func GetTeamLegalEntityAffiliations(
+	context context.Context,
+	param serviceParam.GetTeamLegalEntityAffiliations,
+) (serviceResult.GetTeamLegalEntityAffiliations, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamLegalEntityAffiliations{
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, err
+	}
+
+	result, err := domainService.GetTeamLegalEntityAffiliations(context, domainServiceParam.GetTeamLegalEntityAffiliations{
+		TeamSlug: team.Slug,
+
+		Repository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamLegalEntityAffiliations{
+			Team:         team,
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, fmt.Errorf("failed to list team legal entity affiliations through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamLegalEntityAffiliations{
+		Team:         team,
+		Affiliations: result.Affiliations,
+	}, nil
+}
+
+func GetPersonLegalEntityAffiliations(
+	context context.Context,
+	param serviceParam.GetPersonLegalEntityAffiliations,
+) (serviceResult.GetPersonLegalEntityAffiliations, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.GetPersonLegalEntityAffiliations{
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, err
+	}
+
+	result, err := domainService.GetPersonLegalEntityAffiliations(context, domainServiceParam.GetPersonLegalEntityAffiliations{
+		PersonUserName: person.UserName,
+
+		Repository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return serviceResult.GetPersonLegalEntityAffiliations{
+			Person:       person,
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, fmt.Errorf("failed to list person legal entity affiliations through domain service: %w", err)
+	}
+
+	return serviceResult.GetPersonLegalEntityAffiliations{
+		Person:       person,
+		Affiliations: result.Affiliations,
+	}, nil
+}
+
+func AffiliateToLegalEntity(
+	context context.Context,
+	param serviceParam.AffiliateToLegalEntity,
+) (serviceResult.AffiliateToLegalEntity, error) {
+	legalEntity, err := findLegalEntityBySlug(context, param.LegalEntitySlug, param.LegalEntityRepository)
+	if err != nil || legalEntity == nil {
+		return serviceResult.AffiliateToLegalEntity{}, err
+	}
+
+	team, person, err := findAffiliate(context, param.TeamName, param.PersonUserName, param.TeamRepository, param.PersonRepository)
+	if err != nil || (team == nil && person == nil) {
+		return serviceResult.AffiliateToLegalEntity{
+			LegalEntity: legalEntity,
+		}, err
+	}
+
+	result, err := domainService.AffiliateToLegalEntity(context, domainServiceParam.AffiliateToLegalEntity{
+		Affiliation: &entity.LegalEntityAffiliation{
+			LegalEntity: legalEntity,
+			Team:        team,
+			Person:      person,
+			StartDate:   param.StartDate,
+			EndDate:     param.EndDate,
+			CreatedBy:   param.CreatedBy,
+			UpdatedBy:   param.CreatedBy,
+		},
+
+		Repository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return serviceResult.AffiliateToLegalEntity{
+			LegalEntity: legalEntity,
+			Team:        team,
+			Person:      person,
+		}, fmt.Errorf("failed to affiliate to legal entity through domain service: %w", err)
+	}
+
+	return serviceResult.AffiliateToLegalEntity{
+		LegalEntity: legalEntity,
+		Team:        team,
+		Person:      person,
+		Affiliation: result.Affiliation,
+	}, nil
+}
+
+func EndLegalEntityAffiliation(
+	context context.Context,
+	param serviceParam.EndLegalEntityAffiliation,
+) (serviceResult.EndLegalEntityAffiliation, error) {
+	legalEntity, err := findLegalEntityBySlug(context, param.LegalEntitySlug, param.LegalEntityRepository)
+	if err != nil || legalEntity == nil {
+		return serviceResult.EndLegalEntityAffiliation{}, err
+	}
+
+	team, person, err := findAffiliate(context, param.TeamName, param.PersonUserName, param.TeamRepository, param.PersonRepository)
+	if err != nil || (team == nil && person == nil) {
+		return serviceResult.EndLegalEntityAffiliation{
+			LegalEntity: legalEntity,
+		}, err
+	}
+
+	result, err := domainService.EndLegalEntityAffiliation(context, domainServiceParam.EndLegalEntityAffiliation{
+		LegalEntity: legalEntity,
+		Team:        team,
+		Person:      person,
+		EndDate:     param.EndDate,
+		UpdatedBy:   param.UpdatedBy,
+
+		Repository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return serviceResult.EndLegalEntityAffiliation{
+			LegalEntity: legalEntity,
+			Team:        team,
+			Person:      person,
+		}, fmt.Errorf("failed to end legal entity affiliation through domain service: %w", err)
+	}
+
+	return serviceResult.EndLegalEntityAffiliation{
+		LegalEntity: legalEntity,
+		Team:        team,
+		Person:      person,
+		Affiliation: result.Affiliation,
+	}, nil
+}
+
+func findLegalEntityBySlug(
+	context context.Context,
+	slug string,
+	legalEntityRepository repository.LegalEntity,
+) (*entity.LegalEntity, error) {
+	result, err := domainService.GetLegalEntityBySlug(context, domainServiceParam.GetLegalEntityBySlug{
+		Slug: slug,
+
+		Repository: legalEntityRepository,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve legal entity '%s' through domain service: %w", slug, err)
+	}
+
+	return result.LegalEntity, nil
+}
+
+func findPersonByUserName(context context.Context, userName string, personRepository repository.Person) (*entity.Person, error) {
+	result, err := domainService.GetPersonByUserName(context, domainServiceParam.GetPersonByUserName{
+		UserName: userName,
+
+		Repository: personRepository,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve person '%s' through domain service: %w", userName, err)
+	}
+
+	return result.Person, nil
+}
+
+// findAffiliate retrieves the team with the given name or, when the name is empty, the person with the given username.
+func findAffiliate(
+	context context.Context,
+	teamName string,
+	personUserName string,
+	teamRepository repository.Team,
+	personRepository repository.Person,
+) (*entity.Team, *entity.Person, error) {
+	if teamName != "" {
+		team, err := findTeamByName(context, teamName, teamRepository)
+
+		return team, nil, err
+	}
+
+	person, err := findPersonByUserName(context, personUserName, personRepository)
+
+	return nil, person, err
+}
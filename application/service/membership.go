@@ -0,0 +1,207 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// Memberships are addressed by the username of the person and by the team names used in the API routes. When the
+// person or a team does not exist, the corresponding entity of the result is nil and no error is returned.
+
+func GetTeamMemberships(
+	context context.Context,
+	param serviceParam.GetTeamMemberships,
+) (serviceResult.GetTeamMemberships, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamMemberships{}, err
+	}
+
+	result, err := domainService.GetTeamMemberships(context, domainServiceParam.GetTeamMemberships{
+		TeamSlug: team.Slug,
+		AsOf:     param.AsOf,
+
+		Repository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamMemberships{
+			Team: team,
+		}, fmt.Errorf("failed to retrieve memberships through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamMemberships{
+		Team:        team,
+		Memberships: result.Memberships,
+	}, nil
+}
+
+func GetPersonMemberships(
+	context context.Context,
+	param serviceParam.GetPersonMemberships,
+) (serviceResult.GetPersonMemberships, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.GetPersonMemberships{}, err
+	}
+
+	result, err := domainService.GetPersonMemberships(context, domainServiceParam.GetPersonMemberships{
+		PersonUserName: person.UserName,
+		AsOf:           param.AsOf,
+
+		Repository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.GetPersonMemberships{
+			Person: person,
+		}, fmt.Errorf("failed to retrieve memberships through domain service: %w", err)
+	}
+
+	return serviceResult.GetPersonMemberships{
+		Person:      person,
+		Memberships: result.Memberships,
+	}, nil
+}
+
+func TransferMembership(
+	context context.Context,
+	param serviceParam.TransferMembership,
+) (serviceResult.TransferMembership, error) {
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.TransferMembership{}, err
+	}
+
+	fromTeam, err := findTeamByName(context, param.FromTeamName, param.TeamRepository)
+	if err != nil || fromTeam == nil {
+		return serviceResult.TransferMembership{
+			Person: person,
+		}, err
+	}
+
+	toTeam, err := findTeamByName(context, param.ToTeamName, param.TeamRepository)
+	if err != nil || toTeam == nil {
+		return serviceResult.TransferMembership{
+			Person:   person,
+			FromTeam: fromTeam,
+		}, err
+	}
+
+	result, err := domainService.TransferMembership(context, domainServiceParam.TransferMembership{
+		Person:          person,
+		FromTeam:        fromTeam,
+		ToTeam:          toTeam,
+		Role:            param.Role,
+		TransferDate:    param.TransferDate,
+		UpdatedBy:       param.UpdatedBy,
+		TransferWindows: param.TransferWindows,
+
+		MembershipRepository: param.MembershipRepository,
+		GuardianRepository:   param.GuardianRepository,
+		TransactionManager:   param.TransactionManager,
+	})
+	if err != nil {
+		return serviceResult.TransferMembership{
+			Person:   person,
+			FromTeam: fromTeam,
+			ToTeam:   toTeam,
+		}, fmt.Errorf("failed to transfer membership through domain service: %w", err)
+	}
+
+	return serviceResult.TransferMembership{
+		Person:          person,
+		FromTeam:        fromTeam,
+		ToTeam:          toTeam,
+		EndedMembership: result.EndedMembership,
+		Membership:      result.Membership,
+	}, nil
+}
+
+// AssignJerseyNumber sets the jersey number of the person in every membership of the team that has not ended by the
+// date, or clears it when the number is empty.
+func AssignJerseyNumber(
+	context context.Context,
+	param serviceParam.AssignJerseyNumber,
+) (serviceResult.AssignJerseyNumber, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.AssignJerseyNumber{}, err
+	}
+
+	person, err := findPersonByUserName(context, param.PersonUserName, param.PersonRepository)
+	if err != nil || person == nil {
+		return serviceResult.AssignJerseyNumber{
+			Team: team,
+		}, err
+	}
+
+	result, err := domainService.AssignJerseyNumber(context, domainServiceParam.AssignJerseyNumber{
+		Team:         team,
+		Person:       person,
+		JerseyNumber: param.JerseyNumber,
+		Date:         param.Date,
+		UpdatedBy:    param.UpdatedBy,
+
+		MembershipRepository: param.MembershipRepository,
+		TransactionManager:   param.TransactionManager,
+	})
+	if err != nil {
+		return serviceResult.AssignJerseyNumber{
+			Team:   team,
+			Person: person,
+		}, fmt.Errorf("failed to assign jersey number through domain service: %w", err)
+	}
+
+	return serviceResult.AssignJerseyNumber{
+		Team:        team,
+		Person:      person,
+		Memberships: result.Memberships,
+	}, nil
+}
+
+// GetTeamMemberByJerseyNumber resolves the jersey number that a person wore on the team on a date to the person.
+func GetTeamMemberByJerseyNumber(
+	context context.Context,
+	param serviceParam.GetTeamMemberByJerseyNumber,
+) (serviceResult.GetTeamMemberByJerseyNumber, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamMemberByJerseyNumber{}, err
+	}
+
+	result, err := domainService.FindMemberByJerseyNumber(context, domainServiceParam.FindMemberByJerseyNumber{
+		TeamSlug:     team.Slug,
+		JerseyNumber: param.JerseyNumber,
+		Date:         param.Date,
+
+		Repository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamMemberByJerseyNumber{
+			Team: team,
+		}, fmt.Errorf("failed to find member by jersey number through domain service: %w", err)
+	}
+	if result.Membership == nil {
+		return serviceResult.GetTeamMemberByJerseyNumber{
+			Team: team,
+		}, nil
+	}
+
+	person, err := findPersonByUserName(context, result.Membership.Person.UserName, param.PersonRepository)
+	if err != nil {
+		return serviceResult.GetTeamMemberByJerseyNumber{
+			Team: team,
+		}, err
+	}
+
+	return serviceResult.GetTeamMemberByJerseyNumber{
+		Team:       team,
+		Membership: result.Membership,
+		Person:     person,
+	}, nil
+}
@@ -8,14 +8,14 @@ import (
 	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
 	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
 )
 
 func GetTeamGameCaptains(context context.Context, param serviceParam.GetTeamGameCaptains) (serviceResult.GetTeamGameCaptains, error) {
 	var gameCaptains []*entity.Person
-	// TODO(lhaddad): register roles programmatically instead of relying on magic strings
-	role := "Game Captain"
+	role := entity.MembershipRoles.GameCaptain
 
 	// Retrieve all team memberships with the specified role
 	result, err := domainService.GetTeamMembershipsByRole(context, domainServiceParam.GetTeamMembershipsByRole{
@@ -56,3 +56,16 @@ func GetTeamGameCaptains(context context.Context, param serviceParam.GetTeamGame
 		GameCaptains: gameCaptains,
 	}, nil
 }
+
+func findTeamByName(context context.Context, teamName string, teamRepository repository.Team) (*entity.Team, error) {
+	result, err := domainService.GetTeamByName(context, domainServiceParam.GetTeamByName{
+		Name: teamName,
+
+		Repository: teamRepository,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve team '%s' through domain service: %w", teamName, err)
+	}
+
+	return result.Team, nil
+}
@@ -0,0 +1,189 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// Team events are addressed by the team name used in the API routes. When the team does not exist, the result
+// holds a nil Team and no error.
+
+func GetTeamEvents(context context.Context, param serviceParam.GetTeamEvents) (serviceResult.GetTeamEvents, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamEvents{
+			Events: []*entity.TeamEvent{},
+		}, err
+	}
+
+	result, err := domainService.GetTeamEvents(context, domainServiceParam.GetTeamEvents{
+		TeamSlug: team.Slug,
+		From:     param.From,
+		To:       param.To,
+
+		Repository: param.TeamEventRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamEvents{
+			Team:   team,
+			Events: []*entity.TeamEvent{},
+		}, fmt.Errorf("failed to list team events through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamEvents{
+		Team:   team,
+		Events: result.Events,
+	}, nil
+}
+
+func CreateTeamEvent(context context.Context, param serviceParam.CreateTeamEvent) (serviceResult.CreateTeamEvent, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.CreateTeamEvent{}, err
+	}
+
+	event := param.Event.Clone()
+	event.Team = team
+	result, err := domainService.CreateTeamEvent(context, domainServiceParam.CreateTeamEvent{
+		Event: event,
+
+		Repository: param.TeamEventRepository,
+	})
+	if err != nil {
+		return serviceResult.CreateTeamEvent{
+			Team: team,
+		}, fmt.Errorf("failed to create team event through domain service: %w", err)
+	}
+
+	return serviceResult.CreateTeamEvent{
+		Team:  team,
+		Event: result.Event,
+	}, nil
+}
+
+func RespondToTeamEvent(context context.Context, param serviceParam.RespondToTeamEvent) (serviceResult.RespondToTeamEvent, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.RespondToTeamEvent{}, err
+	}
+
+	result, err := domainService.RespondToTeamEvent(context, domainServiceParam.RespondToTeamEvent{
+		TeamSlug:       team.Slug,
+		EventID:        param.EventID,
+		PersonUserName: param.PersonUserName,
+		RSVP:           param.RSVP,
+
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.RespondToTeamEvent{
+			Team: team,
+		}, fmt.Errorf("failed to respond to team event through domain service: %w", err)
+	}
+
+	return serviceResult.RespondToTeamEvent{
+		Team:          team,
+		Event:         result.Event,
+		Participation: result.Participation,
+	}, nil
+}
+
+func RecordTeamEventAttendance(
+	context context.Context,
+	param serviceParam.RecordTeamEventAttendance,
+) (serviceResult.RecordTeamEventAttendance, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.RecordTeamEventAttendance{}, err
+	}
+
+	result, err := domainService.RecordTeamEventAttendance(context, domainServiceParam.RecordTeamEventAttendance{
+		TeamSlug:   team.Slug,
+		EventID:    param.EventID,
+		Attendance: param.Attendance,
+		RecordedBy: param.RecordedBy,
+
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.RecordTeamEventAttendance{
+			Team: team,
+		}, fmt.Errorf("failed to record team event attendance through domain service: %w", err)
+	}
+
+	return serviceResult.RecordTeamEventAttendance{
+		Team:           team,
+		Event:          result.Event,
+		Participations: result.Participations,
+	}, nil
+}
+
+func GetTeamAttendanceReport(
+	context context.Context,
+	param serviceParam.GetTeamAttendanceReport,
+) (serviceResult.GetTeamAttendanceReport, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamAttendanceReport{
+			Rates: []*entity.TeamAttendanceRate{},
+		}, err
+	}
+
+	result, err := domainService.GetTeamAttendanceReport(context, domainServiceParam.GetTeamAttendanceReport{
+		TeamSlug: team.Slug,
+		From:     param.From,
+		To:       param.To,
+
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamAttendanceReport{
+			Team:  team,
+			Rates: []*entity.TeamAttendanceRate{},
+		}, fmt.Errorf("failed to compute attendance report through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamAttendanceReport{
+		Team:  team,
+		Rates: result.Rates,
+	}, nil
+}
+
+func GetTeamAvailability(context context.Context, param serviceParam.GetTeamAvailability) (serviceResult.GetTeamAvailability, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamAvailability{
+			Availabilities: []*entity.TeamEventAvailability{},
+		}, err
+	}
+
+	result, err := domainService.GetTeamAvailability(context, domainServiceParam.GetTeamAvailability{
+		TeamSlug: team.Slug,
+		From:     param.From,
+		To:       param.To,
+
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamAvailability{
+			Team:           team,
+			Availabilities: []*entity.TeamEventAvailability{},
+		}, fmt.Errorf("failed to summarize availability through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamAvailability{
+		Team:           team,
+		Availabilities: result.Availabilities,
+	}, nil
+}
@@ -0,0 +1,249 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	serviceParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	serviceResult "github.com/leeohaddad/ultimate-frisbee-api/application/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// Tryouts are addressed by the team name used in the API routes. When the team does not exist, the result holds a
+// nil Team and no error.
+
+func GetTeamTryouts(context context.Context, param serviceParam.GetTeamTryouts) (serviceResult.GetTeamTryouts, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamTryouts{
+			Tryouts: []*entity.Tryout{},
+		}, err
+	}
+
+	result, err := domainService.GetTryouts(context, domainServiceParam.GetTryouts{
+		TeamSlug: team.Slug,
+
+		Repository: param.TryoutRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamTryouts{
+			Team:    team,
+			Tryouts: []*entity.Tryout{},
+		}, fmt.Errorf("failed to list tryouts through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamTryouts{
+		Team:    team,
+		Tryouts: result.Tryouts,
+	}, nil
+}
+
+func CreateTeamTryout(context context.Context, param serviceParam.CreateTeamTryout) (serviceResult.CreateTeamTryout, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.CreateTeamTryout{}, err
+	}
+
+	result, err := domainService.CreateTryout(context, domainServiceParam.CreateTryout{
+		Tryout: param.Tryout.WithTeam(team),
+
+		Repository: param.TryoutRepository,
+	})
+	if err != nil {
+		return serviceResult.CreateTeamTryout{
+			Team: team,
+		}, fmt.Errorf("failed to create tryout through domain service: %w", err)
+	}
+
+	return serviceResult.CreateTeamTryout{
+		Team:   team,
+		Tryout: result.Tryout,
+	}, nil
+}
+
+func SignUpForTeamTryout(
+	context context.Context,
+	param serviceParam.SignUpForTeamTryout,
+) (serviceResult.SignUpForTeamTryout, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.SignUpForTeamTryout{}, err
+	}
+
+	newPerson := param.NewPerson
+	if newPerson != nil {
+		now := time.Now()
+		newPerson = newPerson.Clone()
+		newPerson.CreatedAt = now
+		newPerson.UpdatedAt = now
+		newPerson.UpdatedBy = newPerson.CreatedBy
+	}
+
+	result, err := domainService.SignUpForTryout(context, domainServiceParam.SignUpForTryout{
+		TeamSlug:       team.Slug,
+		TryoutID:       param.TryoutID,
+		PersonUserName: param.PersonUserName,
+		NewPerson:      newPerson,
+
+		PersonRepository:   param.PersonRepository,
+		TryoutRepository:   param.TryoutRepository,
+		CountryRepository:  param.CountryRepository,
+		TransactionManager: param.TransactionManager,
+	})
+	if err != nil {
+		return serviceResult.SignUpForTeamTryout{
+			Team:   team,
+			Tryout: result.Tryout,
+		}, fmt.Errorf("failed to sign up for tryout through domain service: %w", err)
+	}
+
+	return serviceResult.SignUpForTeamTryout{
+		Team:      team,
+		Tryout:    result.Tryout,
+		Person:    result.Person,
+		Candidate: result.Candidate,
+	}, nil
+}
+
+func GetTeamTryoutCandidates(
+	context context.Context,
+	param serviceParam.GetTeamTryoutCandidates,
+) (serviceResult.GetTeamTryoutCandidates, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamTryoutCandidates{
+			Candidates: []*entity.TryoutCandidate{},
+		}, err
+	}
+
+	result, err := domainService.GetTryoutCandidates(context, domainServiceParam.GetTryoutCandidates{
+		TeamSlug: team.Slug,
+		TryoutID: param.TryoutID,
+
+		Repository: param.TryoutRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamTryoutCandidates{
+			Team:       team,
+			Tryout:     result.Tryout,
+			Candidates: []*entity.TryoutCandidate{},
+		}, fmt.Errorf("failed to list tryout candidates through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamTryoutCandidates{
+		Team:       team,
+		Tryout:     result.Tryout,
+		Candidates: result.Candidates,
+	}, nil
+}
+
+func EvaluateTeamTryoutCandidate(
+	context context.Context,
+	param serviceParam.EvaluateTeamTryoutCandidate,
+) (serviceResult.EvaluateTeamTryoutCandidate, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.EvaluateTeamTryoutCandidate{}, err
+	}
+
+	result, err := domainService.EvaluateTryoutCandidate(context, domainServiceParam.EvaluateTryoutCandidate{
+		TeamSlug:          team.Slug,
+		TryoutID:          param.TryoutID,
+		CandidateUserName: param.CandidateUserName,
+		EvaluatorUserName: param.EvaluatorUserName,
+		Scores:            param.Scores,
+
+		TryoutRepository:     param.TryoutRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.EvaluateTeamTryoutCandidate{
+			Team:   team,
+			Tryout: result.Tryout,
+		}, fmt.Errorf("failed to evaluate tryout candidate through domain service: %w", err)
+	}
+
+	return serviceResult.EvaluateTeamTryoutCandidate{
+		Team:        team,
+		Tryout:      result.Tryout,
+		Candidate:   result.Candidate,
+		Evaluations: result.Evaluations,
+	}, nil
+}
+
+func GetTeamTryoutRankings(
+	context context.Context,
+	param serviceParam.GetTeamTryoutRankings,
+) (serviceResult.GetTeamTryoutRankings, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.GetTeamTryoutRankings{
+			Rankings: []*entity.TryoutRanking{},
+		}, err
+	}
+
+	result, err := domainService.GetTryoutRankings(context, domainServiceParam.GetTryoutRankings{
+		TeamSlug:    team.Slug,
+		TryoutID:    param.TryoutID,
+		RequestedBy: param.RequestedBy,
+
+		TryoutRepository:     param.TryoutRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return serviceResult.GetTeamTryoutRankings{
+			Team:     team,
+			Tryout:   result.Tryout,
+			Rankings: []*entity.TryoutRanking{},
+		}, fmt.Errorf("failed to rank tryout candidates through domain service: %w", err)
+	}
+
+	return serviceResult.GetTeamTryoutRankings{
+		Team:     team,
+		Tryout:   result.Tryout,
+		Rankings: result.Rankings,
+	}, nil
+}
+
+func DecideTeamTryoutCandidate(
+	context context.Context,
+	param serviceParam.DecideTeamTryoutCandidate,
+) (serviceResult.DecideTeamTryoutCandidate, error) {
+	team, err := findTeamByName(context, param.TeamName, param.TeamRepository)
+	if err != nil || team == nil {
+		return serviceResult.DecideTeamTryoutCandidate{}, err
+	}
+
+	result, err := domainService.DecideTryoutCandidate(context, domainServiceParam.DecideTryoutCandidate{
+		TeamSlug:            team.Slug,
+		TryoutID:            param.TryoutID,
+		CandidateUserName:   param.CandidateUserName,
+		Decision:            param.Decision,
+		Role:                param.Role,
+		MembershipStartDate: param.MembershipStartDate,
+		DecidedBy:           param.DecidedBy,
+
+		TryoutRepository:     param.TryoutRepository,
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		GuardianRepository:   param.GuardianRepository,
+		TransactionManager:   param.TransactionManager,
+	})
+	if err != nil {
+		return serviceResult.DecideTeamTryoutCandidate{
+			Team:   team,
+			Tryout: result.Tryout,
+		}, fmt.Errorf("failed to decide about tryout candidate through domain service: %w", err)
+	}
+
+	return serviceResult.DecideTeamTryoutCandidate{
+		Team:       team,
+		Tryout:     result.Tryout,
+		Candidate:  result.Candidate,
+		Membership: result.Membership,
+	}, nil
+}
@@ -0,0 +1,278 @@
+package deduplication
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// DefaultMinimumScore is the score from which a pair of people is reported as a possible duplicate when no other
+// minimum is given. A matching normalized name is enough to reach it, while a similar name needs to be close.
+const DefaultMinimumScore = 0.5
+
+// minimumNameSimilarity is the similarity from which two names that are not equal are still considered a match.
+const minimumNameSimilarity = 0.8
+
+// minimumPhoneNumberDigits is the number of digits that a phone number needs to be compared without its prefix.
+const minimumPhoneNumberDigits = 8
+
+// Pair is a possible duplicate. The Person is the one registered first, which is the suggested survivor of a merge.
+type Pair struct {
+	Person    *entity.Person
+	Duplicate *entity.Person
+	Score     float64
+	Matches   []Match
+}
+
+// Match is one of the reasons for two people to be considered the same, along with how much it weighs in the score.
+type Match struct {
+	Signal Signal
+	Weight float64
+}
+
+/*****************/
+/*    SIGNALS    */
+/*****************/
+
+type Signal string
+
+type signalList struct {
+	WFDFNumber  Signal
+	Email       Signal
+	PhoneNumber Signal
+	Name        Signal
+	SimilarName Signal
+}
+
+// Signals represents the attributes that are compared to detect duplicates.
+var Signals = &signalList{
+	WFDFNumber:  "WFDFNumber",
+	Email:       "Email",
+	PhoneNumber: "PhoneNumber",
+	Name:        "Name",
+	SimilarName: "SimilarName",
+}
+
+var signalWeights = map[Signal]float64{
+	Signals.WFDFNumber:  0.95,
+	Signals.Email:       0.9,
+	Signals.PhoneNumber: 0.7,
+	Signals.Name:        0.6,
+	Signals.SimilarName: 0.6,
+}
+
+/***************/
+/*    RULES    */
+/***************/
+
+// FindDuplicates compares every pair of people and returns the ones that score at least the minimum, the most likely
+// duplicates first.
+func FindDuplicates(people []*entity.Person, minimumScore float64) []Pair {
+	pairs := make([]Pair, 0)
+
+	for index, person := range people {
+		for _, other := range people[index+1:] {
+			pair := Compare(person, other)
+			if len(pair.Matches) > 0 && pair.Score >= minimumScore {
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		if pairs[i].Score != pairs[j].Score {
+			return pairs[i].Score > pairs[j].Score
+		}
+		if pairs[i].Person.UserName != pairs[j].Person.UserName {
+			return pairs[i].Person.UserName < pairs[j].Person.UserName
+		}
+
+		return pairs[i].Duplicate.UserName < pairs[j].Duplicate.UserName
+	})
+
+	return pairs
+}
+
+// Compare scores how likely two people are the same. Each matching signal is an independent evidence, so the score is
+// the probability of at least one of them being right: 1 - (1 - w1) * (1 - w2) * ...
+func Compare(person *entity.Person, other *entity.Person) Pair {
+	if other.CreatedAt.Before(person.CreatedAt) {
+		person, other = other, person
+	}
+
+	matches := make([]Match, 0)
+	if wfdfNumber := NormalizeWFDFNumber(person.WFDFNumber); wfdfNumber != "" && wfdfNumber == NormalizeWFDFNumber(other.WFDFNumber) {
+		matches = append(matches, Match{Signal: Signals.WFDFNumber, Weight: signalWeights[Signals.WFDFNumber]})
+	}
+	if email := NormalizeEmail(person.Email); email != "" && email == NormalizeEmail(other.Email) {
+		matches = append(matches, Match{Signal: Signals.Email, Weight: signalWeights[Signals.Email]})
+	}
+	if samePhoneNumber(person.PhoneNumber, other.PhoneNumber) {
+		matches = append(matches, Match{Signal: Signals.PhoneNumber, Weight: signalWeights[Signals.PhoneNumber]})
+	}
+	if similarity := NameSimilarity(person.Name, other.Name); similarity == 1 {
+		matches = append(matches, Match{Signal: Signals.Name, Weight: signalWeights[Signals.Name]})
+	} else if similarity >= minimumNameSimilarity {
+		matches = append(matches, Match{Signal: Signals.SimilarName, Weight: signalWeights[Signals.SimilarName] * similarity})
+	}
+
+	unlikelihood := 1.0
+	for _, match := range matches {
+		unlikelihood *= 1 - match.Weight
+	}
+
+	return Pair{
+		Person:    person,
+		Duplicate: other,
+		Score:     1 - unlikelihood,
+		Matches:   matches,
+	}
+}
+
+// Merge returns a copy of the survivor whose missing contact details and birth date are taken from the duplicate.
+func Merge(survivor *entity.Person, duplicate *entity.Person) *entity.Person {
+	merged := survivor.Clone()
+
+	if merged.PhoneNumber == "" {
+		merged.PhoneNumber = duplicate.PhoneNumber
+	}
+	if merged.WFDFNumber == "" {
+		merged.WFDFNumber = duplicate.WFDFNumber
+	}
+	if merged.OriginCountry == "" {
+		merged.OriginCountry = duplicate.OriginCountry
+	}
+	if merged.BirthDate.IsZero() {
+		merged.BirthDate = duplicate.BirthDate
+	}
+
+	return merged
+}
+
+/*******************/
+/*  NORMALIZATION  */
+/*******************/
+
+// NormalizeName removes accents, punctuation and casing from a name and sorts its words, so that "Silva, João" and
+// "joao silva" are the same name.
+func NormalizeName(name string) string {
+	builder := strings.Builder{}
+	for _, character := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, character):
+			// Accents are decomposed into combining marks, which are dropped
+		case unicode.IsLetter(character) || unicode.IsDigit(character):
+			builder.WriteRune(unicode.ToLower(character))
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	words := strings.Fields(builder.String())
+	sort.Strings(words)
+
+	return strings.Join(words, " ")
+}
+
+// NameSimilarity compares the normalized names by their edit distance, going from 0 for nothing in common to 1 for the
+// same name.
+func NameSimilarity(name string, other string) float64 {
+	normalizedName := []rune(NormalizeName(name))
+	normalizedOther := []rune(NormalizeName(other))
+
+	longestLength := len(normalizedName)
+	if len(normalizedOther) > longestLength {
+		longestLength = len(normalizedOther)
+	}
+	if longestLength == 0 {
+		return 0
+	}
+
+	return 1 - float64(editDistance(normalizedName, normalizedOther))/float64(longestLength)
+}
+
+// NormalizeEmail lowercases the email and removes the sub-address that follows a '+' in its local part.
+func NormalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return email
+	}
+
+	localPart, domain := email[:at], email[at:]
+	if plus := strings.Index(localPart, "+"); plus >= 0 {
+		localPart = localPart[:plus]
+	}
+
+	return localPart + domain
+}
+
+// NormalizePhoneNumber keeps only the digits of the phone number.
+func NormalizePhoneNumber(phoneNumber string) string {
+	return strings.Map(func(character rune) rune {
+		if unicode.IsDigit(character) {
+			return character
+		}
+
+		return -1
+	}, phoneNumber)
+}
+
+// NormalizeWFDFNumber keeps only the letters and digits of the WFDF number, in uppercase.
+func NormalizeWFDFNumber(wfdfNumber string) string {
+	return strings.Map(func(character rune) rune {
+		if unicode.IsLetter(character) || unicode.IsDigit(character) {
+			return unicode.ToUpper(character)
+		}
+
+		return -1
+	}, wfdfNumber)
+}
+
+// samePhoneNumber compares the digits of the phone numbers, allowing one of them to have a country or area prefix that
+// the other is missing.
+func samePhoneNumber(phoneNumber string, other string) bool {
+	normalizedPhoneNumber := NormalizePhoneNumber(phoneNumber)
+	normalizedOther := NormalizePhoneNumber(other)
+	if normalizedPhoneNumber == "" || normalizedOther == "" {
+		return false
+	}
+	if normalizedPhoneNumber == normalizedOther {
+		return true
+	}
+
+	if len(normalizedOther) < len(normalizedPhoneNumber) {
+		normalizedPhoneNumber, normalizedOther = normalizedOther, normalizedPhoneNumber
+	}
+
+	return len(normalizedPhoneNumber) >= minimumPhoneNumberDigits && strings.HasSuffix(normalizedOther, normalizedPhoneNumber)
+}
+
+// editDistance is the Levenshtein distance between the words, the number of single character insertions, deletions or
+// substitutions that turn one into the other.
+func editDistance(word []rune, other []rune) int {
+	previousRow := make([]int, len(other)+1)
+	for index := range previousRow {
+		previousRow[index] = index
+	}
+
+	for i := 1; i <= len(word); i++ {
+		currentRow := make([]int, len(other)+1)
+		currentRow[0] = i
+		for j := 1; j <= len(other); j++ {
+			substitutionCost := 1
+			if word[i-1] == other[j-1] {
+				substitutionCost = 0
+			}
+			currentRow[j] = min(previousRow[j]+1, currentRow[j-1]+1, previousRow[j-1]+substitutionCost)
+		}
+		previousRow = currentRow
+	}
+
+	return previousRow[len(other)]
+}
@@ -0,0 +1,133 @@
+//go:build unit
+// +build unit
+
+package deduplication_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/deduplication"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestDeduplication_Compare(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{
+		UserName:    "joao.silva",
+		Name:        "João da Silva",
+		Email:       "joao@example.com",
+		PhoneNumber: "+55 (11) 91234-5678",
+		WFDFNumber:  "BR-1234",
+		CreatedAt:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	stranger := &entity.Person{
+		UserName:  "maria.souza",
+		Name:      "Maria Souza",
+		Email:     "maria@example.com",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	scenarios := []struct {
+		description     string
+		other           *entity.Person
+		expectedSignals []deduplication.Signal
+	}{
+		{
+			description:     "should match nothing when the people have nothing in common",
+			other:           stranger,
+			expectedSignals: []deduplication.Signal{},
+		},
+		{
+			description:     "should match the WFDF number regardless of its punctuation and casing",
+			other:           stranger.WithWFDFNumber("br1234"),
+			expectedSignals: []deduplication.Signal{deduplication.Signals.WFDFNumber},
+		},
+		{
+			description:     "should match the email regardless of its casing and sub-address",
+			other:           stranger.WithEmail("Joao+frisbee@Example.com"),
+			expectedSignals: []deduplication.Signal{deduplication.Signals.Email},
+		},
+		{
+			description:     "should match the phone number when it misses the country and area prefix",
+			other:           stranger.WithPhoneNumber("912345678"),
+			expectedSignals: []deduplication.Signal{deduplication.Signals.PhoneNumber},
+		},
+		{
+			description:     "should match the name regardless of its accents, casing and word order",
+			other:           stranger.WithName("silva, joao da"),
+			expectedSignals: []deduplication.Signal{deduplication.Signals.Name},
+		},
+		{
+			description:     "should match a similar name when it has a typo",
+			other:           stranger.WithName("Joao da Sliva"),
+			expectedSignals: []deduplication.Signal{deduplication.Signals.SimilarName},
+		},
+		{
+			description: "should match every signal in common",
+			other:       stranger.WithName("Joao da Silva").WithEmail("JOAO@example.com").WithPhoneNumber("11912345678"),
+			expectedSignals: []deduplication.Signal{
+				deduplication.Signals.Email,
+				deduplication.Signals.PhoneNumber,
+				deduplication.Signals.Name,
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			pair := deduplication.Compare(scenario.other, person)
+
+			require.Equal(t, person.UserName, pair.Person.UserName, "the person registered first should be the survivor")
+			obtainedSignals := []deduplication.Signal{}
+			for _, match := range pair.Matches {
+				obtainedSignals = append(obtainedSignals, match.Signal)
+			}
+			require.Equal(t, scenario.expectedSignals, obtainedSignals)
+			require.GreaterOrEqual(t, pair.Score, 0.0)
+			require.Less(t, pair.Score, 1.0)
+		})
+	}
+}
+
+func TestDeduplication_FindDuplicates(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{UserName: "joao.silva", Name: "João Silva", Email: "joao@example.com", WFDFNumber: "1234"}
+	sameWFDFNumber := &entity.Person{UserName: "jsilva", Name: "J. Silva", Email: "js@example.com", WFDFNumber: "1234"}
+	sameName := &entity.Person{UserName: "joao", Name: "Joao Silva", Email: "other@example.com"}
+	stranger := &entity.Person{UserName: "maria.souza", Name: "Maria Souza", Email: "maria@example.com"}
+
+	pairs := deduplication.FindDuplicates(
+		[]*entity.Person{person, sameWFDFNumber, sameName, stranger},
+		deduplication.DefaultMinimumScore,
+	)
+
+	require.Len(t, pairs, 2)
+	require.Equal(t, "jsilva", pairs[0].Duplicate.UserName)
+	require.Equal(t, "joao", pairs[1].Duplicate.UserName)
+	require.Greater(t, pairs[0].Score, pairs[1].Score)
+}
+
+func TestDeduplication_Merge(t *testing.T) {
+	t.Parallel()
+
+	survivor := &entity.Person{UserName: "joao.silva", Name: "João Silva", Email: "joao@example.com", PhoneNumber: "11912345678"}
+	duplicate := &entity.Person{UserName: "jsilva", Name: "J. Silva", Email: "js@example.com", PhoneNumber: "1100000000", WFDFNumber: "1234", OriginCountry: "BR", BirthDate: time.Date(2008, 3, 15, 0, 0, 0, 0, time.UTC)}
+
+	merged := deduplication.Merge(survivor, duplicate)
+
+	require.Equal(t, "joao.silva", merged.UserName)
+	require.Equal(t, "joao@example.com", merged.Email)
+	require.Equal(t, "11912345678", merged.PhoneNumber)
+	require.Equal(t, "1234", merged.WFDFNumber)
+	require.Equal(t, "BR", merged.OriginCountry)
+	require.Equal(t, duplicate.BirthDate, merged.BirthDate)
+	require.Empty(t, survivor.WFDFNumber, "the survivor should not be changed")
+}
@@ -0,0 +1,95 @@
+package eligibility
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// Candidate gathers the data the rules need to decide whether a person can join a team roster.
+type Candidate struct {
+	Person      *entity.Person
+	Team        *entity.Team
+	Memberships []entity.Membership
+
+	WFDFAccreditations []*entity.WFDFAccreditation
+	Consents           []*entity.Consent
+
+	EventStartDate time.Time
+	EventEndDate   time.Time
+}
+
+// Settings holds what the rules of an event can be configured with, such as the minimum age of its players.
+type Settings struct {
+	MinimumAge int
+}
+
+// Violation describes, in a human-readable way, why a candidate failed an eligibility rule.
+type Violation struct {
+	PersonUserName string
+	Rule           RuleName
+	Reason         string
+}
+
+// Rule validates a single eligibility requirement for a roster addition.
+type Rule interface {
+	Name() RuleName
+	Check(candidate Candidate) []Violation
+}
+
+// RuleFactory builds a new instance of a registered rule, configured with the settings of the event.
+type RuleFactory func(settings Settings) Rule
+
+var registeredRules = map[RuleName]RuleFactory{}
+
+// Register makes a rule available to be configured by name. Registering the same name twice overrides the factory.
+func Register(name RuleName, factory RuleFactory) {
+	registeredRules[name] = factory
+}
+
+// IsRegistered checks if a rule can be configured by the given name.
+func IsRegistered(name RuleName) bool {
+	_, ok := registeredRules[name]
+
+	return ok
+}
+
+// BuildRules instantiates the rules configured for an event, in the order they were first given. A rule given more
+// than once is built only once, so that it does not report the same violations again.
+func BuildRules(names []RuleName, settings Settings) ([]Rule, error) {
+	rules := make([]Rule, 0, len(names))
+	builtNames := map[RuleName]bool{}
+
+	for _, name := range names {
+		if builtNames[name] {
+			continue
+		}
+		builtNames[name] = true
+
+		factory, ok := registeredRules[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown eligibility rule '%s'", name)
+		}
+		rules = append(rules, factory(settings))
+	}
+
+	return rules, nil
+}
+
+// Evaluate runs every rule against the candidate and returns all the violations found, each one tagged with the
+// username of the candidate.
+func Evaluate(candidate Candidate, rules []Rule) []Violation {
+	violations := []Violation{}
+
+	for _, rule := range rules {
+		for _, violation := range rule.Check(candidate) {
+			if candidate.Person != nil {
+				violation.PersonUserName = candidate.Person.UserName
+			}
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,300 @@
+//go:build unit
+// +build unit
+
+package eligibility_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestEligibility_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	team := &entity.Team{Slug: "bra-sp-my-team-slug"}
+	person := &entity.Person{UserName: "some.player", WFDFNumber: "1234"}
+	eventStartDate := time.Date(2025, 7, 12, 0, 0, 0, 0, time.UTC)
+	eventEndDate := time.Date(2025, 7, 13, 0, 0, 0, 0, time.UTC)
+
+	rules, err := eligibility.BuildRules([]eligibility.RuleName{
+		eligibility.RuleNames.ActiveMembership,
+		eligibility.RuleNames.WFDFNumber,
+	}, eligibility.Settings{})
+	require.NoError(t, err)
+
+	scenarios := []struct {
+		description        string
+		candidate          eligibility.Candidate
+		expectedViolations []eligibility.RuleName
+	}{
+		{
+			description: "should return no violations when the membership covers the event and the WFDF number is set",
+			candidate: eligibility.Candidate{
+				Person: person,
+				Team:   team,
+				Memberships: []entity.Membership{
+					{Team: team, Person: person, StartDate: eventStartDate.AddDate(-1, 0, 0)},
+				},
+				EventStartDate: eventStartDate,
+				EventEndDate:   eventEndDate,
+			},
+			expectedViolations: []eligibility.RuleName{},
+		},
+		{
+			description: "should report the membership when it ends before the event does",
+			candidate: eligibility.Candidate{
+				Person: person,
+				Team:   team,
+				Memberships: []entity.Membership{
+					{Team: team, Person: person, StartDate: eventStartDate.AddDate(-1, 0, 0), EndDate: eventStartDate},
+				},
+				EventStartDate: eventStartDate,
+				EventEndDate:   eventEndDate,
+			},
+			expectedViolations: []eligibility.RuleName{eligibility.RuleNames.ActiveMembership},
+		},
+		{
+			description: "should report the membership when it belongs to another team",
+			candidate: eligibility.Candidate{
+				Person: person,
+				Team:   team,
+				Memberships: []entity.Membership{
+					{Team: &entity.Team{Slug: "another-team"}, Person: person, StartDate: eventStartDate.AddDate(-1, 0, 0)},
+				},
+				EventStartDate: eventStartDate,
+				EventEndDate:   eventEndDate,
+			},
+			expectedViolations: []eligibility.RuleName{eligibility.RuleNames.ActiveMembership},
+		},
+		{
+			description: "should return all violations when several rules fail",
+			candidate: eligibility.Candidate{
+				Person:         person.WithWFDFNumber(""),
+				Team:           team,
+				Memberships:    []entity.Membership{},
+				EventStartDate: eventStartDate,
+				EventEndDate:   eventEndDate,
+			},
+			expectedViolations: []eligibility.RuleName{
+				eligibility.RuleNames.ActiveMembership,
+				eligibility.RuleNames.WFDFNumber,
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			violations := eligibility.Evaluate(scenario.candidate, rules)
+
+			obtainedViolations := []eligibility.RuleName{}
+			for _, violation := range violations {
+				require.NotEmpty(t, violation.Reason)
+				obtainedViolations = append(obtainedViolations, violation.Rule)
+			}
+			require.Equal(t, scenario.expectedViolations, obtainedViolations)
+		})
+	}
+}
+
+func TestEligibility_WFDFAccreditationRule(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{UserName: "some.player"}
+	eventStartDate := time.Date(2025, 7, 12, 0, 0, 0, 0, time.UTC)
+	eventEndDate := time.Date(2025, 7, 13, 0, 0, 0, 0, time.UTC)
+	accreditation := &entity.WFDFAccreditation{
+		Person:     person,
+		Level:      entity.WFDFAccreditationLevels.Standard,
+		IssueDate:  eventStartDate.AddDate(-1, 0, 0),
+		ExpiryDate: eventEndDate,
+	}
+
+	rules, err := eligibility.BuildRules([]eligibility.RuleName{eligibility.RuleNames.WFDFAccreditation}, eligibility.Settings{})
+	require.NoError(t, err)
+
+	scenarios := []struct {
+		description        string
+		accreditations     []*entity.WFDFAccreditation
+		expectedViolations int
+	}{
+		{
+			description:        "should return no violations when the accreditation expires on the last day of the event",
+			accreditations:     []*entity.WFDFAccreditation{accreditation},
+			expectedViolations: 0,
+		},
+		{
+			description:        "should report the accreditation when it expires during the event",
+			accreditations:     []*entity.WFDFAccreditation{accreditation.WithExpiryDate(eventStartDate)},
+			expectedViolations: 1,
+		},
+		{
+			description:        "should report the accreditation when the person has none",
+			accreditations:     []*entity.WFDFAccreditation{},
+			expectedViolations: 1,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			violations := eligibility.Evaluate(eligibility.Candidate{
+				Person:             person,
+				WFDFAccreditations: scenario.accreditations,
+				EventStartDate:     eventStartDate,
+				EventEndDate:       eventEndDate,
+			}, rules)
+			require.Len(t, violations, scenario.expectedViolations)
+		})
+	}
+}
+
+func TestEligibility_GuardianConsentRule(t *testing.T) {
+	t.Parallel()
+
+	eventStartDate := time.Date(2025, 7, 12, 0, 0, 0, 0, time.UTC)
+	eventEndDate := time.Date(2025, 7, 13, 0, 0, 0, 0, time.UTC)
+	minor := &entity.Person{UserName: "some.player", BirthDate: time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC)}
+	medicalConsent := &entity.Consent{
+		Person:     minor,
+		Kind:       entity.ConsentKinds.Medical,
+		GrantedBy:  &entity.Person{UserName: "some.guardian"},
+		GrantDate:  eventStartDate.AddDate(0, -1, 0),
+		ExpiryDate: eventEndDate,
+	}
+	travelConsent := medicalConsent.WithKind(entity.ConsentKinds.Travel)
+
+	rules, err := eligibility.BuildRules([]eligibility.RuleName{eligibility.RuleNames.GuardianConsent}, eligibility.Settings{})
+	require.NoError(t, err)
+
+	scenarios := []struct {
+		description        string
+		person             *entity.Person
+		consents           []*entity.Consent
+		expectedViolations int
+	}{
+		{
+			description:        "should return no violations when the minor has every roster consent during the event",
+			person:             minor,
+			consents:           []*entity.Consent{medicalConsent, travelConsent},
+			expectedViolations: 0,
+		},
+		{
+			description:        "should report the consents when the minor has only some of them",
+			person:             minor,
+			consents:           []*entity.Consent{medicalConsent.WithKind(entity.ConsentKinds.Media), travelConsent},
+			expectedViolations: 1,
+		},
+		{
+			description:        "should report the consents when they expire during the event",
+			person:             minor,
+			consents:           []*entity.Consent{medicalConsent.WithExpiryDate(eventStartDate), travelConsent},
+			expectedViolations: 1,
+		},
+		{
+			description:        "should return no violations when the person is an adult",
+			person:             minor.WithBirthDate(time.Date(2007, 7, 12, 0, 0, 0, 0, time.UTC)),
+			consents:           []*entity.Consent{},
+			expectedViolations: 0,
+		},
+		{
+			description:        "should report the birth date when it is unknown",
+			person:             minor.WithBirthDate(time.Time{}),
+			consents:           []*entity.Consent{medicalConsent, travelConsent},
+			expectedViolations: 1,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			violations := eligibility.Evaluate(eligibility.Candidate{
+				Person:         scenario.person,
+				Consents:       scenario.consents,
+				EventStartDate: eventStartDate,
+				EventEndDate:   eventEndDate,
+			}, rules)
+			require.Len(t, violations, scenario.expectedViolations)
+		})
+	}
+}
+
+func TestEligibility_BuildRules(t *testing.T) {
+	t.Parallel()
+
+	_, err := eligibility.BuildRules([]eligibility.RuleName{"SomeUnknownRule"}, eligibility.Settings{})
+	require.Error(t, err)
+
+	rules, err := eligibility.BuildRules([]eligibility.RuleName{
+		eligibility.RuleNames.WFDFNumber,
+		eligibility.RuleNames.ActiveMembership,
+		eligibility.RuleNames.WFDFNumber,
+	}, eligibility.Settings{})
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, eligibility.RuleNames.WFDFNumber, rules[0].Name())
+	require.Equal(t, eligibility.RuleNames.ActiveMembership, rules[1].Name())
+}
+
+func TestEligibility_MinimumAgeRule(t *testing.T) {
+	t.Parallel()
+
+	eventStartDate := time.Date(2025, 7, 12, 0, 0, 0, 0, time.UTC)
+	eventEndDate := time.Date(2025, 7, 13, 0, 0, 0, 0, time.UTC)
+	person := &entity.Person{UserName: "some.player"}
+
+	rules, err := eligibility.BuildRules([]eligibility.RuleName{eligibility.RuleNames.MinimumAge}, eligibility.Settings{
+		MinimumAge: 17,
+	})
+	require.NoError(t, err)
+
+	scenarios := []struct {
+		description        string
+		birthDate          time.Time
+		expectedViolations int
+	}{
+		{
+			description:        "should return no violations when the person turns the minimum age on the first day of the event",
+			birthDate:          time.Date(2008, 7, 12, 0, 0, 0, 0, time.UTC),
+			expectedViolations: 0,
+		},
+		{
+			description:        "should report the age when the person turns the minimum age during the event",
+			birthDate:          time.Date(2008, 7, 13, 0, 0, 0, 0, time.UTC),
+			expectedViolations: 1,
+		},
+		{
+			description:        "should report the birth date when it is unknown",
+			birthDate:          time.Time{},
+			expectedViolations: 1,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			violations := eligibility.Evaluate(eligibility.Candidate{
+				Person:         person.WithBirthDate(scenario.birthDate),
+				EventStartDate: eventStartDate,
+				EventEndDate:   eventEndDate,
+			}, rules)
+			require.Len(t, violations, scenario.expectedViolations)
+			for _, violation := range violations {
+				require.Equal(t, "some.player", violation.PersonUserName)
+			}
+		})
+	}
+}
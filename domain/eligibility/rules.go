@@ -0,0 +1,230 @@
+package eligibility
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// dateLayout is the layout used to show event dates in violation reasons.
+const dateLayout = "2006-01-02"
+
+/****************/
+/*  RULE NAMES  */
+/****************/
+
+type RuleName string
+
+type ruleNameList struct {
+	ActiveMembership  RuleName
+	WFDFNumber        RuleName
+	WFDFAccreditation RuleName
+	GuardianConsent   RuleName
+	MinimumAge        RuleName
+}
+
+// RuleNames represents the names of the built-in eligibility rules.
+var RuleNames = &ruleNameList{
+	ActiveMembership:  "ActiveMembership",
+	WFDFNumber:        "WFDFNumber",
+	WFDFAccreditation: "WFDFAccreditation",
+	GuardianConsent:   "GuardianConsent",
+	MinimumAge:        "MinimumAge",
+}
+
+func init() {
+	Register(RuleNames.ActiveMembership, func(Settings) Rule { return ActiveMembershipRule{} })
+	Register(RuleNames.WFDFNumber, func(Settings) Rule { return WFDFNumberRule{} })
+	Register(RuleNames.WFDFAccreditation, func(Settings) Rule { return WFDFAccreditationRule{} })
+	Register(RuleNames.GuardianConsent, func(Settings) Rule { return GuardianConsentRule{} })
+	Register(RuleNames.MinimumAge, func(settings Settings) Rule { return MinimumAgeRule{Age: settings.MinimumAge} })
+}
+
+/*****************/
+/*     RULES     */
+/*****************/
+
+// ActiveMembershipRule requires the person to be a member of the team during the whole event.
+type ActiveMembershipRule struct{}
+
+func (rule ActiveMembershipRule) Name() RuleName {
+	return RuleNames.ActiveMembership
+}
+
+func (rule ActiveMembershipRule) Check(candidate Candidate) []Violation {
+	for _, membership := range candidate.Memberships {
+		if isMembershipActiveDuringEvent(membership, candidate) {
+			return nil
+		}
+	}
+
+	return []Violation{{
+		Rule: rule.Name(),
+		Reason: fmt.Sprintf(
+			"%s has no active membership in team '%s' from %s to %s",
+			personName(candidate.Person),
+			teamSlug(candidate.Team),
+			candidate.EventStartDate.Format(dateLayout),
+			candidate.EventEndDate.Format(dateLayout),
+		),
+	}}
+}
+
+// WFDFNumberRule requires the person to have a WFDF number registered.
+type WFDFNumberRule struct{}
+
+func (rule WFDFNumberRule) Name() RuleName {
+	return RuleNames.WFDFNumber
+}
+
+func (rule WFDFNumberRule) Check(candidate Candidate) []Violation {
+	if candidate.Person != nil && candidate.Person.WFDFNumber != "" {
+		return nil
+	}
+
+	return []Violation{{
+		Rule:   rule.Name(),
+		Reason: fmt.Sprintf("%s has no WFDF number registered", personName(candidate.Person)),
+	}}
+}
+
+// WFDFAccreditationRule requires the person to hold a WFDF accreditation that is valid during the whole event.
+type WFDFAccreditationRule struct{}
+
+func (rule WFDFAccreditationRule) Name() RuleName {
+	return RuleNames.WFDFAccreditation
+}
+
+func (rule WFDFAccreditationRule) Check(candidate Candidate) []Violation {
+	for _, accreditation := range candidate.WFDFAccreditations {
+		if accreditation.IsValidAt(candidate.EventStartDate) && accreditation.IsValidAt(candidate.EventEndDate) {
+			return nil
+		}
+	}
+
+	return []Violation{{
+		Rule: rule.Name(),
+		Reason: fmt.Sprintf(
+			"%s has no WFDF accreditation valid from %s to %s",
+			personName(candidate.Person),
+			candidate.EventStartDate.Format(dateLayout),
+			candidate.EventEndDate.Format(dateLayout),
+		),
+	}}
+}
+
+// GuardianConsentRule requires a person that is a minor when the event starts to hold the entity.RosterConsentKinds
+// from a guardian, valid during the whole event. Since a person with no birth date could be a minor, the rule is
+// only met when the birth date is known.
+type GuardianConsentRule struct{}
+
+func (rule GuardianConsentRule) Name() RuleName {
+	return RuleNames.GuardianConsent
+}
+
+func (rule GuardianConsentRule) Check(candidate Candidate) []Violation {
+	if candidate.Person == nil || candidate.Person.BirthDate.IsZero() {
+		return []Violation{{
+			Rule:   rule.Name(),
+			Reason: fmt.Sprintf("%s has no birth date registered to tell whether a guardian's consent is needed", personName(candidate.Person)),
+		}}
+	}
+
+	if !candidate.Person.IsMinorAt(candidate.EventStartDate) {
+		return nil
+	}
+
+	missingKinds := entity.MissingConsentKinds(
+		candidate.Consents,
+		entity.RosterConsentKinds,
+		candidate.EventStartDate,
+		candidate.EventEndDate,
+	)
+	if len(missingKinds) == 0 {
+		return nil
+	}
+
+	missingKindNames := make([]string, 0, len(missingKinds))
+	for _, kind := range missingKinds {
+		missingKindNames = append(missingKindNames, string(kind))
+	}
+
+	return []Violation{{
+		Rule: rule.Name(),
+		Reason: fmt.Sprintf(
+			"%s is a minor and has no %s consent from a guardian valid from %s to %s",
+			personName(candidate.Person),
+			strings.Join(missingKindNames, " or "),
+			candidate.EventStartDate.Format(dateLayout),
+			candidate.EventEndDate.Format(dateLayout),
+		),
+	}}
+}
+
+// MinimumAgeRule requires the person to be at least Age years old when the event starts. Since a person with no birth
+// date could be younger, the rule is only met when the birth date is known.
+type MinimumAgeRule struct {
+	Age int
+}
+
+func (rule MinimumAgeRule) Name() RuleName {
+	return RuleNames.MinimumAge
+}
+
+func (rule MinimumAgeRule) Check(candidate Candidate) []Violation {
+	if candidate.Person == nil || candidate.Person.BirthDate.IsZero() {
+		return []Violation{{
+			Rule:   rule.Name(),
+			Reason: fmt.Sprintf("%s has no birth date registered to tell whether they are at least %d", personName(candidate.Person), rule.Age),
+		}}
+	}
+
+	if candidate.Person.AgeAt(candidate.EventStartDate) >= rule.Age {
+		return nil
+	}
+
+	return []Violation{{
+		Rule: rule.Name(),
+		Reason: fmt.Sprintf(
+			"%s is younger than %d on %s",
+			personName(candidate.Person),
+			rule.Age,
+			candidate.EventStartDate.Format(dateLayout),
+		),
+	}}
+}
+
+/*****************/
+/*    HELPERS    */
+/*****************/
+
+// isMembershipActiveDuringEvent checks if the membership covers all the event dates. A zero EndDate means the
+// membership is still ongoing.
+func isMembershipActiveDuringEvent(membership entity.Membership, candidate Candidate) bool {
+	if membership.Team == nil || membership.Team.Slug != teamSlug(candidate.Team) {
+		return false
+	}
+
+	if membership.StartDate.After(candidate.EventStartDate) {
+		return false
+	}
+
+	return membership.EndDate.IsZero() || !membership.EndDate.Before(candidate.EventEndDate)
+}
+
+func personName(person *entity.Person) string {
+	if person == nil {
+		return "the person"
+	}
+
+	return fmt.Sprintf("'%s'", person.UserName)
+}
+
+func teamSlug(team *entity.Team) string {
+	if team == nil {
+		return ""
+	}
+
+	return team.Slug
+}
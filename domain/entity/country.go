@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Country represents a country from the ISO 3166-1 standard, with its names translated by the Debian iso-codes
+// project. Teams, people and legal entities refer to their countries by the Alpha2Code.
+type Country struct {
+	Alpha2Code     string
+	Alpha3Code     string
+	Name           string
+	LocalizedNames map[CountryLocale]string
+}
+
+/****************/
+/*   LOCALES    */
+/****************/
+
+type CountryLocale string
+
+type countryLocaleList struct {
+	English    CountryLocale
+	German     CountryLocale
+	Spanish    CountryLocale
+	French     CountryLocale
+	Portuguese CountryLocale
+}
+
+// CountryLocales represents the languages in which the names of the countries are available.
+var CountryLocales = &countryLocaleList{
+	English:    "en",
+	German:     "de",
+	Spanish:    "es",
+	French:     "fr",
+	Portuguese: "pt",
+}
+
+// IsValid checks if the locale is one of the CountryLocales.
+func (locale CountryLocale) IsValid() bool {
+	switch locale {
+	case CountryLocales.English, CountryLocales.German, CountryLocales.Spanish, CountryLocales.French, CountryLocales.Portuguese:
+		return true
+	}
+
+	return false
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// LocalizedName returns the name of the country in the given locale, falling back to the English name.
+func (country *Country) LocalizedName(locale CountryLocale) string {
+	if localizedName, ok := country.LocalizedNames[locale]; ok {
+		return localizedName
+	}
+
+	return country.Name
+}
+
+// SortCountriesByName sorts the countries by their names in the given locale.
+func SortCountriesByName(countries []*Country, locale CountryLocale) {
+	sort.SliceStable(countries, func(i, j int) bool {
+		return strings.ToLower(countries[i].LocalizedName(locale)) < strings.ToLower(countries[j].LocalizedName(locale))
+	})
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (country *Country) String() string {
+	return country.StringWithIndentation(0)
+}
+
+func (country *Country) StringWithIndentation(indentationLevel int) string {
+	if country == nil {
+		return "[Country]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[Country]\n")
+	builder.WriteString(fmt.Sprintf("%sAlpha2Code: %s\n", indentation, country.Alpha2Code))
+	builder.WriteString(fmt.Sprintf("%sAlpha3Code: %s\n", indentation, country.Alpha3Code))
+	builder.WriteString(fmt.Sprintf("%sName: %s\n", indentation, country.Name))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (country *Country) Clone() *Country {
+	if country == nil {
+		return nil
+	}
+	localizedNames := make(map[CountryLocale]string, len(country.LocalizedNames))
+	for locale, localizedName := range country.LocalizedNames {
+		localizedNames[locale] = localizedName
+	}
+
+	return &Country{
+		Alpha2Code:     country.Alpha2Code,
+		Alpha3Code:     country.Alpha3Code,
+		Name:           country.Name,
+		LocalizedNames: localizedNames,
+	}
+}
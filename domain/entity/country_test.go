@@ -0,0 +1,55 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func getGermany() *entity.Country {
+	return &entity.Country{
+		Alpha2Code: "DE",
+		Alpha3Code: "DEU",
+		Name:       "Germany",
+		LocalizedNames: map[entity.CountryLocale]string{
+			entity.CountryLocales.German:     "Deutschland",
+			entity.CountryLocales.Portuguese: "Alemanha",
+		},
+	}
+}
+
+func TestCountry_LocalizedName(t *testing.T) {
+	t.Parallel()
+
+	country := getGermany()
+
+	require.Equal(t, "Deutschland", country.LocalizedName(entity.CountryLocales.German))
+	require.Equal(t, "Alemanha", country.LocalizedName(entity.CountryLocales.Portuguese))
+	require.Equal(t, "Germany", country.LocalizedName(entity.CountryLocales.English))
+	require.Equal(t, "Germany", country.LocalizedName(entity.CountryLocales.French))
+}
+
+func TestSortCountriesByName(t *testing.T) {
+	t.Parallel()
+
+	austria := &entity.Country{
+		Alpha2Code: "AT",
+		Alpha3Code: "AUT",
+		Name:       "Austria",
+		LocalizedNames: map[entity.CountryLocale]string{
+			entity.CountryLocales.German: "Österreich",
+		},
+	}
+	countries := []*entity.Country{austria, getGermany()}
+
+	entity.SortCountriesByName(countries, entity.CountryLocales.English)
+	require.Equal(t, []string{"AT", "DE"}, []string{countries[0].Alpha2Code, countries[1].Alpha2Code})
+
+	entity.SortCountriesByName(countries, entity.CountryLocales.German)
+	require.Equal(t, []string{"DE", "AT"}, []string{countries[0].Alpha2Code, countries[1].Alpha2Code})
+}
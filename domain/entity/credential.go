@@ -0,0 +1,290 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WFDFAccreditation represents the WFDF rules accreditation of a person, which many events require from their
+// players. Accreditations are valid from the IssueDate until the ExpiryDate.
+type WFDFAccreditation struct {
+	Person *Person
+	Level  WFDFAccreditationLevel
+
+	IssueDate  time.Time
+	ExpiryDate time.Time
+
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// FederationMembership represents the membership of a person in a national federation, which is registered as a
+// legal entity, for a season.
+type FederationMembership struct {
+	Person     *Person
+	Federation *LegalEntity
+	Season     string
+
+	ValidFrom  time.Time
+	ValidUntil time.Time
+
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// CoachCertification represents a coaching certification issued to a person. A zero ExpiryDate means the
+// certification does not expire.
+type CoachCertification struct {
+	Person   *Person
+	Name     string
+	IssuedBy string
+
+	IssueDate  time.Time
+	ExpiryDate time.Time
+
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// WFDFAccreditationStatus represents the most lasting WFDF accreditation of a person, which is nil when the person
+// has never been accredited.
+type WFDFAccreditationStatus struct {
+	Person        *Person
+	Accreditation *WFDFAccreditation
+}
+
+/****************/
+/*    LEVELS    */
+/****************/
+
+type WFDFAccreditationLevel string
+
+type wfdfAccreditationLevelList struct {
+	Standard WFDFAccreditationLevel
+	Advanced WFDFAccreditationLevel
+}
+
+// WFDFAccreditationLevels represents the levels of the WFDF rules accreditation.
+var WFDFAccreditationLevels = &wfdfAccreditationLevelList{
+	Standard: "Standard",
+	Advanced: "Advanced",
+}
+
+// IsValid checks if the level is one of the WFDFAccreditationLevels.
+func (level WFDFAccreditationLevel) IsValid() bool {
+	switch level {
+	case WFDFAccreditationLevels.Standard, WFDFAccreditationLevels.Advanced:
+		return true
+	}
+
+	return false
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsValidAt checks if the accreditation was in effect on the given date.
+func (accreditation *WFDFAccreditation) IsValidAt(date time.Time) bool {
+	return !accreditation.IssueDate.After(date) && !accreditation.ExpiryDate.Before(date)
+}
+
+// IsValidAt checks if the federation membership was in effect on the given date.
+func (membership *FederationMembership) IsValidAt(date time.Time) bool {
+	return !membership.ValidFrom.After(date) && !membership.ValidUntil.Before(date)
+}
+
+// IsValidAt checks if the certification was in effect on the given date. A zero ExpiryDate means the certification
+// does not expire.
+func (certification *CoachCertification) IsValidAt(date time.Time) bool {
+	if certification.IssueDate.After(date) {
+		return false
+	}
+
+	return certification.ExpiryDate.IsZero() || !certification.ExpiryDate.Before(date)
+}
+
+// HasValidWFDFAccreditationAt checks if any of the accreditations was in effect on the given date.
+func HasValidWFDFAccreditationAt(accreditations []*WFDFAccreditation, date time.Time) bool {
+	for _, accreditation := range accreditations {
+		if accreditation.IsValidAt(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MostLastingWFDFAccreditation returns the accreditation that expires last, or nil when there are none.
+func MostLastingWFDFAccreditation(accreditations []*WFDFAccreditation) *WFDFAccreditation {
+	var mostLasting *WFDFAccreditation
+	for _, accreditation := range accreditations {
+		if mostLasting == nil || accreditation.ExpiryDate.After(mostLasting.ExpiryDate) {
+			mostLasting = accreditation
+		}
+	}
+
+	return mostLasting
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (accreditation *WFDFAccreditation) String() string {
+	return accreditation.StringWithIndentation(0)
+}
+
+func (accreditation *WFDFAccreditation) StringWithIndentation(indentationLevel int) string {
+	if accreditation == nil {
+		return "[WFDFAccreditation]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[WFDFAccreditation]\n")
+	person := accreditation.Person.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sPerson: %s\n", indentation, person))
+	builder.WriteString(fmt.Sprintf("%sLevel: %s\n", indentation, accreditation.Level))
+
+	builder.WriteString(fmt.Sprintf("%sIssueDate: %s\n", indentation, accreditation.IssueDate.String()))
+	builder.WriteString(fmt.Sprintf("%sExpiryDate: %s\n", indentation, accreditation.ExpiryDate.String()))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, accreditation.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, accreditation.CreatedBy))
+	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, accreditation.UpdatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, accreditation.UpdatedBy))
+
+	return builder.String()
+}
+
+func (membership *FederationMembership) String() string {
+	return membership.StringWithIndentation(0)
+}
+
+func (membership *FederationMembership) StringWithIndentation(indentationLevel int) string {
+	if membership == nil {
+		return "[FederationMembership]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[FederationMembership]\n")
+	person := membership.Person.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sPerson: %s\n", indentation, person))
+	federation := membership.Federation.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sFederation: %s\n", indentation, federation))
+	builder.WriteString(fmt.Sprintf("%sSeason: %s\n", indentation, membership.Season))
+
+	builder.WriteString(fmt.Sprintf("%sValidFrom: %s\n", indentation, membership.ValidFrom.String()))
+	builder.WriteString(fmt.Sprintf("%sValidUntil: %s\n", indentation, membership.ValidUntil.String()))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, membership.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, membership.CreatedBy))
+	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, membership.UpdatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, membership.UpdatedBy))
+
+	return builder.String()
+}
+
+func (certification *CoachCertification) String() string {
+	return certification.StringWithIndentation(0)
+}
+
+func (certification *CoachCertification) StringWithIndentation(indentationLevel int) string {
+	if certification == nil {
+		return "[CoachCertification]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[CoachCertification]\n")
+	person := certification.Person.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sPerson: %s\n", indentation, person))
+	builder.WriteString(fmt.Sprintf("%sName: %s\n", indentation, certification.Name))
+	builder.WriteString(fmt.Sprintf("%sIssuedBy: %s\n", indentation, certification.IssuedBy))
+
+	builder.WriteString(fmt.Sprintf("%sIssueDate: %s\n", indentation, certification.IssueDate.String()))
+	builder.WriteString(fmt.Sprintf("%sExpiryDate: %s\n", indentation, certification.ExpiryDate.String()))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, certification.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, certification.CreatedBy))
+	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, certification.UpdatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, certification.UpdatedBy))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (accreditation *WFDFAccreditation) Clone() *WFDFAccreditation {
+	if accreditation == nil {
+		return nil
+	}
+
+	return &WFDFAccreditation{
+		Person: accreditation.Person.Clone(),
+		Level:  accreditation.Level,
+
+		IssueDate:  accreditation.IssueDate,
+		ExpiryDate: accreditation.ExpiryDate,
+
+		CreatedAt: accreditation.CreatedAt,
+		CreatedBy: accreditation.CreatedBy,
+		UpdatedAt: accreditation.UpdatedAt,
+		UpdatedBy: accreditation.UpdatedBy,
+	}
+}
+
+func (accreditation *WFDFAccreditation) WithExpiryDate(newExpiryDate time.Time) *WFDFAccreditation {
+	newAccreditation := accreditation.Clone()
+	newAccreditation.ExpiryDate = newExpiryDate
+
+	return newAccreditation
+}
+
+func (membership *FederationMembership) Clone() *FederationMembership {
+	if membership == nil {
+		return nil
+	}
+
+	return &FederationMembership{
+		Person:     membership.Person.Clone(),
+		Federation: membership.Federation.Clone(),
+		Season:     membership.Season,
+
+		ValidFrom:  membership.ValidFrom,
+		ValidUntil: membership.ValidUntil,
+
+		CreatedAt: membership.CreatedAt,
+		CreatedBy: membership.CreatedBy,
+		UpdatedAt: membership.UpdatedAt,
+		UpdatedBy: membership.UpdatedBy,
+	}
+}
+
+func (certification *CoachCertification) Clone() *CoachCertification {
+	if certification == nil {
+		return nil
+	}
+
+	return &CoachCertification{
+		Person:   certification.Person.Clone(),
+		Name:     certification.Name,
+		IssuedBy: certification.IssuedBy,
+
+		IssueDate:  certification.IssueDate,
+		ExpiryDate: certification.ExpiryDate,
+
+		CreatedAt: certification.CreatedAt,
+		CreatedBy: certification.CreatedBy,
+		UpdatedAt: certification.UpdatedAt,
+		UpdatedBy: certification.UpdatedBy,
+	}
+}
@@ -0,0 +1,114 @@
+package entity
+
+// Criteria narrows down and orders a listing. The entities are kept when they match every filter and are ordered by
+// the sorts, in the given precedence, and then by the key of the listing, which keeps the order stable across pages.
+// The filters and sorts refer to the attributes of the listed entity, such as the TeamAttributes, which must be among
+// its criteria attributes, such as the TeamCriteriaAttributes.
+type Criteria struct {
+	Filters []Filter
+	Sorts   []Sort
+}
+
+// Filter keeps the entities whose attribute compares to the value with the operator.
+type Filter struct {
+	Attribute string
+	Operator  FilterOperator
+	Value     string
+}
+
+// Sort orders the entities by the attribute, from the smallest value unless Descending is set.
+type Sort struct {
+	Attribute  string
+	Descending bool
+}
+
+/****************/
+/*  ATTRIBUTES  */
+/****************/
+
+// FilterOperator tells how a filter compares the attribute with its value.
+type FilterOperator string
+
+type filterOperatorList struct {
+	Equal              FilterOperator
+	NotEqual           FilterOperator
+	GreaterThan        FilterOperator
+	GreaterThanOrEqual FilterOperator
+	LessThan           FilterOperator
+	LessThanOrEqual    FilterOperator
+	Contains           FilterOperator
+}
+
+// FilterOperators represents the operators that the filters may use.
+var FilterOperators = &filterOperatorList{
+	Equal:              "eq",
+	NotEqual:           "ne",
+	GreaterThan:        "gt",
+	GreaterThanOrEqual: "gte",
+	LessThan:           "lt",
+	LessThanOrEqual:    "lte",
+	Contains:           "contains",
+}
+
+// CriteriaAttributeKind tells how the values of an attribute are compared.
+type CriteriaAttributeKind string
+
+type criteriaAttributeKindList struct {
+	Text CriteriaAttributeKind
+	Time CriteriaAttributeKind
+}
+
+// CriteriaAttributeKinds represents the kinds of the attributes that the listings can be filtered and sorted by.
+var CriteriaAttributeKinds = &criteriaAttributeKindList{
+	Text: "Text",
+	Time: "Time",
+}
+
+// TeamCriteriaAttributes are the attributes of the teams that the listings can be filtered and sorted by.
+var TeamCriteriaAttributes = map[TeamAttribute]CriteriaAttributeKind{
+	TeamAttributes.Slug:          CriteriaAttributeKinds.Text,
+	TeamAttributes.Name:          CriteriaAttributeKinds.Text,
+	TeamAttributes.OriginCountry: CriteriaAttributeKinds.Text,
+	TeamAttributes.CreatedAt:     CriteriaAttributeKinds.Time,
+	TeamAttributes.CreatedBy:     CriteriaAttributeKinds.Text,
+	TeamAttributes.UpdatedAt:     CriteriaAttributeKinds.Time,
+	TeamAttributes.UpdatedBy:     CriteriaAttributeKinds.Text,
+}
+
+// PersonCriteriaAttributes are the attributes of the people that the listings can be filtered and sorted by. The
+// contact details are left out, since filtering by them would reveal the ones that are hidden from the requester.
+var PersonCriteriaAttributes = map[PersonAttribute]CriteriaAttributeKind{
+	PersonAttributes.UserName:      CriteriaAttributeKinds.Text,
+	PersonAttributes.Name:          CriteriaAttributeKinds.Text,
+	PersonAttributes.OriginCountry: CriteriaAttributeKinds.Text,
+	PersonAttributes.CreatedAt:     CriteriaAttributeKinds.Time,
+	PersonAttributes.CreatedBy:     CriteriaAttributeKinds.Text,
+	PersonAttributes.UpdatedAt:     CriteriaAttributeKinds.Time,
+	PersonAttributes.UpdatedBy:     CriteriaAttributeKinds.Text,
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsValidFilterOperator checks if the operator is one of the FilterOperators.
+func IsValidFilterOperator(operator FilterOperator) bool {
+	switch operator {
+	case FilterOperators.Equal,
+		FilterOperators.NotEqual,
+		FilterOperators.GreaterThan,
+		FilterOperators.GreaterThanOrEqual,
+		FilterOperators.LessThan,
+		FilterOperators.LessThanOrEqual,
+		FilterOperators.Contains:
+		return true
+	}
+
+	return false
+}
+
+// AppliesTo checks if the operator can compare the values of an attribute of the kind, which only the text ones can be
+// searched by the Contains operator.
+func (operator FilterOperator) AppliesTo(kind CriteriaAttributeKind) bool {
+	return operator != FilterOperators.Contains || kind == CriteriaAttributeKinds.Text
+}
@@ -0,0 +1,83 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestFilterOperator_AppliesTo(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description     string
+		operator        entity.FilterOperator
+		kind            entity.CriteriaAttributeKind
+		expectedValid   bool
+		expectedApplies bool
+	}{
+		{
+			description:     "should compare text attributes for equality",
+			operator:        entity.FilterOperators.Equal,
+			kind:            entity.CriteriaAttributeKinds.Text,
+			expectedValid:   true,
+			expectedApplies: true,
+		},
+		{
+			description:     "should compare the order of time attributes",
+			operator:        entity.FilterOperators.GreaterThanOrEqual,
+			kind:            entity.CriteriaAttributeKinds.Time,
+			expectedValid:   true,
+			expectedApplies: true,
+		},
+		{
+			description:     "should search text attributes",
+			operator:        entity.FilterOperators.Contains,
+			kind:            entity.CriteriaAttributeKinds.Text,
+			expectedValid:   true,
+			expectedApplies: true,
+		},
+		{
+			description:     "should not search time attributes",
+			operator:        entity.FilterOperators.Contains,
+			kind:            entity.CriteriaAttributeKinds.Time,
+			expectedValid:   true,
+			expectedApplies: false,
+		},
+		{
+			description:     "should reject an unknown operator",
+			operator:        entity.FilterOperator("like"),
+			kind:            entity.CriteriaAttributeKinds.Text,
+			expectedValid:   false,
+			expectedApplies: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, scenario.expectedValid, entity.IsValidFilterOperator(scenario.operator))
+			require.Equal(t, scenario.expectedApplies, scenario.operator.AppliesTo(scenario.kind))
+		})
+	}
+}
+
+func TestCriteriaAttributes_LeaveContactDetailsOut(t *testing.T) {
+	t.Parallel()
+
+	for _, attribute := range []entity.PersonAttribute{
+		entity.PersonAttributes.Email,
+		entity.PersonAttributes.PhoneNumber,
+		entity.PersonAttributes.BirthDate,
+	} {
+		_, ok := entity.PersonCriteriaAttributes[attribute]
+		require.False(t, ok, attribute)
+	}
+}
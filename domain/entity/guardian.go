@@ -0,0 +1,221 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Guardianship represents a person that answers for a minor, usually one of their parents or their legal guardian.
+type Guardianship struct {
+	Guardian     *Person
+	Dependent    *Person
+	Relationship string
+
+	CreatedAt time.Time
+	CreatedBy string
+}
+
+// Consent represents an authorization granted by a guardian on behalf of a minor, which replaces the paper consent
+// forms. Consents are valid from the GrantDate until the ExpiryDate.
+type Consent struct {
+	Person    *Person
+	Kind      ConsentKind
+	GrantedBy *Person
+
+	GrantDate  time.Time
+	ExpiryDate time.Time
+
+	CreatedAt time.Time
+	CreatedBy string
+}
+
+// DependentTeamEvent represents an event of a team in which the dependent of a guardian is an active member, which
+// makes up the schedule that guardians follow.
+type DependentTeamEvent struct {
+	Dependent *Person
+	Event     *TeamEvent
+}
+
+/*****************/
+/*     KINDS     */
+/*****************/
+
+type ConsentKind string
+
+type consentKindList struct {
+	Media   ConsentKind
+	Medical ConsentKind
+	Travel  ConsentKind
+}
+
+// ConsentKinds represents the kinds of consent that a guardian can grant.
+var ConsentKinds = &consentKindList{
+	Media:   "Media",
+	Medical: "Medical",
+	Travel:  "Travel",
+}
+
+// RosterConsentKinds are the consents that a minor needs to be part of an event roster. The media consent only
+// governs the publishing of photos and videos, so missing it does not keep a player out of the roster.
+var RosterConsentKinds = []ConsentKind{
+	ConsentKinds.Medical,
+	ConsentKinds.Travel,
+}
+
+// IsValid checks if the kind is one of the ConsentKinds.
+func (kind ConsentKind) IsValid() bool {
+	switch kind {
+	case ConsentKinds.Media, ConsentKinds.Medical, ConsentKinds.Travel:
+		return true
+	}
+
+	return false
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsValidAt checks if the consent was in effect on the given date.
+func (consent *Consent) IsValidAt(date time.Time) bool {
+	return !consent.GrantDate.After(date) && !consent.ExpiryDate.Before(date)
+}
+
+// MissingConsentKinds returns the kinds, in the given order, that no single consent covers from the start until the
+// end date.
+func MissingConsentKinds(consents []*Consent, kinds []ConsentKind, startDate time.Time, endDate time.Time) []ConsentKind {
+	missingKinds := []ConsentKind{}
+
+	for _, kind := range kinds {
+		covered := false
+		for _, consent := range consents {
+			if consent.Kind == kind && consent.IsValidAt(startDate) && consent.IsValidAt(endDate) {
+				covered = true
+
+				break
+			}
+		}
+
+		if !covered {
+			missingKinds = append(missingKinds, kind)
+		}
+	}
+
+	return missingKinds
+}
+
+// IsGuardianOf checks if any of the guardianships makes the guardian answer for the dependent.
+func IsGuardianOf(guardianships []*Guardianship, guardianUserName string, dependentUserName string) bool {
+	for _, guardianship := range guardianships {
+		if guardianship.Guardian.UserName == guardianUserName && guardianship.Dependent.UserName == dependentUserName {
+			return true
+		}
+	}
+
+	return false
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (guardianship *Guardianship) String() string {
+	return guardianship.StringWithIndentation(0)
+}
+
+func (guardianship *Guardianship) StringWithIndentation(indentationLevel int) string {
+	if guardianship == nil {
+		return "[Guardianship]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[Guardianship]\n")
+	guardian := guardianship.Guardian.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sGuardian: %s\n", indentation, guardian))
+	dependent := guardianship.Dependent.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sDependent: %s\n", indentation, dependent))
+	builder.WriteString(fmt.Sprintf("%sRelationship: %s\n", indentation, guardianship.Relationship))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, guardianship.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, guardianship.CreatedBy))
+
+	return builder.String()
+}
+
+func (consent *Consent) String() string {
+	return consent.StringWithIndentation(0)
+}
+
+func (consent *Consent) StringWithIndentation(indentationLevel int) string {
+	if consent == nil {
+		return "[Consent]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[Consent]\n")
+	person := consent.Person.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sPerson: %s\n", indentation, person))
+	builder.WriteString(fmt.Sprintf("%sKind: %s\n", indentation, consent.Kind))
+	grantedBy := consent.GrantedBy.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sGrantedBy: %s\n", indentation, grantedBy))
+
+	builder.WriteString(fmt.Sprintf("%sGrantDate: %s\n", indentation, consent.GrantDate.String()))
+	builder.WriteString(fmt.Sprintf("%sExpiryDate: %s\n", indentation, consent.ExpiryDate.String()))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, consent.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, consent.CreatedBy))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (guardianship *Guardianship) Clone() *Guardianship {
+	if guardianship == nil {
+		return nil
+	}
+
+	return &Guardianship{
+		Guardian:     guardianship.Guardian.Clone(),
+		Dependent:    guardianship.Dependent.Clone(),
+		Relationship: guardianship.Relationship,
+
+		CreatedAt: guardianship.CreatedAt,
+		CreatedBy: guardianship.CreatedBy,
+	}
+}
+
+func (consent *Consent) Clone() *Consent {
+	if consent == nil {
+		return nil
+	}
+
+	return &Consent{
+		Person:    consent.Person.Clone(),
+		Kind:      consent.Kind,
+		GrantedBy: consent.GrantedBy.Clone(),
+
+		GrantDate:  consent.GrantDate,
+		ExpiryDate: consent.ExpiryDate,
+
+		CreatedAt: consent.CreatedAt,
+		CreatedBy: consent.CreatedBy,
+	}
+}
+
+func (consent *Consent) WithKind(newKind ConsentKind) *Consent {
+	newConsent := consent.Clone()
+	newConsent.Kind = newKind
+
+	return newConsent
+}
+
+func (consent *Consent) WithExpiryDate(newExpiryDate time.Time) *Consent {
+	newConsent := consent.Clone()
+	newConsent.ExpiryDate = newExpiryDate
+
+	return newConsent
+}
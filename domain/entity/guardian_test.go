@@ -0,0 +1,134 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestPerson_IsMinorAt(t *testing.T) {
+	t.Parallel()
+
+	date := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+	person := &entity.Person{UserName: "some.player", BirthDate: date("2008-03-15")}
+
+	scenarios := []struct {
+		description     string
+		person          *entity.Person
+		date            time.Time
+		expectedIsMinor bool
+	}{
+		{
+			description:     "should be a minor on the day before the 18th birthday",
+			person:          person,
+			date:            date("2026-03-14"),
+			expectedIsMinor: true,
+		},
+		{
+			description:     "should not be a minor on the 18th birthday",
+			person:          person,
+			date:            date("2026-03-15"),
+			expectedIsMinor: false,
+		},
+		{
+			description:     "should turn 18 on the first of March when born on a leap day",
+			person:          person.WithBirthDate(date("2008-02-29")),
+			date:            date("2026-03-01"),
+			expectedIsMinor: false,
+		},
+		{
+			description:     "should not be a minor when the birth date is unknown",
+			person:          person.WithBirthDate(time.Time{}),
+			date:            date("2026-03-14"),
+			expectedIsMinor: false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, scenario.expectedIsMinor, scenario.person.IsMinorAt(scenario.date))
+		})
+	}
+}
+
+func TestMissingConsentKinds(t *testing.T) {
+	t.Parallel()
+
+	date := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+	medicalConsent := &entity.Consent{
+		Person:     &entity.Person{UserName: "some.player"},
+		Kind:       entity.ConsentKinds.Medical,
+		GrantedBy:  &entity.Person{UserName: "some.guardian"},
+		GrantDate:  date("2026-01-01"),
+		ExpiryDate: date("2026-12-31"),
+	}
+	travelConsent := medicalConsent.WithKind(entity.ConsentKinds.Travel)
+
+	scenarios := []struct {
+		description   string
+		consents      []*entity.Consent
+		startDate     time.Time
+		endDate       time.Time
+		expectedKinds []entity.ConsentKind
+	}{
+		{
+			description:   "should miss nothing when every kind covers the period",
+			consents:      []*entity.Consent{medicalConsent, travelConsent},
+			startDate:     date("2026-07-11"),
+			endDate:       date("2026-07-12"),
+			expectedKinds: []entity.ConsentKind{},
+		},
+		{
+			description:   "should miss the kinds without any consent",
+			consents:      []*entity.Consent{travelConsent},
+			startDate:     date("2026-07-11"),
+			endDate:       date("2026-07-12"),
+			expectedKinds: []entity.ConsentKind{entity.ConsentKinds.Medical},
+		},
+		{
+			description:   "should miss the kinds whose consent expires during the period",
+			consents:      []*entity.Consent{medicalConsent, travelConsent.WithExpiryDate(date("2026-07-11"))},
+			startDate:     date("2026-07-11"),
+			endDate:       date("2026-07-12"),
+			expectedKinds: []entity.ConsentKind{entity.ConsentKinds.Travel},
+		},
+		{
+			description:   "should miss every kind when the period starts before the consents were granted",
+			consents:      []*entity.Consent{medicalConsent, travelConsent},
+			startDate:     date("2025-12-31"),
+			endDate:       date("2026-01-01"),
+			expectedKinds: []entity.ConsentKind{entity.ConsentKinds.Medical, entity.ConsentKinds.Travel},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			obtainedKinds := entity.MissingConsentKinds(
+				scenario.consents,
+				entity.RosterConsentKinds,
+				scenario.startDate,
+				scenario.endDate,
+			)
+
+			require.Equal(t, scenario.expectedKinds, obtainedKinds)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LedgerTransaction represents a money movement in a team's finance ledger. Following double-entry bookkeeping,
+// the amounts of all its entries always sum up to zero.
+type LedgerTransaction struct {
+	ID            string
+	Team          *Team
+	Kind          LedgerTransactionKind
+	Description   string
+	EffectiveDate time.Time
+	Entries       []LedgerEntry
+
+	CreatedAt time.Time
+	CreatedBy string
+}
+
+// LedgerEntry represents one side of a ledger transaction, posted to a single account.
+// Entries posted to the Receivable account always refer to the person who owes the money.
+type LedgerEntry struct {
+	Account       LedgerAccount
+	Person        *Person
+	AmountInCents int64
+}
+
+// LedgerBalance represents how much a person owes to a team. Negative balances mean the team owes the person.
+type LedgerBalance struct {
+	Team           *Team
+	Person         *Person
+	BalanceInCents int64
+}
+
+/****************/
+/*    KINDS     */
+/****************/
+
+type LedgerTransactionKind string
+
+type ledgerTransactionKindList struct {
+	Dues          LedgerTransactionKind
+	TournamentFee LedgerTransactionKind
+	Payment       LedgerTransactionKind
+}
+
+// LedgerTransactionKinds represents the kinds of transactions that can be recorded in a team ledger.
+var LedgerTransactionKinds = &ledgerTransactionKindList{
+	Dues:          "Dues",
+	TournamentFee: "TournamentFee",
+	Payment:       "Payment",
+}
+
+/****************/
+/*   ACCOUNTS   */
+/****************/
+
+type LedgerAccount string
+
+type ledgerAccountList struct {
+	Receivable LedgerAccount
+	Income     LedgerAccount
+	Cash       LedgerAccount
+}
+
+// LedgerAccounts represents the accounts that entries can be posted to. Receivable holds what members owe to the
+// team, Income holds what the team charged and Cash holds what the team actually received.
+var LedgerAccounts = &ledgerAccountList{
+	Receivable: "Receivable",
+	Income:     "Income",
+	Cash:       "Cash",
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsBalanced checks if the amounts of the transaction entries sum up to zero.
+func (transaction *LedgerTransaction) IsBalanced() bool {
+	var total int64
+	for _, entry := range transaction.Entries {
+		total += entry.AmountInCents
+	}
+
+	return total == 0
+}
+
+// SplitAmountInCents splits the amount into the given number of parts as evenly as possible. When the amount cannot
+// be split evenly, the remaining cents go to the first parts, one cent each.
+func SplitAmountInCents(amountInCents int64, parts int) []int64 {
+	amounts := make([]int64, parts)
+	for i := range amounts {
+		amounts[i] = amountInCents / int64(parts)
+		if int64(i) < amountInCents%int64(parts) {
+			amounts[i]++
+		}
+	}
+
+	return amounts
+}
+
+// TotalOutstandingInCents sums what the people owe to the team. The negative balances, which the team owes back, are
+// left out, so that they do not hide what is still to be received.
+func TotalOutstandingInCents(balances []*LedgerBalance) int64 {
+	var totalInCents int64
+	for _, balance := range balances {
+		if balance.BalanceInCents > 0 {
+			totalInCents += balance.BalanceInCents
+		}
+	}
+
+	return totalInCents
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (transaction *LedgerTransaction) String() string {
+	return transaction.StringWithIndentation(0)
+}
+
+func (transaction *LedgerTransaction) StringWithIndentation(indentationLevel int) string {
+	if transaction == nil {
+		return "[LedgerTransaction]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[LedgerTransaction]\n")
+	builder.WriteString(fmt.Sprintf("%sID: %s\n", indentation, transaction.ID))
+	team := transaction.Team.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sTeam: %s\n", indentation, team))
+	builder.WriteString(fmt.Sprintf("%sKind: %s\n", indentation, transaction.Kind))
+	builder.WriteString(fmt.Sprintf("%sDescription: %s\n", indentation, transaction.Description))
+	builder.WriteString(fmt.Sprintf("%sEffectiveDate: %s\n", indentation, transaction.EffectiveDate.String()))
+	for _, entry := range transaction.Entries {
+		userName := ""
+		if entry.Person != nil {
+			userName = entry.Person.UserName
+		}
+		builder.WriteString(fmt.Sprintf("%sEntry: %s '%s' %d\n", indentation, entry.Account, userName, entry.AmountInCents))
+	}
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, transaction.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, transaction.CreatedBy))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (transaction *LedgerTransaction) Clone() *LedgerTransaction {
+	if transaction == nil {
+		return nil
+	}
+	entries := make([]LedgerEntry, 0, len(transaction.Entries))
+	for _, entry := range transaction.Entries {
+		entries = append(entries, LedgerEntry{
+			Account:       entry.Account,
+			Person:        entry.Person.Clone(),
+			AmountInCents: entry.AmountInCents,
+		})
+	}
+	newTransaction := &LedgerTransaction{
+		ID:            transaction.ID,
+		Team:          transaction.Team.Clone(),
+		Kind:          transaction.Kind,
+		Description:   transaction.Description,
+		EffectiveDate: transaction.EffectiveDate,
+		Entries:       entries,
+
+		CreatedAt: transaction.CreatedAt,
+		CreatedBy: transaction.CreatedBy,
+	}
+
+	return newTransaction
+}
+
+func (transaction *LedgerTransaction) WithKind(newKind LedgerTransactionKind) *LedgerTransaction {
+	newTransaction := transaction.Clone()
+	newTransaction.Kind = newKind
+
+	return newTransaction
+}
+
+func (transaction *LedgerTransaction) WithDescription(newDescription string) *LedgerTransaction {
+	newTransaction := transaction.Clone()
+	newTransaction.Description = newDescription
+
+	return newTransaction
+}
+
+func (transaction *LedgerTransaction) WithEffectiveDate(newEffectiveDate time.Time) *LedgerTransaction {
+	newTransaction := transaction.Clone()
+	newTransaction.EffectiveDate = newEffectiveDate
+
+	return newTransaction
+}
+
+func (transaction *LedgerTransaction) WithEntries(newEntries []LedgerEntry) *LedgerTransaction {
+	newTransaction := transaction.Clone()
+	newTransaction.Entries = newEntries
+
+	return newTransaction
+}
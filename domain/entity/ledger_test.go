@@ -0,0 +1,165 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestSplitAmountInCents(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description   string
+		amountInCents int64
+		parts         int
+		expected      []int64
+	}{
+		{
+			description:   "should split the amount evenly when it is divisible by the parts",
+			amountInCents: 30000,
+			parts:         3,
+			expected:      []int64{10000, 10000, 10000},
+		},
+		{
+			description:   "should charge the remaining cents to the first parts, one cent each",
+			amountInCents: 10000,
+			parts:         3,
+			expected:      []int64{3334, 3333, 3333},
+		},
+		{
+			description:   "should charge the remaining cents to all but the last part",
+			amountInCents: 11,
+			parts:         4,
+			expected:      []int64{3, 3, 3, 2},
+		},
+		{
+			description:   "should give a single part the whole amount",
+			amountInCents: 12345,
+			parts:         1,
+			expected:      []int64{12345},
+		},
+		{
+			description:   "should split an amount smaller than the parts in cents",
+			amountInCents: 2,
+			parts:         3,
+			expected:      []int64{1, 1, 0},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			amounts := entity.SplitAmountInCents(scenario.amountInCents, scenario.parts)
+			require.Equal(t, scenario.expected, amounts)
+
+			var totalInCents int64
+			for _, amount := range amounts {
+				totalInCents += amount
+			}
+			require.Equal(t, scenario.amountInCents, totalInCents)
+		})
+	}
+}
+
+func TestTotalOutstandingInCents(t *testing.T) {
+	t.Parallel()
+
+	balance := func(balanceInCents int64) *entity.LedgerBalance {
+		return &entity.LedgerBalance{BalanceInCents: balanceInCents}
+	}
+
+	scenarios := []struct {
+		description string
+		balances    []*entity.LedgerBalance
+		expected    int64
+	}{
+		{
+			description: "should sum what every person owes",
+			balances:    []*entity.LedgerBalance{balance(5000), balance(2500)},
+			expected:    7500,
+		},
+		{
+			description: "should leave out what the team owes back",
+			balances:    []*entity.LedgerBalance{balance(5000), balance(-3000), balance(2500)},
+			expected:    7500,
+		},
+		{
+			description: "should be zero when the team only owes back",
+			balances:    []*entity.LedgerBalance{balance(-3000), balance(0)},
+			expected:    0,
+		},
+		{
+			description: "should be zero when there are no balances",
+			balances:    []*entity.LedgerBalance{},
+			expected:    0,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, scenario.expected, entity.TotalOutstandingInCents(scenario.balances))
+		})
+	}
+}
+
+func TestLedgerTransaction_IsBalanced(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description string
+		entries     []entity.LedgerEntry
+		expected    bool
+	}{
+		{
+			description: "should be balanced when the charges sum up to the income",
+			entries: []entity.LedgerEntry{
+				{Account: entity.LedgerAccounts.Receivable, Person: &entity.Person{UserName: "some.player"}, AmountInCents: 3334},
+				{Account: entity.LedgerAccounts.Receivable, Person: &entity.Person{UserName: "another.player"}, AmountInCents: 3333},
+				{Account: entity.LedgerAccounts.Income, AmountInCents: -6667},
+			},
+			expected: true,
+		},
+		{
+			description: "should be balanced when the payment leaves the receivable account",
+			entries: []entity.LedgerEntry{
+				{Account: entity.LedgerAccounts.Cash, AmountInCents: 5000},
+				{Account: entity.LedgerAccounts.Receivable, Person: &entity.Person{UserName: "some.player"}, AmountInCents: -5000},
+			},
+			expected: true,
+		},
+		{
+			description: "should not be balanced when a cent is missing",
+			entries: []entity.LedgerEntry{
+				{Account: entity.LedgerAccounts.Receivable, Person: &entity.Person{UserName: "some.player"}, AmountInCents: 3333},
+				{Account: entity.LedgerAccounts.Income, AmountInCents: -3334},
+			},
+			expected: false,
+		},
+		{
+			description: "should be balanced when there are no entries",
+			entries:     []entity.LedgerEntry{},
+			expected:    true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			transaction := &entity.LedgerTransaction{Entries: scenario.entries}
+			require.Equal(t, scenario.expected, transaction.IsBalanced())
+		})
+	}
+}
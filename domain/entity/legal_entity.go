@@ -0,0 +1,289 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LegalEntity represents a registered organization of the Ultimate Frisbee community, like a club or an
+// association. Federations register legal entities rather than teams, so teams and people affiliate to them.
+type LegalEntity struct {
+	Slug               string
+	Name               string
+	Kind               LegalEntityKind
+	RegistrationNumber string
+	Country            string
+	ContactName        string
+	ContactEmail       string
+	ContactPhoneNumber string
+	Website            string
+
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// LegalEntityAffiliation represents the affiliation of either a team or a person to a legal entity during a period.
+type LegalEntityAffiliation struct {
+	LegalEntity *LegalEntity
+	Team        *Team
+	Person      *Person
+
+	StartDate time.Time
+	EndDate   time.Time
+
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+/****************/
+/*  ATTRIBUTES  */
+/****************/
+
+type LegalEntityAttribute string
+
+type legalEntityAttributeList struct {
+	Slug               LegalEntityAttribute
+	Name               LegalEntityAttribute
+	Kind               LegalEntityAttribute
+	RegistrationNumber LegalEntityAttribute
+	Country            LegalEntityAttribute
+	ContactName        LegalEntityAttribute
+	ContactEmail       LegalEntityAttribute
+	ContactPhoneNumber LegalEntityAttribute
+	Website            LegalEntityAttribute
+
+	CreatedAt LegalEntityAttribute
+	CreatedBy LegalEntityAttribute
+	UpdatedAt LegalEntityAttribute
+	UpdatedBy LegalEntityAttribute
+}
+
+// LegalEntityAttributes represents the names of the attributes that a LegalEntity entity can have.
+var LegalEntityAttributes = &legalEntityAttributeList{
+	Slug:               "Slug",
+	Name:               "Name",
+	Kind:               "Kind",
+	RegistrationNumber: "RegistrationNumber",
+	Country:            "Country",
+	ContactName:        "ContactName",
+	ContactEmail:       "ContactEmail",
+	ContactPhoneNumber: "ContactPhoneNumber",
+	Website:            "Website",
+
+	CreatedAt: "CreatedAt",
+	CreatedBy: "CreatedBy",
+	UpdatedAt: "UpdatedAt",
+	UpdatedBy: "UpdatedBy",
+}
+
+/****************/
+/*    KINDS     */
+/****************/
+
+type LegalEntityKind string
+
+type legalEntityKindList struct {
+	Club        LegalEntityKind
+	Association LegalEntityKind
+	Federation  LegalEntityKind
+}
+
+// LegalEntityKinds represents the kinds of organizations that can be registered as legal entities.
+var LegalEntityKinds = &legalEntityKindList{
+	Club:        "Club",
+	Association: "Association",
+	Federation:  "Federation",
+}
+
+// IsValid checks if the kind is one of the LegalEntityKinds.
+func (kind LegalEntityKind) IsValid() bool {
+	switch kind {
+	case LegalEntityKinds.Club, LegalEntityKinds.Association, LegalEntityKinds.Federation:
+		return true
+	}
+
+	return false
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsActiveAt checks if the affiliation was in effect on the given date. A zero EndDate means the affiliation is ongoing.
+func (affiliation *LegalEntityAffiliation) IsActiveAt(date time.Time) bool {
+	if affiliation.StartDate.After(date) {
+		return false
+	}
+
+	return affiliation.EndDate.IsZero() || !affiliation.EndDate.Before(date)
+}
+
+// Overlaps checks if both affiliations are in effect on at least one common date.
+func (affiliation *LegalEntityAffiliation) Overlaps(other *LegalEntityAffiliation) bool {
+	startsBeforeOtherEnds := other.EndDate.IsZero() || !affiliation.StartDate.After(other.EndDate)
+	endsAfterOtherStarts := affiliation.EndDate.IsZero() || !affiliation.EndDate.Before(other.StartDate)
+
+	return startsBeforeOtherEnds && endsAfterOtherStarts
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (legalEntity *LegalEntity) String() string {
+	return legalEntity.StringWithIndentation(0)
+}
+
+func (legalEntity *LegalEntity) StringWithIndentation(indentationLevel int) string {
+	if legalEntity == nil {
+		return "[LegalEntity]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[LegalEntity]\n")
+	builder.WriteString(fmt.Sprintf("%sSlug: %s\n", indentation, legalEntity.Slug))
+	builder.WriteString(fmt.Sprintf("%sName: %s\n", indentation, legalEntity.Name))
+	builder.WriteString(fmt.Sprintf("%sKind: %s\n", indentation, legalEntity.Kind))
+	builder.WriteString(fmt.Sprintf("%sRegistrationNumber: %s\n", indentation, legalEntity.RegistrationNumber))
+	builder.WriteString(fmt.Sprintf("%sCountry: %s\n", indentation, legalEntity.Country))
+	builder.WriteString(fmt.Sprintf("%sContactName: %s\n", indentation, legalEntity.ContactName))
+	builder.WriteString(fmt.Sprintf("%sContactEmail: %s\n", indentation, legalEntity.ContactEmail))
+	builder.WriteString(fmt.Sprintf("%sContactPhoneNumber: %s\n", indentation, legalEntity.ContactPhoneNumber))
+	builder.WriteString(fmt.Sprintf("%sWebsite: %s\n", indentation, legalEntity.Website))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, legalEntity.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, legalEntity.CreatedBy))
+	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, legalEntity.UpdatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, legalEntity.UpdatedBy))
+
+	return builder.String()
+}
+
+func (affiliation *LegalEntityAffiliation) String() string {
+	return affiliation.StringWithIndentation(0)
+}
+
+func (affiliation *LegalEntityAffiliation) StringWithIndentation(indentationLevel int) string {
+	if affiliation == nil {
+		return "[LegalEntityAffiliation]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[LegalEntityAffiliation]\n")
+	legalEntity := affiliation.LegalEntity.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sLegalEntity: %s\n", indentation, legalEntity))
+	if affiliation.Team != nil {
+		team := affiliation.Team.StringWithIndentation(indentationLevel + 2)
+		builder.WriteString(fmt.Sprintf("%sTeam: %s\n", indentation, team))
+	}
+	if affiliation.Person != nil {
+		person := affiliation.Person.StringWithIndentation(indentationLevel + 2)
+		builder.WriteString(fmt.Sprintf("%sPerson: %s\n", indentation, person))
+	}
+
+	builder.WriteString(fmt.Sprintf("%sStartDate: %s\n", indentation, affiliation.StartDate.String()))
+	builder.WriteString(fmt.Sprintf("%sEndDate: %s\n", indentation, affiliation.EndDate.String()))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, affiliation.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, affiliation.CreatedBy))
+	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, affiliation.UpdatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, affiliation.UpdatedBy))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (legalEntity *LegalEntity) Clone() *LegalEntity {
+	if legalEntity == nil {
+		return nil
+	}
+	newLegalEntity := &LegalEntity{
+		Slug:               legalEntity.Slug,
+		Name:               legalEntity.Name,
+		Kind:               legalEntity.Kind,
+		RegistrationNumber: legalEntity.RegistrationNumber,
+		Country:            legalEntity.Country,
+		ContactName:        legalEntity.ContactName,
+		ContactEmail:       legalEntity.ContactEmail,
+		ContactPhoneNumber: legalEntity.ContactPhoneNumber,
+		Website:            legalEntity.Website,
+
+		CreatedAt: legalEntity.CreatedAt,
+		CreatedBy: legalEntity.CreatedBy,
+		UpdatedAt: legalEntity.UpdatedAt,
+		UpdatedBy: legalEntity.UpdatedBy,
+	}
+
+	return newLegalEntity
+}
+
+func (legalEntity *LegalEntity) WithSlug(newSlug string) *LegalEntity {
+	newLegalEntity := legalEntity.Clone()
+	newLegalEntity.Slug = newSlug
+
+	return newLegalEntity
+}
+
+func (legalEntity *LegalEntity) WithName(newName string) *LegalEntity {
+	newLegalEntity := legalEntity.Clone()
+	newLegalEntity.Name = newName
+
+	return newLegalEntity
+}
+
+func (legalEntity *LegalEntity) WithKind(newKind LegalEntityKind) *LegalEntity {
+	newLegalEntity := legalEntity.Clone()
+	newLegalEntity.Kind = newKind
+
+	return newLegalEntity
+}
+
+func (legalEntity *LegalEntity) WithCountry(newCountry string) *LegalEntity {
+	newLegalEntity := legalEntity.Clone()
+	newLegalEntity.Country = newCountry
+
+	return newLegalEntity
+}
+
+func (affiliation *LegalEntityAffiliation) Clone() *LegalEntityAffiliation {
+	if affiliation == nil {
+		return nil
+	}
+	newAffiliation := &LegalEntityAffiliation{
+		LegalEntity: affiliation.LegalEntity.Clone(),
+		Team:        affiliation.Team.Clone(),
+		Person:      affiliation.Person.Clone(),
+
+		StartDate: affiliation.StartDate,
+		EndDate:   affiliation.EndDate,
+
+		CreatedAt: affiliation.CreatedAt,
+		CreatedBy: affiliation.CreatedBy,
+		UpdatedAt: affiliation.UpdatedAt,
+		UpdatedBy: affiliation.UpdatedBy,
+	}
+
+	return newAffiliation
+}
+
+func (affiliation *LegalEntityAffiliation) WithStartDate(newStartDate time.Time) *LegalEntityAffiliation {
+	newAffiliation := affiliation.Clone()
+	newAffiliation.StartDate = newStartDate
+
+	return newAffiliation
+}
+
+func (affiliation *LegalEntityAffiliation) WithEndDate(newEndDate time.Time) *LegalEntityAffiliation {
+	newAffiliation := affiliation.Clone()
+	newAffiliation.EndDate = newEndDate
+
+	return newAffiliation
+}
@@ -0,0 +1,62 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestLegalEntityAffiliation_Overlaps(t *testing.T) {
+	t.Parallel()
+
+	date := func(value string) time.Time {
+		parsedDate, err := time.Parse("2006-01-02", value)
+		require.NoError(t, err)
+
+		return parsedDate
+	}
+	existing := &entity.LegalEntityAffiliation{StartDate: date("2024-01-01"), EndDate: date("2024-12-31")}
+
+	scenarios := []struct {
+		description      string
+		affiliation      *entity.LegalEntityAffiliation
+		expectedOverlaps bool
+	}{
+		{
+			description:      "should not overlap an affiliation that starts after the existing one ends",
+			affiliation:      &entity.LegalEntityAffiliation{StartDate: date("2025-01-01")},
+			expectedOverlaps: false,
+		},
+		{
+			description:      "should not overlap an affiliation that ends before the existing one starts",
+			affiliation:      &entity.LegalEntityAffiliation{StartDate: date("2023-01-01"), EndDate: date("2023-12-31")},
+			expectedOverlaps: false,
+		},
+		{
+			description:      "should overlap an affiliation that starts on the last day of the existing one",
+			affiliation:      &entity.LegalEntityAffiliation{StartDate: date("2024-12-31")},
+			expectedOverlaps: true,
+		},
+		{
+			description:      "should overlap an ongoing affiliation that started before the existing one",
+			affiliation:      &entity.LegalEntityAffiliation{StartDate: date("2023-06-01")},
+			expectedOverlaps: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, scenario.expectedOverlaps, scenario.affiliation.Overlaps(existing))
+			require.Equal(t, scenario.expectedOverlaps, existing.Overlaps(scenario.affiliation))
+		})
+	}
+}
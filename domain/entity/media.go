@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// MediaObject represents a file, such as a team logo or a person avatar, that is kept in the storage under a key.
+type MediaObject struct {
+	Key         string
+	ContentType string
+	Content     []byte
+}
+
+// ImageContentTypes lists the content types accepted for logos and avatars, which browsers can display and from which
+// the thumbnails can be generated.
+var ImageContentTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+}
+
+// ThumbnailSize is the width and height, in pixels, of the square in which the thumbnails of the images fit.
+const ThumbnailSize = 128
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsImageContentType checks if the content type is one of the ImageContentTypes.
+func IsImageContentType(contentType string) bool {
+	for _, imageContentType := range ImageContentTypes {
+		if contentType == imageContentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsValidMediaKey checks if the key is a clean relative path, which cannot point outside of where the objects are kept.
+func IsValidMediaKey(key string) bool {
+	return key != "" && path.Clean("/"+key) == "/"+key
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (object *MediaObject) String() string {
+	return object.StringWithIndentation(0)
+}
+
+func (object *MediaObject) StringWithIndentation(indentationLevel int) string {
+	if object == nil {
+		return "[MediaObject]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[MediaObject]\n")
+	builder.WriteString(fmt.Sprintf("%sKey: %s\n", indentation, object.Key))
+	builder.WriteString(fmt.Sprintf("%sContentType: %s\n", indentation, object.ContentType))
+	builder.WriteString(fmt.Sprintf("%sContent: %d bytes\n", indentation, len(object.Content)))
+
+	return builder.String()
+}
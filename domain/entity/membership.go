@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -11,6 +12,8 @@ type Membership struct {
 	Team   *Team
 	Person *Person
 	Role   string
+	// JerseyNumber is the number worn by the person on the team, such as "7" or "00", which is empty when unknown.
+	JerseyNumber string
 
 	StartDate time.Time
 	EndDate   time.Time
@@ -21,6 +24,15 @@ type Membership struct {
 	UpdatedBy string
 }
 
+// TransferWindow represents a yearly period, from the start day to the end day inclusive, in which people may
+// transfer between teams. A window that ends before it starts spans the turn of the year.
+type TransferWindow struct {
+	StartMonth time.Month
+	StartDay   int
+	EndMonth   time.Month
+	EndDay     int
+}
+
 /****************/
 /*  ATTRIBUTES  */
 /****************/
@@ -32,6 +44,8 @@ type membershipAttributeList struct {
 	Person MembershipAttribute
 	Role   MembershipAttribute
 
+	JerseyNumber MembershipAttribute
+
 	StartDate MembershipAttribute
 	EndDate   MembershipAttribute
 
@@ -47,6 +61,8 @@ var MembershipAttributes = &membershipAttributeList{
 	Person: "Person",
 	Role:   "Role",
 
+	JerseyNumber: "JerseyNumber",
+
 	StartDate: "StartDate",
 	EndDate:   "EndDate",
 
@@ -56,6 +72,179 @@ var MembershipAttributes = &membershipAttributeList{
 	UpdatedBy: "UpdatedBy",
 }
 
+/****************/
+/*    ROLES     */
+/****************/
+
+type membershipRoleList struct {
+	Player      string
+	GameCaptain string
+	Captain     string
+	Coach       string
+	Manager     string
+}
+
+// MembershipRoles represents the roles a person can have in a team.
+var MembershipRoles = &membershipRoleList{
+	Player:      "Player",
+	GameCaptain: "Game Captain",
+	Captain:     "Captain",
+	Coach:       "Coach",
+	Manager:     "Manager",
+}
+
+// IsStaffRole checks if the role belongs to the staff of a team, who can run tryouts and see evaluations.
+func IsStaffRole(role string) bool {
+	switch role {
+	case MembershipRoles.Captain, MembershipRoles.Coach, MembershipRoles.Manager:
+		return true
+	}
+
+	return false
+}
+
+// IsPlayingRole checks if the role belongs to the players of a team, who take the field in games.
+func IsPlayingRole(role string) bool {
+	switch role {
+	case MembershipRoles.Player, MembershipRoles.GameCaptain, MembershipRoles.Captain:
+		return true
+	}
+
+	return false
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsActiveAt checks if the membership was in effect on the given date. A zero EndDate means the membership is ongoing.
+func (membership *Membership) IsActiveAt(date time.Time) bool {
+	if membership.StartDate.After(date) {
+		return false
+	}
+
+	return membership.EndDate.IsZero() || !membership.EndDate.Before(date)
+}
+
+// Overlaps checks if both memberships were in effect on at least one common date. A zero EndDate means the
+// membership is ongoing.
+func (membership *Membership) Overlaps(other *Membership) bool {
+	if !membership.EndDate.IsZero() && membership.EndDate.Before(other.StartDate) {
+		return false
+	}
+
+	return other.EndDate.IsZero() || !other.EndDate.Before(membership.StartDate)
+}
+
+// FindOverlappingPlayingMembership returns the first of the memberships in another team that overlaps the given one
+// when both have playing roles, or nil when there is none. A person may hold several playing roles in the same team,
+// such as Player and Captain. Teams do not have a division yet, so playing memberships overlap regardless of the
+// division of their teams.
+func FindOverlappingPlayingMembership(memberships []*Membership, membership *Membership) *Membership {
+	if !IsPlayingRole(membership.Role) {
+		return nil
+	}
+
+	for _, other := range memberships {
+		if other.Team != nil && membership.Team != nil && other.Team.Slug == membership.Team.Slug {
+			continue
+		}
+		if IsPlayingRole(other.Role) && other.Overlaps(membership) {
+			return other
+		}
+	}
+
+	return nil
+}
+
+// jerseyNumberPattern matches the numbers printed on jerseys, from "0" to "99", where "00" is a number of its own.
+var jerseyNumberPattern = regexp.MustCompile(`^[0-9]{1,2}$`)
+
+// IsValidJerseyNumber checks if the jersey number is between "0" and "99", "00" included.
+func IsValidJerseyNumber(jerseyNumber string) bool {
+	return jerseyNumberPattern.MatchString(jerseyNumber)
+}
+
+// IsCurrentOrUpcomingAt checks if the membership has not ended by the given date, whether it already started or not.
+func (membership *Membership) IsCurrentOrUpcomingAt(date time.Time) bool {
+	return membership.EndDate.IsZero() || !membership.EndDate.Before(date)
+}
+
+// FindJerseyNumberConflict returns the first of the memberships of the team in which another person wears the jersey
+// number of the given membership while both overlap, or nil when there is none. A person may keep the same number
+// across their own memberships, such as when they play and coach the team.
+func FindJerseyNumberConflict(teamMemberships []Membership, membership *Membership) *Membership {
+	if membership.JerseyNumber == "" {
+		return nil
+	}
+
+	for index := range teamMemberships {
+		other := &teamMemberships[index]
+		isSameTeam := other.Team.Slug == membership.Team.Slug
+		isOtherPerson := other.Person.UserName != membership.Person.UserName
+		if isSameTeam && isOtherPerson && other.JerseyNumber == membership.JerseyNumber && other.Overlaps(membership) {
+			return other
+		}
+	}
+
+	return nil
+}
+
+// ParseTransferWindow parses a transfer window written as MM-DD/MM-DD, such as 12-01/01-31.
+func ParseTransferWindow(value string) (TransferWindow, error) {
+	bounds := strings.Split(value, "/")
+	if len(bounds) != 2 {
+		return TransferWindow{}, fmt.Errorf("transfer window '%s' should follow the format MM-DD/MM-DD", value)
+	}
+
+	// A leap year is used so that windows may start or end on February 29th
+	start, startErr := time.Parse("2006-01-02", "2024-"+bounds[0])
+	end, endErr := time.Parse("2006-01-02", "2024-"+bounds[1])
+	if startErr != nil || endErr != nil {
+		return TransferWindow{}, fmt.Errorf("transfer window '%s' should follow the format MM-DD/MM-DD", value)
+	}
+
+	return TransferWindow{
+		StartMonth: start.Month(),
+		StartDay:   start.Day(),
+		EndMonth:   end.Month(),
+		EndDay:     end.Day(),
+	}, nil
+}
+
+// Contains checks if the date falls within the window, whatever its year.
+func (window TransferWindow) Contains(date time.Time) bool {
+	day := monthDay(date.Month(), date.Day())
+	start := monthDay(window.StartMonth, window.StartDay)
+	end := monthDay(window.EndMonth, window.EndDay)
+
+	if start <= end {
+		return start <= day && day <= end
+	}
+
+	return day >= start || day <= end
+}
+
+// IsWithinTransferWindows checks if the date falls within any of the windows. Transfers are allowed at any time when
+// there are no windows.
+func IsWithinTransferWindows(windows []TransferWindow, date time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+
+	for _, window := range windows {
+		if window.Contains(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func monthDay(month time.Month, day int) int {
+	return int(month)*100 + day
+}
+
 /***************/
 /*    DEBUG    */
 /***************/
@@ -76,6 +265,7 @@ func (membership *Membership) StringWithIndentation(indentationLevel int) string
 	person := membership.Team.StringWithIndentation(indentationLevel + 2)
 	builder.WriteString(fmt.Sprintf("%s Person: %s\n", indentation, person))
 	builder.WriteString(fmt.Sprintf("%s Role: '%s'\n", indentation, membership.Role))
+	builder.WriteString(fmt.Sprintf("%s JerseyNumber: '%s'\n", indentation, membership.JerseyNumber))
 
 	builder.WriteString(fmt.Sprintf("%s StartDate: '%s'\n", indentation, membership.StartDate))
 	builder.WriteString(fmt.Sprintf("%s EndDate: '%s'\n", indentation, membership.EndDate))
@@ -88,6 +278,10 @@ func (membership *Membership) StringWithIndentation(indentationLevel int) string
 	return builder.String()
 }
 
+func (window TransferWindow) String() string {
+	return fmt.Sprintf("%02d-%02d/%02d-%02d", window.StartMonth, window.StartDay, window.EndMonth, window.EndDay)
+}
+
 /***************/
 /*   TESTING   */
 /***************/
@@ -101,6 +295,8 @@ func (membership *Membership) Clone() *Membership {
 		Person: membership.Person.Clone(),
 		Role:   membership.Role,
 
+		JerseyNumber: membership.JerseyNumber,
+
 		StartDate: membership.StartDate,
 		EndDate:   membership.EndDate,
 
@@ -134,6 +330,13 @@ func (membership *Membership) WithRole(newRole string) *Membership {
 	return newMembership
 }
 
+func (membership *Membership) WithJerseyNumber(newJerseyNumber string) *Membership {
+	newMembership := membership.Clone()
+	newMembership.JerseyNumber = newJerseyNumber
+
+	return newMembership
+}
+
 func (membership *Membership) WithStartDate(newStartDate time.Time) *Membership {
 	newMembership := membership.Clone()
 	newMembership.StartDate = newStartDate
@@ -0,0 +1,257 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestFindOverlappingPlayingMembership(t *testing.T) {
+	t.Parallel()
+
+	date := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+	ongoingPlayer := &entity.Membership{
+		Team:      &entity.Team{Slug: "old-club"},
+		Role:      entity.MembershipRoles.Player,
+		StartDate: date("2020-01-01"),
+	}
+	pastCaptain := &entity.Membership{
+		Team:      &entity.Team{Slug: "older-club"},
+		Role:      entity.MembershipRoles.Captain,
+		StartDate: date("2018-01-01"),
+		EndDate:   date("2019-12-31"),
+	}
+	ongoingCoach := &entity.Membership{
+		Team:      &entity.Team{Slug: "youth-club"},
+		Role:      entity.MembershipRoles.Coach,
+		StartDate: date("2021-01-01"),
+	}
+
+	scenarios := []struct {
+		description string
+		membership  *entity.Membership
+		expected    *entity.Membership
+	}{
+		{
+			description: "should find an ongoing playing membership",
+			membership: &entity.Membership{
+				Team:      &entity.Team{Slug: "new-club"},
+				Role:      entity.MembershipRoles.Player,
+				StartDate: date("2023-03-01"),
+			},
+			expected: ongoingPlayer,
+		},
+		{
+			description: "should accept another playing role in the same team",
+			membership: &entity.Membership{
+				Team:      &entity.Team{Slug: "old-club"},
+				Role:      entity.MembershipRoles.Captain,
+				StartDate: date("2023-03-01"),
+			},
+			expected: nil,
+		},
+		{
+			description: "should find a past playing membership ending after the start",
+			membership: &entity.Membership{
+				Team:      &entity.Team{Slug: "new-club"},
+				Role:      entity.MembershipRoles.GameCaptain,
+				StartDate: date("2019-06-01"),
+				EndDate:   date("2019-08-31"),
+			},
+			expected: pastCaptain,
+		},
+		{
+			description: "should accept a playing membership ending the day before another starts",
+			membership: &entity.Membership{
+				Team:      &entity.Team{Slug: "new-club"},
+				Role:      entity.MembershipRoles.Player,
+				StartDate: date("2017-01-01"),
+				EndDate:   date("2017-12-31"),
+			},
+			expected: nil,
+		},
+		{
+			description: "should ignore staff roles",
+			membership:  &entity.Membership{Role: entity.MembershipRoles.Coach, StartDate: date("2023-03-01")},
+			expected:    nil,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			memberships := []*entity.Membership{ongoingPlayer, pastCaptain, ongoingCoach}
+			overlapping := entity.FindOverlappingPlayingMembership(memberships, scenario.membership)
+
+			require.Equal(t, scenario.expected, overlapping)
+		})
+	}
+}
+
+func TestFindJerseyNumberConflict(t *testing.T) {
+	t.Parallel()
+
+	date := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+	team := &entity.Team{Slug: "sao-paulo"}
+	teamMemberships := []entity.Membership{
+		{
+			Team:         team,
+			Person:       &entity.Person{UserName: "maria.souza"},
+			Role:         entity.MembershipRoles.Player,
+			JerseyNumber: "7",
+			StartDate:    date("2022-01-01"),
+		},
+		{
+			Team:         team,
+			Person:       &entity.Person{UserName: "ana.lima"},
+			Role:         entity.MembershipRoles.Player,
+			JerseyNumber: "00",
+			StartDate:    date("2019-01-01"),
+			EndDate:      date("2021-12-31"),
+		},
+		{
+			Team:         &entity.Team{Slug: "rio-de-janeiro"},
+			Person:       &entity.Person{UserName: "pedro.alves"},
+			Role:         entity.MembershipRoles.Player,
+			JerseyNumber: "10",
+			StartDate:    date("2022-01-01"),
+		},
+	}
+	membership := &entity.Membership{
+		Team:      team,
+		Person:    &entity.Person{UserName: "joao.silva"},
+		Role:      entity.MembershipRoles.Player,
+		StartDate: date("2023-01-01"),
+	}
+
+	scenarios := []struct {
+		description string
+		membership  *entity.Membership
+		expected    *entity.Membership
+	}{
+		{
+			description: "should find another person wearing the number in an overlapping membership",
+			membership:  membership.WithJerseyNumber("7"),
+			expected:    &teamMemberships[0],
+		},
+		{
+			description: "should tell the numbers 0 and 00 apart",
+			membership:  membership.WithJerseyNumber("0").WithStartDate(date("2020-01-01")),
+			expected:    nil,
+		},
+		{
+			description: "should accept a number worn by someone else in a membership that already ended",
+			membership:  membership.WithJerseyNumber("00"),
+			expected:    nil,
+		},
+		{
+			description: "should accept a number worn by the same person in another membership",
+			membership:  membership.WithJerseyNumber("7").WithPerson(&entity.Person{UserName: "maria.souza"}),
+			expected:    nil,
+		},
+		{
+			description: "should accept a number worn by someone else on another team",
+			membership:  membership.WithJerseyNumber("10"),
+			expected:    nil,
+		},
+		{
+			description: "should accept a membership without a number",
+			membership:  membership,
+			expected:    nil,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			conflicting := entity.FindJerseyNumberConflict(teamMemberships, scenario.membership)
+
+			require.Equal(t, scenario.expected, conflicting)
+		})
+	}
+}
+
+func TestTransferWindow_Contains(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description string
+		window      string
+		date        string
+		expected    bool
+	}{
+		{
+			description: "should contain its first day",
+			window:      "01-01/03-31",
+			date:        "2023-01-01",
+			expected:    true,
+		},
+		{
+			description: "should contain its last day",
+			window:      "01-01/03-31",
+			date:        "2023-03-31",
+			expected:    true,
+		},
+		{
+			description: "should not contain a day after its end",
+			window:      "01-01/03-31",
+			date:        "2023-04-01",
+			expected:    false,
+		},
+		{
+			description: "should contain days on both sides of the turn of the year",
+			window:      "12-01/01-31",
+			date:        "2024-01-15",
+			expected:    true,
+		},
+		{
+			description: "should not contain days outside a window spanning the turn of the year",
+			window:      "12-01/01-31",
+			date:        "2024-06-15",
+			expected:    false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			window, err := entity.ParseTransferWindow(scenario.window)
+			require.NoError(t, err)
+			date, _ := time.Parse("2006-01-02", scenario.date)
+
+			require.Equal(t, scenario.expected, window.Contains(date))
+		})
+	}
+}
+
+func TestParseTransferWindow(t *testing.T) {
+	t.Parallel()
+
+	window, err := entity.ParseTransferWindow("02-29/03-15")
+	require.NoError(t, err)
+	require.Equal(t, "02-29/03-15", window.String())
+
+	_, err = entity.ParseTransferWindow("01-01")
+	require.Error(t, err)
+
+	_, err = entity.ParseTransferWindow("13-01/01-31")
+	require.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package entity
+
+// PageRequest asks a listing for at most Limit entities, starting right after the entity whose key is After in the
+// stable order of the listing, or from the first entity when After is empty. A Limit of zero asks for every entity,
+// which only the internal listings do.
+type PageRequest struct {
+	Limit int
+	After string
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsUnbounded checks if the request asks for every entity that follows After.
+func (page PageRequest) IsUnbounded() bool {
+	return page.Limit <= 0
+}
+
+// WithLookAhead asks for one more entity than the page holds, whose presence tells that another page follows.
+func (page PageRequest) WithLookAhead() PageRequest {
+	if page.IsUnbounded() {
+		return page
+	}
+
+	return PageRequest{
+		Limit: page.Limit + 1,
+		After: page.After,
+	}
+}
+
+// HasMore checks if the amount of entities fetched with WithLookAhead goes beyond the page, in which case the extra
+// one is left out of the page and another page follows.
+func (page PageRequest) HasMore(fetched int) bool {
+	return !page.IsUnbounded() && fetched > page.Limit
+}
@@ -0,0 +1,63 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestPageRequest_WithLookAhead(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description     string
+		page            entity.PageRequest
+		fetched         int
+		expectedRequest entity.PageRequest
+		expectedHasMore bool
+	}{
+		{
+			description:     "should ask for one more entity and tell that another page follows when it is fetched",
+			page:            entity.PageRequest{Limit: 2, After: "some-team"},
+			fetched:         3,
+			expectedRequest: entity.PageRequest{Limit: 3, After: "some-team"},
+			expectedHasMore: true,
+		},
+		{
+			description:     "should tell that the page is the last one when it is not full",
+			page:            entity.PageRequest{Limit: 2},
+			fetched:         1,
+			expectedRequest: entity.PageRequest{Limit: 3},
+			expectedHasMore: false,
+		},
+		{
+			description:     "should tell that the page is the last one when the extra entity is not fetched",
+			page:            entity.PageRequest{Limit: 2},
+			fetched:         2,
+			expectedRequest: entity.PageRequest{Limit: 3},
+			expectedHasMore: false,
+		},
+		{
+			description:     "should keep asking for every entity when the request is unbounded",
+			page:            entity.PageRequest{},
+			fetched:         10,
+			expectedRequest: entity.PageRequest{},
+			expectedHasMore: false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, scenario.expectedRequest, scenario.page.WithLookAhead())
+			require.Equal(t, scenario.expectedHasMore, scenario.page.HasMore(scenario.fetched))
+		})
+	}
+}
@@ -14,11 +14,22 @@ type Person struct {
 	PhoneNumber   string
 	WFDFNumber    string
 	OriginCountry string
+	BirthDate     time.Time
+
+	// The visibilities restrict who sees the contact details of the person, which are left empty for the defaults
+	EmailVisibility       Audience
+	PhoneNumberVisibility Audience
+
+	// The avatar is kept in the storage under these keys, which are empty while the person has no avatar
+	AvatarKey          string
+	AvatarThumbnailKey string
 
 	CreatedAt time.Time
 	CreatedBy string
 	UpdatedAt time.Time
 	UpdatedBy string
+	DeletedAt time.Time
+	DeletedBy string
 }
 
 /****************/
@@ -28,10 +39,18 @@ type Person struct {
 type PersonAttribute string
 
 type personAttributeList struct {
+	UserName      PersonAttribute
 	Email         PersonAttribute
 	PhoneNumber   PersonAttribute
 	WFDFNumber    PersonAttribute
 	OriginCountry PersonAttribute
+	BirthDate     PersonAttribute
+
+	EmailVisibility       PersonAttribute
+	PhoneNumberVisibility PersonAttribute
+
+	AvatarKey          PersonAttribute
+	AvatarThumbnailKey PersonAttribute
 
 	Name PersonAttribute
 
@@ -39,14 +58,24 @@ type personAttributeList struct {
 	CreatedBy PersonAttribute
 	UpdatedAt PersonAttribute
 	UpdatedBy PersonAttribute
+	DeletedAt PersonAttribute
+	DeletedBy PersonAttribute
 }
 
 // PersonAttributes represents the names of the attributes that a Person entity can have.
 var PersonAttributes = &personAttributeList{
+	UserName:      "UserName",
 	Email:         "Email",
 	PhoneNumber:   "PhoneNumber",
 	WFDFNumber:    "WFDFNumber",
 	OriginCountry: "OriginCountry",
+	BirthDate:     "BirthDate",
+
+	EmailVisibility:       "EmailVisibility",
+	PhoneNumberVisibility: "PhoneNumberVisibility",
+
+	AvatarKey:          "AvatarKey",
+	AvatarThumbnailKey: "AvatarThumbnailKey",
 
 	Name: "Name",
 
@@ -54,6 +83,71 @@ var PersonAttributes = &personAttributeList{
 	CreatedBy: "CreatedBy",
 	UpdatedAt: "UpdatedAt",
 	UpdatedBy: "UpdatedBy",
+	DeletedAt: "DeletedAt",
+	DeletedBy: "DeletedBy",
+}
+
+// AgeOfMajority is the age from which a person no longer needs a guardian's consent to take part in team activities.
+const AgeOfMajority = 18
+
+// AnonymizedUserNamePrefix starts the usernames given to the people whose personal data was erased.
+const AnonymizedUserNamePrefix = "anonymized-"
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsArchived checks if the person was soft deleted. Archived people are kept for the historical records that reference
+// them, but are left out of the listings unless explicitly requested.
+func (person *Person) IsArchived() bool {
+	return !person.DeletedAt.IsZero()
+}
+
+// AgeAt returns how many years old the person was on the given date. It should only be called when the birth date is
+// known.
+func (person *Person) AgeAt(date time.Time) int {
+	age := date.Year() - person.BirthDate.Year()
+
+	// The birthday of the year was not reached yet
+	birthMonth, birthDay := person.BirthDate.Month(), person.BirthDate.Day()
+	if date.Month() < birthMonth || (date.Month() == birthMonth && date.Day() < birthDay) {
+		age--
+	}
+
+	return age
+}
+
+// IsMinorAt checks if the person was under the AgeOfMajority on the given date. A person whose birth date is unknown is
+// not considered a minor.
+func (person *Person) IsMinorAt(date time.Time) bool {
+	if person.BirthDate.IsZero() {
+		return false
+	}
+
+	return person.AgeAt(date) < AgeOfMajority
+}
+
+// IsAnonymized checks if the personal data of the person was already erased.
+func (person *Person) IsAnonymized() bool {
+	return strings.HasPrefix(person.UserName, AnonymizedUserNamePrefix)
+}
+
+// Anonymize returns the archived person that replaces this one once their personal data is erased. Nothing that
+// identifies them is kept, but the suffix makes the username, name and email unique as the database requires. The
+// records of the person keep their dates, so the history and the statistics still add up.
+func (person *Person) Anonymize(suffix string, erasedBy string) *Person {
+	userName := AnonymizedUserNamePrefix + suffix
+
+	return &Person{
+		UserName: userName,
+		Name:     "Anonymized person " + suffix,
+		Email:    userName + "@anonymized.invalid",
+
+		CreatedAt: person.CreatedAt,
+		CreatedBy: person.CreatedBy,
+		UpdatedBy: erasedBy,
+		DeletedBy: erasedBy,
+	}
 }
 
 /***************/
@@ -77,11 +171,18 @@ func (person *Person) StringWithIndentation(indentationLevel int) string {
 	builder.WriteString(fmt.Sprintf("%s Phone Number: %s\n", indentation, person.PhoneNumber))
 	builder.WriteString(fmt.Sprintf("%s WFDF Number: %s\n", indentation, person.WFDFNumber))
 	builder.WriteString(fmt.Sprintf("%s Origin Country: %s\n", indentation, person.OriginCountry))
+	builder.WriteString(fmt.Sprintf("%s Birth Date: %s\n", indentation, person.BirthDate.String()))
+	builder.WriteString(fmt.Sprintf("%s Email Visibility: %s\n", indentation, person.EmailVisibility))
+	builder.WriteString(fmt.Sprintf("%s Phone Number Visibility: %s\n", indentation, person.PhoneNumberVisibility))
+	builder.WriteString(fmt.Sprintf("%s Avatar Key: %s\n", indentation, person.AvatarKey))
+	builder.WriteString(fmt.Sprintf("%s Avatar Thumbnail Key: %s\n", indentation, person.AvatarThumbnailKey))
 
 	builder.WriteString(fmt.Sprintf("%s CreatedAt: %s\n", indentation, person.CreatedAt.String()))
 	builder.WriteString(fmt.Sprintf("%s CreatedBy: %s\n", indentation, person.CreatedBy))
 	builder.WriteString(fmt.Sprintf("%s UpdatedAt: %s\n", indentation, person.UpdatedAt.String()))
 	builder.WriteString(fmt.Sprintf("%s UpdatedBy: %s\n", indentation, person.UpdatedBy))
+	builder.WriteString(fmt.Sprintf("%s DeletedAt: %s\n", indentation, person.DeletedAt.String()))
+	builder.WriteString(fmt.Sprintf("%s DeletedBy: %s\n", indentation, person.DeletedBy))
 
 	return builder.String()
 }
@@ -101,11 +202,20 @@ func (person *Person) Clone() *Person {
 		PhoneNumber:   person.PhoneNumber,
 		WFDFNumber:    person.WFDFNumber,
 		OriginCountry: person.OriginCountry,
+		BirthDate:     person.BirthDate,
+
+		EmailVisibility:       person.EmailVisibility,
+		PhoneNumberVisibility: person.PhoneNumberVisibility,
+
+		AvatarKey:          person.AvatarKey,
+		AvatarThumbnailKey: person.AvatarThumbnailKey,
 
 		CreatedAt: person.CreatedAt,
 		CreatedBy: person.CreatedBy,
 		UpdatedAt: person.UpdatedAt,
 		UpdatedBy: person.UpdatedBy,
+		DeletedAt: person.DeletedAt,
+		DeletedBy: person.DeletedBy,
 	}
 
 	return newPerson
@@ -153,6 +263,35 @@ func (person *Person) WithOriginCountry(newOriginCountry string) *Person {
 	return newPerson
 }
 
+func (person *Person) WithBirthDate(newBirthDate time.Time) *Person {
+	newPerson := person.Clone()
+	newPerson.BirthDate = newBirthDate
+
+	return newPerson
+}
+
+func (person *Person) WithEmailVisibility(newEmailVisibility Audience) *Person {
+	newPerson := person.Clone()
+	newPerson.EmailVisibility = newEmailVisibility
+
+	return newPerson
+}
+
+func (person *Person) WithPhoneNumberVisibility(newPhoneNumberVisibility Audience) *Person {
+	newPerson := person.Clone()
+	newPerson.PhoneNumberVisibility = newPhoneNumberVisibility
+
+	return newPerson
+}
+
+func (person *Person) WithAvatar(newAvatarKey string, newAvatarThumbnailKey string) *Person {
+	newPerson := person.Clone()
+	newPerson.AvatarKey = newAvatarKey
+	newPerson.AvatarThumbnailKey = newAvatarThumbnailKey
+
+	return newPerson
+}
+
 func (person *Person) WithCreatedAt(newCreatedAt time.Time) *Person {
 	newPerson := person.Clone()
 	newPerson.CreatedAt = newCreatedAt
@@ -180,3 +319,17 @@ func (person *Person) WithUpdatedBy(newUpdatedBy string) *Person {
 
 	return newPerson
 }
+
+func (person *Person) WithDeletedAt(newDeletedAt time.Time) *Person {
+	newPerson := person.Clone()
+	newPerson.DeletedAt = newDeletedAt
+
+	return newPerson
+}
+
+func (person *Person) WithDeletedBy(newDeletedBy string) *Person {
+	newPerson := person.Clone()
+	newPerson.DeletedBy = newDeletedBy
+
+	return newPerson
+}
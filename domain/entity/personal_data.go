@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PersonalData gathers everything that is known about a person, which is handed to them when they exercise their
+// right of access under GDPR and LGPD.
+type PersonalData struct {
+	Person       *Person
+	Records      []*PersonalDataRecord
+	AuditEntries []*AuditEntry
+
+	ExportedAt time.Time
+}
+
+// PersonalDataRecord holds the rows of a table that reference the person through one of its columns, exactly as they
+// are stored.
+type PersonalDataRecord struct {
+	Table  string
+	Column string
+	Rows   []map[string]interface{}
+}
+
+// AuditEntry tells that the person did something to a record of a table. Only the table and the time are kept, since
+// the record itself may hold the personal data of someone else.
+type AuditEntry struct {
+	Table  string
+	Action AuditAction
+	At     time.Time
+}
+
+/*****************/
+/*    ACTIONS    */
+/*****************/
+
+type AuditAction string
+
+type auditActionList struct {
+	Created            AuditAction
+	Updated            AuditAction
+	Archived           AuditAction
+	AttendanceRecorded AuditAction
+	Decided            AuditAction
+}
+
+// AuditActions represents the actions on records whose author is kept.
+var AuditActions = &auditActionList{
+	Created:            "Created",
+	Updated:            "Updated",
+	Archived:           "Archived",
+	AttendanceRecorded: "AttendanceRecorded",
+	Decided:            "Decided",
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (personalData *PersonalData) String() string {
+	return personalData.StringWithIndentation(0)
+}
+
+func (personalData *PersonalData) StringWithIndentation(indentationLevel int) string {
+	if personalData == nil {
+		return "[PersonalData]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[PersonalData]\n")
+	person := personalData.Person.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sPerson: %s\n", indentation, person))
+	for _, record := range personalData.Records {
+		builder.WriteString(fmt.Sprintf("%sRecords: %s.%s (%d rows)\n", indentation, record.Table, record.Column, len(record.Rows)))
+	}
+	builder.WriteString(fmt.Sprintf("%sAuditEntries: %d\n", indentation, len(personalData.AuditEntries)))
+
+	builder.WriteString(fmt.Sprintf("%sExportedAt: %s\n", indentation, personalData.ExportedAt.String()))
+
+	return builder.String()
+}
@@ -0,0 +1,47 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestPerson_Anonymize(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2021, time.May, 10, 12, 0, 0, 0, time.UTC)
+	person := &entity.Person{
+		UserName:      "some.player",
+		Name:          "Some Player",
+		Email:         "some.player@example.com",
+		PhoneNumber:   "+5511999999999",
+		WFDFNumber:    "12345",
+		OriginCountry: "BR",
+		BirthDate:     time.Date(2008, time.March, 15, 0, 0, 0, 0, time.UTC),
+
+		CreatedAt: createdAt,
+		CreatedBy: "some.admin",
+		UpdatedBy: "some.player",
+	}
+
+	anonymized := person.Anonymize("0a1b2c3d4e5f", "data.officer")
+
+	require.Equal(t, &entity.Person{
+		UserName: "anonymized-0a1b2c3d4e5f",
+		Name:     "Anonymized person 0a1b2c3d4e5f",
+		Email:    "anonymized-0a1b2c3d4e5f@anonymized.invalid",
+
+		CreatedAt: createdAt,
+		CreatedBy: "some.admin",
+		UpdatedBy: "data.officer",
+		DeletedBy: "data.officer",
+	}, anonymized)
+	require.True(t, anonymized.IsAnonymized())
+	require.False(t, person.IsAnonymized())
+}
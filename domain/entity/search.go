@@ -0,0 +1,32 @@
+package entity
+
+// SearchResult is a team or a person found by a search, whichever is not nil, along with how well it matches the
+// query.
+type SearchResult struct {
+	Team   *Team
+	Person *Person
+	// Rank goes from 0 for a weak match to 1 for the best ones, which the results are ordered by
+	Rank float64
+	// Highlights are the spans of the attributes of the team or person that match the query, such as the ones of its
+	// name under TeamAttributes.Name
+	Highlights map[string][]TextSpan
+}
+
+// TextSpan is the range of bytes of a text from Start up to, but not including, End.
+type TextSpan struct {
+	Start int
+	End   int
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// Name is the name of the team or person that was found.
+func (result *SearchResult) Name() string {
+	if result.Team != nil {
+		return result.Team.Name
+	}
+
+	return result.Person.Name
+}
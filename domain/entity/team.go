@@ -13,10 +13,16 @@ type Team struct {
 	Description   string
 	OriginCountry string
 
+	// The logo is kept in the storage under these keys, which are empty while the team has no logo
+	LogoKey          string
+	LogoThumbnailKey string
+
 	CreatedAt time.Time
 	CreatedBy string
 	UpdatedAt time.Time
 	UpdatedBy string
+	DeletedAt time.Time
+	DeletedBy string
 }
 
 /****************/
@@ -31,10 +37,15 @@ type teamAttributeList struct {
 	Description   TeamAttribute
 	OriginCountry TeamAttribute
 
+	LogoKey          TeamAttribute
+	LogoThumbnailKey TeamAttribute
+
 	CreatedAt TeamAttribute
 	CreatedBy TeamAttribute
 	UpdatedAt TeamAttribute
 	UpdatedBy TeamAttribute
+	DeletedAt TeamAttribute
+	DeletedBy TeamAttribute
 }
 
 // TeamAttributes represents the names of the attributes that a Team entity can have.
@@ -44,10 +55,25 @@ var TeamAttributes = &teamAttributeList{
 	Description:   "Description",
 	OriginCountry: "OriginCountry",
 
+	LogoKey:          "LogoKey",
+	LogoThumbnailKey: "LogoThumbnailKey",
+
 	CreatedAt: "CreatedAt",
 	CreatedBy: "CreatedBy",
 	UpdatedAt: "UpdatedAt",
 	UpdatedBy: "UpdatedBy",
+	DeletedAt: "DeletedAt",
+	DeletedBy: "DeletedBy",
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// IsArchived checks if the team was soft deleted. Archived teams are kept for the historical records that reference
+// them, but are left out of the listings unless explicitly requested.
+func (team *Team) IsArchived() bool {
+	return !team.DeletedAt.IsZero()
 }
 
 /***************/
@@ -69,11 +95,15 @@ func (team *Team) StringWithIndentation(indentationLevel int) string {
 	builder.WriteString(fmt.Sprintf("%sName: %s\n", indentation, team.Name))
 	builder.WriteString(fmt.Sprintf("%sDescription: %s\n", indentation, team.Description))
 	builder.WriteString(fmt.Sprintf("%sOriginCountry: %s\n", indentation, team.OriginCountry))
+	builder.WriteString(fmt.Sprintf("%sLogoKey: %s\n", indentation, team.LogoKey))
+	builder.WriteString(fmt.Sprintf("%sLogoThumbnailKey: %s\n", indentation, team.LogoThumbnailKey))
 
 	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, team.CreatedAt.String()))
 	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, team.CreatedBy))
 	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, team.UpdatedAt.String()))
 	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, team.UpdatedBy))
+	builder.WriteString(fmt.Sprintf("%sDeletedAt: %s\n", indentation, team.DeletedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sDeletedBy: %s\n", indentation, team.DeletedBy))
 
 	return builder.String()
 }
@@ -92,10 +122,15 @@ func (team *Team) Clone() *Team {
 		Description:   team.Description,
 		OriginCountry: team.OriginCountry,
 
+		LogoKey:          team.LogoKey,
+		LogoThumbnailKey: team.LogoThumbnailKey,
+
 		CreatedAt: team.CreatedAt,
 		CreatedBy: team.CreatedBy,
 		UpdatedAt: team.UpdatedAt,
 		UpdatedBy: team.UpdatedBy,
+		DeletedAt: team.DeletedAt,
+		DeletedBy: team.DeletedBy,
 	}
 
 	return newTeam
@@ -129,6 +164,14 @@ func (team *Team) WithOriginCountry(newOriginCountry string) *Team {
 	return newTeam
 }
 
+func (team *Team) WithLogo(newLogoKey string, newLogoThumbnailKey string) *Team {
+	newTeam := team.Clone()
+	newTeam.LogoKey = newLogoKey
+	newTeam.LogoThumbnailKey = newLogoThumbnailKey
+
+	return newTeam
+}
+
 func (team *Team) WithCreatedAt(newCreatedAt time.Time) *Team {
 	newTeam := team.Clone()
 	newTeam.CreatedAt = newCreatedAt
@@ -156,3 +199,17 @@ func (team *Team) WithUpdatedBy(newUpdatedBy string) *Team {
 
 	return newTeam
 }
+
+func (team *Team) WithDeletedAt(newDeletedAt time.Time) *Team {
+	newTeam := team.Clone()
+	newTeam.DeletedAt = newDeletedAt
+
+	return newTeam
+}
+
+func (team *Team) WithDeletedBy(newDeletedBy string) *Team {
+	newTeam := team.Clone()
+	newTeam.DeletedBy = newDeletedBy
+
+	return newTeam
+}
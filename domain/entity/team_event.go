@@ -0,0 +1,211 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TeamEvent represents a practice, scrimmage or social event scheduled by a team.
+type TeamEvent struct {
+	ID          string
+	Team        *Team
+	Kind        TeamEventKind
+	Title       string
+	Description string
+	Location    string
+	StartTime   time.Time
+	EndTime     time.Time
+
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// TeamEventParticipation represents the RSVP of a team member to an event and whether the member actually attended it.
+type TeamEventParticipation struct {
+	Event  *TeamEvent
+	Person *Person
+
+	RSVP        RSVPStatus
+	RespondedAt time.Time
+
+	Attendance           AttendanceStatus
+	AttendanceRecordedAt time.Time
+	AttendanceRecordedBy string
+}
+
+// TeamAttendanceRate represents how often a person attended the events in which attendance was taken while being
+// an active member of the team.
+type TeamAttendanceRate struct {
+	Person         *Person
+	EventsCount    int
+	AttendedCount  int
+	AttendanceRate float64
+}
+
+// TeamEventAvailability summarizes the RSVPs of the active members of a team to an event.
+type TeamEventAvailability struct {
+	Event      *TeamEvent
+	Yes        []*Person
+	No         []*Person
+	Maybe      []*Person
+	NoResponse []*Person
+}
+
+/****************/
+/*    KINDS     */
+/****************/
+
+type TeamEventKind string
+
+type teamEventKindList struct {
+	Practice  TeamEventKind
+	Scrimmage TeamEventKind
+	Social    TeamEventKind
+}
+
+// TeamEventKinds represents the kinds of events a team can schedule.
+var TeamEventKinds = &teamEventKindList{
+	Practice:  "Practice",
+	Scrimmage: "Scrimmage",
+	Social:    "Social",
+}
+
+// IsValid checks if the kind is one of the TeamEventKinds.
+func (kind TeamEventKind) IsValid() bool {
+	switch kind {
+	case TeamEventKinds.Practice, TeamEventKinds.Scrimmage, TeamEventKinds.Social:
+		return true
+	}
+
+	return false
+}
+
+type RSVPStatus string
+
+type rsvpStatusList struct {
+	Yes   RSVPStatus
+	No    RSVPStatus
+	Maybe RSVPStatus
+}
+
+// RSVPStatuses represents the answers a member can give when invited to an event.
+var RSVPStatuses = &rsvpStatusList{
+	Yes:   "Yes",
+	No:    "No",
+	Maybe: "Maybe",
+}
+
+// IsValid checks if the status is one of the RSVPStatuses.
+func (status RSVPStatus) IsValid() bool {
+	switch status {
+	case RSVPStatuses.Yes, RSVPStatuses.No, RSVPStatuses.Maybe:
+		return true
+	}
+
+	return false
+}
+
+type AttendanceStatus string
+
+type attendanceStatusList struct {
+	Present AttendanceStatus
+	Absent  AttendanceStatus
+}
+
+// AttendanceStatuses represents the attendance a captain can record for a member. An empty status means the
+// attendance was not recorded yet.
+var AttendanceStatuses = &attendanceStatusList{
+	Present: "Present",
+	Absent:  "Absent",
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (event *TeamEvent) String() string {
+	return event.StringWithIndentation(0)
+}
+
+func (event *TeamEvent) StringWithIndentation(indentationLevel int) string {
+	if event == nil {
+		return "[TeamEvent]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[TeamEvent]\n")
+	builder.WriteString(fmt.Sprintf("%sID: %s\n", indentation, event.ID))
+	team := event.Team.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sTeam: %s\n", indentation, team))
+	builder.WriteString(fmt.Sprintf("%sKind: %s\n", indentation, event.Kind))
+	builder.WriteString(fmt.Sprintf("%sTitle: %s\n", indentation, event.Title))
+	builder.WriteString(fmt.Sprintf("%sDescription: %s\n", indentation, event.Description))
+	builder.WriteString(fmt.Sprintf("%sLocation: %s\n", indentation, event.Location))
+	builder.WriteString(fmt.Sprintf("%sStartTime: %s\n", indentation, event.StartTime.String()))
+	builder.WriteString(fmt.Sprintf("%sEndTime: %s\n", indentation, event.EndTime.String()))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, event.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, event.CreatedBy))
+	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, event.UpdatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, event.UpdatedBy))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (event *TeamEvent) Clone() *TeamEvent {
+	if event == nil {
+		return nil
+	}
+	newEvent := &TeamEvent{
+		ID:          event.ID,
+		Team:        event.Team.Clone(),
+		Kind:        event.Kind,
+		Title:       event.Title,
+		Description: event.Description,
+		Location:    event.Location,
+		StartTime:   event.StartTime,
+		EndTime:     event.EndTime,
+
+		CreatedAt: event.CreatedAt,
+		CreatedBy: event.CreatedBy,
+		UpdatedAt: event.UpdatedAt,
+		UpdatedBy: event.UpdatedBy,
+	}
+
+	return newEvent
+}
+
+func (event *TeamEvent) WithKind(newKind TeamEventKind) *TeamEvent {
+	newEvent := event.Clone()
+	newEvent.Kind = newKind
+
+	return newEvent
+}
+
+func (event *TeamEvent) WithTitle(newTitle string) *TeamEvent {
+	newEvent := event.Clone()
+	newEvent.Title = newTitle
+
+	return newEvent
+}
+
+func (event *TeamEvent) WithStartTime(newStartTime time.Time) *TeamEvent {
+	newEvent := event.Clone()
+	newEvent.StartTime = newStartTime
+
+	return newEvent
+}
+
+func (event *TeamEvent) WithEndTime(newEndTime time.Time) *TeamEvent {
+	newEvent := event.Clone()
+	newEvent.EndTime = newEndTime
+
+	return newEvent
+}
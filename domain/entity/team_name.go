@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TeamName represents a name and slug that a team used before being renamed. The name was in effect from the
+// EffectiveFrom date, which is zero for the name the team was created with, until the day before EffectiveUntil,
+// when the next name took effect.
+type TeamName struct {
+	Team *Team
+	Name string
+	Slug string
+
+	EffectiveFrom  time.Time
+	EffectiveUntil time.Time
+
+	CreatedAt time.Time
+	CreatedBy string
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// LatestTeamName returns the previous name that was replaced last, or nil when the team was never renamed.
+func LatestTeamName(previousNames []*TeamName) *TeamName {
+	var latest *TeamName
+	for _, previousName := range previousNames {
+		if latest == nil || previousName.EffectiveUntil.After(latest.EffectiveUntil) {
+			latest = previousName
+		}
+	}
+
+	return latest
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (teamName *TeamName) String() string {
+	return teamName.StringWithIndentation(0)
+}
+
+func (teamName *TeamName) StringWithIndentation(indentationLevel int) string {
+	if teamName == nil {
+		return "[TeamName]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[TeamName]\n")
+	team := teamName.Team.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sTeam: %s\n", indentation, team))
+	builder.WriteString(fmt.Sprintf("%sName: %s\n", indentation, teamName.Name))
+	builder.WriteString(fmt.Sprintf("%sSlug: %s\n", indentation, teamName.Slug))
+
+	builder.WriteString(fmt.Sprintf("%sEffectiveFrom: %s\n", indentation, teamName.EffectiveFrom.String()))
+	builder.WriteString(fmt.Sprintf("%sEffectiveUntil: %s\n", indentation, teamName.EffectiveUntil.String()))
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, teamName.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, teamName.CreatedBy))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (teamName *TeamName) Clone() *TeamName {
+	if teamName == nil {
+		return nil
+	}
+
+	return &TeamName{
+		Team: teamName.Team.Clone(),
+		Name: teamName.Name,
+		Slug: teamName.Slug,
+
+		EffectiveFrom:  teamName.EffectiveFrom,
+		EffectiveUntil: teamName.EffectiveUntil,
+
+		CreatedAt: teamName.CreatedAt,
+		CreatedBy: teamName.CreatedBy,
+	}
+}
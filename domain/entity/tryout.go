@@ -0,0 +1,293 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Tryout represents a selection process run by a team, in which evaluators score candidates on a set of criteria.
+type Tryout struct {
+	ID        string
+	Team      *Team
+	Title     string
+	Location  string
+	StartDate time.Time
+	EndDate   time.Time
+	Criteria  []TryoutCriterion
+
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// TryoutCriterion represents an aspect in which candidates are scored, such as throwing or field awareness. The weight
+// defines how much the criterion counts in the aggregated score.
+type TryoutCriterion struct {
+	Name   string
+	Weight float64
+}
+
+// TryoutCandidate represents a person who signed up for a tryout and the selection decision about this person.
+type TryoutCandidate struct {
+	Tryout       *Tryout
+	Person       *Person
+	Status       TryoutCandidateStatus
+	RegisteredAt time.Time
+	DecidedAt    time.Time
+	DecidedBy    string
+}
+
+// TryoutEvaluation represents the score given by an evaluator to a candidate on one of the criteria of a tryout.
+type TryoutEvaluation struct {
+	Tryout    *Tryout
+	Candidate *Person
+	Evaluator *Person
+	Criterion string
+	Score     int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TryoutRanking represents the aggregated evaluation of a candidate.
+type TryoutRanking struct {
+	Person           *Person
+	Rank             int
+	NormalizedScore  float64
+	AverageScore     float64
+	EvaluatorsCount  int
+	EvaluationsCount int
+}
+
+// TryoutMinScore and TryoutMaxScore define the range of the scores evaluators can give.
+const (
+	TryoutMinScore = 1
+	TryoutMaxScore = 10
+)
+
+/****************/
+/*   STATUSES   */
+/****************/
+
+type TryoutCandidateStatus string
+
+type tryoutCandidateStatusList struct {
+	Registered TryoutCandidateStatus
+	Selected   TryoutCandidateStatus
+	Rejected   TryoutCandidateStatus
+}
+
+// TryoutCandidateStatuses represents the stages a candidate goes through in a tryout.
+var TryoutCandidateStatuses = &tryoutCandidateStatusList{
+	Registered: "Registered",
+	Selected:   "Selected",
+	Rejected:   "Rejected",
+}
+
+// IsDecision checks if the status is one of the final decisions about a candidate.
+func (status TryoutCandidateStatus) IsDecision() bool {
+	return status == TryoutCandidateStatuses.Selected || status == TryoutCandidateStatuses.Rejected
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// HasCriterion checks if the tryout evaluates candidates on the criterion with the given name.
+func (tryout *Tryout) HasCriterion(name string) bool {
+	for _, criterion := range tryout.Criteria {
+		if criterion.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RankCandidates aggregates the evaluations of the tryout into a ranking of candidates.
+//
+// Evaluators do not use the score range in the same way, so each score is first normalized against the scores given
+// by the same evaluator (z-score). The normalized scores of a candidate are averaged per criterion and then combined
+// using the criteria weights. Candidates without evaluations are left out of the ranking.
+func (tryout *Tryout) RankCandidates(evaluations []*TryoutEvaluation) []*TryoutRanking {
+	scoresByEvaluator := map[string][]float64{}
+	for _, evaluation := range evaluations {
+		evaluatorUserName := evaluation.Evaluator.UserName
+		scoresByEvaluator[evaluatorUserName] = append(scoresByEvaluator[evaluatorUserName], float64(evaluation.Score))
+	}
+
+	means := map[string]float64{}
+	deviations := map[string]float64{}
+	for evaluatorUserName, scores := range scoresByEvaluator {
+		means[evaluatorUserName], deviations[evaluatorUserName] = meanAndStandardDeviation(scores)
+	}
+
+	type candidateScores struct {
+		person             *Person
+		normalizedByName   map[string][]float64
+		scoresSum          float64
+		evaluationsCount   int
+		evaluatorUserNames map[string]bool
+	}
+	candidates := map[string]*candidateScores{}
+	for _, evaluation := range evaluations {
+		candidateUserName := evaluation.Candidate.UserName
+		candidate, ok := candidates[candidateUserName]
+		if !ok {
+			candidate = &candidateScores{
+				person:             evaluation.Candidate,
+				normalizedByName:   map[string][]float64{},
+				evaluatorUserNames: map[string]bool{},
+			}
+			candidates[candidateUserName] = candidate
+		}
+
+		evaluatorUserName := evaluation.Evaluator.UserName
+		normalizedScore := 0.0
+		if deviations[evaluatorUserName] > 0 {
+			normalizedScore = (float64(evaluation.Score) - means[evaluatorUserName]) / deviations[evaluatorUserName]
+		}
+
+		candidate.normalizedByName[evaluation.Criterion] = append(candidate.normalizedByName[evaluation.Criterion], normalizedScore)
+		candidate.scoresSum += float64(evaluation.Score)
+		candidate.evaluationsCount++
+		candidate.evaluatorUserNames[evaluatorUserName] = true
+	}
+
+	rankings := make([]*TryoutRanking, 0, len(candidates))
+	for _, candidate := range candidates {
+		weightedSum, weightsSum := 0.0, 0.0
+		for _, criterion := range tryout.Criteria {
+			normalizedScores := candidate.normalizedByName[criterion.Name]
+			if len(normalizedScores) == 0 {
+				continue
+			}
+			criterionMean, _ := meanAndStandardDeviation(normalizedScores)
+			weightedSum += criterion.Weight * criterionMean
+			weightsSum += criterion.Weight
+		}
+
+		normalizedScore := 0.0
+		if weightsSum > 0 {
+			normalizedScore = weightedSum / weightsSum
+		}
+
+		rankings = append(rankings, &TryoutRanking{
+			Person:           candidate.person,
+			NormalizedScore:  normalizedScore,
+			AverageScore:     candidate.scoresSum / float64(candidate.evaluationsCount),
+			EvaluatorsCount:  len(candidate.evaluatorUserNames),
+			EvaluationsCount: candidate.evaluationsCount,
+		})
+	}
+
+	sort.Slice(rankings, func(i, j int) bool {
+		if rankings[i].NormalizedScore != rankings[j].NormalizedScore {
+			return rankings[i].NormalizedScore > rankings[j].NormalizedScore
+		}
+
+		return rankings[i].Person.UserName < rankings[j].Person.UserName
+	})
+	for index, ranking := range rankings {
+		ranking.Rank = index + 1
+	}
+
+	return rankings
+}
+
+func meanAndStandardDeviation(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+
+	squaredDifferencesSum := 0.0
+	for _, value := range values {
+		squaredDifferencesSum += (value - mean) * (value - mean)
+	}
+
+	return mean, math.Sqrt(squaredDifferencesSum / float64(len(values)))
+}
+
+/***************/
+/*    DEBUG    */
+/***************/
+
+func (tryout *Tryout) String() string {
+	return tryout.StringWithIndentation(0)
+}
+
+func (tryout *Tryout) StringWithIndentation(indentationLevel int) string {
+	if tryout == nil {
+		return "[Tryout]=nil"
+	}
+	indentation := strings.Repeat(" ", indentationLevel)
+	builder := strings.Builder{}
+	builder.WriteString("[Tryout]\n")
+	builder.WriteString(fmt.Sprintf("%sID: %s\n", indentation, tryout.ID))
+	team := tryout.Team.StringWithIndentation(indentationLevel + 2)
+	builder.WriteString(fmt.Sprintf("%sTeam: %s\n", indentation, team))
+	builder.WriteString(fmt.Sprintf("%sTitle: %s\n", indentation, tryout.Title))
+	builder.WriteString(fmt.Sprintf("%sLocation: %s\n", indentation, tryout.Location))
+	builder.WriteString(fmt.Sprintf("%sStartDate: %s\n", indentation, tryout.StartDate.String()))
+	builder.WriteString(fmt.Sprintf("%sEndDate: %s\n", indentation, tryout.EndDate.String()))
+	for _, criterion := range tryout.Criteria {
+		builder.WriteString(fmt.Sprintf("%sCriterion: %s (weight %.2f)\n", indentation, criterion.Name, criterion.Weight))
+	}
+
+	builder.WriteString(fmt.Sprintf("%sCreatedAt: %s\n", indentation, tryout.CreatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sCreatedBy: %s\n", indentation, tryout.CreatedBy))
+	builder.WriteString(fmt.Sprintf("%sUpdatedAt: %s\n", indentation, tryout.UpdatedAt.String()))
+	builder.WriteString(fmt.Sprintf("%sUpdatedBy: %s\n", indentation, tryout.UpdatedBy))
+
+	return builder.String()
+}
+
+/***************/
+/*   TESTING   */
+/***************/
+
+func (tryout *Tryout) Clone() *Tryout {
+	if tryout == nil {
+		return nil
+	}
+	newTryout := &Tryout{
+		ID:        tryout.ID,
+		Team:      tryout.Team.Clone(),
+		Title:     tryout.Title,
+		Location:  tryout.Location,
+		StartDate: tryout.StartDate,
+		EndDate:   tryout.EndDate,
+		Criteria:  append([]TryoutCriterion{}, tryout.Criteria...),
+
+		CreatedAt: tryout.CreatedAt,
+		CreatedBy: tryout.CreatedBy,
+		UpdatedAt: tryout.UpdatedAt,
+		UpdatedBy: tryout.UpdatedBy,
+	}
+
+	return newTryout
+}
+
+func (tryout *Tryout) WithTeam(newTeam *Team) *Tryout {
+	newTryout := tryout.Clone()
+	newTryout.Team = newTeam
+
+	return newTryout
+}
+
+func (tryout *Tryout) WithCriteria(newCriteria []TryoutCriterion) *Tryout {
+	newTryout := tryout.Clone()
+	newTryout.Criteria = newCriteria
+
+	return newTryout
+}
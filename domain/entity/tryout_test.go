@@ -0,0 +1,89 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestTryout_RankCandidates(t *testing.T) {
+	t.Parallel()
+
+	harshEvaluator := &entity.Person{UserName: "harsh.coach"}
+	lenientEvaluator := &entity.Person{UserName: "lenient.coach"}
+	alice := &entity.Person{UserName: "alice"}
+	bob := &entity.Person{UserName: "bob"}
+	carol := &entity.Person{UserName: "carol"}
+
+	evaluation := func(evaluator, candidate *entity.Person, criterion string, score int) *entity.TryoutEvaluation {
+		return &entity.TryoutEvaluation{Evaluator: evaluator, Candidate: candidate, Criterion: criterion, Score: score}
+	}
+
+	scenarios := []struct {
+		description        string
+		criteria           []entity.TryoutCriterion
+		evaluations        []*entity.TryoutEvaluation
+		expectedUserNames  []string
+		expectedNormalized []float64
+	}{
+		{
+			description: "should normalize scores per evaluator so harsh and lenient evaluators weigh the same",
+			criteria:    []entity.TryoutCriterion{{Name: "Throwing", Weight: 1}},
+			evaluations: []*entity.TryoutEvaluation{
+				evaluation(harshEvaluator, alice, "Throwing", 4),
+				evaluation(harshEvaluator, bob, "Throwing", 2),
+				evaluation(lenientEvaluator, bob, "Throwing", 10),
+				evaluation(lenientEvaluator, carol, "Throwing", 8),
+			},
+			expectedUserNames:  []string{"alice", "bob", "carol"},
+			expectedNormalized: []float64{1, 0, -1},
+		},
+		{
+			description: "should combine criteria using their weights",
+			criteria: []entity.TryoutCriterion{
+				{Name: "Throwing", Weight: 3},
+				{Name: "Defense", Weight: 1},
+			},
+			evaluations: []*entity.TryoutEvaluation{
+				evaluation(harshEvaluator, alice, "Throwing", 10),
+				evaluation(harshEvaluator, alice, "Defense", 2),
+				evaluation(harshEvaluator, bob, "Throwing", 2),
+				evaluation(harshEvaluator, bob, "Defense", 10),
+			},
+			expectedUserNames:  []string{"alice", "bob"},
+			expectedNormalized: []float64{0.5, -0.5},
+		},
+		{
+			description: "should give a neutral score when the evaluator gave the same score to everyone",
+			criteria:    []entity.TryoutCriterion{{Name: "Throwing", Weight: 1}},
+			evaluations: []*entity.TryoutEvaluation{
+				evaluation(harshEvaluator, bob, "Throwing", 5),
+				evaluation(harshEvaluator, alice, "Throwing", 5),
+			},
+			expectedUserNames:  []string{"alice", "bob"},
+			expectedNormalized: []float64{0, 0},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			tryout := &entity.Tryout{Criteria: scenario.criteria}
+			rankings := tryout.RankCandidates(scenario.evaluations)
+
+			require.Len(t, rankings, len(scenario.expectedUserNames))
+			for index, ranking := range rankings {
+				require.Equal(t, index+1, ranking.Rank)
+				require.Equal(t, scenario.expectedUserNames[index], ranking.Person.UserName)
+				require.InDelta(t, scenario.expectedNormalized[index], ranking.NormalizedScore, 0.0001)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"time"
+)
+
+/*****************/
+/*   AUDIENCES   */
+/*****************/
+
+// Audience represents how close whoever requests the data of a person is to them, which tells what they can see.
+type Audience string
+
+type audienceList struct {
+	Public   Audience
+	Teammate Audience
+	Staff    Audience
+	Self     Audience
+}
+
+// Audiences represents the audiences from the least to the most trusted one. Each audience sees everything that the
+// less trusted ones see.
+var Audiences = &audienceList{
+	Public:   "Public",
+	Teammate: "Teammate",
+	Staff:    "Staff",
+	Self:     "Self",
+}
+
+// audienceRanks orders the Audiences by trust.
+var audienceRanks = map[Audience]int{
+	Audiences.Public:   0,
+	Audiences.Teammate: 1,
+	Audiences.Staff:    2,
+	Audiences.Self:     3,
+}
+
+// IsValid checks if the audience is one of the Audiences.
+func (audience Audience) IsValid() bool {
+	_, isValid := audienceRanks[audience]
+
+	return isValid
+}
+
+// Includes checks if the audience is at least as trusted as the other one.
+func (audience Audience) Includes(other Audience) bool {
+	return audienceRanks[audience] >= audienceRanks[other]
+}
+
+// DefaultPersonAttributeAudiences tells the least trusted audience that can see each personal detail of a person. The
+// attributes that are left out, such as the name and the origin country, are public.
+var DefaultPersonAttributeAudiences = map[PersonAttribute]Audience{
+	PersonAttributes.Email:       Audiences.Teammate,
+	PersonAttributes.WFDFNumber:  Audiences.Teammate,
+	PersonAttributes.PhoneNumber: Audiences.Staff,
+	PersonAttributes.BirthDate:   Audiences.Staff,
+}
+
+/****************/
+/*    RULES     */
+/****************/
+
+// AudienceOf returns the least trusted audience that can see the attribute of the person. The visibilities chosen by
+// the person can only restrict the default audience further.
+func (person *Person) AudienceOf(attribute PersonAttribute) Audience {
+	audience, isRestricted := DefaultPersonAttributeAudiences[attribute]
+	if !isRestricted {
+		audience = Audiences.Public
+	}
+
+	visibility := Audience("")
+	switch attribute {
+	case PersonAttributes.Email:
+		visibility = person.EmailVisibility
+	case PersonAttributes.PhoneNumber:
+		visibility = person.PhoneNumberVisibility
+	}
+
+	if visibility.IsValid() && visibility.Includes(audience) {
+		return visibility
+	}
+
+	return audience
+}
+
+// IsVisibleTo checks if the audience can see the attribute of the person.
+func (person *Person) IsVisibleTo(attribute PersonAttribute, audience Audience) bool {
+	return audience.Includes(person.AudienceOf(attribute))
+}
+
+// AudiencesOf tells which audience the requester belongs to for each person they share a team with on the given
+// date, based on the memberships of the requester and the memberships of the teams. The staff of a team see its
+// members as Staff, while the other members see them as Teammates. Anyone who is left out only sees what is Public.
+func AudiencesOf(
+	requesterUserName string,
+	requesterMemberships []Membership,
+	teamMemberships []Membership,
+	date time.Time,
+) map[string]Audience {
+	requesterAudiences := map[string]Audience{}
+	for _, membership := range requesterMemberships {
+		if membership.Person.UserName != requesterUserName || !membership.IsActiveAt(date) {
+			continue
+		}
+
+		audience := Audiences.Teammate
+		if IsStaffRole(membership.Role) {
+			audience = Audiences.Staff
+		}
+		if audience.Includes(requesterAudiences[membership.Team.Slug]) {
+			requesterAudiences[membership.Team.Slug] = audience
+		}
+	}
+
+	audiences := map[string]Audience{}
+	for _, membership := range teamMemberships {
+		audience, sharesTeam := requesterAudiences[membership.Team.Slug]
+		if !sharesTeam || !membership.IsActiveAt(date) {
+			continue
+		}
+
+		if audience.Includes(audiences[membership.Person.UserName]) {
+			audiences[membership.Person.UserName] = audience
+		}
+	}
+	audiences[requesterUserName] = Audiences.Self
+
+	return audiences
+}
@@ -0,0 +1,137 @@
+//go:build unit
+// +build unit
+
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+func TestPerson_IsVisibleTo(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{UserName: "some.player"}
+
+	scenarios := []struct {
+		description       string
+		person            *entity.Person
+		attribute         entity.PersonAttribute
+		audience          entity.Audience
+		expectedIsVisible bool
+	}{
+		{
+			description:       "should show the name to the public",
+			person:            person,
+			attribute:         entity.PersonAttributes.Name,
+			audience:          entity.Audiences.Public,
+			expectedIsVisible: true,
+		},
+		{
+			description:       "should hide the email from the public",
+			person:            person,
+			attribute:         entity.PersonAttributes.Email,
+			audience:          entity.Audiences.Public,
+			expectedIsVisible: false,
+		},
+		{
+			description:       "should show the email to teammates",
+			person:            person,
+			attribute:         entity.PersonAttributes.Email,
+			audience:          entity.Audiences.Teammate,
+			expectedIsVisible: true,
+		},
+		{
+			description:       "should hide the phone number from teammates",
+			person:            person,
+			attribute:         entity.PersonAttributes.PhoneNumber,
+			audience:          entity.Audiences.Teammate,
+			expectedIsVisible: false,
+		},
+		{
+			description:       "should show the phone number to the staff",
+			person:            person,
+			attribute:         entity.PersonAttributes.PhoneNumber,
+			audience:          entity.Audiences.Staff,
+			expectedIsVisible: true,
+		},
+		{
+			description:       "should hide the email from teammates when restricted to the staff",
+			person:            person.WithEmailVisibility(entity.Audiences.Staff),
+			attribute:         entity.PersonAttributes.Email,
+			audience:          entity.Audiences.Teammate,
+			expectedIsVisible: false,
+		},
+		{
+			description:       "should hide the phone number from the staff when restricted to the person",
+			person:            person.WithPhoneNumberVisibility(entity.Audiences.Self),
+			attribute:         entity.PersonAttributes.PhoneNumber,
+			audience:          entity.Audiences.Staff,
+			expectedIsVisible: false,
+		},
+		{
+			description:       "should ignore a visibility that is less restrictive than the default",
+			person:            person.WithPhoneNumberVisibility(entity.Audiences.Public),
+			attribute:         entity.PersonAttributes.PhoneNumber,
+			audience:          entity.Audiences.Teammate,
+			expectedIsVisible: false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, scenario.expectedIsVisible, scenario.person.IsVisibleTo(scenario.attribute, scenario.audience))
+		})
+	}
+}
+
+func TestAudiencesOf(t *testing.T) {
+	t.Parallel()
+
+	date := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+	membership := func(teamSlug string, userName string, role string, endDate string) entity.Membership {
+		membership := entity.Membership{
+			Team:      &entity.Team{Slug: teamSlug},
+			Person:    &entity.Person{UserName: userName},
+			Role:      role,
+			StartDate: date("2020-01-01"),
+		}
+		if endDate != "" {
+			membership.EndDate = date(endDate)
+		}
+
+		return membership
+	}
+
+	requesterMemberships := []entity.Membership{
+		membership("team-a", "some.captain", entity.MembershipRoles.Captain, ""),
+		membership("team-b", "some.captain", entity.MembershipRoles.Player, ""),
+		membership("team-c", "some.captain", entity.MembershipRoles.Player, "2021-12-31"),
+	}
+	teamMemberships := []entity.Membership{
+		membership("team-a", "some.captain", entity.MembershipRoles.Captain, ""),
+		membership("team-a", "some.player", entity.MembershipRoles.Player, ""),
+		membership("team-a", "former.player", entity.MembershipRoles.Player, "2021-12-31"),
+		membership("team-b", "some.player", entity.MembershipRoles.Player, ""),
+		membership("team-b", "other.player", entity.MembershipRoles.Player, ""),
+		membership("team-c", "old.teammate", entity.MembershipRoles.Player, ""),
+	}
+
+	audiences := entity.AudiencesOf("some.captain", requesterMemberships, teamMemberships, date("2022-06-01"))
+
+	require.Equal(t, map[string]entity.Audience{
+		"some.captain": entity.Audiences.Self,
+		"some.player":  entity.Audiences.Staff,
+		"other.player": entity.Audiences.Teammate,
+	}, audiences)
+}
@@ -0,0 +1,112 @@
+// Package failure tells apart what can go wrong in the domain by stable codes, which the clients can rely on instead
+// of the wording of the messages.
+package failure
+
+import (
+	"errors"
+)
+
+// Error is a failure identified by its Code, whatever the wording of its Message.
+type Error struct {
+	// Code is made of lowercase words separated by underscores, such as team_not_found, and never changes once released
+	Code    string
+	Message string
+	// Fields tells what is wrong with each field of the input that caused the failure, if any
+	Fields []FieldError
+}
+
+// FieldError tells what is wrong with a field of the input.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// The failures below are the ones that the clients can tell apart, each by its code.
+var (
+	// ErrInvalidInput is a failure of an input with invalid fields, each of which is told in Fields.
+	ErrInvalidInput = New("invalid_input", "invalid input")
+
+	ErrTeamNotFound         = New("team_not_found", "team not found")
+	ErrPersonNotFound       = New("person_not_found", "person not found")
+	ErrLegalEntityNotFound  = New("legal_entity_not_found", "legal entity not found")
+	ErrMembershipNotFound   = New("membership_not_found", "membership not found")
+	ErrAffiliationNotFound  = New("affiliation_not_found", "affiliation not found")
+	ErrGuardianshipNotFound = New("guardianship_not_found", "guardianship not found")
+	ErrTeamEventNotFound    = New("team_event_not_found", "team event not found")
+	ErrTryoutNotFound       = New("tryout_not_found", "tryout not found")
+	ErrCandidateNotFound    = New("candidate_not_found", "candidate not found")
+	ErrMediaNotFound        = New("media_not_found", "media not found")
+
+	// ErrAlreadyExists is a failure to create an entity whose unique attributes, such as its name, are already taken.
+	ErrAlreadyExists = New("already_exists", "already exists")
+	// ErrStillReferenced is a failure to delete an entity that other records, such as memberships, still reference.
+	ErrStillReferenced         = New("still_referenced", "still referenced")
+	ErrAlreadyArchived         = New("already_archived", "already archived")
+	ErrNotArchived             = New("not_archived", "not archived")
+	ErrAlreadyErased           = New("already_erased", "personal data already erased")
+	ErrOverlappingPeriod       = New("overlapping_period", "overlaps another period")
+	ErrJerseyNumberTaken       = New("jersey_number_taken", "jersey number already taken")
+	ErrAlreadySignedUp         = New("already_signed_up", "already signed up")
+	ErrCandidateAlreadyDecided = New("candidate_already_decided", "candidate already decided")
+
+	ErrNotStaff              = New("not_staff", "not part of the staff of the team")
+	ErrNotGuardian           = New("not_guardian", "not a guardian of the dependent")
+	ErrNotSelfOrAdmin        = New("not_self_or_admin", "neither the person nor an admin")
+	ErrNotFederation         = New("not_federation", "legal entity is not a federation")
+	ErrNotActiveMember       = New("not_active_member", "not an active member of the team")
+	ErrNoActiveMembers       = New("no_active_members", "team has no active members")
+	ErrInvalidPeriod         = New("invalid_period", "period ends before it starts")
+	ErrOutsideTransferWindow = New("outside_transfer_window", "outside the transfer windows")
+	ErrUnknownTryoutCriteria = New("unknown_tryout_criteria", "criteria are not part of the tryout")
+	ErrInvalidImage          = New("invalid_image", "invalid image")
+	ErrUnsupportedMediaType  = New("unsupported_media_type", "unsupported media type")
+	ErrContentTooLarge       = New("content_too_large", "content too large")
+	ErrRenameBeforeLast      = New("rename_before_last", "rename takes effect before the last one")
+	ErrMissingConsent        = New("missing_consent", "minor without the consents of a guardian")
+)
+
+// New creates a failure, which is usually declared along with the ones above so that errors.Is tells it apart.
+func New(code string, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (failure *Error) Error() string {
+	return failure.Message
+}
+
+// Is matches the failures of the same code, so that a failure with other fields is still the same failure.
+func (failure *Error) Is(target error) bool {
+	targetFailure, ok := target.(*Error)
+
+	return ok && targetFailure.Code == failure.Code
+}
+
+// WithMessage returns a copy of the failure that tells what went wrong in the words of the message, such as which team
+// was not found.
+func (failure *Error) WithMessage(message string) *Error {
+	copied := *failure
+	copied.Message = message
+
+	return &copied
+}
+
+// WithFields returns a copy of the failure that tells what is wrong with the given fields.
+func (failure *Error) WithFields(fields ...FieldError) *Error {
+	copied := *failure
+	copied.Fields = append(append([]FieldError{}, failure.Fields...), fields...)
+
+	return &copied
+}
+
+// Of returns the first failure within the chain of the error, or nil when there is none.
+func Of(err error) *Error {
+	var failure *Error
+	if errors.As(err, &failure) {
+		return failure
+	}
+
+	return nil
+}
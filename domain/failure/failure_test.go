@@ -0,0 +1,83 @@
+//go:build unit
+// +build unit
+
+package failure_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+)
+
+func TestFailure_Is(t *testing.T) {
+	t.Parallel()
+
+	withFields := failure.ErrTeamNotFound.WithFields(failure.FieldError{Field: "name", Message: "unknown team"})
+
+	require.True(t, errors.Is(withFields, failure.ErrTeamNotFound))
+	require.False(t, errors.Is(withFields, failure.ErrPersonNotFound))
+	require.Empty(t, failure.ErrTeamNotFound.Fields)
+	require.Len(t, withFields.Fields, 1)
+}
+
+func TestFailure_WithMessage(t *testing.T) {
+	t.Parallel()
+
+	withMessage := failure.ErrTeamNotFound.WithMessage("no team with name 'unknown-team' was found")
+
+	require.True(t, errors.Is(withMessage, failure.ErrTeamNotFound))
+	require.Equal(t, "no team with name 'unknown-team' was found", withMessage.Error())
+	require.Equal(t, "team not found", failure.ErrTeamNotFound.Error())
+}
+
+func TestFailure_Of(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description  string
+		err          error
+		expectedCode string
+	}{
+		{
+			description:  "should find the failure itself",
+			err:          failure.ErrAlreadyExists,
+			expectedCode: "already_exists",
+		},
+		{
+			description:  "should find the failure wrapped by other errors",
+			err:          fmt.Errorf("failed to create team: %w", failure.ErrAlreadyExists),
+			expectedCode: "already_exists",
+		},
+		{
+			description:  "should find no failure in other errors",
+			err:          errors.New("connection refused"),
+			expectedCode: "",
+		},
+		{
+			description:  "should find no failure without an error",
+			err:          nil,
+			expectedCode: "",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			found := failure.Of(scenario.err)
+			if scenario.expectedCode == "" {
+				require.Nil(t, found)
+
+				return
+			}
+
+			require.NotNil(t, found)
+			require.Equal(t, scenario.expectedCode, found.Code)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package media
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	// Registers the decoders of the other ImageContentTypes
+	_ "image/gif"
+	_ "image/jpeg"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+)
+
+// maxImagePixels bounds the size of the decoded images, since a small file may still hold a huge image.
+const maxImagePixels = 40_000_000
+
+// contentHashLength is how many hexadecimal digits of the hash of the content tell the images of an owner apart.
+const contentHashLength = 16
+
+// ErrInvalidImage is returned when the content is not an image of the given content type.
+var ErrInvalidImage = failure.ErrInvalidImage
+
+// imageFormats maps the ImageContentTypes to the name of their decoder and to the extension of their keys.
+var imageFormats = map[string]struct {
+	decoder   string
+	extension string
+}{
+	"image/png":  {decoder: "png", extension: ".png"},
+	"image/jpeg": {decoder: "jpeg", extension: ".jpg"},
+	"image/gif":  {decoder: "gif", extension: ".gif"},
+}
+
+// Image is an uploaded image along with the thumbnail generated from it, both ready to be kept in the storage.
+type Image struct {
+	Original  *entity.MediaObject
+	Thumbnail *entity.MediaObject
+}
+
+// PrepareImage checks that the content is an image of the given content type and generates its thumbnail. The keys of
+// both objects start with the prefix and end with the hash of the content, so a new image never replaces an older one
+// under the same key and clients can cache them for good.
+func PrepareImage(keyPrefix string, contentType string, content []byte) (*Image, error) {
+	format, isImage := imageFormats[contentType]
+	if !isImage {
+		return nil, fmt.Errorf("%w: unsupported content type %s", ErrInvalidImage, contentType)
+	}
+
+	config, decoder, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if decoder != format.decoder {
+		return nil, fmt.Errorf("%w: content is a %s image instead of %s", ErrInvalidImage, decoder, contentType)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("%w: image of %dx%d pixels is too large", ErrInvalidImage, config.Width, config.Height)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	var thumbnail bytes.Buffer
+	err = png.Encode(&thumbnail, Thumbnail(decoded, entity.ThumbnailSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	hash := sha256.Sum256(content)
+	key := keyPrefix + "-" + hex.EncodeToString(hash[:])[:contentHashLength]
+
+	return &Image{
+		Original: &entity.MediaObject{
+			Key:         key + format.extension,
+			ContentType: contentType,
+			Content:     content,
+		},
+		Thumbnail: &entity.MediaObject{
+			Key:         key + "-thumbnail.png",
+			ContentType: "image/png",
+			Content:     thumbnail.Bytes(),
+		},
+	}, nil
+}
+
+// Thumbnail scales the image down, keeping its aspect ratio, until it fits in a square of the given size. Each pixel of
+// the thumbnail is the average of the pixels it covers in the source. Images that already fit are only copied.
+func Thumbnail(source image.Image, size int) image.Image {
+	bounds := source.Bounds()
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+
+	width, height := sourceWidth, sourceHeight
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, sourceHeight*size/sourceWidth)
+		} else {
+			width, height = max(1, sourceWidth*size/sourceHeight), size
+		}
+	}
+
+	thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		top := bounds.Min.Y + y*sourceHeight/height
+		bottom := max(top+1, bounds.Min.Y+(y+1)*sourceHeight/height)
+
+		for x := 0; x < width; x++ {
+			left := bounds.Min.X + x*sourceWidth/width
+			right := max(left+1, bounds.Min.X+(x+1)*sourceWidth/width)
+
+			var red, green, blue, alpha, count uint64
+			for sourceY := top; sourceY < bottom; sourceY++ {
+				for sourceX := left; sourceX < right; sourceX++ {
+					r, g, b, a := source.At(sourceX, sourceY).RGBA()
+					red, green, blue, alpha = red+uint64(r), green+uint64(g), blue+uint64(b), alpha+uint64(a)
+					count++
+				}
+			}
+
+			thumbnail.Set(x, y, color.RGBA64{
+				R: uint16(red / count),
+				G: uint16(green / count),
+				B: uint16(blue / count),
+				A: uint16(alpha / count),
+			})
+		}
+	}
+
+	return thumbnail
+}
@@ -0,0 +1,164 @@
+//go:build unit
+// +build unit
+
+package media_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/media"
+)
+
+func TestMedia_PrepareImage(t *testing.T) {
+	t.Parallel()
+
+	wideImage := encodedImage(t, png.Encode, 512, 256)
+	tallImage := encodedImage(t, png.Encode, 100, 400)
+	smallImage := encodedImage(t, png.Encode, 64, 32)
+	jpegImage := encodedImage(t, func(writer io.Writer, source image.Image) error {
+		return jpeg.Encode(writer, source, nil)
+	}, 300, 300)
+
+	scenarios := []struct {
+		description           string
+		contentType           string
+		content               []byte
+		expectedInvalid       bool
+		expectedExtension     string
+		expectedThumbnailSize image.Point
+	}{
+		{
+			description:           "should scale a wide image down to the thumbnail width",
+			contentType:           "image/png",
+			content:               wideImage,
+			expectedExtension:     ".png",
+			expectedThumbnailSize: image.Pt(entity.ThumbnailSize, entity.ThumbnailSize/2),
+		},
+		{
+			description:           "should scale a tall image down to the thumbnail height",
+			contentType:           "image/png",
+			content:               tallImage,
+			expectedExtension:     ".png",
+			expectedThumbnailSize: image.Pt(entity.ThumbnailSize/4, entity.ThumbnailSize),
+		},
+		{
+			description:           "should keep the size of an image that already fits in the thumbnail",
+			contentType:           "image/png",
+			content:               smallImage,
+			expectedExtension:     ".png",
+			expectedThumbnailSize: image.Pt(64, 32),
+		},
+		{
+			description:           "should keep the extension of a JPEG image",
+			contentType:           "image/jpeg",
+			content:               jpegImage,
+			expectedExtension:     ".jpg",
+			expectedThumbnailSize: image.Pt(entity.ThumbnailSize, entity.ThumbnailSize),
+		},
+		{
+			description:     "should reject an image whose format is not the given content type",
+			contentType:     "image/jpeg",
+			content:         wideImage,
+			expectedInvalid: true,
+		},
+		{
+			description:     "should reject a content type that is not an image",
+			contentType:     "application/pdf",
+			content:         wideImage,
+			expectedInvalid: true,
+		},
+		{
+			description:     "should reject a truncated image",
+			contentType:     "image/png",
+			content:         wideImage[:len(wideImage)/2],
+			expectedInvalid: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			preparedImage, err := media.PrepareImage("teams/sao-paulo/logo", scenario.contentType, scenario.content)
+			if scenario.expectedInvalid {
+				require.ErrorIs(t, err, media.ErrInvalidImage)
+				require.Nil(t, preparedImage)
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.True(t, strings.HasPrefix(preparedImage.Original.Key, "teams/sao-paulo/logo-"))
+			require.True(t, strings.HasSuffix(preparedImage.Original.Key, scenario.expectedExtension))
+			require.Equal(t, scenario.contentType, preparedImage.Original.ContentType)
+			require.Equal(t, scenario.content, preparedImage.Original.Content)
+
+			expectedThumbnailKey := strings.TrimSuffix(preparedImage.Original.Key, scenario.expectedExtension) + "-thumbnail.png"
+			require.Equal(t, expectedThumbnailKey, preparedImage.Thumbnail.Key)
+			require.Equal(t, "image/png", preparedImage.Thumbnail.ContentType)
+
+			thumbnail, err := png.Decode(bytes.NewReader(preparedImage.Thumbnail.Content))
+			require.NoError(t, err)
+			require.Equal(t, scenario.expectedThumbnailSize, thumbnail.Bounds().Size())
+		})
+	}
+}
+
+func TestMedia_PrepareImage_KeysFollowTheContent(t *testing.T) {
+	t.Parallel()
+
+	firstImage, err := media.PrepareImage("people/joao.silva/avatar", "image/png", encodedImage(t, png.Encode, 10, 10))
+	require.NoError(t, err)
+	sameImage, err := media.PrepareImage("people/joao.silva/avatar", "image/png", encodedImage(t, png.Encode, 10, 10))
+	require.NoError(t, err)
+	otherImage, err := media.PrepareImage("people/joao.silva/avatar", "image/png", encodedImage(t, png.Encode, 20, 10))
+	require.NoError(t, err)
+
+	require.Equal(t, firstImage.Original.Key, sameImage.Original.Key)
+	require.NotEqual(t, firstImage.Original.Key, otherImage.Original.Key)
+}
+
+func TestMedia_Thumbnail(t *testing.T) {
+	t.Parallel()
+
+	// The left half is black and the right half is white, so each pixel of the thumbnail averages a single color
+	source := image.NewGray(image.Rect(0, 0, 8, 4))
+	for y := 0; y < 4; y++ {
+		for x := 4; x < 8; x++ {
+			source.SetGray(x, y, color.Gray{Y: 255})
+		}
+	}
+
+	thumbnail := media.Thumbnail(source, 2)
+
+	require.Equal(t, image.Pt(2, 1), thumbnail.Bounds().Size())
+	require.Equal(t, color.RGBAModel.Convert(color.Black), color.RGBAModel.Convert(thumbnail.At(0, 0)))
+	require.Equal(t, color.RGBAModel.Convert(color.White), color.RGBAModel.Convert(thumbnail.At(1, 0)))
+}
+
+func encodedImage(t *testing.T, encode func(writer io.Writer, source image.Image) error, width int, height int) []byte {
+	t.Helper()
+
+	source := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			source.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, encode(&buffer, source))
+
+	return buffer.Bytes()
+}
@@ -1,13 +1,20 @@
 package config
 
+import "github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+
 type Config interface {
 	GetConfigs() (*Application, error)
 }
 
 type Application struct {
-	API      *APISection
-	Database *DatabaseSection
-	Logger   *LoggerSection
+	API         *APISection
+	Database    *DatabaseSection
+	Logger      *LoggerSection
+	Memberships *MembershipsSection
+	Archive     *ArchiveSection
+	Storage     *StorageSection
+	Pagination  *PaginationSection
+	Privacy     *PrivacySection
 }
 
 type APISection struct {
@@ -28,3 +35,47 @@ type LoggerSection struct {
 	Level string
 	Mode  string
 }
+
+type MembershipsSection struct {
+	// TransferWindows are the yearly periods in which people may transfer between teams, at any time when empty.
+	TransferWindows []entity.TransferWindow
+}
+
+type ArchiveSection struct {
+	// RetentionDays is how long archived teams and people are kept before the purge job deletes them.
+	RetentionDays int
+}
+
+type PaginationSection struct {
+	// DefaultLimit is the size of the pages of the listings when the 'limit' query param is left out.
+	DefaultLimit int
+	// MaxLimit is the largest 'limit' query param accepted by the listings.
+	MaxLimit int
+}
+
+type PrivacySection struct {
+	// Admins are the usernames allowed to export or erase the personal data of anyone, besides the person themself.
+	Admins []string
+}
+
+type StorageSection struct {
+	// Driver tells where the media objects are kept, either "local" or "s3".
+	Driver string
+	// MaxUploadBytes is the largest image accepted as a team logo or a person avatar.
+	MaxUploadBytes int64
+	Local          *LocalStorageSection
+	S3             *S3StorageSection
+}
+
+type LocalStorageSection struct {
+	Directory string
+}
+
+// S3StorageSection configures any S3-compatible object storage, such as AWS S3 or MinIO, addressed in path style.
+type S3StorageSection struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
@@ -11,4 +11,6 @@ const (
 	StatusCode Key = "StatusCode"
 	// Key is the label that identifies the unique identifier of some entity or resource.
 	ID Key = "ID"
+	// CorrelationID is the label that identifies the HTTP request that a log message is about.
+	CorrelationID Key = "CorrelationID"
 )
@@ -1,6 +1,16 @@
 package repository
 
 type Collection struct {
-	Team   Team
-	Person Person
+	Team        Team
+	Person      Person
+	Membership  Membership
+	Ledger      Ledger
+	TeamEvent   TeamEvent
+	Tryout      Tryout
+	LegalEntity LegalEntity
+	Credential  Credential
+	Guardian    Guardian
+	Country     Country
+
+	TransactionManager TransactionManager
 }
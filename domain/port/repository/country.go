@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// Country deals with the countries of the ISO 3166-1 standard, along with their names in each of the
+// entity.CountryLocales and the other names commonly used for them. Countries are returned sorted by the alpha-2 code.
+type Country interface {
+	GetAllCountries(context context.Context) ([]*entity.Country, error)
+	// FindCountry matches the value against the alpha-2 and alpha-3 codes, then the names in every locale and then the
+	// aliases of the countries, ignoring the case. It returns nil when no country matches.
+	FindCountry(context context.Context, value string) (*entity.Country, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// Credential deals with the WFDF accreditations, national federation memberships and coach certifications of
+// people. Credentials are returned from the most recently issued to the oldest.
+type Credential interface {
+	GetWFDFAccreditationsByPersonUserName(context context.Context, personUserName string) ([]*entity.WFDFAccreditation, error)
+	GetWFDFAccreditationsByPersonUserNames(context context.Context, personUserNames []string) ([]*entity.WFDFAccreditation, error)
+	// CreateWFDFAccreditation returns ErrAlreadyExists when the person already has an accreditation of the same level
+	// issued on the same date.
+	CreateWFDFAccreditation(context context.Context, accreditation *entity.WFDFAccreditation) (*entity.WFDFAccreditation, error)
+
+	GetFederationMembershipsByPersonUserName(context context.Context, personUserName string) ([]*entity.FederationMembership, error)
+	// CreateFederationMembership returns ErrAlreadyExists when the person is already a member of the federation in
+	// the same season.
+	CreateFederationMembership(context context.Context, membership *entity.FederationMembership) (*entity.FederationMembership, error)
+
+	GetCoachCertificationsByPersonUserName(context context.Context, personUserName string) ([]*entity.CoachCertification, error)
+	// CreateCoachCertification returns ErrAlreadyExists when the person already has the same certification issued on
+	// the same date.
+	CreateCoachCertification(context context.Context, certification *entity.CoachCertification) (*entity.CoachCertification, error)
+}
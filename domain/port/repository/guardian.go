@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// Guardian deals with the guardians of minors and the consents they grant on their behalf. Consents are returned from
+// the most recently granted to the oldest.
+type Guardian interface {
+	GetGuardianshipsByDependentUserName(context context.Context, dependentUserName string) ([]*entity.Guardianship, error)
+	GetGuardianshipsByGuardianUserName(context context.Context, guardianUserName string) ([]*entity.Guardianship, error)
+	// CreateGuardianship returns ErrAlreadyExists when the person is already a guardian of the dependent.
+	CreateGuardianship(context context.Context, guardianship *entity.Guardianship) (*entity.Guardianship, error)
+	// DeleteGuardianship returns nil when the person is not a guardian of the dependent.
+	DeleteGuardianship(context context.Context, guardianUserName string, dependentUserName string) (*entity.Guardianship, error)
+
+	GetConsentsByPersonUserName(context context.Context, personUserName string) ([]*entity.Consent, error)
+	// CreateConsent returns ErrAlreadyExists when the person already has a consent of the same kind granted on the
+	// same date.
+	CreateConsent(context context.Context, consent *entity.Consent) (*entity.Consent, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type Ledger interface {
+	GetLedgerTransactionsByTeamSlug(context context.Context, teamSlug string) ([]*entity.LedgerTransaction, error)
+	GetLedgerBalancesByTeamSlug(context context.Context, teamSlug string) ([]*entity.LedgerBalance, error)
+	CreateLedgerTransaction(context context.Context, transaction *entity.LedgerTransaction) (*entity.LedgerTransaction, error)
+}
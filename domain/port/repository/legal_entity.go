@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// LegalEntity deals with legal entities and the affiliations of teams and people to them. Affiliations are
+// returned from the most recent to the oldest.
+type LegalEntity interface {
+	// GetAllLegalEntities orders the legal entities by name, which is the key that the page follows.
+	GetAllLegalEntities(context context.Context, page entity.PageRequest) ([]*entity.LegalEntity, error)
+	GetLegalEntityBySlug(context context.Context, slug string) (*entity.LegalEntity, error)
+	// CreateLegalEntity returns ErrAlreadyExists when the slug, name or registration number in the country is already taken.
+	CreateLegalEntity(context context.Context, legalEntity *entity.LegalEntity) (*entity.LegalEntity, error)
+	UpdateLegalEntity(context context.Context, legalEntity *entity.LegalEntity, updatedAttributes []entity.LegalEntityAttribute) (*entity.LegalEntity, error)
+
+	GetLegalEntityAffiliationsByLegalEntitySlug(context context.Context, legalEntitySlug string) ([]*entity.LegalEntityAffiliation, error)
+	GetLegalEntityAffiliationsByTeamSlug(context context.Context, teamSlug string) ([]*entity.LegalEntityAffiliation, error)
+	GetLegalEntityAffiliationsByPersonUserName(context context.Context, personUserName string) ([]*entity.LegalEntityAffiliation, error)
+	// CreateLegalEntityAffiliation stores the affiliation of either the Team or the Person of the affiliation. It
+	// returns ErrAlreadyExists when the same affiliation already starts on the same date.
+	CreateLegalEntityAffiliation(context context.Context, affiliation *entity.LegalEntityAffiliation) (*entity.LegalEntityAffiliation, error)
+	// EndLegalEntityAffiliation saves the EndDate of the affiliation that started on its StartDate. Nil is returned
+	// when there is no such affiliation.
+	EndLegalEntityAffiliation(context context.Context, affiliation *entity.LegalEntityAffiliation) (*entity.LegalEntityAffiliation, error)
+}
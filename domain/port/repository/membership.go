@@ -2,10 +2,34 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
 )
 
+// ErrOverlappingPeriod is returned by repository implementations when a playing membership cannot be saved because it
+// overlaps another playing membership of the same person in a different team.
+var ErrOverlappingPeriod = failure.ErrOverlappingPeriod
+
 type Membership interface {
 	GetMembershipsByTeamSlug(context context.Context, teamSlug string) ([]entity.Membership, error)
+	// GetMembershipsByTeamSlugAt returns the memberships of the team that were in effect on the given date.
+	GetMembershipsByTeamSlugAt(context context.Context, teamSlug string, date time.Time) ([]entity.Membership, error)
+	// GetMembershipsByPersonUserName returns the memberships of the person in every team, the oldest first.
+	GetMembershipsByPersonUserName(context context.Context, personUserName string) ([]entity.Membership, error)
+	// GetMembershipsByPersonUserNameAt returns the memberships of the person that were in effect on the given date,
+	// the oldest first.
+	GetMembershipsByPersonUserNameAt(context context.Context, personUserName string, date time.Time) ([]entity.Membership, error)
+	// CreateMembership returns ErrAlreadyExists when the person already has the same role in the team since the same date,
+	// and ErrOverlappingPeriod when it is a playing membership that overlaps another one of the person in a different
+	// team.
+	CreateMembership(context context.Context, membership *entity.Membership) (*entity.Membership, error)
+	// EndMembership saves the EndDate of the membership that started on its StartDate. Nil is returned when there is
+	// no such membership.
+	EndMembership(context context.Context, membership *entity.Membership) (*entity.Membership, error)
+	// UpdateMembershipJerseyNumber saves the JerseyNumber of the membership that started on its StartDate on behalf of
+	// its UpdatedBy. It returns ErrAlreadyExists when another person of the team wears the number in an overlapping
+	// membership, and nil when there is no such membership.
+	UpdateMembershipJerseyNumber(context context.Context, membership *entity.Membership) (*entity.Membership, error)
 }
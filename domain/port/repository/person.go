@@ -2,13 +2,59 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
 )
 
 type Person interface {
-	GetAllPeople(context context.Context) ([]*entity.Person, error)
+	// GetAllPeople leaves the archived people out unless includeArchived is set. The people are narrowed down and
+	// ordered by the criteria and then by username, which is the key that the page follows.
+	GetAllPeople(
+		context context.Context,
+		includeArchived bool,
+		criteria entity.Criteria,
+		page entity.PageRequest,
+	) ([]*entity.Person, error)
+	// GetPersonByUserName finds archived people as well, so their history is still reachable.
 	GetPersonByUserName(context context.Context, ID string) (*entity.Person, error)
+	// CreatePerson returns ErrAlreadyExists when the username, name, email or WFDF number is already taken, including
+	// usernames left as aliases by merges.
 	CreatePerson(context context.Context, person *entity.Person) (*entity.Person, error)
+	// ArchivePerson soft deletes the person on behalf of its DeletedBy. Nil is returned when there is no such person
+	// that is not archived yet.
+	ArchivePerson(context context.Context, person *entity.Person) (*entity.Person, error)
+	// RestorePerson brings an archived person back on behalf of its UpdatedBy. Nil is returned when there is no such
+	// archived person.
+	RestorePerson(context context.Context, person *entity.Person) (*entity.Person, error)
+	GetPeopleArchivedBefore(context context.Context, date time.Time) ([]*entity.Person, error)
+	// DeletePerson permanently deletes the person, returning ErrStillReferenced when other records still reference it.
+	DeletePerson(context context.Context, userName string) error
+	// GetPersonByAlias finds the person into which the person with the given username was merged. Nil is returned when
+	// the username is not an alias.
+	GetPersonByAlias(context context.Context, alias string) (*entity.Person, error)
+	// MergePeople moves every record of the duplicate to the survivor, dropping the ones the survivor already has,
+	// deletes the duplicate leaving its username as an alias of the survivor and saves the contact details of the
+	// survivor on behalf of its UpdatedBy, all within one transaction. Of two playing memberships of both people in
+	// different teams that overlap, the earlier one ends the day before the later one starts, and the one of the
+	// duplicate is dropped when both start on the same day.
+	MergePeople(context context.Context, survivor *entity.Person, duplicate *entity.Person) (*entity.Person, error)
+	// UpdatePersonBirthDate saves the birth date of the person on behalf of its UpdatedBy. Nil is returned when there
+	// is no such person.
+	UpdatePersonBirthDate(context context.Context, person *entity.Person) (*entity.Person, error)
+	// UpdatePersonVisibility saves the visibilities of the contact details of the person on behalf of its UpdatedBy. Nil
+	// is returned when there is no such person.
+	UpdatePersonVisibility(context context.Context, person *entity.Person) (*entity.Person, error)
+	// UpdatePersonAvatar saves the AvatarKey and AvatarThumbnailKey of the person on behalf of its UpdatedBy. Nil is
+	// returned when there is no such person.
+	UpdatePersonAvatar(context context.Context, person *entity.Person) (*entity.Person, error)
+	// GetPersonalData gathers the records that reference the person and the audit entries of what they did.
+	GetPersonalData(context context.Context, person *entity.Person) (*entity.PersonalData, error)
+	// ErasePerson replaces the person by the anonymized one in every record, including the audit columns, and deletes
+	// the original person along with their guardianships, consents and aliases, all within one transaction.
+	ErasePerson(context context.Context, person *entity.Person, anonymized *entity.Person) (*entity.Person, error)
+	// SearchPeople finds up to limit people that are not archived whose name matches the query, ignoring accents and
+	// casing and tolerating typos. The best ranked people come first, and then by username.
+	SearchPeople(context context.Context, query string, limit int) ([]*entity.SearchResult, error)
 	// UpdatePerson(context context.Context, person *entity.Person, updatedAttributes []entity.PersonAttribute) (*entity.Person, error)
 }
@@ -2,19 +2,54 @@ package repository
 
 import (
 	"context"
-
-	"errors"
+	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
 )
 
 // ErrAlreadyExists is returned by repository implementations when an entity
 // cannot be created because a unique constraint (eg. name) already exists.
-var ErrAlreadyExists = errors.New("repository: already exists")
+var ErrAlreadyExists = failure.ErrAlreadyExists
+
+// ErrStillReferenced is returned by repository implementations when an entity
+// cannot be deleted because other records (eg. memberships) still reference it.
+var ErrStillReferenced = failure.ErrStillReferenced
 
 type Team interface {
-	GetAllTeams(context context.Context) ([]*entity.Team, error)
+	// GetAllTeams leaves the archived teams out unless includeArchived is set. The teams are narrowed down and ordered
+	// by the criteria and then by slug, which is the key that the page follows.
+	GetAllTeams(
+		context context.Context,
+		includeArchived bool,
+		criteria entity.Criteria,
+		page entity.PageRequest,
+	) ([]*entity.Team, error)
+	// GetTeamByName finds archived teams as well, so their history is still reachable.
 	GetTeamByName(context context.Context, name string) (*entity.Team, error)
 	CreateTeam(context context.Context, team *entity.Team) (*entity.Team, error)
 	UpdateTeam(context context.Context, team *entity.Team, updatedAttributes []entity.TeamAttribute) (*entity.Team, error)
+	// ArchiveTeam soft deletes the team on behalf of its DeletedBy. Nil is returned when there is no such team that
+	// is not archived yet.
+	ArchiveTeam(context context.Context, team *entity.Team) (*entity.Team, error)
+	// RestoreTeam brings an archived team back on behalf of its UpdatedBy. Nil is returned when there is no such
+	// archived team.
+	RestoreTeam(context context.Context, team *entity.Team) (*entity.Team, error)
+	GetTeamsArchivedBefore(context context.Context, date time.Time) ([]*entity.Team, error)
+	// DeleteTeam permanently deletes the team, returning ErrStillReferenced when other records still reference it.
+	DeleteTeam(context context.Context, slug string) error
+	// GetTeamByPreviousName finds the team that used the given name or slug most recently before being renamed. Nil
+	// is returned when a team currently has that name, so the current name always takes precedence.
+	GetTeamByPreviousName(context context.Context, nameOrSlug string) (*entity.Team, error)
+	// GetPreviousTeamNames returns the names that the team used before being renamed, the oldest first.
+	GetPreviousTeamNames(context context.Context, slug string) ([]*entity.TeamName, error)
+	// RenameTeam records the current name of the team as a previous name and saves the new Name and Slug of the team
+	// on behalf of its UpdatedBy. It returns ErrAlreadyExists when another team already has the new name or slug.
+	RenameTeam(context context.Context, slug string, team *entity.Team, previousName *entity.TeamName) (*entity.Team, error)
+	// UpdateTeamLogo saves the LogoKey and LogoThumbnailKey of the team on behalf of its UpdatedBy. Nil is returned
+	// when there is no such team.
+	UpdateTeamLogo(context context.Context, team *entity.Team) (*entity.Team, error)
+	// SearchTeams finds up to limit teams that are not archived whose name or description matches the query, ignoring
+	// accents and casing and tolerating typos in the name. The best ranked teams come first, and then by slug.
+	SearchTeams(context context.Context, query string, limit int) ([]*entity.SearchResult, error)
 }
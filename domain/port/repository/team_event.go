@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// TeamEvent deals with team events and their participations. Zero from/to times leave the period unbounded.
+type TeamEvent interface {
+	GetTeamEventsByTeamSlug(context context.Context, teamSlug string, from, to time.Time) ([]*entity.TeamEvent, error)
+	GetTeamEventByID(context context.Context, id string) (*entity.TeamEvent, error)
+	CreateTeamEvent(context context.Context, event *entity.TeamEvent) (*entity.TeamEvent, error)
+	GetTeamEventParticipationsByTeamSlug(context context.Context, teamSlug string, from, to time.Time) ([]*entity.TeamEventParticipation, error)
+	SaveTeamEventRSVP(context context.Context, participation *entity.TeamEventParticipation) (*entity.TeamEventParticipation, error)
+	SaveTeamEventAttendance(context context.Context, participations []*entity.TeamEventParticipation) error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+)
+
+// TransactionManager runs operations that span several repositories atomically. Repositories called with the
+// transaction context join the ongoing transaction instead of starting their own.
+type TransactionManager interface {
+	RunInTransaction(context context.Context, operation func(transactionContext context.Context) error) error
+}
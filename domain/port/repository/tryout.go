@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type Tryout interface {
+	GetTryoutsByTeamSlug(context context.Context, teamSlug string) ([]*entity.Tryout, error)
+	GetTryoutByID(context context.Context, id string) (*entity.Tryout, error)
+	CreateTryout(context context.Context, tryout *entity.Tryout) (*entity.Tryout, error)
+
+	GetTryoutCandidates(context context.Context, tryoutID string) ([]*entity.TryoutCandidate, error)
+	GetTryoutCandidate(context context.Context, tryoutID, personUserName string) (*entity.TryoutCandidate, error)
+	// CreateTryoutCandidate returns ErrAlreadyExists when the person already signed up for the tryout.
+	CreateTryoutCandidate(context context.Context, candidate *entity.TryoutCandidate) (*entity.TryoutCandidate, error)
+	SaveTryoutDecision(context context.Context, candidate *entity.TryoutCandidate) (*entity.TryoutCandidate, error)
+
+	GetTryoutEvaluations(context context.Context, tryoutID string) ([]*entity.TryoutEvaluation, error)
+	// SaveTryoutEvaluations replaces previous scores given by the same evaluator to the same candidate and criterion.
+	SaveTryoutEvaluations(context context.Context, evaluations []*entity.TryoutEvaluation) error
+}
@@ -0,0 +1,17 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// Storage keeps media objects, such as team logos and person avatars, addressed by their keys.
+type Storage interface {
+	// PutObject saves the object under its key, replacing any object that was already saved under it.
+	PutObject(context context.Context, object *entity.MediaObject) error
+	// GetObject returns the object saved under the key. Nil is returned when there is no such object.
+	GetObject(context context.Context, key string) (*entity.MediaObject, error)
+	// DeleteObject deletes the object saved under the key, which is not an error when there is no such object.
+	DeleteObject(context context.Context, key string) error
+}
@@ -0,0 +1,66 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/deduplication"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// MinimumQueryLength is the number of characters from which a query is long enough to be searched.
+const MinimumQueryLength = 2
+
+// minimumTypoTermLength is the number of characters from which a term is long enough to be matched despite a typo.
+const minimumTypoTermLength = 4
+
+// minimumWordSimilarity is the similarity from which a word that does not start with a term still matches it.
+const minimumWordSimilarity = 0.75
+
+// Terms splits the query into its words, without accents, punctuation or casing.
+func Terms(query string) []string {
+	return strings.Fields(deduplication.NormalizeName(query))
+}
+
+// Highlight returns the spans of the words of the text that match a term of the query, ignoring accents and casing.
+// A word matches a term that it starts with, so partial queries are highlighted as well, or a term that is similar
+// enough, so that "Joao" highlights "João" and "Silvia" highlights "Silva".
+func Highlight(text string, query string) []entity.TextSpan {
+	terms := Terms(query)
+	spans := []entity.TextSpan{}
+	if len(terms) == 0 {
+		return spans
+	}
+
+	wordStart := -1
+	for index, character := range text + " " {
+		// Decomposed accents are combining marks, which are part of the word
+		isWordCharacter := unicode.IsLetter(character) || unicode.IsDigit(character) || unicode.Is(unicode.Mn, character)
+		if isWordCharacter && wordStart < 0 {
+			wordStart = index
+		}
+		if !isWordCharacter && wordStart >= 0 {
+			if matchesAnyTerm(text[wordStart:index], terms) {
+				spans = append(spans, entity.TextSpan{Start: wordStart, End: index})
+			}
+			wordStart = -1
+		}
+	}
+
+	return spans
+}
+
+func matchesAnyTerm(word string, terms []string) bool {
+	normalizedWord := deduplication.NormalizeName(word)
+	for _, term := range terms {
+		if strings.HasPrefix(normalizedWord, term) {
+			return true
+		}
+
+		if len([]rune(term)) >= minimumTypoTermLength && deduplication.NameSimilarity(normalizedWord, term) >= minimumWordSimilarity {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+//go:build unit
+// +build unit
+
+package search_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/search"
+)
+
+func TestSearch_Highlight(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description   string
+		text          string
+		query         string
+		expectedWords []string
+	}{
+		{
+			description:   "should highlight an accented word from a query without accents",
+			text:          "João da Silva",
+			query:         "Joao",
+			expectedWords: []string{"João"},
+		},
+		{
+			description:   "should highlight a word without accents from an accented query",
+			text:          "Sao Paulo Ultimate",
+			query:         "são paulo",
+			expectedWords: []string{"Sao", "Paulo"},
+		},
+		{
+			description:   "should highlight the words that start with a partial query",
+			text:          "Frisbee Club of Florianópolis",
+			query:         "flo",
+			expectedWords: []string{"Florianópolis"},
+		},
+		{
+			description:   "should highlight a word despite a typo in the query",
+			text:          "Maria Silva",
+			query:         "silvia",
+			expectedWords: []string{"Silva"},
+		},
+		{
+			description:   "should not highlight a short word that is only similar to the query",
+			text:          "Ana Maria",
+			query:         "ama",
+			expectedWords: []string{},
+		},
+		{
+			description:   "should not highlight anything for a query without words",
+			text:          "Ana Maria",
+			query:         "...",
+			expectedWords: []string{},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			spans := search.Highlight(scenario.text, scenario.query)
+
+			highlightedWords := make([]string, 0, len(spans))
+			for _, span := range spans {
+				highlightedWords = append(highlightedWords, highlightedWord(scenario.text, span))
+			}
+			require.Equal(t, scenario.expectedWords, highlightedWords)
+		})
+	}
+}
+
+func TestSearch_Terms(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"joao", "silva"}, search.Terms("  João, SILVA "))
+	require.Empty(t, search.Terms("- !"))
+}
+
+func highlightedWord(text string, span entity.TextSpan) string {
+	return text[span.Start:span.End]
+}
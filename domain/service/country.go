@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+// GetAllCountries lists the countries of the ISO 3166-1 standard sorted by their names in the requested locale.
+func GetAllCountries(
+	context context.Context,
+	param domainServiceParam.GetAllCountries,
+) (domainServiceResult.GetAllCountries, error) {
+	countries, err := param.Repository.GetAllCountries(context)
+	if err != nil {
+		return domainServiceResult.GetAllCountries{}, fmt.Errorf("failed to get all countries from repository: %w", err)
+	}
+	entity.SortCountriesByName(countries, param.Locale)
+
+	return domainServiceResult.GetAllCountries{
+		Countries: countries,
+	}, nil
+}
+
+// getCountryCode returns the alpha-2 code of the country identified by the value, which is how countries are stored.
+// The value may be any of the codes, names or aliases of the country. It fails with failure.ErrInvalidInput about the
+// field when no country matches the value, whose attribute of the entity is told in the message.
+func getCountryCode(
+	context context.Context,
+	value string,
+	field string,
+	entityName string,
+	attributeName string,
+	countryRepository repository.Country,
+) (string, error) {
+	country, err := countryRepository.FindCountry(context, value)
+	if err != nil {
+		return "", fmt.Errorf("failed to find country '%s' in repository: %w", value, err)
+	}
+	if country == nil {
+		message := fmt.Sprintf(
+			"the %s's '%s' should be an ISO 3166-1 alpha-2 or alpha-3 code or the name of a country", entityName, attributeName,
+		)
+
+		return "", failure.ErrInvalidInput.WithMessage(message).WithFields(failure.FieldError{Field: field, Message: message})
+	}
+
+	return country.Alpha2Code, nil
+}
@@ -0,0 +1,186 @@
+//go:build unit
+// +build unit
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// fakeCountryRepository answers the given countries from memory, matching them by their codes and names.
+type fakeCountryRepository struct {
+	countries []*entity.Country
+}
+
+func (fake *fakeCountryRepository) GetAllCountries(_ context.Context) ([]*entity.Country, error) {
+	countries := make([]*entity.Country, 0, len(fake.countries))
+	for _, country := range fake.countries {
+		countries = append(countries, country.Clone())
+	}
+
+	return countries, nil
+}
+
+func (fake *fakeCountryRepository) FindCountry(_ context.Context, value string) (*entity.Country, error) {
+	for _, country := range fake.countries {
+		names := []string{country.Alpha2Code, country.Alpha3Code, country.Name}
+		for _, localizedName := range country.LocalizedNames {
+			names = append(names, localizedName)
+		}
+		for _, name := range names {
+			if strings.EqualFold(name, strings.TrimSpace(value)) {
+				return country.Clone(), nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// fakeTeamRepository keeps the teams it creates. The methods it does not implement panic through the nil embedded
+// interface.
+type fakeTeamRepository struct {
+	repository.Team
+	createdTeams []*entity.Team
+}
+
+func (fake *fakeTeamRepository) CreateTeam(_ context.Context, team *entity.Team) (*entity.Team, error) {
+	fake.createdTeams = append(fake.createdTeams, team)
+
+	return team, nil
+}
+
+func countryTestCountries() []*entity.Country {
+	return []*entity.Country{
+		{
+			Alpha2Code: "BR",
+			Alpha3Code: "BRA",
+			Name:       "Brazil",
+			LocalizedNames: map[entity.CountryLocale]string{
+				entity.CountryLocales.German:     "Brasilien",
+				entity.CountryLocales.Portuguese: "Brasil",
+			},
+		},
+		{
+			Alpha2Code: "DE",
+			Alpha3Code: "DEU",
+			Name:       "Germany",
+			LocalizedNames: map[entity.CountryLocale]string{
+				entity.CountryLocales.German:     "Deutschland",
+				entity.CountryLocales.Portuguese: "Alemanha",
+			},
+		},
+	}
+}
+
+func TestGetAllCountries(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description         string
+		locale              entity.CountryLocale
+		expectedAlpha2Codes []string
+	}{
+		{
+			description:         "should sort the countries by their English names",
+			locale:              entity.CountryLocales.English,
+			expectedAlpha2Codes: []string{"BR", "DE"},
+		},
+		{
+			description:         "should sort the countries by their names in the requested locale",
+			locale:              entity.CountryLocales.Portuguese,
+			expectedAlpha2Codes: []string{"DE", "BR"},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := domainService.GetAllCountries(context.Background(), domainServiceParam.GetAllCountries{
+				Locale:     scenario.locale,
+				Repository: &fakeCountryRepository{countries: countryTestCountries()},
+			})
+			require.NoError(t, err)
+
+			obtainedAlpha2Codes := []string{}
+			for _, country := range result.Countries {
+				obtainedAlpha2Codes = append(obtainedAlpha2Codes, country.Alpha2Code)
+			}
+			require.Equal(t, scenario.expectedAlpha2Codes, obtainedAlpha2Codes)
+		})
+	}
+}
+
+func TestCreateTeam_OriginCountry(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description           string
+		originCountry         string
+		expectedOriginCountry string
+		expectedMessage       string
+	}{
+		{
+			description:           "should keep an alpha-2 code",
+			originCountry:         "BR",
+			expectedOriginCountry: "BR",
+		},
+		{
+			description:           "should store the alpha-2 code of an alpha-3 code",
+			originCountry:         "deu",
+			expectedOriginCountry: "DE",
+		},
+		{
+			description:           "should store the alpha-2 code of a localized name",
+			originCountry:         "Brasil",
+			expectedOriginCountry: "BR",
+		},
+		{
+			description:     "should refuse an unknown country without creating the team",
+			originCountry:   "Atlantis",
+			expectedMessage: "the Team's 'Origin Country' should be an ISO 3166-1 alpha-2 or alpha-3 code or the name of a country",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			teamRepository := &fakeTeamRepository{}
+			team := &entity.Team{Slug: "bra-sp-my-team-slug", Name: "My Team Name", OriginCountry: scenario.originCountry}
+
+			result, err := domainService.CreateTeam(context.Background(), domainServiceParam.CreateTeam{
+				Team:              team,
+				Repository:        teamRepository,
+				CountryRepository: &fakeCountryRepository{countries: countryTestCountries()},
+			})
+
+			if scenario.expectedMessage != "" {
+				require.True(t, errors.Is(err, failure.ErrInvalidInput))
+				require.Equal(t, scenario.expectedMessage, err.Error())
+				require.Equal(t, []failure.FieldError{{Field: "originCountry", Message: scenario.expectedMessage}}, failure.Of(err).Fields)
+				require.Empty(t, teamRepository.createdTeams)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, scenario.expectedOriginCountry, result.Team.OriginCountry)
+			require.Len(t, teamRepository.createdTeams, 1)
+			require.Equal(t, scenario.originCountry, team.OriginCountry)
+		})
+	}
+}
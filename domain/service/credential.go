@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+func GetPersonCredentials(
+	context context.Context,
+	param domainServiceParam.GetPersonCredentials,
+) (domainServiceResult.GetPersonCredentials, error) {
+	emptyResult := domainServiceResult.GetPersonCredentials{
+		WFDFAccreditations:    []*entity.WFDFAccreditation{},
+		FederationMemberships: []*entity.FederationMembership{},
+		CoachCertifications:   []*entity.CoachCertification{},
+	}
+
+	accreditations, err := param.Repository.GetWFDFAccreditationsByPersonUserName(context, param.PersonUserName)
+	if err != nil {
+		return emptyResult, fmt.Errorf("failed to fetch WFDF accreditations of '%s' from repository: %w", param.PersonUserName, err)
+	}
+
+	memberships, err := param.Repository.GetFederationMembershipsByPersonUserName(context, param.PersonUserName)
+	if err != nil {
+		return emptyResult, fmt.Errorf("failed to fetch federation memberships of '%s' from repository: %w", param.PersonUserName, err)
+	}
+
+	certifications, err := param.Repository.GetCoachCertificationsByPersonUserName(context, param.PersonUserName)
+	if err != nil {
+		return emptyResult, fmt.Errorf("failed to fetch coach certifications of '%s' from repository: %w", param.PersonUserName, err)
+	}
+
+	return domainServiceResult.GetPersonCredentials{
+		WFDFAccreditations:    accreditations,
+		FederationMemberships: memberships,
+		CoachCertifications:   certifications,
+	}, nil
+}
+
+// CreateWFDFAccreditation registers the WFDF rules accreditation of a person, failing with failure.ErrAlreadyExists
+// when the person already has an accreditation of the same level issued on the same date.
+func CreateWFDFAccreditation(
+	context context.Context,
+	param domainServiceParam.CreateWFDFAccreditation,
+) (domainServiceResult.CreateWFDFAccreditation, error) {
+	accreditation, err := param.Repository.CreateWFDFAccreditation(context, param.Accreditation)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.CreateWFDFAccreditation{}, failure.ErrAlreadyExists.WithMessage(fmt.Sprintf(
+			"'%s' already has a WFDF accreditation of level %s issued on %s",
+			param.Accreditation.Person.UserName,
+			param.Accreditation.Level,
+			param.Accreditation.IssueDate.Format(time.DateOnly),
+		))
+	}
+	if err != nil {
+		return domainServiceResult.CreateWFDFAccreditation{}, fmt.Errorf(
+			"failed to create WFDF accreditation of '%s' in repository: %w", param.Accreditation.Person.UserName, err,
+		)
+	}
+
+	return domainServiceResult.CreateWFDFAccreditation{
+		Accreditation: accreditation,
+	}, nil
+}
+
+// CreateFederationMembership registers the membership of a person in a national federation for a season. Nothing is
+// saved when the legal entity is not a federation, failing with failure.ErrNotFederation, nor when the person is
+// already a member of the federation in the season, failing with failure.ErrAlreadyExists.
+func CreateFederationMembership(
+	context context.Context,
+	param domainServiceParam.CreateFederationMembership,
+) (domainServiceResult.CreateFederationMembership, error) {
+	federation := param.Membership.Federation
+	if federation.Kind != entity.LegalEntityKinds.Federation {
+		return domainServiceResult.CreateFederationMembership{}, failure.ErrNotFederation.WithMessage(
+			fmt.Sprintf("the legal entity '%s' is a %s, not a Federation", federation.Slug, federation.Kind),
+		)
+	}
+
+	membership, err := param.Repository.CreateFederationMembership(context, param.Membership)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.CreateFederationMembership{}, failure.ErrAlreadyExists.WithMessage(fmt.Sprintf(
+			"'%s' is already a member of federation '%s' in season '%s'",
+			param.Membership.Person.UserName,
+			federation.Slug,
+			param.Membership.Season,
+		))
+	}
+	if err != nil {
+		return domainServiceResult.CreateFederationMembership{}, fmt.Errorf(
+			"failed to create membership of '%s' in federation '%s' in repository: %w",
+			param.Membership.Person.UserName,
+			param.Membership.Federation.Slug,
+			err,
+		)
+	}
+
+	return domainServiceResult.CreateFederationMembership{
+		Membership: membership,
+	}, nil
+}
+
+// CreateCoachCertification registers a coaching certification of a person, failing with failure.ErrAlreadyExists when
+// the person already has the same certification issued on the same date.
+func CreateCoachCertification(
+	context context.Context,
+	param domainServiceParam.CreateCoachCertification,
+) (domainServiceResult.CreateCoachCertification, error) {
+	certification, err := param.Repository.CreateCoachCertification(context, param.Certification)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.CreateCoachCertification{}, failure.ErrAlreadyExists.WithMessage(fmt.Sprintf(
+			"'%s' already has the '%s' certification issued on %s",
+			param.Certification.Person.UserName,
+			param.Certification.Name,
+			param.Certification.IssueDate.Format(time.DateOnly),
+		))
+	}
+	if err != nil {
+		return domainServiceResult.CreateCoachCertification{}, fmt.Errorf(
+			"failed to create coach certification of '%s' in repository: %w", param.Certification.Person.UserName, err,
+		)
+	}
+
+	return domainServiceResult.CreateCoachCertification{
+		Certification: certification,
+	}, nil
+}
+
+// GetTeamExpiringWFDFAccreditations lists the players of the Roster of the team for a tournament whose WFDF
+// accreditation is missing or is not valid on the given date, usually the first day of the tournament. Without a
+// Roster, the players are the active members of the team on that date, whose staff members are not listed. It fails
+// with failure.ErrPersonNotFound when someone in the Roster is not registered.
+func GetTeamExpiringWFDFAccreditations(
+	context context.Context,
+	param domainServiceParam.GetTeamExpiringWFDFAccreditations,
+) (domainServiceResult.GetTeamExpiringWFDFAccreditations, error) {
+	playerUserNames, err := teamPlayerUserNames(context, param)
+	if err != nil {
+		return domainServiceResult.GetTeamExpiringWFDFAccreditations{
+			Statuses: []*entity.WFDFAccreditationStatus{},
+		}, err
+	}
+
+	accreditations, err := param.CredentialRepository.GetWFDFAccreditationsByPersonUserNames(context, playerUserNames)
+	if err != nil {
+		return domainServiceResult.GetTeamExpiringWFDFAccreditations{
+			Statuses: []*entity.WFDFAccreditationStatus{},
+		}, fmt.Errorf("failed to fetch WFDF accreditations of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	accreditationsByUserName := map[string][]*entity.WFDFAccreditation{}
+	for _, accreditation := range accreditations {
+		userName := accreditation.Person.UserName
+		accreditationsByUserName[userName] = append(accreditationsByUserName[userName], accreditation)
+	}
+
+	statuses := []*entity.WFDFAccreditationStatus{}
+	for _, userName := range playerUserNames {
+		if entity.HasValidWFDFAccreditationAt(accreditationsByUserName[userName], param.Date) {
+			continue
+		}
+		statuses = append(statuses, &entity.WFDFAccreditationStatus{
+			Person:        &entity.Person{UserName: userName},
+			Accreditation: entity.MostLastingWFDFAccreditation(accreditationsByUserName[userName]),
+		})
+	}
+
+	return domainServiceResult.GetTeamExpiringWFDFAccreditations{
+		Statuses: statuses,
+	}, nil
+}
+
+// teamPlayerUserNames returns the usernames of the Roster, once each, or of the active players of the team on the date
+// when there is no Roster.
+func teamPlayerUserNames(
+	context context.Context,
+	param domainServiceParam.GetTeamExpiringWFDFAccreditations,
+) ([]string, error) {
+	if len(param.Roster) > 0 {
+		roster := uniqueStrings(param.Roster)
+		for _, userName := range roster {
+			person, err := param.PersonRepository.GetPersonByUserName(context, userName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch person '%s' from repository: %w", userName, err)
+			}
+			if person == nil {
+				return nil, rosterPersonNotFound(param.TeamSlug, userName)
+			}
+		}
+
+		return roster, nil
+	}
+
+	memberships, err := param.MembershipRepository.GetMembershipsByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	playerMemberships := []entity.Membership{}
+	for _, membership := range memberships {
+		if entity.IsPlayingRole(membership.Role) {
+			playerMemberships = append(playerMemberships, membership)
+		}
+	}
+
+	return activeMemberUserNamesAt(playerMemberships, param.Date), nil
+}
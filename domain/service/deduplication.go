@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/deduplication"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+// FindDuplicatePeople compares every person that is not archived with each other and returns the pairs that are
+// likely the same person, the most likely first.
+func FindDuplicatePeople(
+	context context.Context,
+	param domainServiceParam.FindDuplicatePeople,
+) (domainServiceResult.FindDuplicatePeople, error) {
+	people, err := param.Repository.GetAllPeople(context, false, entity.Criteria{}, entity.PageRequest{})
+	if err != nil {
+		return domainServiceResult.FindDuplicatePeople{
+			Pairs: []deduplication.Pair{},
+		}, fmt.Errorf("failed to fetch all people from repository: %w", err)
+	}
+
+	return domainServiceResult.FindDuplicatePeople{
+		Pairs: deduplication.FindDuplicates(people, param.MinimumScore),
+	}, nil
+}
+
+// MergePeople merges the duplicate into the person, which keeps its own details and takes the missing ones from the
+// duplicate. The result holds a nil Person or Duplicate when there is no person with the corresponding username. The
+// playing memberships of both people in different teams that overlap are shortened to fit a single person.
+func MergePeople(
+	context context.Context,
+	param domainServiceParam.MergePeople,
+) (domainServiceResult.MergePeople, error) {
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.MergePeople{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.MergePeople{}, nil
+	}
+
+	duplicate, err := param.Repository.GetPersonByUserName(context, param.DuplicateUserName)
+	if err != nil {
+		return domainServiceResult.MergePeople{
+			Person: person,
+		}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.DuplicateUserName, err)
+	}
+	if duplicate == nil {
+		return domainServiceResult.MergePeople{
+			Person: person,
+		}, nil
+	}
+
+	survivor := deduplication.Merge(person, duplicate).WithUpdatedBy(param.UpdatedBy)
+	mergedPerson, err := param.Repository.MergePeople(context, survivor, duplicate)
+	if err != nil {
+		return domainServiceResult.MergePeople{
+			Person:    person,
+			Duplicate: duplicate,
+		}, fmt.Errorf("failed to merge person '%s' into '%s' in repository: %w", param.DuplicateUserName, param.UserName, err)
+	}
+
+	return domainServiceResult.MergePeople{
+		Person:       person,
+		Duplicate:    duplicate,
+		MergedPerson: mergedPerson,
+	}, nil
+}
+
+// GetPersonByAlias finds the person into which the person with the given username was merged. The result holds a nil
+// Person when the username is not an alias.
+func GetPersonByAlias(
+	context context.Context,
+	param domainServiceParam.GetPersonByAlias,
+) (domainServiceResult.GetPersonByAlias, error) {
+	person, err := param.Repository.GetPersonByAlias(context, param.Alias)
+	if err != nil {
+		return domainServiceResult.GetPersonByAlias{}, fmt.Errorf("failed to fetch person by alias '%s' from repository: %w", param.Alias, err)
+	}
+
+	return domainServiceResult.GetPersonByAlias{
+		Person: person,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+// CheckRosterEligibility runs the rules of an event against every person of the roster of the team and returns all
+// the violations found. It fails with failure.ErrPersonNotFound when someone in the roster is not registered.
+func CheckRosterEligibility(
+	context context.Context,
+	param domainServiceParam.CheckRosterEligibility,
+) (domainServiceResult.CheckRosterEligibility, error) {
+	teamMemberships, err := param.MembershipRepository.GetMembershipsByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.CheckRosterEligibility{
+			Violations: []eligibility.Violation{},
+		}, fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	accreditations, err := param.CredentialRepository.GetWFDFAccreditationsByPersonUserNames(context, param.Roster)
+	if err != nil {
+		return domainServiceResult.CheckRosterEligibility{
+			Violations: []eligibility.Violation{},
+		}, fmt.Errorf("failed to fetch WFDF accreditations of the roster of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	violations := []eligibility.Violation{}
+	for _, userName := range param.Roster {
+		person, err := param.PersonRepository.GetPersonByUserName(context, userName)
+		if err != nil {
+			return domainServiceResult.CheckRosterEligibility{
+				Violations: []eligibility.Violation{},
+			}, fmt.Errorf("failed to fetch person '%s' from repository: %w", userName, err)
+		}
+		if person == nil {
+			return domainServiceResult.CheckRosterEligibility{
+				Violations: []eligibility.Violation{},
+			}, rosterPersonNotFound(param.TeamSlug, userName)
+		}
+
+		consents, err := param.GuardianRepository.GetConsentsByPersonUserName(context, person.UserName)
+		if err != nil {
+			return domainServiceResult.CheckRosterEligibility{
+				Violations: []eligibility.Violation{},
+			}, fmt.Errorf("failed to fetch consents of '%s' from repository: %w", person.UserName, err)
+		}
+
+		violations = append(violations, eligibility.Evaluate(eligibility.Candidate{
+			Person:      person,
+			Team:        &entity.Team{Slug: param.TeamSlug},
+			Memberships: personMemberships(teamMemberships, person.UserName),
+
+			WFDFAccreditations: personWFDFAccreditations(accreditations, person.UserName),
+			Consents:           consents,
+
+			EventStartDate: param.EventStartDate,
+			EventEndDate:   param.EventEndDate,
+		}, param.Rules)...)
+	}
+
+	return domainServiceResult.CheckRosterEligibility{
+		Eligible:   len(violations) == 0,
+		Violations: violations,
+	}, nil
+}
+
+// checkGuardianConsent applies the eligibility.GuardianConsentRule to a person who joins a team on the given date,
+// failing with failure.ErrMissingConsent when they are a minor without the consents. Unlike in the event rosters, a
+// person whose birth date is unknown can join, since most adults never registered it.
+func checkGuardianConsent(
+	context context.Context,
+	person *entity.Person,
+	date time.Time,
+	guardianRepository repository.Guardian,
+) error {
+	if !person.IsMinorAt(date) {
+		return nil
+	}
+
+	consents, err := guardianRepository.GetConsentsByPersonUserName(context, person.UserName)
+	if err != nil {
+		return fmt.Errorf("failed to fetch consents of '%s' from repository: %w", person.UserName, err)
+	}
+
+	violations := eligibility.Evaluate(eligibility.Candidate{
+		Person:         person,
+		Consents:       consents,
+		EventStartDate: date,
+		EventEndDate:   date,
+	}, []eligibility.Rule{eligibility.GuardianConsentRule{}})
+	if len(violations) > 0 {
+		return failure.ErrMissingConsent.WithMessage(fmt.Sprintf(
+			"'%s' is a minor and needs the medical and travel consents of a guardian to join the team", person.UserName,
+		)).WithFields(failure.FieldError{
+			Field:   "username",
+			Message: violations[0].Reason,
+		})
+	}
+
+	return nil
+}
+
+func personMemberships(memberships []entity.Membership, userName string) []entity.Membership {
+	personMemberships := []entity.Membership{}
+	for _, membership := range memberships {
+		if membership.Person != nil && membership.Person.UserName == userName {
+			personMemberships = append(personMemberships, membership)
+		}
+	}
+
+	return personMemberships
+}
+
+func personWFDFAccreditations(accreditations []*entity.WFDFAccreditation, userName string) []*entity.WFDFAccreditation {
+	personAccreditations := []*entity.WFDFAccreditation{}
+	for _, accreditation := range accreditations {
+		if accreditation.Person != nil && accreditation.Person.UserName == userName {
+			personAccreditations = append(personAccreditations, accreditation)
+		}
+	}
+
+	return personAccreditations
+}
+
+// rosterPersonNotFound is the failure of a roster of the team with someone who is not registered.
+func rosterPersonNotFound(teamSlug string, userName string) error {
+	return failure.ErrPersonNotFound.WithMessage(
+		fmt.Sprintf("a person of the roster of team '%s' was not found in the repository", teamSlug),
+	).WithFields(failure.FieldError{
+		Field:   "roster",
+		Message: fmt.Sprintf("no person with username '%s' was found in the repository", userName),
+	})
+}
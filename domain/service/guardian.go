@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+func GetGuardians(
+	context context.Context,
+	param domainServiceParam.GetGuardians,
+) (domainServiceResult.GetGuardians, error) {
+	guardianships, err := param.Repository.GetGuardianshipsByDependentUserName(context, param.DependentUserName)
+	if err != nil {
+		return domainServiceResult.GetGuardians{
+			Guardianships: []*entity.Guardianship{},
+		}, fmt.Errorf("failed to fetch guardians of '%s' from repository: %w", param.DependentUserName, err)
+	}
+
+	return domainServiceResult.GetGuardians{
+		Guardianships: guardianships,
+	}, nil
+}
+
+// AddGuardian makes a person answer for a dependent, failing with failure.ErrAlreadyExists when they already do.
+func AddGuardian(
+	context context.Context,
+	param domainServiceParam.AddGuardian,
+) (domainServiceResult.AddGuardian, error) {
+	guardianship, err := param.Repository.CreateGuardianship(context, param.Guardianship)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.AddGuardian{}, failure.ErrAlreadyExists.WithMessage(fmt.Sprintf(
+			"'%s' is already a guardian of '%s'", param.Guardianship.Guardian.UserName, param.Guardianship.Dependent.UserName,
+		))
+	}
+	if err != nil {
+		return domainServiceResult.AddGuardian{}, fmt.Errorf(
+			"failed to add '%s' as a guardian of '%s' in repository: %w",
+			param.Guardianship.Guardian.UserName,
+			param.Guardianship.Dependent.UserName,
+			err,
+		)
+	}
+
+	return domainServiceResult.AddGuardian{
+		Guardianship: guardianship,
+	}, nil
+}
+
+// RemoveGuardian stops a person from answering for a dependent. The consents that they already granted are kept,
+// since they were valid when granted. The result holds a nil Guardianship when the person is not a guardian of the
+// dependent.
+func RemoveGuardian(
+	context context.Context,
+	param domainServiceParam.RemoveGuardian,
+) (domainServiceResult.RemoveGuardian, error) {
+	guardianship, err := param.Repository.DeleteGuardianship(context, param.GuardianUserName, param.DependentUserName)
+	if err != nil {
+		return domainServiceResult.RemoveGuardian{}, fmt.Errorf(
+			"failed to remove '%s' as a guardian of '%s' in repository: %w",
+			param.GuardianUserName,
+			param.DependentUserName,
+			err,
+		)
+	}
+
+	return domainServiceResult.RemoveGuardian{
+		Guardianship: guardianship,
+	}, nil
+}
+
+func GetConsents(
+	context context.Context,
+	param domainServiceParam.GetConsents,
+) (domainServiceResult.GetConsents, error) {
+	consents, err := param.Repository.GetConsentsByPersonUserName(context, param.PersonUserName)
+	if err != nil {
+		return domainServiceResult.GetConsents{
+			Consents: []*entity.Consent{},
+		}, fmt.Errorf("failed to fetch consents of '%s' from repository: %w", param.PersonUserName, err)
+	}
+
+	return domainServiceResult.GetConsents{
+		Consents: consents,
+	}, nil
+}
+
+// GrantConsent records a consent granted on behalf of a person. Only the guardians of the person can grant it; when
+// GrantedBy is not one of them, nothing is saved, failing with failure.ErrNotGuardian. Nothing is saved either when the
+// person already has a consent of the same kind granted on the same date, failing with failure.ErrAlreadyExists.
+func GrantConsent(
+	context context.Context,
+	param domainServiceParam.GrantConsent,
+) (domainServiceResult.GrantConsent, error) {
+	personUserName := param.Consent.Person.UserName
+
+	guardianships, err := param.Repository.GetGuardianshipsByDependentUserName(context, personUserName)
+	if err != nil {
+		return domainServiceResult.GrantConsent{}, fmt.Errorf("failed to fetch guardians of '%s' from repository: %w", personUserName, err)
+	}
+	if !entity.IsGuardianOf(guardianships, param.Consent.GrantedBy.UserName, personUserName) {
+		return domainServiceResult.GrantConsent{}, failure.ErrNotGuardian.WithMessage(fmt.Sprintf(
+			"'%s' is not a guardian of '%s' and cannot grant consents on their behalf",
+			param.Consent.GrantedBy.UserName,
+			personUserName,
+		))
+	}
+
+	consent, err := param.Repository.CreateConsent(context, param.Consent)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.GrantConsent{}, failure.ErrAlreadyExists.WithMessage(fmt.Sprintf(
+			"'%s' already has a %s consent granted on %s",
+			personUserName,
+			param.Consent.Kind,
+			param.Consent.GrantDate.Format(time.DateOnly),
+		))
+	}
+	if err != nil {
+		return domainServiceResult.GrantConsent{}, fmt.Errorf("failed to create consent of '%s' in repository: %w", personUserName, err)
+	}
+
+	return domainServiceResult.GrantConsent{
+		Consent: consent,
+	}, nil
+}
+
+// GetDependentsSchedule lists the events of the teams of every dependent of the guardian within the period, as long
+// as the dependent is an active member of the team on the day of the event. Events come in chronological order.
+func GetDependentsSchedule(
+	context context.Context,
+	param domainServiceParam.GetDependentsSchedule,
+) (domainServiceResult.GetDependentsSchedule, error) {
+	emptyResult := domainServiceResult.GetDependentsSchedule{
+		Events: []*entity.DependentTeamEvent{},
+	}
+
+	guardianships, err := param.GuardianRepository.GetGuardianshipsByGuardianUserName(context, param.GuardianUserName)
+	if err != nil {
+		return emptyResult, fmt.Errorf("failed to fetch dependents of '%s' from repository: %w", param.GuardianUserName, err)
+	}
+
+	// Siblings often play for the same team, so the events of each team are fetched only once
+	eventsByTeamSlug := map[string][]*entity.TeamEvent{}
+	schedule := []*entity.DependentTeamEvent{}
+	for _, guardianship := range guardianships {
+		dependent := guardianship.Dependent
+
+		memberships, err := param.MembershipRepository.GetMembershipsByPersonUserName(context, dependent.UserName)
+		if err != nil {
+			return emptyResult, fmt.Errorf("failed to fetch memberships of '%s' from repository: %w", dependent.UserName, err)
+		}
+
+		for _, teamSlug := range membershipTeamSlugs(memberships) {
+			events, fetched := eventsByTeamSlug[teamSlug]
+			if !fetched {
+				events, err = param.TeamEventRepository.GetTeamEventsByTeamSlug(context, teamSlug, param.From, param.To)
+				if err != nil {
+					return emptyResult, fmt.Errorf("failed to fetch events of team '%s' from repository: %w", teamSlug, err)
+				}
+				eventsByTeamSlug[teamSlug] = events
+			}
+
+			for _, event := range events {
+				if isActiveMemberOfTeamAt(memberships, teamSlug, eventDate(event)) {
+					schedule = append(schedule, &entity.DependentTeamEvent{
+						Dependent: dependent,
+						Event:     event,
+					})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(schedule, func(i, j int) bool {
+		if !schedule[i].Event.StartTime.Equal(schedule[j].Event.StartTime) {
+			return schedule[i].Event.StartTime.Before(schedule[j].Event.StartTime)
+		}
+
+		return schedule[i].Dependent.UserName < schedule[j].Dependent.UserName
+	})
+
+	return domainServiceResult.GetDependentsSchedule{
+		Events: schedule,
+	}, nil
+}
+
+// membershipTeamSlugs returns the slugs of the teams of the memberships, without repetitions.
+func membershipTeamSlugs(memberships []entity.Membership) []string {
+	teamSlugs := []string{}
+	for _, membership := range memberships {
+		teamSlugs = append(teamSlugs, membership.Team.Slug)
+	}
+
+	return uniqueStrings(teamSlugs)
+}
+
+func isActiveMemberOfTeamAt(memberships []entity.Membership, teamSlug string, date time.Time) bool {
+	for _, membership := range memberships {
+		if membership.Team.Slug == teamSlug && membership.IsActiveAt(date) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+func GetTeamLedgerTransactions(
+	context context.Context,
+	param domainServiceParam.GetTeamLedgerTransactions,
+) (domainServiceResult.GetTeamLedgerTransactions, error) {
+	transactions, err := param.Repository.GetLedgerTransactionsByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.GetTeamLedgerTransactions{
+			Transactions: []*entity.LedgerTransaction{},
+		}, fmt.Errorf("failed to fetch ledger transactions of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	return domainServiceResult.GetTeamLedgerTransactions{
+		Transactions: transactions,
+	}, nil
+}
+
+func GetTeamLedgerBalances(
+	context context.Context,
+	param domainServiceParam.GetTeamLedgerBalances,
+) (domainServiceResult.GetTeamLedgerBalances, error) {
+	balances, err := param.Repository.GetLedgerBalancesByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.GetTeamLedgerBalances{
+			Balances: []*entity.LedgerBalance{},
+		}, fmt.Errorf("failed to fetch ledger balances of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	// Settled balances are not relevant for the outstanding report
+	outstandingBalances := []*entity.LedgerBalance{}
+	for _, balance := range balances {
+		if balance.BalanceInCents != 0 {
+			outstandingBalances = append(outstandingBalances, balance)
+		}
+	}
+
+	return domainServiceResult.GetTeamLedgerBalances{
+		Balances:                outstandingBalances,
+		TotalOutstandingInCents: entity.TotalOutstandingInCents(outstandingBalances),
+	}, nil
+}
+
+// ChargeMembershipDues charges the same amount to every person with an active membership in the team on the
+// effective date. People holding more than one role in the team are charged only once. Nothing is charged when the
+// team has no active members on the effective date, failing with failure.ErrNoActiveMembers.
+func ChargeMembershipDues(
+	context context.Context,
+	param domainServiceParam.ChargeMembershipDues,
+) (domainServiceResult.ChargeMembershipDues, error) {
+	memberships, err := param.MembershipRepository.GetMembershipsByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.ChargeMembershipDues{
+			Transaction: nil,
+		}, fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	activeUserNames := []string{}
+	for _, membership := range memberships {
+		if membership.IsActiveAt(param.EffectiveDate) {
+			activeUserNames = append(activeUserNames, membership.Person.UserName)
+		}
+	}
+	activeUserNames = uniqueStrings(activeUserNames)
+
+	if len(activeUserNames) == 0 {
+		return domainServiceResult.ChargeMembershipDues{
+			Transaction: nil,
+		}, failure.ErrNoActiveMembers.WithMessage(fmt.Sprintf(
+			"team '%s' has no active members on %s", param.TeamSlug, param.EffectiveDate.Format(time.DateOnly),
+		))
+	}
+
+	amounts := make([]int64, len(activeUserNames))
+	for i := range amounts {
+		amounts[i] = param.AmountInCents
+	}
+
+	transaction, err := param.LedgerRepository.CreateLedgerTransaction(context, buildLedgerChargeTransaction(
+		param.TeamSlug,
+		entity.LedgerTransactionKinds.Dues,
+		param.Description,
+		param.EffectiveDate,
+		param.CreatedBy,
+		activeUserNames,
+		amounts,
+	))
+	if err != nil {
+		return domainServiceResult.ChargeMembershipDues{
+			Transaction: nil,
+		}, fmt.Errorf("failed to create dues transaction of team '%s' in repository: %w", param.TeamSlug, err)
+	}
+
+	return domainServiceResult.ChargeMembershipDues{
+		Transaction: transaction,
+	}, nil
+}
+
+// ChargeTournamentFee splits a tournament fee evenly among the rostered people. When the fee cannot be split
+// evenly, the remaining cents are charged to the first people of the roster. Nothing is charged when someone in the
+// roster has no active membership in the team on the effective date, failing with failure.ErrNotActiveMember.
+func ChargeTournamentFee(
+	context context.Context,
+	param domainServiceParam.ChargeTournamentFee,
+) (domainServiceResult.ChargeTournamentFee, error) {
+	userNames := uniqueStrings(param.PersonUserNames)
+	if len(userNames) == 0 {
+		return domainServiceResult.ChargeTournamentFee{
+			Transaction: nil,
+		}, fmt.Errorf("cannot split tournament fee of team '%s' among an empty roster", param.TeamSlug)
+	}
+
+	err := checkActiveMembers(context, param.TeamSlug, userNames, "personUserNames", param.EffectiveDate, param.MembershipRepository)
+	if err != nil {
+		return domainServiceResult.ChargeTournamentFee{
+			Transaction: nil,
+		}, err
+	}
+
+	amounts := entity.SplitAmountInCents(param.TotalAmountInCents, len(userNames))
+
+	transaction, err := param.LedgerRepository.CreateLedgerTransaction(context, buildLedgerChargeTransaction(
+		param.TeamSlug,
+		entity.LedgerTransactionKinds.TournamentFee,
+		param.Description,
+		param.EffectiveDate,
+		param.CreatedBy,
+		userNames,
+		amounts,
+	))
+	if err != nil {
+		return domainServiceResult.ChargeTournamentFee{
+			Transaction: nil,
+		}, fmt.Errorf("failed to create tournament fee transaction of team '%s' in repository: %w", param.TeamSlug, err)
+	}
+
+	return domainServiceResult.ChargeTournamentFee{
+		Transaction: transaction,
+	}, nil
+}
+
+// RecordLedgerPayment records money received by the team from a person, reducing what this person owes. Former
+// members can still settle what they owe, so payments are accepted from anyone with entries in the team ledger. Nothing
+// is recorded when the person has no entries in it nor an active membership in the team on the effective date, failing
+// with failure.ErrNotActiveMember.
+func RecordLedgerPayment(
+	context context.Context,
+	param domainServiceParam.RecordLedgerPayment,
+) (domainServiceResult.RecordLedgerPayment, error) {
+	balances, err := param.Repository.GetLedgerBalancesByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.RecordLedgerPayment{
+			Transaction: nil,
+		}, fmt.Errorf("failed to fetch ledger balances of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	if !hasLedgerBalance(balances, param.PersonUserName) {
+		err = checkActiveMembers(
+			context,
+			param.TeamSlug,
+			[]string{param.PersonUserName},
+			"personUserName",
+			param.EffectiveDate,
+			param.MembershipRepository,
+		)
+		if err != nil {
+			return domainServiceResult.RecordLedgerPayment{
+				Transaction: nil,
+			}, err
+		}
+	}
+
+	transaction, err := param.Repository.CreateLedgerTransaction(context, &entity.LedgerTransaction{
+		Team:          &entity.Team{Slug: param.TeamSlug},
+		Kind:          entity.LedgerTransactionKinds.Payment,
+		Description:   param.Description,
+		EffectiveDate: param.EffectiveDate,
+		Entries: []entity.LedgerEntry{
+			{
+				Account:       entity.LedgerAccounts.Cash,
+				AmountInCents: param.AmountInCents,
+			},
+			{
+				Account:       entity.LedgerAccounts.Receivable,
+				Person:        &entity.Person{UserName: param.PersonUserName},
+				AmountInCents: -param.AmountInCents,
+			},
+		},
+
+		CreatedBy: param.CreatedBy,
+	})
+	if err != nil {
+		return domainServiceResult.RecordLedgerPayment{
+			Transaction: nil,
+		}, fmt.Errorf("failed to record payment of '%s' to team '%s' in repository: %w", param.PersonUserName, param.TeamSlug, err)
+	}
+
+	return domainServiceResult.RecordLedgerPayment{
+		Transaction: transaction,
+	}, nil
+}
+
+// buildLedgerChargeTransaction builds a transaction that charges each person the amount at the same index, crediting
+// the team income with the total.
+func buildLedgerChargeTransaction(
+	teamSlug string,
+	kind entity.LedgerTransactionKind,
+	description string,
+	effectiveDate time.Time,
+	createdBy string,
+	userNames []string,
+	amounts []int64,
+) *entity.LedgerTransaction {
+	entries := []entity.LedgerEntry{}
+	var totalInCents int64
+	for i, userName := range userNames {
+		entries = append(entries, entity.LedgerEntry{
+			Account:       entity.LedgerAccounts.Receivable,
+			Person:        &entity.Person{UserName: userName},
+			AmountInCents: amounts[i],
+		})
+		totalInCents += amounts[i]
+	}
+	entries = append(entries, entity.LedgerEntry{
+		Account:       entity.LedgerAccounts.Income,
+		AmountInCents: -totalInCents,
+	})
+
+	return &entity.LedgerTransaction{
+		Team:          &entity.Team{Slug: teamSlug},
+		Kind:          kind,
+		Description:   description,
+		EffectiveDate: effectiveDate,
+		Entries:       entries,
+
+		CreatedBy: createdBy,
+	}
+}
+
+// checkActiveMembers fails with failure.ErrNotActiveMember, telling each of the people that has no active membership
+// in the team on the date in the given field, unless all of them have one.
+func checkActiveMembers(
+	context context.Context,
+	teamSlug string,
+	userNames []string,
+	field string,
+	date time.Time,
+	membershipRepository repository.Membership,
+) error {
+	memberships, err := membershipRepository.GetMembershipsByTeamSlug(context, teamSlug)
+	if err != nil {
+		return fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", teamSlug, err)
+	}
+
+	activeUserNames := map[string]bool{}
+	for _, userName := range activeMemberUserNamesAt(memberships, date) {
+		activeUserNames[userName] = true
+	}
+
+	fieldErrors := []failure.FieldError{}
+	for _, userName := range userNames {
+		if !activeUserNames[userName] {
+			fieldErrors = append(fieldErrors, failure.FieldError{
+				Field:   field,
+				Message: fmt.Sprintf("'%s' has no active membership in team '%s' on %s", userName, teamSlug, date.Format(time.DateOnly)),
+			})
+		}
+	}
+	if len(fieldErrors) > 0 {
+		return failure.ErrNotActiveMember.WithMessage(fmt.Sprintf(
+			"only active members of team '%s' on %s can be charged or pay", teamSlug, date.Format(time.DateOnly),
+		)).WithFields(fieldErrors...)
+	}
+
+	return nil
+}
+
+// hasLedgerBalance tells whether the person has entries in the ledger the balances were computed from.
+func hasLedgerBalance(balances []*entity.LedgerBalance, userName string) bool {
+	for _, balance := range balances {
+		if balance.Person != nil && balance.Person.UserName == userName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// uniqueStrings removes duplicated values, keeping the order of their first occurrence.
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	uniqueValues := []string{}
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		uniqueValues = append(uniqueValues, value)
+	}
+
+	return uniqueValues
+}
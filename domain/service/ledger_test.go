@@ -0,0 +1,267 @@
+//go:build unit
+// +build unit
+
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// fakeMembershipRepository answers the memberships of every team from memory. The methods it does not implement
+// panic through the nil embedded interface.
+type fakeMembershipRepository struct {
+	repository.Membership
+	memberships []entity.Membership
+}
+
+func (fake *fakeMembershipRepository) GetMembershipsByTeamSlug(_ context.Context, teamSlug string) ([]entity.Membership, error) {
+	memberships := []entity.Membership{}
+	for _, membership := range fake.memberships {
+		if membership.Team.Slug == teamSlug {
+			memberships = append(memberships, membership)
+		}
+	}
+
+	return memberships, nil
+}
+
+func (fake *fakeMembershipRepository) GetMembershipsByTeamSlugAt(
+	_ context.Context,
+	teamSlug string,
+	date time.Time,
+) ([]entity.Membership, error) {
+	memberships := []entity.Membership{}
+	for _, membership := range fake.memberships {
+		if membership.Team.Slug == teamSlug && membership.IsActiveAt(date) {
+			memberships = append(memberships, membership)
+		}
+	}
+
+	return memberships, nil
+}
+
+func (fake *fakeMembershipRepository) GetMembershipsByPersonUserNameAt(
+	_ context.Context,
+	personUserName string,
+	date time.Time,
+) ([]entity.Membership, error) {
+	memberships := []entity.Membership{}
+	for _, membership := range fake.memberships {
+		if membership.Person.UserName == personUserName && membership.IsActiveAt(date) {
+			memberships = append(memberships, membership)
+		}
+	}
+
+	return memberships, nil
+}
+
+// fakeLedgerRepository keeps the transactions it creates and answers the given balances.
+type fakeLedgerRepository struct {
+	repository.Ledger
+	balances     []*entity.LedgerBalance
+	transactions []*entity.LedgerTransaction
+}
+
+func (fake *fakeLedgerRepository) GetLedgerBalancesByTeamSlug(_ context.Context, _ string) ([]*entity.LedgerBalance, error) {
+	return fake.balances, nil
+}
+
+func (fake *fakeLedgerRepository) CreateLedgerTransaction(
+	_ context.Context,
+	transaction *entity.LedgerTransaction,
+) (*entity.LedgerTransaction, error) {
+	fake.transactions = append(fake.transactions, transaction)
+
+	return transaction, nil
+}
+
+func ledgerTestMemberships() []entity.Membership {
+	team := &entity.Team{Slug: "bra-sp-my-team-slug"}
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return []entity.Membership{
+		{Team: team, Person: &entity.Person{UserName: "some.player"}, Role: entity.MembershipRoles.Player, StartDate: startDate},
+		{Team: team, Person: &entity.Person{UserName: "another.player"}, Role: entity.MembershipRoles.Player, StartDate: startDate},
+		{Team: team, Person: &entity.Person{UserName: "some.coach"}, Role: entity.MembershipRoles.Coach, StartDate: startDate},
+		{
+			Team:      team,
+			Person:    &entity.Person{UserName: "former.player"},
+			Role:      entity.MembershipRoles.Player,
+			StartDate: startDate,
+			EndDate:   startDate.AddDate(0, 6, 0),
+		},
+	}
+}
+
+func TestChargeTournamentFee(t *testing.T) {
+	t.Parallel()
+
+	effectiveDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	scenarios := []struct {
+		description          string
+		personUserNames      []string
+		expectedAmounts      map[string]int64
+		expectedInvalidUsers []string
+	}{
+		{
+			description:     "should charge the remaining cents to the first people of the roster",
+			personUserNames: []string{"some.player", "another.player", "some.coach"},
+			expectedAmounts: map[string]int64{"some.player": 3334, "another.player": 3333, "some.coach": 3333},
+		},
+		{
+			description:     "should charge people listed twice only once",
+			personUserNames: []string{"some.player", "another.player", "some.player"},
+			expectedAmounts: map[string]int64{"some.player": 5000, "another.player": 5000},
+		},
+		{
+			description:          "should charge nobody when someone has no active membership",
+			personUserNames:      []string{"some.player", "former.player", "unknown.player"},
+			expectedInvalidUsers: []string{"former.player", "unknown.player"},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			ledgerRepository := &fakeLedgerRepository{}
+			result, err := domainService.ChargeTournamentFee(context.Background(), domainServiceParam.ChargeTournamentFee{
+				TeamSlug:           "bra-sp-my-team-slug",
+				PersonUserNames:    scenario.personUserNames,
+				TotalAmountInCents: 10000,
+				EffectiveDate:      effectiveDate,
+				CreatedBy:          "some.manager",
+
+				MembershipRepository: &fakeMembershipRepository{memberships: ledgerTestMemberships()},
+				LedgerRepository:     ledgerRepository,
+			})
+
+			if len(scenario.expectedInvalidUsers) > 0 {
+				require.ErrorIs(t, err, failure.ErrNotActiveMember)
+				require.Len(t, failure.Of(err).Fields, len(scenario.expectedInvalidUsers))
+				for index, userName := range scenario.expectedInvalidUsers {
+					require.Contains(t, failure.Of(err).Fields[index].Message, userName)
+				}
+				require.Empty(t, ledgerRepository.transactions)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, result.Transaction.IsBalanced())
+			obtainedAmounts := map[string]int64{}
+			for _, entry := range result.Transaction.Entries {
+				if entry.Account == entity.LedgerAccounts.Receivable {
+					obtainedAmounts[entry.Person.UserName] = entry.AmountInCents
+				}
+			}
+			require.Equal(t, scenario.expectedAmounts, obtainedAmounts)
+		})
+	}
+}
+
+func TestRecordLedgerPayment(t *testing.T) {
+	t.Parallel()
+
+	effectiveDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	scenarios := []struct {
+		description    string
+		personUserName string
+		balances       []*entity.LedgerBalance
+		expectedErr    error
+	}{
+		{
+			description:    "should record the payment of an active member",
+			personUserName: "some.player",
+		},
+		{
+			description:    "should record the payment of a former member with entries in the ledger",
+			personUserName: "former.player",
+			balances: []*entity.LedgerBalance{
+				{Person: &entity.Person{UserName: "former.player"}, BalanceInCents: 5000},
+			},
+		},
+		{
+			description:    "should not record the payment of a former member without entries in the ledger",
+			personUserName: "former.player",
+			expectedErr:    failure.ErrNotActiveMember,
+		},
+		{
+			description:    "should not record the payment of someone who was never a member",
+			personUserName: "unknown.person",
+			balances: []*entity.LedgerBalance{
+				{Person: &entity.Person{UserName: "former.player"}, BalanceInCents: 5000},
+			},
+			expectedErr: failure.ErrNotActiveMember,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			ledgerRepository := &fakeLedgerRepository{balances: scenario.balances}
+			result, err := domainService.RecordLedgerPayment(context.Background(), domainServiceParam.RecordLedgerPayment{
+				TeamSlug:       "bra-sp-my-team-slug",
+				PersonUserName: scenario.personUserName,
+				AmountInCents:  5000,
+				EffectiveDate:  effectiveDate,
+				CreatedBy:      "some.manager",
+
+				Repository:           ledgerRepository,
+				MembershipRepository: &fakeMembershipRepository{memberships: ledgerTestMemberships()},
+			})
+
+			if scenario.expectedErr != nil {
+				require.ErrorIs(t, err, scenario.expectedErr)
+				require.Empty(t, ledgerRepository.transactions)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, result.Transaction.IsBalanced())
+		})
+	}
+}
+
+func TestGetTeamLedgerBalances(t *testing.T) {
+	t.Parallel()
+
+	balance := func(userName string, balanceInCents int64) *entity.LedgerBalance {
+		return &entity.LedgerBalance{Person: &entity.Person{UserName: userName}, BalanceInCents: balanceInCents}
+	}
+
+	result, err := domainService.GetTeamLedgerBalances(context.Background(), domainServiceParam.GetTeamLedgerBalances{
+		TeamSlug: "bra-sp-my-team-slug",
+
+		Repository: &fakeLedgerRepository{balances: []*entity.LedgerBalance{
+			balance("some.player", 5000),
+			balance("another.player", 0),
+			balance("some.coach", -2000),
+			balance("former.player", 1500),
+		}},
+	})
+	require.NoError(t, err)
+
+	obtainedUserNames := []string{}
+	for _, outstandingBalance := range result.Balances {
+		obtainedUserNames = append(obtainedUserNames, outstandingBalance.Person.UserName)
+	}
+	require.Equal(t, []string{"some.player", "some.coach", "former.player"}, obtainedUserNames)
+	require.Equal(t, int64(6500), result.TotalOutstandingInCents)
+}
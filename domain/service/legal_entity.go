@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+// GetAllLegalEntities returns a page of the legal entities, along with the key that the next page follows when there
+// is one.
+func GetAllLegalEntities(
+	context context.Context,
+	param domainServiceParam.GetAllLegalEntities,
+) (domainServiceResult.GetAllLegalEntities, error) {
+	legalEntities, err := param.Repository.GetAllLegalEntities(context, param.Page.WithLookAhead())
+	if err != nil {
+		return domainServiceResult.GetAllLegalEntities{
+			LegalEntities: []*entity.LegalEntity{},
+		}, fmt.Errorf("failed to fetch all legal entities from repository: %w", err)
+	}
+
+	nextAfter := ""
+	if param.Page.HasMore(len(legalEntities)) {
+		legalEntities = legalEntities[:param.Page.Limit]
+		nextAfter = legalEntities[len(legalEntities)-1].Name
+	}
+
+	return domainServiceResult.GetAllLegalEntities{
+		LegalEntities: legalEntities,
+		NextAfter:     nextAfter,
+	}, nil
+}
+
+func GetLegalEntityBySlug(
+	context context.Context,
+	param domainServiceParam.GetLegalEntityBySlug,
+) (domainServiceResult.GetLegalEntityBySlug, error) {
+	legalEntity, err := param.Repository.GetLegalEntityBySlug(context, param.Slug)
+	if err != nil {
+		return domainServiceResult.GetLegalEntityBySlug{
+			LegalEntity: legalEntity,
+		}, fmt.Errorf("failed to fetch legal entity by slug '%s' from repository: %w", param.Slug, err)
+	}
+
+	return domainServiceResult.GetLegalEntityBySlug{
+		LegalEntity: legalEntity,
+	}, nil
+}
+
+// CreateLegalEntity registers a legal entity, failing with failure.ErrAlreadyExists when its slug, name or
+// registration number in the country is already taken, and with failure.ErrInvalidInput when its country is unknown.
+func CreateLegalEntity(
+	context context.Context,
+	param domainServiceParam.CreateLegalEntity,
+) (domainServiceResult.CreateLegalEntity, error) {
+	country, err := getCountryCode(
+		context, param.LegalEntity.Country, "country", "Legal Entity", "Country", param.CountryRepository,
+	)
+	if err != nil {
+		return domainServiceResult.CreateLegalEntity{}, err
+	}
+
+	legalEntity, err := param.Repository.CreateLegalEntity(context, param.LegalEntity.WithCountry(country))
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.CreateLegalEntity{}, failure.ErrAlreadyExists.WithMessage(
+			"a legal entity with the same slug, name or registration number in the country already exists",
+		)
+	}
+	if err != nil {
+		return domainServiceResult.CreateLegalEntity{
+			LegalEntity: legalEntity,
+		}, fmt.Errorf("failed to create legal entity with slug '%s' in repository: %w", param.LegalEntity.Slug, err)
+	}
+
+	return domainServiceResult.CreateLegalEntity{
+		LegalEntity: legalEntity,
+	}, nil
+}
+
+// UpdateLegalEntity updates the given attributes of a legal entity, failing with failure.ErrAlreadyExists when its new
+// name or registration number in the country is already taken, and with failure.ErrInvalidInput when its new country
+// is unknown.
+func UpdateLegalEntity(
+	context context.Context,
+	param domainServiceParam.UpdateLegalEntity,
+) (domainServiceResult.UpdateLegalEntity, error) {
+	updatedLegalEntity := param.LegalEntity
+	if updatedLegalEntity.Country != "" {
+		country, err := getCountryCode(
+			context, updatedLegalEntity.Country, "country", "Legal Entity", "Country", param.CountryRepository,
+		)
+		if err != nil {
+			return domainServiceResult.UpdateLegalEntity{}, err
+		}
+		updatedLegalEntity = updatedLegalEntity.WithCountry(country)
+	}
+
+	legalEntity, err := param.Repository.UpdateLegalEntity(context, updatedLegalEntity, param.UpdatedAttributes)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.UpdateLegalEntity{}, failure.ErrAlreadyExists.WithMessage(
+			"a legal entity with the same name or registration number in the country already exists",
+		)
+	}
+	if err != nil {
+		return domainServiceResult.UpdateLegalEntity{
+			LegalEntity: legalEntity,
+		}, fmt.Errorf("failed to update legal entity with slug '%s' in repository: %w", param.LegalEntity.Slug, err)
+	}
+
+	return domainServiceResult.UpdateLegalEntity{
+		LegalEntity: legalEntity,
+	}, nil
+}
+
+func GetLegalEntityAffiliations(
+	context context.Context,
+	param domainServiceParam.GetLegalEntityAffiliations,
+) (domainServiceResult.GetLegalEntityAffiliations, error) {
+	affiliations, err := param.Repository.GetLegalEntityAffiliationsByLegalEntitySlug(context, param.LegalEntitySlug)
+	if err != nil {
+		return domainServiceResult.GetLegalEntityAffiliations{
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, fmt.Errorf("failed to fetch affiliations of legal entity '%s' from repository: %w", param.LegalEntitySlug, err)
+	}
+
+	return domainServiceResult.GetLegalEntityAffiliations{
+		Affiliations: affiliations,
+	}, nil
+}
+
+func GetTeamLegalEntityAffiliations(
+	context context.Context,
+	param domainServiceParam.GetTeamLegalEntityAffiliations,
+) (domainServiceResult.GetTeamLegalEntityAffiliations, error) {
+	affiliations, err := param.Repository.GetLegalEntityAffiliationsByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.GetTeamLegalEntityAffiliations{
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, fmt.Errorf("failed to fetch legal entity affiliations of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	return domainServiceResult.GetTeamLegalEntityAffiliations{
+		Affiliations: affiliations,
+	}, nil
+}
+
+func GetPersonLegalEntityAffiliations(
+	context context.Context,
+	param domainServiceParam.GetPersonLegalEntityAffiliations,
+) (domainServiceResult.GetPersonLegalEntityAffiliations, error) {
+	affiliations, err := param.Repository.GetLegalEntityAffiliationsByPersonUserName(context, param.PersonUserName)
+	if err != nil {
+		return domainServiceResult.GetPersonLegalEntityAffiliations{
+			Affiliations: []*entity.LegalEntityAffiliation{},
+		}, fmt.Errorf("failed to fetch legal entity affiliations of '%s' from repository: %w", param.PersonUserName, err)
+	}
+
+	return domainServiceResult.GetPersonLegalEntityAffiliations{
+		Affiliations: affiliations,
+	}, nil
+}
+
+// AffiliateToLegalEntity affiliates the team or the person of the affiliation to its legal entity. A team is
+// affiliated to a single legal entity at a time, while a person may hold affiliations to several legal entities
+// but never two overlapping ones to the same legal entity. Nothing is saved when the new affiliation overlaps an
+// existing one, failing with failure.ErrOverlappingPeriod, nor when the same affiliation already exists, failing with
+// failure.ErrAlreadyExists.
+func AffiliateToLegalEntity(
+	context context.Context,
+	param domainServiceParam.AffiliateToLegalEntity,
+) (domainServiceResult.AffiliateToLegalEntity, error) {
+	affiliations, err := getAffiliationsOfAffiliate(context, param.Affiliation.Team, param.Affiliation.Person, param.Repository)
+	if err != nil {
+		return domainServiceResult.AffiliateToLegalEntity{}, err
+	}
+
+	for _, affiliation := range affiliations {
+		isSameLegalEntity := affiliation.LegalEntity.Slug == param.Affiliation.LegalEntity.Slug
+		if (param.Affiliation.Team != nil || isSameLegalEntity) && affiliation.Overlaps(param.Affiliation) {
+			return domainServiceResult.AffiliateToLegalEntity{}, failure.ErrOverlappingPeriod.WithMessage(fmt.Sprintf(
+				"the affiliation overlaps the affiliation to legal entity '%s' that started on %s",
+				affiliation.LegalEntity.Slug,
+				affiliation.StartDate.Format(time.DateOnly),
+			))
+		}
+	}
+
+	affiliation, err := param.Repository.CreateLegalEntityAffiliation(context, param.Affiliation)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.AffiliateToLegalEntity{}, failure.ErrAlreadyExists.WithMessage(fmt.Sprintf(
+			"the same affiliation to legal entity '%s' already exists", param.Affiliation.LegalEntity.Slug,
+		))
+	}
+	if err != nil {
+		return domainServiceResult.AffiliateToLegalEntity{}, fmt.Errorf(
+			"failed to create affiliation to legal entity '%s' in repository: %w", param.Affiliation.LegalEntity.Slug, err,
+		)
+	}
+
+	return domainServiceResult.AffiliateToLegalEntity{
+		Affiliation: affiliation,
+	}, nil
+}
+
+// EndLegalEntityAffiliation sets the end date of the ongoing affiliation of the team or the person to the legal
+// entity. It fails with failure.ErrAffiliationNotFound when there is no ongoing affiliation to end, and with
+// failure.ErrInvalidPeriod when the affiliation would end before it started.
+func EndLegalEntityAffiliation(
+	context context.Context,
+	param domainServiceParam.EndLegalEntityAffiliation,
+) (domainServiceResult.EndLegalEntityAffiliation, error) {
+	affiliations, err := getAffiliationsOfAffiliate(context, param.Team, param.Person, param.Repository)
+	if err != nil {
+		return domainServiceResult.EndLegalEntityAffiliation{}, err
+	}
+
+	var ongoingAffiliation *entity.LegalEntityAffiliation
+	for _, affiliation := range affiliations {
+		if affiliation.LegalEntity.Slug == param.LegalEntity.Slug && affiliation.EndDate.IsZero() {
+			ongoingAffiliation = affiliation
+
+			break
+		}
+	}
+	if ongoingAffiliation == nil {
+		return domainServiceResult.EndLegalEntityAffiliation{}, failure.ErrAffiliationNotFound.WithMessage(fmt.Sprintf(
+			"there is no ongoing affiliation to legal entity '%s' to end", param.LegalEntity.Slug,
+		))
+	}
+	if param.EndDate.Before(ongoingAffiliation.StartDate) {
+		return domainServiceResult.EndLegalEntityAffiliation{}, failure.ErrInvalidPeriod.WithMessage(fmt.Sprintf(
+			"the affiliation cannot end before it started on %s", ongoingAffiliation.StartDate.Format(time.DateOnly),
+		))
+	}
+
+	endedAffiliation := ongoingAffiliation.WithEndDate(param.EndDate)
+	endedAffiliation.LegalEntity = param.LegalEntity
+	endedAffiliation.Team = param.Team
+	endedAffiliation.Person = param.Person
+	endedAffiliation.UpdatedBy = param.UpdatedBy
+	affiliation, err := param.Repository.EndLegalEntityAffiliation(context, endedAffiliation)
+	if err != nil {
+		return domainServiceResult.EndLegalEntityAffiliation{}, fmt.Errorf("failed to end affiliation to legal entity '%s' in repository: %w", param.LegalEntity.Slug, err)
+	}
+
+	return domainServiceResult.EndLegalEntityAffiliation{
+		Affiliation: affiliation,
+	}, nil
+}
+
+// getAffiliationsOfAffiliate retrieves the legal entity affiliations of the team or, when it is nil, of the person.
+func getAffiliationsOfAffiliate(
+	context context.Context,
+	team *entity.Team,
+	person *entity.Person,
+	legalEntityRepository repository.LegalEntity,
+) ([]*entity.LegalEntityAffiliation, error) {
+	if team != nil {
+		affiliations, err := legalEntityRepository.GetLegalEntityAffiliationsByTeamSlug(context, team.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch legal entity affiliations of team '%s' from repository: %w", team.Slug, err)
+		}
+
+		return affiliations, nil
+	}
+
+	affiliations, err := legalEntityRepository.GetLegalEntityAffiliationsByPersonUserName(context, person.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch legal entity affiliations of '%s' from repository: %w", person.UserName, err)
+	}
+
+	return affiliations, nil
+}
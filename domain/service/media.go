@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/media"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+// UploadTeamLogo replaces the logo of a team, generating its thumbnail. The result holds a nil Team when there is no
+// team with the given name. It fails with failure.ErrInvalidImage when the content is not an image of the given
+// content type.
+func UploadTeamLogo(
+	context context.Context,
+	param domainServiceParam.UploadTeamLogo,
+) (domainServiceResult.UploadTeamLogo, error) {
+	team, err := param.Repository.GetTeamByName(context, param.TeamName)
+	if err != nil {
+		return domainServiceResult.UploadTeamLogo{}, fmt.Errorf("failed to fetch team by name '%s' from repository: %w", param.TeamName, err)
+	}
+	if team == nil {
+		return domainServiceResult.UploadTeamLogo{}, nil
+	}
+
+	var updatedTeam *entity.Team
+	err = replaceImage(
+		context,
+		param.Storage,
+		"teams/"+team.Slug+"/logo",
+		param.ContentType,
+		param.Content,
+		func(image *media.Image) error {
+			var err error
+			updatedTeam, err = param.Repository.UpdateTeamLogo(
+				context,
+				team.WithLogo(image.Original.Key, image.Thumbnail.Key).WithUpdatedBy(param.UpdatedBy),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update logo of team '%s' in repository: %w", param.TeamName, err)
+			}
+
+			return nil
+		},
+		team.LogoKey,
+		team.LogoThumbnailKey,
+	)
+	if err != nil {
+		return domainServiceResult.UploadTeamLogo{
+			Team: team,
+		}, err
+	}
+
+	return domainServiceResult.UploadTeamLogo{
+		Team: updatedTeam,
+	}, nil
+}
+
+// UploadPersonAvatar replaces the avatar of a person, generating its thumbnail. The result holds a nil Person when
+// there is no person with the given username. It fails with failure.ErrInvalidImage when the content is not an image of
+// the given content type.
+func UploadPersonAvatar(
+	context context.Context,
+	param domainServiceParam.UploadPersonAvatar,
+) (domainServiceResult.UploadPersonAvatar, error) {
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.UploadPersonAvatar{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.UploadPersonAvatar{}, nil
+	}
+
+	var updatedPerson *entity.Person
+	err = replaceImage(
+		context,
+		param.Storage,
+		"people/"+person.UserName+"/avatar",
+		param.ContentType,
+		param.Content,
+		func(image *media.Image) error {
+			var err error
+			updatedPerson, err = param.Repository.UpdatePersonAvatar(
+				context,
+				person.WithAvatar(image.Original.Key, image.Thumbnail.Key).WithUpdatedBy(param.UpdatedBy),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to update avatar of person '%s' in repository: %w", param.UserName, err)
+			}
+
+			return nil
+		},
+		person.AvatarKey,
+		person.AvatarThumbnailKey,
+	)
+	if err != nil {
+		return domainServiceResult.UploadPersonAvatar{
+			Person: person,
+		}, err
+	}
+
+	return domainServiceResult.UploadPersonAvatar{
+		Person: updatedPerson,
+	}, nil
+}
+
+// GetMediaObject fetches an object from the storage. The result holds a nil MediaObject when there is no object with
+// the given key, which is always the case for invalid keys.
+func GetMediaObject(
+	context context.Context,
+	param domainServiceParam.GetMediaObject,
+) (domainServiceResult.GetMediaObject, error) {
+	if !entity.IsValidMediaKey(param.Key) {
+		return domainServiceResult.GetMediaObject{}, nil
+	}
+
+	object, err := param.Storage.GetObject(context, param.Key)
+	if err != nil {
+		return domainServiceResult.GetMediaObject{}, fmt.Errorf("failed to fetch object '%s' from storage: %w", param.Key, err)
+	}
+
+	return domainServiceResult.GetMediaObject{
+		MediaObject: object,
+	}, nil
+}
+
+// replaceImage keeps the image and its thumbnail in the storage before saving their keys, so the saved keys always
+// point to existing objects. The new objects are deleted when saving fails, and the objects under the previous keys
+// once it succeeds.
+func replaceImage(
+	context context.Context,
+	mediaStorage storage.Storage,
+	keyPrefix string,
+	contentType string,
+	content []byte,
+	save func(image *media.Image) error,
+	previousKeys ...string,
+) error {
+	image, err := media.PrepareImage(keyPrefix, contentType, content)
+	if errors.Is(err, media.ErrInvalidImage) {
+		return failure.ErrInvalidImage.WithMessage(fmt.Sprintf("the content is not a valid '%s' image", contentType))
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, object := range []*entity.MediaObject{image.Original, image.Thumbnail} {
+		err = mediaStorage.PutObject(context, object)
+		if err != nil {
+			return fmt.Errorf("failed to put object '%s' in storage: %w", object.Key, err)
+		}
+	}
+
+	// Uploading the current image again keeps the same keys, whose objects must never be deleted then
+	newKeys := []string{image.Original.Key, image.Thumbnail.Key}
+	err = save(image)
+	if err != nil {
+		deleteObjects(context, mediaStorage, keysNotIn(newKeys, previousKeys)...)
+
+		return err
+	}
+	deleteObjects(context, mediaStorage, keysNotIn(previousKeys, newKeys)...)
+
+	return nil
+}
+
+// deleteObjects deletes the objects under the keys that are not empty. Failures are ignored, since an object that is
+// left behind only takes up space and is no longer referenced.
+func deleteObjects(context context.Context, mediaStorage storage.Storage, keys ...string) {
+	for _, key := range keys {
+		if key != "" {
+			_ = mediaStorage.DeleteObject(context, key)
+		}
+	}
+}
+
+// keysNotIn returns the keys that are not in the excluded ones.
+func keysNotIn(keys []string, excludedKeys []string) []string {
+	var remainingKeys []string
+	for _, key := range keys {
+		isExcluded := false
+		for _, excludedKey := range excludedKeys {
+			isExcluded = isExcluded || key == excludedKey
+		}
+		if !isExcluded {
+			remainingKeys = append(remainingKeys, key)
+		}
+	}
+
+	return remainingKeys
+}
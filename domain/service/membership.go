@@ -2,9 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
 	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
 )
@@ -31,3 +36,283 @@ func GetTeamMembershipsByRole(
 		Memberships: membershipsWithRole,
 	}, nil
 }
+
+// GetTeamMemberships retrieves the memberships of a team, answering who was on the team on a date when AsOf is set.
+func GetTeamMemberships(
+	context context.Context,
+	param domainServiceParam.GetTeamMemberships,
+) (domainServiceResult.GetTeamMemberships, error) {
+	var memberships []entity.Membership
+	var err error
+	if param.AsOf.IsZero() {
+		memberships, err = param.Repository.GetMembershipsByTeamSlug(context, param.TeamSlug)
+	} else {
+		memberships, err = param.Repository.GetMembershipsByTeamSlugAt(context, param.TeamSlug, param.AsOf)
+	}
+	if err != nil {
+		return domainServiceResult.GetTeamMemberships{
+			Memberships: []entity.Membership{},
+		}, fmt.Errorf("failed to fetch memberships of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	return domainServiceResult.GetTeamMemberships{
+		Memberships: memberships,
+	}, nil
+}
+
+// GetPersonMemberships retrieves the memberships of a person in every team, the oldest first, answering which teams
+// the person represented on a date when AsOf is set.
+func GetPersonMemberships(
+	context context.Context,
+	param domainServiceParam.GetPersonMemberships,
+) (domainServiceResult.GetPersonMemberships, error) {
+	var memberships []entity.Membership
+	var err error
+	if param.AsOf.IsZero() {
+		memberships, err = param.Repository.GetMembershipsByPersonUserName(context, param.PersonUserName)
+	} else {
+		memberships, err = param.Repository.GetMembershipsByPersonUserNameAt(context, param.PersonUserName, param.AsOf)
+	}
+	if err != nil {
+		return domainServiceResult.GetPersonMemberships{
+			Memberships: []entity.Membership{},
+		}, fmt.Errorf("failed to fetch all memberships of '%s' from repository: %w", param.PersonUserName, err)
+	}
+
+	return domainServiceResult.GetPersonMemberships{
+		Memberships: memberships,
+	}, nil
+}
+
+// TransferMembership moves a player from one team to another: the playing memberships in FromTeam that are ongoing on
+// the transfer date, such as being its Player and its Captain, end the day before it, and a membership in ToTeam
+// starts on it, all within the same transaction. The new membership keeps the role of the first ended one unless a
+// Role is given. Nothing is saved when the transfer date is outside the transfer windows, failing with
+// failure.ErrOutsideTransferWindow, when there is no such membership to end, failing with
+// failure.ErrMembershipNotFound, when one of them started on the transfer date, failing with failure.ErrInvalidPeriod,
+// when the new membership would overlap another playing membership of the person, failing with
+// failure.ErrOverlappingPeriod, or when the person is a minor without the consents of a guardian, failing with
+// failure.ErrMissingConsent.
+func TransferMembership(
+	ctx context.Context,
+	param domainServiceParam.TransferMembership,
+) (domainServiceResult.TransferMembership, error) {
+	if !entity.IsWithinTransferWindows(param.TransferWindows, param.TransferDate) {
+		windows := make([]string, 0, len(param.TransferWindows))
+		for _, window := range param.TransferWindows {
+			windows = append(windows, window.String())
+		}
+
+		return domainServiceResult.TransferMembership{}, failure.ErrOutsideTransferWindow.WithMessage(fmt.Sprintf(
+			"transfers are only allowed within the transfer windows %s", strings.Join(windows, ", "),
+		))
+	}
+
+	memberships, err := param.MembershipRepository.GetMembershipsByPersonUserName(ctx, param.Person.UserName)
+	if err != nil {
+		return domainServiceResult.TransferMembership{}, fmt.Errorf(
+			"failed to fetch all memberships of '%s' from repository: %w", param.Person.UserName, err,
+		)
+	}
+
+	ongoingMemberships := []*entity.Membership{}
+	otherMemberships := make([]*entity.Membership, 0, len(memberships))
+	for index := range memberships {
+		membership := &memberships[index]
+		isFromTeam := membership.Team.Slug == param.FromTeam.Slug
+		if isFromTeam && entity.IsPlayingRole(membership.Role) && membership.IsActiveAt(param.TransferDate) {
+			ongoingMemberships = append(ongoingMemberships, membership)
+
+			continue
+		}
+		otherMemberships = append(otherMemberships, membership)
+	}
+	if len(ongoingMemberships) == 0 {
+		return domainServiceResult.TransferMembership{}, failure.ErrMembershipNotFound.WithMessage(fmt.Sprintf(
+			"'%s' has no playing membership in team '%s' ongoing on %s",
+			param.Person.UserName,
+			param.FromTeam.Slug,
+			param.TransferDate.Format(time.DateOnly),
+		))
+	}
+
+	endDate := param.TransferDate.AddDate(0, 0, -1)
+	for _, ongoingMembership := range ongoingMemberships {
+		if endDate.Before(ongoingMembership.StartDate) {
+			return domainServiceResult.TransferMembership{}, failure.ErrInvalidPeriod.WithMessage(fmt.Sprintf(
+				"the membership in team '%s' started on %s, so it cannot end before the transfer date",
+				param.FromTeam.Slug,
+				ongoingMembership.StartDate.Format(time.DateOnly),
+			))
+		}
+	}
+
+	role := param.Role
+	if role == "" {
+		role = ongoingMemberships[0].Role
+	}
+	newMembership := &entity.Membership{
+		Team:      param.ToTeam,
+		Person:    param.Person,
+		Role:      role,
+		StartDate: param.TransferDate,
+		CreatedBy: param.UpdatedBy,
+	}
+	if conflictingMembership := entity.FindOverlappingPlayingMembership(otherMemberships, newMembership); conflictingMembership != nil {
+		return domainServiceResult.TransferMembership{}, overlappingPlayingMembership(conflictingMembership)
+	}
+
+	err = checkGuardianConsent(ctx, param.Person, param.TransferDate, param.GuardianRepository)
+	if err != nil {
+		return domainServiceResult.TransferMembership{}, err
+	}
+
+	var endedMembership, membership *entity.Membership
+	err = param.TransactionManager.RunInTransaction(ctx, func(transactionContext context.Context) error {
+		for _, ongoingMembership := range ongoingMemberships {
+			endingMembership := ongoingMembership.WithEndDate(endDate)
+			endingMembership.UpdatedBy = param.UpdatedBy
+
+			ended, err := param.MembershipRepository.EndMembership(transactionContext, endingMembership)
+			if err != nil {
+				return fmt.Errorf("failed to end membership of '%s' in repository: %w", param.Person.UserName, err)
+			}
+			if ended == nil {
+				return fmt.Errorf("membership of '%s' in team '%s' disappeared during the transfer", param.Person.UserName, param.FromTeam.Slug)
+			}
+			if endedMembership == nil {
+				endedMembership = ended
+			}
+		}
+
+		membership, err = param.MembershipRepository.CreateMembership(transactionContext, newMembership)
+		if err != nil {
+			return fmt.Errorf("failed to create membership of '%s' in repository: %w", param.Person.UserName, err)
+		}
+
+		return nil
+	})
+	// Another playing membership of the person was saved during the transfer
+	if errors.Is(err, repository.ErrOverlappingPeriod) {
+		return domainServiceResult.TransferMembership{}, failure.ErrOverlappingPeriod.WithMessage(fmt.Sprintf(
+			"the membership of '%s' overlaps another playing membership", param.Person.UserName,
+		))
+	}
+	if err != nil {
+		return domainServiceResult.TransferMembership{}, err
+	}
+
+	return domainServiceResult.TransferMembership{
+		EndedMembership: endedMembership,
+		Membership:      membership,
+	}, nil
+}
+
+// AssignJerseyNumber saves the jersey number that a person wears on a team in every membership of the person in the
+// team that has not ended by the given date. An empty JerseyNumber clears it. It fails with
+// failure.ErrMembershipNotFound when the person has no such membership, and nothing is saved when another person of
+// the team wears the number in an overlapping membership, failing with failure.ErrJerseyNumberTaken.
+func AssignJerseyNumber(
+	ctx context.Context,
+	param domainServiceParam.AssignJerseyNumber,
+) (domainServiceResult.AssignJerseyNumber, error) {
+	teamMemberships, err := param.MembershipRepository.GetMembershipsByTeamSlug(ctx, param.Team.Slug)
+	if err != nil {
+		return domainServiceResult.AssignJerseyNumber{}, fmt.Errorf(
+			"failed to fetch all memberships of team '%s' from repository: %w", param.Team.Slug, err,
+		)
+	}
+
+	var numberedMemberships []*entity.Membership
+	for index := range teamMemberships {
+		membership := &teamMemberships[index]
+		if membership.Person.UserName != param.Person.UserName || !membership.IsCurrentOrUpcomingAt(param.Date) {
+			continue
+		}
+
+		numberedMembership := membership.WithJerseyNumber(param.JerseyNumber).WithUpdatedBy(param.UpdatedBy)
+		if conflictingMembership := entity.FindJerseyNumberConflict(teamMemberships, numberedMembership); conflictingMembership != nil {
+			return domainServiceResult.AssignJerseyNumber{}, failure.ErrJerseyNumberTaken.WithMessage(fmt.Sprintf(
+				"jersey number '%s' is worn by '%s' in team '%s' in the membership that started on %s",
+				param.JerseyNumber,
+				conflictingMembership.Person.UserName,
+				param.Team.Slug,
+				conflictingMembership.StartDate.Format(time.DateOnly),
+			))
+		}
+		numberedMemberships = append(numberedMemberships, numberedMembership)
+	}
+	if len(numberedMemberships) == 0 {
+		return domainServiceResult.AssignJerseyNumber{}, failure.ErrMembershipNotFound.WithMessage(fmt.Sprintf(
+			"'%s' has no membership in team '%s' that is ongoing or upcoming on %s",
+			param.Person.UserName,
+			param.Team.Slug,
+			param.Date.Format(time.DateOnly),
+		))
+	}
+
+	// The number is worn in every membership of the person or in none of them
+	memberships := make([]*entity.Membership, 0, len(numberedMemberships))
+	err = param.TransactionManager.RunInTransaction(ctx, func(transactionContext context.Context) error {
+		for _, numberedMembership := range numberedMemberships {
+			membership, err := param.MembershipRepository.UpdateMembershipJerseyNumber(transactionContext, numberedMembership)
+			if err != nil {
+				return fmt.Errorf("failed to update jersey number of '%s' in repository: %w", param.Person.UserName, err)
+			}
+			if membership == nil {
+				return fmt.Errorf("membership of '%s' in team '%s' disappeared while numbering it", param.Person.UserName, param.Team.Slug)
+			}
+			memberships = append(memberships, membership)
+		}
+
+		return nil
+	})
+	// Another member took the number between the check and the update
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.AssignJerseyNumber{}, failure.ErrJerseyNumberTaken.WithMessage(fmt.Sprintf(
+			"jersey number '%s' is already worn by another member of team '%s'", param.JerseyNumber, param.Team.Slug,
+		))
+	}
+	if err != nil {
+		return domainServiceResult.AssignJerseyNumber{}, err
+	}
+
+	return domainServiceResult.AssignJerseyNumber{
+		Memberships: memberships,
+	}, nil
+}
+
+// FindMemberByJerseyNumber finds the membership in which a person wore the jersey number on the team on the given
+// date, so that scorekeepers can record the players by their numbers. The result holds a nil Membership when nobody
+// wore the number on that date.
+func FindMemberByJerseyNumber(
+	context context.Context,
+	param domainServiceParam.FindMemberByJerseyNumber,
+) (domainServiceResult.FindMemberByJerseyNumber, error) {
+	memberships, err := param.Repository.GetMembershipsByTeamSlugAt(context, param.TeamSlug, param.Date)
+	if err != nil {
+		return domainServiceResult.FindMemberByJerseyNumber{}, fmt.Errorf(
+			"failed to fetch memberships of team '%s' from repository: %w", param.TeamSlug, err,
+		)
+	}
+
+	for index := range memberships {
+		if memberships[index].JerseyNumber == param.JerseyNumber {
+			return domainServiceResult.FindMemberByJerseyNumber{
+				Membership: &memberships[index],
+			}, nil
+		}
+	}
+
+	return domainServiceResult.FindMemberByJerseyNumber{}, nil
+}
+
+// overlappingPlayingMembership is the failure of a playing membership that would overlap the given one of the same
+// person.
+func overlappingPlayingMembership(conflictingMembership *entity.Membership) error {
+	return failure.ErrOverlappingPeriod.WithMessage(fmt.Sprintf(
+		"the membership overlaps the playing membership in team '%s' that started on %s",
+		conflictingMembership.Team.Slug,
+		conflictingMembership.StartDate.Format(time.DateOnly),
+	))
+}
@@ -0,0 +1,12 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetAllCountries struct {
+	Locale entity.CountryLocale
+
+	Repository repository.Country
+}
@@ -0,0 +1,42 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetPersonCredentials struct {
+	PersonUserName string
+
+	Repository repository.Credential
+}
+
+type CreateWFDFAccreditation struct {
+	Accreditation *entity.WFDFAccreditation
+
+	Repository repository.Credential
+}
+
+type CreateFederationMembership struct {
+	Membership *entity.FederationMembership
+
+	Repository repository.Credential
+}
+
+type CreateCoachCertification struct {
+	Certification *entity.CoachCertification
+
+	Repository repository.Credential
+}
+
+type GetTeamExpiringWFDFAccreditations struct {
+	TeamSlug string
+	Date     time.Time
+	Roster   []string
+
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	CredentialRepository repository.Credential
+}
@@ -0,0 +1,25 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type FindDuplicatePeople struct {
+	MinimumScore float64
+
+	Repository repository.Person
+}
+
+type MergePeople struct {
+	UserName          string
+	DuplicateUserName string
+	UpdatedBy         string
+
+	Repository repository.Person
+}
+
+type GetPersonByAlias struct {
+	Alias string
+
+	Repository repository.Person
+}
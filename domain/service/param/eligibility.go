@@ -0,0 +1,21 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type CheckRosterEligibility struct {
+	TeamSlug       string
+	Roster         []string
+	EventStartDate time.Time
+	EventEndDate   time.Time
+	Rules          []eligibility.Rule
+
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	CredentialRepository repository.Credential
+	GuardianRepository   repository.Guardian
+}
@@ -0,0 +1,49 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetGuardians struct {
+	DependentUserName string
+
+	Repository repository.Guardian
+}
+
+type AddGuardian struct {
+	Guardianship *entity.Guardianship
+
+	Repository repository.Guardian
+}
+
+type RemoveGuardian struct {
+	GuardianUserName  string
+	DependentUserName string
+
+	Repository repository.Guardian
+}
+
+type GetConsents struct {
+	PersonUserName string
+
+	Repository repository.Guardian
+}
+
+type GrantConsent struct {
+	Consent *entity.Consent
+
+	Repository repository.Guardian
+}
+
+type GetDependentsSchedule struct {
+	GuardianUserName string
+	From             time.Time
+	To               time.Time
+
+	GuardianRepository   repository.Guardian
+	MembershipRepository repository.Membership
+	TeamEventRepository  repository.TeamEvent
+}
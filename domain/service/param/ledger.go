@@ -0,0 +1,54 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetTeamLedgerTransactions struct {
+	TeamSlug string
+
+	Repository repository.Ledger
+}
+
+type GetTeamLedgerBalances struct {
+	TeamSlug string
+
+	Repository repository.Ledger
+}
+
+type ChargeMembershipDues struct {
+	TeamSlug      string
+	AmountInCents int64
+	Description   string
+	EffectiveDate time.Time
+	CreatedBy     string
+
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
+
+type ChargeTournamentFee struct {
+	TeamSlug           string
+	PersonUserNames    []string
+	TotalAmountInCents int64
+	Description        string
+	EffectiveDate      time.Time
+	CreatedBy          string
+
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
+
+type RecordLedgerPayment struct {
+	TeamSlug       string
+	PersonUserName string
+	AmountInCents  int64
+	Description    string
+	EffectiveDate  time.Time
+	CreatedBy      string
+
+	Repository           repository.Ledger
+	MembershipRepository repository.Membership
+}
@@ -0,0 +1,69 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetAllLegalEntities struct {
+	Page entity.PageRequest
+
+	Repository repository.LegalEntity
+}
+
+type GetLegalEntityBySlug struct {
+	Slug string
+
+	Repository repository.LegalEntity
+}
+
+type CreateLegalEntity struct {
+	LegalEntity *entity.LegalEntity
+
+	Repository        repository.LegalEntity
+	CountryRepository repository.Country
+}
+
+type UpdateLegalEntity struct {
+	LegalEntity       *entity.LegalEntity
+	UpdatedAttributes []entity.LegalEntityAttribute
+
+	Repository        repository.LegalEntity
+	CountryRepository repository.Country
+}
+
+type GetLegalEntityAffiliations struct {
+	LegalEntitySlug string
+
+	Repository repository.LegalEntity
+}
+
+type GetTeamLegalEntityAffiliations struct {
+	TeamSlug string
+
+	Repository repository.LegalEntity
+}
+
+type GetPersonLegalEntityAffiliations struct {
+	PersonUserName string
+
+	Repository repository.LegalEntity
+}
+
+type AffiliateToLegalEntity struct {
+	Affiliation *entity.LegalEntityAffiliation
+
+	Repository repository.LegalEntity
+}
+
+type EndLegalEntityAffiliation struct {
+	LegalEntity *entity.LegalEntity
+	Team        *entity.Team
+	Person      *entity.Person
+	EndDate     time.Time
+	UpdatedBy   string
+
+	Repository repository.LegalEntity
+}
@@ -0,0 +1,32 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+)
+
+type UploadTeamLogo struct {
+	TeamName    string
+	ContentType string
+	Content     []byte
+	UpdatedBy   string
+
+	Repository repository.Team
+	Storage    storage.Storage
+}
+
+type UploadPersonAvatar struct {
+	UserName    string
+	ContentType string
+	Content     []byte
+	UpdatedBy   string
+
+	Repository repository.Person
+	Storage    storage.Storage
+}
+
+type GetMediaObject struct {
+	Key string
+
+	Storage storage.Storage
+}
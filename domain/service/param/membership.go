@@ -1,6 +1,9 @@
 package param
 
 import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 )
 
@@ -10,3 +13,52 @@ type GetTeamMembershipsByRole struct {
 
 	Repository repository.Membership
 }
+
+// GetTeamMemberships lists every membership of the team, or only the ones in effect on AsOf when it is not zero.
+type GetTeamMemberships struct {
+	TeamSlug string
+	AsOf     time.Time
+
+	Repository repository.Membership
+}
+
+// GetPersonMemberships lists every membership of the person, or only the ones in effect on AsOf when it is not zero.
+type GetPersonMemberships struct {
+	PersonUserName string
+	AsOf           time.Time
+
+	Repository repository.Membership
+}
+
+type TransferMembership struct {
+	Person          *entity.Person
+	FromTeam        *entity.Team
+	ToTeam          *entity.Team
+	Role            string
+	TransferDate    time.Time
+	UpdatedBy       string
+	TransferWindows []entity.TransferWindow
+
+	MembershipRepository repository.Membership
+	GuardianRepository   repository.Guardian
+	TransactionManager   repository.TransactionManager
+}
+
+type AssignJerseyNumber struct {
+	Team         *entity.Team
+	Person       *entity.Person
+	JerseyNumber string
+	Date         time.Time
+	UpdatedBy    string
+
+	MembershipRepository repository.Membership
+	TransactionManager   repository.TransactionManager
+}
+
+type FindMemberByJerseyNumber struct {
+	TeamSlug     string
+	JerseyNumber string
+	Date         time.Time
+
+	Repository repository.Membership
+}
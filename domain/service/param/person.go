@@ -1,17 +1,87 @@
 package param
 
 import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
 )
 
 // TODO(lhaddad): turn entities into pointers
 
 type GetAllPeople struct {
-	Repository repository.Person
+	IncludeArchived bool
+	// RequestedBy is the username of the requester, who sees everyone as Public when left empty
+	RequestedBy string
+	Criteria    entity.Criteria
+	Page        entity.PageRequest
+
+	Repository           repository.Person
+	MembershipRepository repository.Membership
 }
 
 type GetPersonByUserName struct {
 	UserName string
+	// RequestedBy is the username of the requester, who sees the person as Public when left empty
+	RequestedBy string
+
+	Repository repository.Person
+	// MembershipRepository is only needed to tell the audience of a RequestedBy
+	MembershipRepository repository.Membership
+}
+
+type ArchivePerson struct {
+	UserName  string
+	DeletedBy string
+
+	Repository repository.Person
+}
+
+type RestorePerson struct {
+	UserName  string
+	UpdatedBy string
+
+	Repository repository.Person
+}
+
+type UpdatePersonBirthDate struct {
+	UserName  string
+	BirthDate time.Time
+	UpdatedBy string
+
+	Repository repository.Person
+}
+
+type PurgeArchivedPeople struct {
+	ArchivedBefore time.Time
+
+	Repository repository.Person
+}
+
+type UpdatePersonVisibility struct {
+	UserName              string
+	EmailVisibility       entity.Audience
+	PhoneNumberVisibility entity.Audience
+	UpdatedBy             string
+
+	Repository repository.Person
+}
+
+type ExportPersonalData struct {
+	UserName    string
+	RequestedBy string
+	Admins      []string
+
+	Repository repository.Person
+}
+
+type ErasePerson struct {
+	UserName string
+	ErasedBy string
+	Admins   []string
 
 	Repository repository.Person
+	Storage    storage.Storage
 }
@@ -0,0 +1,13 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type Search struct {
+	Query string
+	Limit int
+
+	TeamRepository   repository.Team
+	PersonRepository repository.Person
+}
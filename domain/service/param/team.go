@@ -1,6 +1,8 @@
 package param
 
 import (
+	"time"
+
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 )
@@ -8,6 +10,10 @@ import (
 // TODO(lhaddad): turn entities into pointers
 
 type GetAllTeams struct {
+	IncludeArchived bool
+	Criteria        entity.Criteria
+	Page            entity.PageRequest
+
 	Repository repository.Team
 }
 
@@ -20,12 +26,56 @@ type GetTeamByName struct {
 type CreateTeam struct {
 	Team *entity.Team
 
-	Repository repository.Team
+	Repository        repository.Team
+	CountryRepository repository.Country
 }
 
 type UpdateTeam struct {
 	Team              *entity.Team
 	UpdatedAttributes []entity.TeamAttribute
 
+	Repository        repository.Team
+	CountryRepository repository.Country
+}
+
+type ArchiveTeam struct {
+	Name      string
+	DeletedBy string
+
+	Repository repository.Team
+}
+
+type RestoreTeam struct {
+	Name      string
+	UpdatedBy string
+
+	Repository repository.Team
+}
+
+type PurgeArchivedTeams struct {
+	ArchivedBefore time.Time
+
+	Repository repository.Team
+}
+
+type RenameTeam struct {
+	Name          string
+	NewName       string
+	NewSlug       string
+	EffectiveDate time.Time
+	UpdatedBy     string
+
+	Repository repository.Team
+}
+
+type GetPreviousTeamNames struct {
+	Name string
+
+	Repository repository.Team
+}
+
+type GetTeamByPreviousName struct {
+	NameOrSlug string
+
 	Repository repository.Team
 }
@@ -0,0 +1,60 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetTeamEvents struct {
+	TeamSlug string
+	From     time.Time
+	To       time.Time
+
+	Repository repository.TeamEvent
+}
+
+type CreateTeamEvent struct {
+	Event *entity.TeamEvent
+
+	Repository repository.TeamEvent
+}
+
+type RespondToTeamEvent struct {
+	TeamSlug       string
+	EventID        string
+	PersonUserName string
+	RSVP           entity.RSVPStatus
+
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type RecordTeamEventAttendance struct {
+	TeamSlug   string
+	EventID    string
+	Attendance map[string]entity.AttendanceStatus
+	RecordedBy string
+
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type GetTeamAttendanceReport struct {
+	TeamSlug string
+	From     time.Time
+	To       time.Time
+
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type GetTeamAvailability struct {
+	TeamSlug string
+	From     time.Time
+	To       time.Time
+
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
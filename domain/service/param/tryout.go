@@ -0,0 +1,76 @@
+package param
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetTryouts struct {
+	TeamSlug string
+
+	Repository repository.Tryout
+}
+
+type CreateTryout struct {
+	Tryout *entity.Tryout
+
+	Repository repository.Tryout
+}
+
+type SignUpForTryout struct {
+	TeamSlug string
+	TryoutID string
+	// Either the username of an existing person or the data of a person to be created.
+	PersonUserName string
+	NewPerson      *entity.Person
+
+	PersonRepository   repository.Person
+	TryoutRepository   repository.Tryout
+	CountryRepository  repository.Country
+	TransactionManager repository.TransactionManager
+}
+
+type GetTryoutCandidates struct {
+	TeamSlug string
+	TryoutID string
+
+	Repository repository.Tryout
+}
+
+type EvaluateTryoutCandidate struct {
+	TeamSlug          string
+	TryoutID          string
+	CandidateUserName string
+	EvaluatorUserName string
+	Scores            map[string]int
+
+	TryoutRepository     repository.Tryout
+	MembershipRepository repository.Membership
+}
+
+type GetTryoutRankings struct {
+	TeamSlug    string
+	TryoutID    string
+	RequestedBy string
+
+	TryoutRepository     repository.Tryout
+	MembershipRepository repository.Membership
+}
+
+type DecideTryoutCandidate struct {
+	TeamSlug            string
+	TryoutID            string
+	CandidateUserName   string
+	Decision            entity.TryoutCandidateStatus
+	Role                string
+	MembershipStartDate time.Time
+	DecidedBy           string
+
+	TryoutRepository     repository.Tryout
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	GuardianRepository   repository.Guardian
+	TransactionManager   repository.TransactionManager
+}
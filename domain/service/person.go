@@ -2,28 +2,53 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
 	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
 )
 
+// GetAllPeople returns a page of the people, along with the key that the next page follows when there is one.
 func GetAllPeople(
 	context context.Context,
 	param domainServiceParam.GetAllPeople,
 ) (domainServiceResult.GetAllPeople, error) {
-	People, err := param.Repository.GetAllPeople(context)
+	People, err := param.Repository.GetAllPeople(context, param.IncludeArchived, param.Criteria, param.Page.WithLookAhead())
 	if err != nil {
 		return domainServiceResult.GetAllPeople{
-			People: People,
+			People:    People,
+			Audiences: map[string]entity.Audience{},
 		}, fmt.Errorf("failed to fetch all People from repository: %w", err)
 	}
 
+	nextAfter := ""
+	if param.Page.HasMore(len(People)) {
+		People = People[:param.Page.Limit]
+		nextAfter = People[len(People)-1].UserName
+	}
+
+	audiences, err := requesterAudiences(context, param.RequestedBy, param.MembershipRepository, time.Now())
+	if err != nil {
+		return domainServiceResult.GetAllPeople{
+			People:    People,
+			Audiences: map[string]entity.Audience{},
+		}, err
+	}
+
 	return domainServiceResult.GetAllPeople{
-		People: People,
+		People:    People,
+		Audiences: audiences,
+		NextAfter: nextAfter,
 	}, nil
 }
 
+// GetPersonByUserName returns the person along with the audience that the requester belongs to, which is Public when
+// they share no team. The result holds a nil Person when there is no person with the given username.
 func GetPersonByUserName(
 	context context.Context,
 	param domainServiceParam.GetPersonByUserName,
@@ -31,11 +56,207 @@ func GetPersonByUserName(
 	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
 	if err != nil {
 		return domainServiceResult.GetPersonByUserName{
-			Person: nil,
+			Person:   nil,
+			Audience: entity.Audiences.Public,
 		}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
 	}
+	if person == nil {
+		return domainServiceResult.GetPersonByUserName{
+			Person:   nil,
+			Audience: entity.Audiences.Public,
+		}, nil
+	}
+
+	audiences, err := requesterAudiences(context, param.RequestedBy, param.MembershipRepository, time.Now())
+	if err != nil {
+		return domainServiceResult.GetPersonByUserName{
+			Person:   person,
+			Audience: entity.Audiences.Public,
+		}, err
+	}
+
+	audience, isKnown := audiences[person.UserName]
+	if !isKnown {
+		audience = entity.Audiences.Public
+	}
 
 	return domainServiceResult.GetPersonByUserName{
-		Person: person,
+		Person:   person,
+		Audience: audience,
+	}, nil
+}
+
+// ArchivePerson soft deletes a person, who leaves the listings but keeps their history. The result holds a nil Person
+// when there is no person with the given username, and it fails with failure.ErrAlreadyArchived when the person is
+// already archived.
+func ArchivePerson(
+	context context.Context,
+	param domainServiceParam.ArchivePerson,
+) (domainServiceResult.ArchivePerson, error) {
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.ArchivePerson{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.ArchivePerson{}, nil
+	}
+	if person.IsArchived() {
+		return domainServiceResult.ArchivePerson{
+			Person: person,
+		}, personAlreadyArchived(param.UserName)
+	}
+
+	archivedPerson, err := param.Repository.ArchivePerson(context, person.WithDeletedBy(param.DeletedBy))
+	if err != nil {
+		return domainServiceResult.ArchivePerson{
+			Person: person,
+		}, fmt.Errorf("failed to archive person '%s' in repository: %w", param.UserName, err)
+	}
+	if archivedPerson == nil {
+		// The person was archived in the meantime
+		return domainServiceResult.ArchivePerson{
+			Person: person,
+		}, personAlreadyArchived(param.UserName)
+	}
+
+	return domainServiceResult.ArchivePerson{
+		Person: archivedPerson,
+	}, nil
+}
+
+// RestorePerson brings an archived person back to the listings. The result holds a nil Person when there is no person
+// with the given username, and it fails with failure.ErrNotArchived when the person is not archived.
+func RestorePerson(
+	context context.Context,
+	param domainServiceParam.RestorePerson,
+) (domainServiceResult.RestorePerson, error) {
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.RestorePerson{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.RestorePerson{}, nil
+	}
+	if !person.IsArchived() {
+		return domainServiceResult.RestorePerson{
+			Person: person,
+		}, personNotArchived(param.UserName)
+	}
+
+	restoredPerson, err := param.Repository.RestorePerson(context, person.WithUpdatedBy(param.UpdatedBy))
+	if err != nil {
+		return domainServiceResult.RestorePerson{
+			Person: person,
+		}, fmt.Errorf("failed to restore person '%s' in repository: %w", param.UserName, err)
+	}
+	if restoredPerson == nil {
+		// The person was restored in the meantime
+		return domainServiceResult.RestorePerson{
+			Person: person,
+		}, personNotArchived(param.UserName)
+	}
+
+	return domainServiceResult.RestorePerson{
+		Person: restoredPerson,
+	}, nil
+}
+
+// UpdatePersonBirthDate saves the birth date of a person, which tells whether they are a minor that needs a
+// guardian's consent. The result holds a nil Person when there is no such person.
+func UpdatePersonBirthDate(
+	context context.Context,
+	param domainServiceParam.UpdatePersonBirthDate,
+) (domainServiceResult.UpdatePersonBirthDate, error) {
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.UpdatePersonBirthDate{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.UpdatePersonBirthDate{}, nil
+	}
+
+	updatedPerson, err := param.Repository.UpdatePersonBirthDate(
+		context,
+		person.WithBirthDate(param.BirthDate).WithUpdatedBy(param.UpdatedBy),
+	)
+	if err != nil {
+		return domainServiceResult.UpdatePersonBirthDate{
+			Person: person,
+		}, fmt.Errorf("failed to update birth date of person '%s' in repository: %w", param.UserName, err)
+	}
+
+	return domainServiceResult.UpdatePersonBirthDate{
+		Person: updatedPerson,
+	}, nil
+}
+
+// PurgeArchivedPeople permanently deletes the people archived before the given date. People who are still referenced
+// by other records, such as memberships or ledger entries, are kept so the history is not broken.
+func PurgeArchivedPeople(
+	context context.Context,
+	param domainServiceParam.PurgeArchivedPeople,
+) (domainServiceResult.PurgeArchivedPeople, error) {
+	people, err := param.Repository.GetPeopleArchivedBefore(context, param.ArchivedBefore)
+	if err != nil {
+		return domainServiceResult.PurgeArchivedPeople{}, fmt.Errorf("failed to fetch archived people from repository: %w", err)
+	}
+
+	result := domainServiceResult.PurgeArchivedPeople{
+		PurgedPeople: []*entity.Person{},
+		KeptPeople:   []*entity.Person{},
+	}
+	for _, person := range people {
+		err := param.Repository.DeletePerson(context, person.UserName)
+		if errors.Is(err, repository.ErrStillReferenced) {
+			result.KeptPeople = append(result.KeptPeople, person)
+
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to delete person '%s' in repository: %w", person.UserName, err)
+		}
+		result.PurgedPeople = append(result.PurgedPeople, person)
+	}
+
+	return result, nil
+}
+
+// UpdatePersonVisibility saves who can see the contact details of a person. The result holds a nil Person when there
+// is no such person.
+func UpdatePersonVisibility(
+	context context.Context,
+	param domainServiceParam.UpdatePersonVisibility,
+) (domainServiceResult.UpdatePersonVisibility, error) {
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.UpdatePersonVisibility{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.UpdatePersonVisibility{}, nil
+	}
+
+	updatedPerson, err := param.Repository.UpdatePersonVisibility(
+		context,
+		person.
+			WithEmailVisibility(param.EmailVisibility).
+			WithPhoneNumberVisibility(param.PhoneNumberVisibility).
+			WithUpdatedBy(param.UpdatedBy),
+	)
+	if err != nil {
+		return domainServiceResult.UpdatePersonVisibility{
+			Person: person,
+		}, fmt.Errorf("failed to update visibility of person '%s' in repository: %w", param.UserName, err)
+	}
+
+	return domainServiceResult.UpdatePersonVisibility{
+		Person: updatedPerson,
 	}, nil
 }
+
+func personAlreadyArchived(userName string) error {
+	return failure.ErrAlreadyArchived.WithMessage(fmt.Sprintf("person '%s' is already archived", userName))
+}
+
+func personNotArchived(userName string) error {
+	return failure.ErrNotArchived.WithMessage(fmt.Sprintf("person '%s' is not archived", userName))
+}
@@ -0,0 +1,148 @@
+//go:build unit
+// +build unit
+
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// fakePersonRepository answers the given people from memory.
+type fakePersonRepository struct {
+	repository.Person
+	people []*entity.Person
+}
+
+func (fake *fakePersonRepository) GetAllPeople(
+	_ context.Context,
+	_ bool,
+	_ entity.Criteria,
+	_ entity.PageRequest,
+) ([]*entity.Person, error) {
+	return fake.people, nil
+}
+
+func (fake *fakePersonRepository) GetPersonByUserName(_ context.Context, userName string) (*entity.Person, error) {
+	for _, person := range fake.people {
+		if person.UserName == userName {
+			return person, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// personTestMemberships are ongoing memberships of a player, their captain and a teammate, plus a player of another
+// team.
+func personTestMemberships() []entity.Membership {
+	team := &entity.Team{Slug: "bra-sp-my-team-slug"}
+	membership := func(team *entity.Team, userName string, role string) entity.Membership {
+		return entity.Membership{
+			Team:      team,
+			Person:    &entity.Person{UserName: userName},
+			Role:      role,
+			StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	return []entity.Membership{
+		membership(team, "some.player", entity.MembershipRoles.Player),
+		membership(team, "some.captain", entity.MembershipRoles.Captain),
+		membership(team, "some.teammate", entity.MembershipRoles.Player),
+		membership(&entity.Team{Slug: "bra-rj-another-team-slug"}, "rival.player", entity.MembershipRoles.Player),
+	}
+}
+
+func TestGetPersonByUserName(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{UserName: "some.player", Email: "some.player@example.com", PhoneNumber: "+5511999999999"}
+
+	scenarios := []struct {
+		description      string
+		requestedBy      string
+		expectedAudience entity.Audience
+	}{
+		{
+			description:      "should show the person as the public sees them when the requester is not told",
+			requestedBy:      "",
+			expectedAudience: entity.Audiences.Public,
+		},
+		{
+			description:      "should show the person as the public sees them to someone who shares no team",
+			requestedBy:      "rival.player",
+			expectedAudience: entity.Audiences.Public,
+		},
+		{
+			description:      "should show the person as a teammate sees them to another player of the team",
+			requestedBy:      "some.teammate",
+			expectedAudience: entity.Audiences.Teammate,
+		},
+		{
+			description:      "should show the person as the staff sees them to the captain of the team",
+			requestedBy:      "some.captain",
+			expectedAudience: entity.Audiences.Staff,
+		},
+		{
+			description:      "should show everything to the person themself",
+			requestedBy:      "some.player",
+			expectedAudience: entity.Audiences.Self,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := domainService.GetPersonByUserName(context.Background(), domainServiceParam.GetPersonByUserName{
+				UserName:    person.UserName,
+				RequestedBy: scenario.requestedBy,
+
+				Repository:           &fakePersonRepository{people: []*entity.Person{person}},
+				MembershipRepository: &fakeMembershipRepository{memberships: personTestMemberships()},
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, person, result.Person)
+			require.Equal(t, scenario.expectedAudience, result.Audience)
+		})
+	}
+}
+
+func TestGetAllPeople(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{UserName: "some.player", Email: "some.player@example.com"}
+	rival := &entity.Person{UserName: "rival.player", Email: "rival.player@example.com"}
+
+	publicResult, err := domainService.GetAllPeople(context.Background(), domainServiceParam.GetAllPeople{
+		Repository:           &fakePersonRepository{people: []*entity.Person{person, rival}},
+		MembershipRepository: &fakeMembershipRepository{memberships: personTestMemberships()},
+	})
+	require.NoError(t, err)
+	require.Empty(t, publicResult.Audiences)
+
+	teammateResult, err := domainService.GetAllPeople(context.Background(), domainServiceParam.GetAllPeople{
+		RequestedBy: "some.teammate",
+
+		Repository:           &fakePersonRepository{people: []*entity.Person{person, rival}},
+		MembershipRepository: &fakeMembershipRepository{memberships: personTestMemberships()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.Audiences.Teammate, teammateResult.Audiences[person.UserName])
+	require.NotContains(t, teammateResult.Audiences, rival.UserName)
+
+	// The email is only visible to teammates, so the public does not see it
+	require.True(t, person.IsVisibleTo(entity.PersonAttributes.Email, teammateResult.Audiences[person.UserName]))
+	require.False(t, person.IsVisibleTo(entity.PersonAttributes.Email, entity.Audiences.Public))
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+// anonymizedSuffixLength is how many random bytes tell the anonymized people apart.
+const anonymizedSuffixLength = 6
+
+// ExportPersonalData gathers everything that is known about a person, so it can be handed to them on request. Only the
+// person or one of the admins may ask for it, failing with failure.ErrNotSelfOrAdmin otherwise. The result holds a nil
+// PersonalData when there is no person with the given username.
+func ExportPersonalData(
+	context context.Context,
+	param domainServiceParam.ExportPersonalData,
+) (domainServiceResult.ExportPersonalData, error) {
+	if !isSelfOrAdmin(param.RequestedBy, param.UserName, param.Admins) {
+		return domainServiceResult.ExportPersonalData{}, failure.ErrNotSelfOrAdmin.WithMessage(
+			fmt.Sprintf("'%s' may not export the personal data of person '%s'", param.RequestedBy, param.UserName),
+		)
+	}
+
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.ExportPersonalData{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.ExportPersonalData{}, nil
+	}
+
+	personalData, err := param.Repository.GetPersonalData(context, person)
+	if err != nil {
+		return domainServiceResult.ExportPersonalData{}, fmt.Errorf("failed to fetch personal data of '%s' from repository: %w", param.UserName, err)
+	}
+	personalData.ExportedAt = time.Now()
+
+	return domainServiceResult.ExportPersonalData{
+		PersonalData: personalData,
+	}, nil
+}
+
+// ErasePerson exercises the right to erasure of a person. They are replaced by an archived anonymized person in every
+// record, so memberships, events, tryouts and ledger entries still add up, while their profile, guardianships, consents,
+// aliases and avatar are deleted. Only the person or one of the admins may ask for it, failing with
+// failure.ErrNotSelfOrAdmin otherwise. The result holds a nil Person when there is no person with the given username,
+// and it fails with failure.ErrAlreadyErased when the personal data of the person was already erased.
+func ErasePerson(
+	context context.Context,
+	param domainServiceParam.ErasePerson,
+) (domainServiceResult.ErasePerson, error) {
+	if !isSelfOrAdmin(param.ErasedBy, param.UserName, param.Admins) {
+		return domainServiceResult.ErasePerson{}, failure.ErrNotSelfOrAdmin.WithMessage(
+			fmt.Sprintf("'%s' may not erase the personal data of person '%s'", param.ErasedBy, param.UserName),
+		)
+	}
+
+	person, err := param.Repository.GetPersonByUserName(context, param.UserName)
+	if err != nil {
+		return domainServiceResult.ErasePerson{}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.UserName, err)
+	}
+	if person == nil {
+		return domainServiceResult.ErasePerson{}, nil
+	}
+	if person.IsAnonymized() {
+		return domainServiceResult.ErasePerson{
+			Person: person,
+		}, failure.ErrAlreadyErased.WithMessage(fmt.Sprintf("the personal data of person '%s' was already erased", param.UserName))
+	}
+
+	suffix, err := randomAnonymizedSuffix()
+	if err != nil {
+		return domainServiceResult.ErasePerson{
+			Person: person,
+		}, err
+	}
+
+	erasedPerson, err := param.Repository.ErasePerson(context, person, person.Anonymize(suffix, param.ErasedBy))
+	if err != nil {
+		return domainServiceResult.ErasePerson{
+			Person: person,
+		}, fmt.Errorf("failed to erase person '%s' in repository: %w", param.UserName, err)
+	}
+
+	// The avatar is personal data as well, but is only deleted once nothing references it anymore
+	deleteObjects(context, param.Storage, person.AvatarKey, person.AvatarThumbnailKey)
+
+	return domainServiceResult.ErasePerson{
+		Person: erasedPerson,
+	}, nil
+}
+
+// isSelfOrAdmin tells whether the requester is the person whose personal data is at stake or one of the admins.
+func isSelfOrAdmin(requesterUserName string, personUserName string, admins []string) bool {
+	if requesterUserName == "" {
+		return false
+	}
+	if requesterUserName == personUserName {
+		return true
+	}
+	for _, admin := range admins {
+		if admin == requesterUserName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func randomAnonymizedSuffix() (string, error) {
+	randomBytes := make([]byte, anonymizedSuffixLength)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate anonymized username: %w", err)
+	}
+
+	return hex.EncodeToString(randomBytes), nil
+}
@@ -0,0 +1,123 @@
+//go:build unit
+// +build unit
+
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// fakePersonalDataRepository answers the given people from memory, with no records referencing them, and erases them
+// by answering the anonymized person.
+type fakePersonalDataRepository struct {
+	fakePersonRepository
+}
+
+func (fake *fakePersonalDataRepository) GetPersonalData(_ context.Context, person *entity.Person) (*entity.PersonalData, error) {
+	return &entity.PersonalData{Person: person}, nil
+}
+
+func (fake *fakePersonalDataRepository) ErasePerson(
+	_ context.Context,
+	_ *entity.Person,
+	anonymized *entity.Person,
+) (*entity.Person, error) {
+	return anonymized, nil
+}
+
+// personalDataRequesterScenarios tell who may act on the personal data of some.player, with some.admin as the only
+// admin.
+var personalDataRequesterScenarios = []struct {
+	description string
+	requestedBy string
+	expectedErr error
+}{
+	{
+		description: "should let the person act on their own personal data",
+		requestedBy: "some.player",
+		expectedErr: nil,
+	},
+	{
+		description: "should let an admin act on the personal data of anyone",
+		requestedBy: "some.admin",
+		expectedErr: nil,
+	},
+	{
+		description: "should forbid anyone else to act on the personal data of the person",
+		requestedBy: "some.teammate",
+		expectedErr: failure.ErrNotSelfOrAdmin,
+	},
+	{
+		description: "should forbid an unknown requester to act on the personal data of the person",
+		requestedBy: "",
+		expectedErr: failure.ErrNotSelfOrAdmin,
+	},
+}
+
+func TestExportPersonalData(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{UserName: "some.player"}
+
+	for _, scenario := range personalDataRequesterScenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := domainService.ExportPersonalData(context.Background(), domainServiceParam.ExportPersonalData{
+				UserName:    person.UserName,
+				RequestedBy: scenario.requestedBy,
+				Admins:      []string{"some.admin"},
+
+				Repository: &fakePersonalDataRepository{fakePersonRepository{people: []*entity.Person{person}}},
+			})
+
+			if scenario.expectedErr != nil {
+				require.ErrorIs(t, err, scenario.expectedErr)
+				require.Nil(t, result.PersonalData)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, person, result.PersonalData.Person)
+		})
+	}
+}
+
+func TestErasePerson(t *testing.T) {
+	t.Parallel()
+
+	person := &entity.Person{UserName: "some.player"}
+
+	for _, scenario := range personalDataRequesterScenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := domainService.ErasePerson(context.Background(), domainServiceParam.ErasePerson{
+				UserName: person.UserName,
+				ErasedBy: scenario.requestedBy,
+				Admins:   []string{"some.admin"},
+
+				Repository: &fakePersonalDataRepository{fakePersonRepository{people: []*entity.Person{person}}},
+			})
+
+			if scenario.expectedErr != nil {
+				require.ErrorIs(t, err, scenario.expectedErr)
+				require.Nil(t, result.Person)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, result.Person.IsAnonymized())
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetPersonCredentials struct {
+	WFDFAccreditations    []*entity.WFDFAccreditation
+	FederationMemberships []*entity.FederationMembership
+	CoachCertifications   []*entity.CoachCertification
+}
+
+type CreateWFDFAccreditation struct {
+	Accreditation *entity.WFDFAccreditation
+}
+
+type CreateFederationMembership struct {
+	Membership *entity.FederationMembership
+}
+
+type CreateCoachCertification struct {
+	Certification *entity.CoachCertification
+}
+
+type GetTeamExpiringWFDFAccreditations struct {
+	Statuses []*entity.WFDFAccreditationStatus
+}
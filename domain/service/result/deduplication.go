@@ -0,0 +1,20 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/deduplication"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type FindDuplicatePeople struct {
+	Pairs []deduplication.Pair
+}
+
+type MergePeople struct {
+	Person       *entity.Person
+	Duplicate    *entity.Person
+	MergedPerson *entity.Person
+}
+
+type GetPersonByAlias struct {
+	Person *entity.Person
+}
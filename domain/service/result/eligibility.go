@@ -0,0 +1,10 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+)
+
+type CheckRosterEligibility struct {
+	Eligible   bool
+	Violations []eligibility.Violation
+}
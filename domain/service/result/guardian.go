@@ -0,0 +1,29 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetGuardians struct {
+	Guardianships []*entity.Guardianship
+}
+
+type AddGuardian struct {
+	Guardianship *entity.Guardianship
+}
+
+type RemoveGuardian struct {
+	Guardianship *entity.Guardianship
+}
+
+type GetConsents struct {
+	Consents []*entity.Consent
+}
+
+type GrantConsent struct {
+	Consent *entity.Consent
+}
+
+type GetDependentsSchedule struct {
+	Events []*entity.DependentTeamEvent
+}
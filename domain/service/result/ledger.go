@@ -0,0 +1,26 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetTeamLedgerTransactions struct {
+	Transactions []*entity.LedgerTransaction
+}
+
+type GetTeamLedgerBalances struct {
+	Balances                []*entity.LedgerBalance
+	TotalOutstandingInCents int64
+}
+
+type ChargeMembershipDues struct {
+	Transaction *entity.LedgerTransaction
+}
+
+type ChargeTournamentFee struct {
+	Transaction *entity.LedgerTransaction
+}
+
+type RecordLedgerPayment struct {
+	Transaction *entity.LedgerTransaction
+}
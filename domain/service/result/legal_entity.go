@@ -0,0 +1,43 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetAllLegalEntities struct {
+	LegalEntities []*entity.LegalEntity
+	// NextAfter is the key that the next page follows, empty on the last page.
+	NextAfter string
+}
+
+type GetLegalEntityBySlug struct {
+	LegalEntity *entity.LegalEntity
+}
+
+type CreateLegalEntity struct {
+	LegalEntity *entity.LegalEntity
+}
+
+type UpdateLegalEntity struct {
+	LegalEntity *entity.LegalEntity
+}
+
+type GetLegalEntityAffiliations struct {
+	Affiliations []*entity.LegalEntityAffiliation
+}
+
+type GetTeamLegalEntityAffiliations struct {
+	Affiliations []*entity.LegalEntityAffiliation
+}
+
+type GetPersonLegalEntityAffiliations struct {
+	Affiliations []*entity.LegalEntityAffiliation
+}
+
+type AffiliateToLegalEntity struct {
+	Affiliation *entity.LegalEntityAffiliation
+}
+
+type EndLegalEntityAffiliation struct {
+	Affiliation *entity.LegalEntityAffiliation
+}
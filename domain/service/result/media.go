@@ -0,0 +1,17 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type UploadTeamLogo struct {
+	Team *entity.Team
+}
+
+type UploadPersonAvatar struct {
+	Person *entity.Person
+}
+
+type GetMediaObject struct {
+	MediaObject *entity.MediaObject
+}
@@ -7,3 +7,24 @@ import (
 type GetTeamMembershipsByRole struct {
 	Memberships []entity.Membership
 }
+
+type GetTeamMemberships struct {
+	Memberships []entity.Membership
+}
+
+type GetPersonMemberships struct {
+	Memberships []entity.Membership
+}
+
+type TransferMembership struct {
+	EndedMembership *entity.Membership
+	Membership      *entity.Membership
+}
+
+type AssignJerseyNumber struct {
+	Memberships []*entity.Membership
+}
+
+type FindMemberByJerseyNumber struct {
+	Membership *entity.Membership
+}
@@ -6,8 +6,43 @@ import (
 
 type GetAllPeople struct {
 	People []*entity.Person
+	// Audiences tells how close the requester is to each person, who is Public when left out.
+	Audiences map[string]entity.Audience
+	// NextAfter is the key that the next page follows, empty on the last page.
+	NextAfter string
 }
 
 type GetPersonByUserName struct {
 	Person *entity.Person
+	// Audience tells how close the requester is to the person.
+	Audience entity.Audience
+}
+
+type ArchivePerson struct {
+	Person *entity.Person
+}
+
+type RestorePerson struct {
+	Person *entity.Person
+}
+
+type UpdatePersonBirthDate struct {
+	Person *entity.Person
+}
+
+type PurgeArchivedPeople struct {
+	PurgedPeople []*entity.Person
+	KeptPeople   []*entity.Person
+}
+
+type UpdatePersonVisibility struct {
+	Person *entity.Person
+}
+
+type ExportPersonalData struct {
+	PersonalData *entity.PersonalData
+}
+
+type ErasePerson struct {
+	Person *entity.Person
 }
@@ -0,0 +1,9 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type Search struct {
+	Results []*entity.SearchResult
+}
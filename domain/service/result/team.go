@@ -6,6 +6,8 @@ import (
 
 type GetAllTeams struct {
 	Teams []*entity.Team
+	// NextAfter is the key that the next page follows, empty on the last page.
+	NextAfter string
 }
 
 type GetTeamByName struct {
@@ -19,3 +21,31 @@ type CreateTeam struct {
 type UpdateTeam struct {
 	Team *entity.Team
 }
+
+type ArchiveTeam struct {
+	Team *entity.Team
+}
+
+type RestoreTeam struct {
+	Team *entity.Team
+}
+
+type PurgeArchivedTeams struct {
+	PurgedTeams []*entity.Team
+	KeptTeams   []*entity.Team
+}
+
+type RenameTeam struct {
+	Team *entity.Team
+	// LatestPreviousName is the name replaced by the latest rename, which must have taken effect before the new one.
+	LatestPreviousName *entity.TeamName
+}
+
+type GetPreviousTeamNames struct {
+	Team          *entity.Team
+	PreviousNames []*entity.TeamName
+}
+
+type GetTeamByPreviousName struct {
+	Team *entity.Team
+}
@@ -0,0 +1,31 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetTeamEvents struct {
+	Events []*entity.TeamEvent
+}
+
+type CreateTeamEvent struct {
+	Event *entity.TeamEvent
+}
+
+type RespondToTeamEvent struct {
+	Event         *entity.TeamEvent
+	Participation *entity.TeamEventParticipation
+}
+
+type RecordTeamEventAttendance struct {
+	Event          *entity.TeamEvent
+	Participations []*entity.TeamEventParticipation
+}
+
+type GetTeamAttendanceReport struct {
+	Rates []*entity.TeamAttendanceRate
+}
+
+type GetTeamAvailability struct {
+	Availabilities []*entity.TeamEventAvailability
+}
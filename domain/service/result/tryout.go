@@ -0,0 +1,41 @@
+package result
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+type GetTryouts struct {
+	Tryouts []*entity.Tryout
+}
+
+type CreateTryout struct {
+	Tryout *entity.Tryout
+}
+
+type SignUpForTryout struct {
+	Tryout    *entity.Tryout
+	Person    *entity.Person
+	Candidate *entity.TryoutCandidate
+}
+
+type GetTryoutCandidates struct {
+	Tryout     *entity.Tryout
+	Candidates []*entity.TryoutCandidate
+}
+
+type EvaluateTryoutCandidate struct {
+	Tryout      *entity.Tryout
+	Candidate   *entity.TryoutCandidate
+	Evaluations []*entity.TryoutEvaluation
+}
+
+type GetTryoutRankings struct {
+	Tryout   *entity.Tryout
+	Rankings []*entity.TryoutRanking
+}
+
+type DecideTryoutCandidate struct {
+	Tryout     *entity.Tryout
+	Candidate  *entity.TryoutCandidate
+	Membership *entity.Membership
+}
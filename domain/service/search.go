@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/search"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+// Search finds the teams and people that best match the query, up to the limit, the best ranked first. Each result
+// highlights the parts of its attributes that match the query.
+func Search(
+	context context.Context,
+	param domainServiceParam.Search,
+) (domainServiceResult.Search, error) {
+	teamResults, err := param.TeamRepository.SearchTeams(context, param.Query, param.Limit)
+	if err != nil {
+		return domainServiceResult.Search{}, fmt.Errorf("failed to search teams from repository: %w", err)
+	}
+
+	personResults, err := param.PersonRepository.SearchPeople(context, param.Query, param.Limit)
+	if err != nil {
+		return domainServiceResult.Search{}, fmt.Errorf("failed to search people from repository: %w", err)
+	}
+
+	results := append(teamResults, personResults...)
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+
+		return results[i].Name() < results[j].Name()
+	})
+	if len(results) > param.Limit {
+		results = results[:param.Limit]
+	}
+
+	for _, result := range results {
+		result.Highlights = searchHighlights(result, param.Query)
+	}
+
+	return domainServiceResult.Search{
+		Results: results,
+	}, nil
+}
+
+// searchHighlights highlights the searched attributes of the result, leaving out the ones without any match.
+func searchHighlights(result *entity.SearchResult, query string) map[string][]entity.TextSpan {
+	texts := map[string]string{}
+	if result.Team != nil {
+		texts[string(entity.TeamAttributes.Name)] = result.Team.Name
+		texts[string(entity.TeamAttributes.Description)] = result.Team.Description
+	} else {
+		texts[string(entity.PersonAttributes.Name)] = result.Person.Name
+	}
+
+	highlights := map[string][]entity.TextSpan{}
+	for attribute, text := range texts {
+		spans := search.Highlight(text, query)
+		if len(spans) > 0 {
+			highlights[attribute] = spans
+		}
+	}
+
+	return highlights
+}
@@ -2,25 +2,38 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
 	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
 )
 
+// GetAllTeams returns a page of the teams, along with the key that the next page follows when there is one.
 func GetAllTeams(
 	context context.Context,
 	param domainServiceParam.GetAllTeams,
 ) (domainServiceResult.GetAllTeams, error) {
-	teams, err := param.Repository.GetAllTeams(context)
+	teams, err := param.Repository.GetAllTeams(context, param.IncludeArchived, param.Criteria, param.Page.WithLookAhead())
 	if err != nil {
 		return domainServiceResult.GetAllTeams{
 			Teams: teams,
 		}, fmt.Errorf("failed to fetch all teams from repository: %w", err)
 	}
 
+	nextAfter := ""
+	if param.Page.HasMore(len(teams)) {
+		teams = teams[:param.Page.Limit]
+		nextAfter = teams[len(teams)-1].Slug
+	}
+
 	return domainServiceResult.GetAllTeams{
-		Teams: teams,
+		Teams:     teams,
+		NextAfter: nextAfter,
 	}, nil
 }
 
@@ -44,7 +57,21 @@ func CreateTeam(
 	context context.Context,
 	param domainServiceParam.CreateTeam,
 ) (domainServiceResult.CreateTeam, error) {
-	team, err := param.Repository.CreateTeam(context, param.Team)
+	originCountry, err := getCountryCode(
+		context, param.Team.OriginCountry, "originCountry", "Team", "Origin Country", param.CountryRepository,
+	)
+	if err != nil {
+		return domainServiceResult.CreateTeam{}, err
+	}
+	newTeam := param.Team.Clone()
+	newTeam.OriginCountry = originCountry
+
+	team, err := param.Repository.CreateTeam(context, newTeam)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.CreateTeam{}, failure.ErrAlreadyExists.WithMessage(
+			fmt.Sprintf("team with name '%s' already exists", param.Team.Name),
+		)
+	}
 	if err != nil {
 		return domainServiceResult.CreateTeam{
 			Team: team,
@@ -60,7 +87,19 @@ func UpdateTeam(
 	context context.Context,
 	param domainServiceParam.UpdateTeam,
 ) (domainServiceResult.UpdateTeam, error) {
-	team, err := param.Repository.UpdateTeam(context, param.Team, param.UpdatedAttributes)
+	updatedTeam := param.Team
+	if updatedTeam.OriginCountry != "" {
+		originCountry, err := getCountryCode(
+			context, updatedTeam.OriginCountry, "originCountry", "Team", "Origin Country", param.CountryRepository,
+		)
+		if err != nil {
+			return domainServiceResult.UpdateTeam{}, err
+		}
+		updatedTeam = updatedTeam.Clone()
+		updatedTeam.OriginCountry = originCountry
+	}
+
+	team, err := param.Repository.UpdateTeam(context, updatedTeam, param.UpdatedAttributes)
 	if err != nil {
 		return domainServiceResult.UpdateTeam{
 			Team: team,
@@ -71,3 +110,234 @@ func UpdateTeam(
 		Team: team,
 	}, nil
 }
+
+// ArchiveTeam soft deletes a team, which leaves the listings but keeps its history. The result holds a nil Team when
+// there is no team with the given name, and it fails with failure.ErrAlreadyArchived when the team is already archived.
+func ArchiveTeam(
+	context context.Context,
+	param domainServiceParam.ArchiveTeam,
+) (domainServiceResult.ArchiveTeam, error) {
+	team, err := param.Repository.GetTeamByName(context, param.Name)
+	if err != nil {
+		return domainServiceResult.ArchiveTeam{}, fmt.Errorf("failed to fetch team by name '%s' from repository: %w", param.Name, err)
+	}
+	if team == nil {
+		return domainServiceResult.ArchiveTeam{}, nil
+	}
+	if team.IsArchived() {
+		return domainServiceResult.ArchiveTeam{
+			Team: team,
+		}, teamAlreadyArchived(param.Name)
+	}
+
+	archivedTeam, err := param.Repository.ArchiveTeam(context, team.WithDeletedBy(param.DeletedBy))
+	if err != nil {
+		return domainServiceResult.ArchiveTeam{
+			Team: team,
+		}, fmt.Errorf("failed to archive team with name '%s' in repository: %w", param.Name, err)
+	}
+	if archivedTeam == nil {
+		// The team was archived in the meantime
+		return domainServiceResult.ArchiveTeam{
+			Team: team,
+		}, teamAlreadyArchived(param.Name)
+	}
+
+	return domainServiceResult.ArchiveTeam{
+		Team: archivedTeam,
+	}, nil
+}
+
+// RestoreTeam brings an archived team back to the listings. The result holds a nil Team when there is no team with
+// the given name, and it fails with failure.ErrNotArchived when the team is not archived.
+func RestoreTeam(
+	context context.Context,
+	param domainServiceParam.RestoreTeam,
+) (domainServiceResult.RestoreTeam, error) {
+	team, err := param.Repository.GetTeamByName(context, param.Name)
+	if err != nil {
+		return domainServiceResult.RestoreTeam{}, fmt.Errorf("failed to fetch team by name '%s' from repository: %w", param.Name, err)
+	}
+	if team == nil {
+		return domainServiceResult.RestoreTeam{}, nil
+	}
+	if !team.IsArchived() {
+		return domainServiceResult.RestoreTeam{
+			Team: team,
+		}, teamNotArchived(param.Name)
+	}
+
+	restoredTeam, err := param.Repository.RestoreTeam(context, team.WithUpdatedBy(param.UpdatedBy))
+	if err != nil {
+		return domainServiceResult.RestoreTeam{
+			Team: team,
+		}, fmt.Errorf("failed to restore team with name '%s' in repository: %w", param.Name, err)
+	}
+	if restoredTeam == nil {
+		// The team was restored in the meantime
+		return domainServiceResult.RestoreTeam{
+			Team: team,
+		}, teamNotArchived(param.Name)
+	}
+
+	return domainServiceResult.RestoreTeam{
+		Team: restoredTeam,
+	}, nil
+}
+
+// PurgeArchivedTeams permanently deletes the teams archived before the given date. Teams that are still referenced by
+// other records, such as memberships or ledger entries, are kept so the history is not broken.
+func PurgeArchivedTeams(
+	context context.Context,
+	param domainServiceParam.PurgeArchivedTeams,
+) (domainServiceResult.PurgeArchivedTeams, error) {
+	teams, err := param.Repository.GetTeamsArchivedBefore(context, param.ArchivedBefore)
+	if err != nil {
+		return domainServiceResult.PurgeArchivedTeams{}, fmt.Errorf("failed to fetch archived teams from repository: %w", err)
+	}
+
+	result := domainServiceResult.PurgeArchivedTeams{
+		PurgedTeams: []*entity.Team{},
+		KeptTeams:   []*entity.Team{},
+	}
+	for _, team := range teams {
+		err := param.Repository.DeleteTeam(context, team.Slug)
+		if errors.Is(err, repository.ErrStillReferenced) {
+			result.KeptTeams = append(result.KeptTeams, team)
+
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to delete team with name '%s' in repository: %w", team.Name, err)
+		}
+		result.PurgedTeams = append(result.PurgedTeams, team)
+	}
+
+	return result, nil
+}
+
+// RenameTeam gives a team a new name and slug, keeping the current ones as previous names that were in effect until
+// the effective date of the rename. The result holds a nil Team when there is no team with the given name. It fails
+// with failure.ErrRenameBeforeLast when the rename takes effect before the latest one, and with
+// failure.ErrAlreadyExists when another team already has the new name or slug.
+func RenameTeam(
+	context context.Context,
+	param domainServiceParam.RenameTeam,
+) (domainServiceResult.RenameTeam, error) {
+	team, err := param.Repository.GetTeamByName(context, param.Name)
+	if err != nil {
+		return domainServiceResult.RenameTeam{}, fmt.Errorf("failed to fetch team by name '%s' from repository: %w", param.Name, err)
+	}
+	if team == nil {
+		return domainServiceResult.RenameTeam{}, nil
+	}
+
+	previousNames, err := param.Repository.GetPreviousTeamNames(context, team.Slug)
+	if err != nil {
+		return domainServiceResult.RenameTeam{
+			Team: team,
+		}, fmt.Errorf("failed to fetch previous names of team '%s' from repository: %w", param.Name, err)
+	}
+
+	// Each name is in effect until the next one takes effect, so renames must follow each other
+	latestPreviousName := entity.LatestTeamName(previousNames)
+	if latestPreviousName != nil && !param.EffectiveDate.After(latestPreviousName.EffectiveUntil) {
+		return domainServiceResult.RenameTeam{
+			Team:               team,
+			LatestPreviousName: latestPreviousName,
+		}, failure.ErrRenameBeforeLast.WithMessage(fmt.Sprintf(
+			"the Rename's 'EffectiveDate' should be after %s, when team '%s' was last renamed",
+			latestPreviousName.EffectiveUntil.Format(time.DateOnly),
+			param.Name,
+		))
+	}
+
+	previousName := &entity.TeamName{
+		Team:           team,
+		Name:           team.Name,
+		Slug:           team.Slug,
+		EffectiveUntil: param.EffectiveDate,
+		CreatedBy:      param.UpdatedBy,
+	}
+	if latestPreviousName != nil {
+		previousName.EffectiveFrom = latestPreviousName.EffectiveUntil
+	}
+
+	renamedTeam := team.WithName(param.NewName).WithSlug(param.NewSlug).WithUpdatedBy(param.UpdatedBy)
+	renamedTeam, err = param.Repository.RenameTeam(context, team.Slug, renamedTeam, previousName)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.RenameTeam{
+			Team:               team,
+			LatestPreviousName: latestPreviousName,
+		}, failure.ErrAlreadyExists.WithMessage(
+			fmt.Sprintf("a team with name '%s' or slug '%s' already exists", param.NewName, param.NewSlug),
+		)
+	}
+	if err != nil {
+		return domainServiceResult.RenameTeam{
+			Team: team,
+		}, fmt.Errorf("failed to rename team with name '%s' in repository: %w", param.Name, err)
+	}
+	if renamedTeam == nil {
+		// The team was renamed in the meantime
+		return domainServiceResult.RenameTeam{}, nil
+	}
+
+	return domainServiceResult.RenameTeam{
+		Team:               renamedTeam,
+		LatestPreviousName: previousName,
+	}, nil
+}
+
+// GetPreviousTeamNames returns the names that a team used before being renamed, the oldest first. The result holds a
+// nil Team when there is no team with the given name.
+func GetPreviousTeamNames(
+	context context.Context,
+	param domainServiceParam.GetPreviousTeamNames,
+) (domainServiceResult.GetPreviousTeamNames, error) {
+	team, err := param.Repository.GetTeamByName(context, param.Name)
+	if err != nil {
+		return domainServiceResult.GetPreviousTeamNames{}, fmt.Errorf("failed to fetch team by name '%s' from repository: %w", param.Name, err)
+	}
+	if team == nil {
+		return domainServiceResult.GetPreviousTeamNames{}, nil
+	}
+
+	previousNames, err := param.Repository.GetPreviousTeamNames(context, team.Slug)
+	if err != nil {
+		return domainServiceResult.GetPreviousTeamNames{
+			Team: team,
+		}, fmt.Errorf("failed to fetch previous names of team '%s' from repository: %w", param.Name, err)
+	}
+
+	return domainServiceResult.GetPreviousTeamNames{
+		Team:          team,
+		PreviousNames: previousNames,
+	}, nil
+}
+
+// GetTeamByPreviousName finds the team that was last known by the given name or slug before being renamed. The
+// result holds a nil Team when a team currently has that name or when no team was ever known by it.
+func GetTeamByPreviousName(
+	context context.Context,
+	param domainServiceParam.GetTeamByPreviousName,
+) (domainServiceResult.GetTeamByPreviousName, error) {
+	team, err := param.Repository.GetTeamByPreviousName(context, param.NameOrSlug)
+	if err != nil {
+		return domainServiceResult.GetTeamByPreviousName{}, fmt.Errorf(
+			"failed to fetch team by previous name '%s' from repository: %w", param.NameOrSlug, err,
+		)
+	}
+
+	return domainServiceResult.GetTeamByPreviousName{
+		Team: team,
+	}, nil
+}
+
+func teamAlreadyArchived(name string) error {
+	return failure.ErrAlreadyArchived.WithMessage(fmt.Sprintf("team '%s' is already archived", name))
+}
+
+func teamNotArchived(name string) error {
+	return failure.ErrNotArchived.WithMessage(fmt.Sprintf("team '%s' is not archived", name))
+}
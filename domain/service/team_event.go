@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+func GetTeamEvents(
+	context context.Context,
+	param domainServiceParam.GetTeamEvents,
+) (domainServiceResult.GetTeamEvents, error) {
+	events, err := param.Repository.GetTeamEventsByTeamSlug(context, param.TeamSlug, param.From, param.To)
+	if err != nil {
+		return domainServiceResult.GetTeamEvents{
+			Events: []*entity.TeamEvent{},
+		}, fmt.Errorf("failed to fetch events of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	return domainServiceResult.GetTeamEvents{
+		Events: events,
+	}, nil
+}
+
+func CreateTeamEvent(
+	context context.Context,
+	param domainServiceParam.CreateTeamEvent,
+) (domainServiceResult.CreateTeamEvent, error) {
+	event, err := param.Repository.CreateTeamEvent(context, param.Event)
+	if err != nil {
+		return domainServiceResult.CreateTeamEvent{
+			Event: event,
+		}, fmt.Errorf("failed to create event '%s' in repository: %w", param.Event.Title, err)
+	}
+
+	return domainServiceResult.CreateTeamEvent{
+		Event: event,
+	}, nil
+}
+
+// RespondToTeamEvent saves the RSVP of a person to an event of the team. Only people with an active membership on
+// the event date can answer, failing with failure.ErrNotActiveMember otherwise. When the event does not belong to the
+// team, the result holds a nil Event.
+func RespondToTeamEvent(
+	context context.Context,
+	param domainServiceParam.RespondToTeamEvent,
+) (domainServiceResult.RespondToTeamEvent, error) {
+	event, err := getTeamEventOfTeam(context, param.TeamSlug, param.EventID, param.TeamEventRepository)
+	if err != nil || event == nil {
+		return domainServiceResult.RespondToTeamEvent{}, err
+	}
+
+	activeUserNames, err := getActiveTeamMemberUserNames(context, param.TeamSlug, eventDate(event), param.MembershipRepository)
+	if err != nil {
+		return domainServiceResult.RespondToTeamEvent{
+			Event: event,
+		}, err
+	}
+	if !activeUserNames[param.PersonUserName] {
+		return domainServiceResult.RespondToTeamEvent{
+			Event: event,
+		}, failure.ErrNotActiveMember.WithMessage(fmt.Sprintf(
+			"'%s' is not an active member of team '%s' on the event date", param.PersonUserName, param.TeamSlug,
+		))
+	}
+
+	participation, err := param.TeamEventRepository.SaveTeamEventRSVP(context, &entity.TeamEventParticipation{
+		Event:  event,
+		Person: &entity.Person{UserName: param.PersonUserName},
+		RSVP:   param.RSVP,
+	})
+	if err != nil {
+		return domainServiceResult.RespondToTeamEvent{
+			Event: event,
+		}, fmt.Errorf("failed to save RSVP of '%s' to event '%s' in repository: %w", param.PersonUserName, event.ID, err)
+	}
+
+	return domainServiceResult.RespondToTeamEvent{
+		Event:         event,
+		Participation: participation,
+	}, nil
+}
+
+// RecordTeamEventAttendance saves the attendance sheet of an event of the team, which only its staff can take, failing
+// with failure.ErrNotStaff otherwise. Nothing is saved when the sheet contains people without an active membership on
+// the event date, failing with failure.ErrNotActiveMember, which tells all of them.
+func RecordTeamEventAttendance(
+	context context.Context,
+	param domainServiceParam.RecordTeamEventAttendance,
+) (domainServiceResult.RecordTeamEventAttendance, error) {
+	event, err := getTeamEventOfTeam(context, param.TeamSlug, param.EventID, param.TeamEventRepository)
+	if err != nil || event == nil {
+		return domainServiceResult.RecordTeamEventAttendance{}, err
+	}
+
+	memberships, err := param.MembershipRepository.GetMembershipsByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.RecordTeamEventAttendance{
+			Event: event,
+		}, fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	if !isActiveStaffMember(memberships, param.RecordedBy, time.Now()) {
+		return domainServiceResult.RecordTeamEventAttendance{
+			Event: event,
+		}, failure.ErrNotStaff.WithMessage(fmt.Sprintf("'%s' is not part of the staff of team '%s'", param.RecordedBy, param.TeamSlug))
+	}
+
+	activeUserNames := map[string]bool{}
+	for _, userName := range activeMemberUserNamesAt(memberships, eventDate(event)) {
+		activeUserNames[userName] = true
+	}
+
+	participations := []*entity.TeamEventParticipation{}
+	nonMemberUserNames := []string{}
+	for userName, attendance := range param.Attendance {
+		if !activeUserNames[userName] {
+			nonMemberUserNames = append(nonMemberUserNames, userName)
+
+			continue
+		}
+		participations = append(participations, &entity.TeamEventParticipation{
+			Event:                event,
+			Person:               &entity.Person{UserName: userName},
+			Attendance:           attendance,
+			AttendanceRecordedBy: param.RecordedBy,
+		})
+	}
+	if len(nonMemberUserNames) > 0 {
+		sort.Strings(nonMemberUserNames)
+
+		return domainServiceResult.RecordTeamEventAttendance{
+			Event: event,
+		}, failure.ErrNotActiveMember.WithMessage(fmt.Sprintf(
+			"the following people are not active members of team '%s' on the event date: %s",
+			param.TeamSlug,
+			strings.Join(nonMemberUserNames, ", "),
+		))
+	}
+
+	err = param.TeamEventRepository.SaveTeamEventAttendance(context, participations)
+	if err != nil {
+		return domainServiceResult.RecordTeamEventAttendance{
+			Event: event,
+		}, fmt.Errorf("failed to save attendance of event '%s' in repository: %w", event.ID, err)
+	}
+
+	return domainServiceResult.RecordTeamEventAttendance{
+		Event:          event,
+		Participations: participations,
+	}, nil
+}
+
+// GetTeamAttendanceReport computes the attendance rate of each person over the events of the period in which
+// attendance was taken. An event only counts for the people that were active members on its date.
+func GetTeamAttendanceReport(
+	context context.Context,
+	param domainServiceParam.GetTeamAttendanceReport,
+) (domainServiceResult.GetTeamAttendanceReport, error) {
+	events, participations, memberships, err := getTeamEventsData(
+		context, param.TeamSlug, param.From, param.To, param.TeamEventRepository, param.MembershipRepository,
+	)
+	if err != nil {
+		return domainServiceResult.GetTeamAttendanceReport{
+			Rates: []*entity.TeamAttendanceRate{},
+		}, err
+	}
+
+	attendanceTaken := map[string]bool{}
+	attendanceByEvent := map[string]map[string]entity.AttendanceStatus{}
+	for _, participation := range participations {
+		if participation.Attendance == "" {
+			continue
+		}
+		attendanceTaken[participation.Event.ID] = true
+		if attendanceByEvent[participation.Event.ID] == nil {
+			attendanceByEvent[participation.Event.ID] = map[string]entity.AttendanceStatus{}
+		}
+		attendanceByEvent[participation.Event.ID][participation.Person.UserName] = participation.Attendance
+	}
+
+	ratesByUserName := map[string]*entity.TeamAttendanceRate{}
+	for _, event := range events {
+		if !attendanceTaken[event.ID] {
+			continue
+		}
+		for _, userName := range activeMemberUserNamesAt(memberships, eventDate(event)) {
+			rate, ok := ratesByUserName[userName]
+			if !ok {
+				rate = &entity.TeamAttendanceRate{Person: &entity.Person{UserName: userName}}
+				ratesByUserName[userName] = rate
+			}
+			rate.EventsCount++
+			if attendanceByEvent[event.ID][userName] == entity.AttendanceStatuses.Present {
+				rate.AttendedCount++
+			}
+		}
+	}
+
+	rates := make([]*entity.TeamAttendanceRate, 0, len(ratesByUserName))
+	for _, rate := range ratesByUserName {
+		rate.AttendanceRate = float64(rate.AttendedCount) / float64(rate.EventsCount)
+		rates = append(rates, rate)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Person.UserName < rates[j].Person.UserName
+	})
+
+	return domainServiceResult.GetTeamAttendanceReport{
+		Rates: rates,
+	}, nil
+}
+
+// GetTeamAvailability summarizes, for each event of the period, the RSVPs of the people that are active members
+// on the event date. It is meant to be checked before roster deadlines.
+func GetTeamAvailability(
+	context context.Context,
+	param domainServiceParam.GetTeamAvailability,
+) (domainServiceResult.GetTeamAvailability, error) {
+	events, participations, memberships, err := getTeamEventsData(
+		context, param.TeamSlug, param.From, param.To, param.TeamEventRepository, param.MembershipRepository,
+	)
+	if err != nil {
+		return domainServiceResult.GetTeamAvailability{
+			Availabilities: []*entity.TeamEventAvailability{},
+		}, err
+	}
+
+	rsvpByEvent := map[string]map[string]entity.RSVPStatus{}
+	for _, participation := range participations {
+		if rsvpByEvent[participation.Event.ID] == nil {
+			rsvpByEvent[participation.Event.ID] = map[string]entity.RSVPStatus{}
+		}
+		rsvpByEvent[participation.Event.ID][participation.Person.UserName] = participation.RSVP
+	}
+
+	availabilities := make([]*entity.TeamEventAvailability, 0, len(events))
+	for _, event := range events {
+		availability := &entity.TeamEventAvailability{
+			Event:      event,
+			Yes:        []*entity.Person{},
+			No:         []*entity.Person{},
+			Maybe:      []*entity.Person{},
+			NoResponse: []*entity.Person{},
+		}
+		for _, userName := range activeMemberUserNamesAt(memberships, eventDate(event)) {
+			person := &entity.Person{UserName: userName}
+			switch rsvpByEvent[event.ID][userName] {
+			case entity.RSVPStatuses.Yes:
+				availability.Yes = append(availability.Yes, person)
+			case entity.RSVPStatuses.No:
+				availability.No = append(availability.No, person)
+			case entity.RSVPStatuses.Maybe:
+				availability.Maybe = append(availability.Maybe, person)
+			default:
+				availability.NoResponse = append(availability.NoResponse, person)
+			}
+		}
+		availabilities = append(availabilities, availability)
+	}
+
+	return domainServiceResult.GetTeamAvailability{
+		Availabilities: availabilities,
+	}, nil
+}
+
+// getTeamEventOfTeam fetches an event, returning nil when it does not exist or belongs to another team.
+func getTeamEventOfTeam(
+	context context.Context,
+	teamSlug string,
+	eventID string,
+	teamEventRepository repository.TeamEvent,
+) (*entity.TeamEvent, error) {
+	event, err := teamEventRepository.GetTeamEventByID(context, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event '%s' from repository: %w", eventID, err)
+	}
+	if event == nil || event.Team == nil || event.Team.Slug != teamSlug {
+		return nil, nil
+	}
+
+	return event, nil
+}
+
+func getActiveTeamMemberUserNames(
+	context context.Context,
+	teamSlug string,
+	date time.Time,
+	membershipRepository repository.Membership,
+) (map[string]bool, error) {
+	memberships, err := membershipRepository.GetMembershipsByTeamSlug(context, teamSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", teamSlug, err)
+	}
+
+	activeUserNames := map[string]bool{}
+	for _, userName := range activeMemberUserNamesAt(memberships, date) {
+		activeUserNames[userName] = true
+	}
+
+	return activeUserNames, nil
+}
+
+func getTeamEventsData(
+	context context.Context,
+	teamSlug string,
+	from, to time.Time,
+	teamEventRepository repository.TeamEvent,
+	membershipRepository repository.Membership,
+) ([]*entity.TeamEvent, []*entity.TeamEventParticipation, []entity.Membership, error) {
+	events, err := teamEventRepository.GetTeamEventsByTeamSlug(context, teamSlug, from, to)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to fetch events of team '%s' from repository: %w", teamSlug, err)
+	}
+
+	participations, err := teamEventRepository.GetTeamEventParticipationsByTeamSlug(context, teamSlug, from, to)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to fetch event participations of team '%s' from repository: %w", teamSlug, err)
+	}
+
+	memberships, err := membershipRepository.GetMembershipsByTeamSlug(context, teamSlug)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", teamSlug, err)
+	}
+
+	return events, participations, memberships, nil
+}
+
+// activeMemberUserNamesAt lists, in alphabetical order and without duplicates, the people with an active membership
+// on the given date.
+func activeMemberUserNamesAt(memberships []entity.Membership, date time.Time) []string {
+	userNames := []string{}
+	for _, membership := range memberships {
+		if membership.IsActiveAt(date) {
+			userNames = append(userNames, membership.Person.UserName)
+		}
+	}
+	userNames = uniqueStrings(userNames)
+	sort.Strings(userNames)
+
+	return userNames
+}
+
+// isActiveStaffMember checks if the person has a staff membership, such as Captain or Coach, active at the date.
+func isActiveStaffMember(memberships []entity.Membership, userName string, date time.Time) bool {
+	for _, membership := range memberships {
+		if membership.Person.UserName == userName && entity.IsStaffRole(membership.Role) && membership.IsActiveAt(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// eventDate is the day in which the event starts. Memberships are defined in days, so they are checked against it.
+func eventDate(event *entity.TeamEvent) time.Time {
+	year, month, day := event.StartTime.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, event.StartTime.Location())
+}
@@ -0,0 +1,517 @@
+//go:build unit
+// +build unit
+
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+)
+
+// fakeTeamEventRepository answers the given events and participations and keeps the participations it saves.
+type fakeTeamEventRepository struct {
+	repository.TeamEvent
+	events              []*entity.TeamEvent
+	participations      []*entity.TeamEventParticipation
+	savedParticipations []*entity.TeamEventParticipation
+}
+
+func (fake *fakeTeamEventRepository) GetTeamEventByID(_ context.Context, id string) (*entity.TeamEvent, error) {
+	for _, event := range fake.events {
+		if event.ID == id {
+			return event, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (fake *fakeTeamEventRepository) GetTeamEventsByTeamSlug(
+	_ context.Context,
+	teamSlug string,
+	_, _ time.Time,
+) ([]*entity.TeamEvent, error) {
+	events := []*entity.TeamEvent{}
+	for _, event := range fake.events {
+		if event.Team.Slug == teamSlug {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (fake *fakeTeamEventRepository) GetTeamEventParticipationsByTeamSlug(
+	_ context.Context,
+	_ string,
+	_, _ time.Time,
+) ([]*entity.TeamEventParticipation, error) {
+	return fake.participations, nil
+}
+
+func (fake *fakeTeamEventRepository) SaveTeamEventRSVP(
+	_ context.Context,
+	participation *entity.TeamEventParticipation,
+) (*entity.TeamEventParticipation, error) {
+	fake.savedParticipations = append(fake.savedParticipations, participation)
+
+	return participation, nil
+}
+
+func (fake *fakeTeamEventRepository) SaveTeamEventAttendance(
+	_ context.Context,
+	participations []*entity.TeamEventParticipation,
+) error {
+	fake.savedParticipations = append(fake.savedParticipations, participations...)
+
+	return nil
+}
+
+// teamEventTestMemberships has a player that leaves the team on the date of the second event and another one that
+// joins it on the same date, so that each event has different active members.
+func teamEventTestMemberships() []entity.Membership {
+	team := &entity.Team{Slug: "bra-sp-my-team-slug"}
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondEventDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	return []entity.Membership{
+		{Team: team, Person: &entity.Person{UserName: "some.player"}, Role: entity.MembershipRoles.Player, StartDate: startDate},
+		{
+			Team:      team,
+			Person:    &entity.Person{UserName: "another.player"},
+			Role:      entity.MembershipRoles.Player,
+			StartDate: startDate,
+			EndDate:   secondEventDate,
+		},
+		{Team: team, Person: &entity.Person{UserName: "new.player"}, Role: entity.MembershipRoles.Player, StartDate: secondEventDate},
+		{Team: team, Person: &entity.Person{UserName: "some.coach"}, Role: entity.MembershipRoles.Coach, StartDate: startDate},
+		{
+			Team:      &entity.Team{Slug: "bra-rj-another-team-slug"},
+			Person:    &entity.Person{UserName: "rival.player"},
+			Role:      entity.MembershipRoles.Player,
+			StartDate: startDate,
+		},
+	}
+}
+
+// teamEventTestEvents are weekly events of the team, starting in the evening, plus an event of another team.
+func teamEventTestEvents() []*entity.TeamEvent {
+	team := &entity.Team{Slug: "bra-sp-my-team-slug"}
+	event := func(id string, team *entity.Team, day int) *entity.TeamEvent {
+		startTime := time.Date(2025, 3, day, 19, 0, 0, 0, time.UTC)
+
+		return &entity.TeamEvent{
+			ID:        id,
+			Team:      team,
+			Kind:      entity.TeamEventKinds.Practice,
+			StartTime: startTime,
+			EndTime:   startTime.Add(2 * time.Hour),
+		}
+	}
+
+	return []*entity.TeamEvent{
+		event("first-event", team, 3),
+		event("second-event", team, 10),
+		event("third-event", team, 17),
+		event("rival-event", &entity.Team{Slug: "bra-rj-another-team-slug"}, 17),
+	}
+}
+
+func teamEventTestParticipation(eventID, userName string) *entity.TeamEventParticipation {
+	return &entity.TeamEventParticipation{
+		Event:  &entity.TeamEvent{ID: eventID},
+		Person: &entity.Person{UserName: userName},
+	}
+}
+
+func teamEventAttendance(eventID, userName string, status entity.AttendanceStatus) *entity.TeamEventParticipation {
+	participation := teamEventTestParticipation(eventID, userName)
+	participation.Attendance = status
+
+	return participation
+}
+
+func teamEventRSVP(eventID, userName string, status entity.RSVPStatus) *entity.TeamEventParticipation {
+	participation := teamEventTestParticipation(eventID, userName)
+	participation.RSVP = status
+
+	return participation
+}
+
+func TestGetTeamAttendanceReport(t *testing.T) {
+	t.Parallel()
+
+	present, absent := entity.AttendanceStatuses.Present, entity.AttendanceStatuses.Absent
+
+	scenarios := []struct {
+		description    string
+		participations []*entity.TeamEventParticipation
+		expectedRates  []entity.TeamAttendanceRate
+	}{
+		{
+			description: "should rate each member over the events of the period in which attendance was taken",
+			participations: []*entity.TeamEventParticipation{
+				teamEventAttendance("first-event", "some.player", present),
+				teamEventAttendance("first-event", "another.player", absent),
+				teamEventAttendance("first-event", "some.coach", present),
+				teamEventAttendance("second-event", "some.player", present),
+				teamEventAttendance("second-event", "another.player", present),
+				teamEventAttendance("second-event", "new.player", absent),
+				teamEventRSVP("third-event", "some.player", entity.RSVPStatuses.Yes),
+			},
+			expectedRates: []entity.TeamAttendanceRate{
+				{Person: &entity.Person{UserName: "another.player"}, EventsCount: 2, AttendedCount: 1, AttendanceRate: 0.5},
+				{Person: &entity.Person{UserName: "new.player"}, EventsCount: 1, AttendedCount: 0, AttendanceRate: 0},
+				{Person: &entity.Person{UserName: "some.coach"}, EventsCount: 2, AttendedCount: 1, AttendanceRate: 0.5},
+				{Person: &entity.Person{UserName: "some.player"}, EventsCount: 2, AttendedCount: 2, AttendanceRate: 1},
+			},
+		},
+		{
+			description: "should count an event for the members that join or leave the team on its date",
+			participations: []*entity.TeamEventParticipation{
+				teamEventAttendance("second-event", "new.player", present),
+				teamEventAttendance("second-event", "another.player", present),
+			},
+			expectedRates: []entity.TeamAttendanceRate{
+				{Person: &entity.Person{UserName: "another.player"}, EventsCount: 1, AttendedCount: 1, AttendanceRate: 1},
+				{Person: &entity.Person{UserName: "new.player"}, EventsCount: 1, AttendedCount: 1, AttendanceRate: 1},
+				{Person: &entity.Person{UserName: "some.coach"}, EventsCount: 1, AttendedCount: 0, AttendanceRate: 0},
+				{Person: &entity.Person{UserName: "some.player"}, EventsCount: 1, AttendedCount: 0, AttendanceRate: 0},
+			},
+		},
+		{
+			description: "should not count an event for the people that were not active members on its date",
+			participations: []*entity.TeamEventParticipation{
+				teamEventAttendance("third-event", "some.player", present),
+				teamEventAttendance("third-event", "another.player", present),
+			},
+			expectedRates: []entity.TeamAttendanceRate{
+				{Person: &entity.Person{UserName: "new.player"}, EventsCount: 1, AttendedCount: 0, AttendanceRate: 0},
+				{Person: &entity.Person{UserName: "some.coach"}, EventsCount: 1, AttendedCount: 0, AttendanceRate: 0},
+				{Person: &entity.Person{UserName: "some.player"}, EventsCount: 1, AttendedCount: 1, AttendanceRate: 1},
+			},
+		},
+		{
+			description: "should rate nobody when attendance was never taken",
+			participations: []*entity.TeamEventParticipation{
+				teamEventRSVP("first-event", "some.player", entity.RSVPStatuses.Yes),
+			},
+			expectedRates: []entity.TeamAttendanceRate{},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := domainService.GetTeamAttendanceReport(context.Background(), domainServiceParam.GetTeamAttendanceReport{
+				TeamSlug: "bra-sp-my-team-slug",
+
+				TeamEventRepository: &fakeTeamEventRepository{
+					events:         teamEventTestEvents(),
+					participations: scenario.participations,
+				},
+				MembershipRepository: &fakeMembershipRepository{memberships: teamEventTestMemberships()},
+			})
+			require.NoError(t, err)
+
+			obtainedRates := make([]entity.TeamAttendanceRate, 0, len(result.Rates))
+			for _, rate := range result.Rates {
+				obtainedRates = append(obtainedRates, *rate)
+			}
+			require.Equal(t, scenario.expectedRates, obtainedRates)
+		})
+	}
+}
+
+func TestGetTeamAvailability(t *testing.T) {
+	t.Parallel()
+
+	// expectedAvailability lists the usernames of each group of an event
+	type expectedAvailability struct {
+		yes, no, maybe, noResponse []string
+	}
+
+	scenarios := []struct {
+		description            string
+		participations         []*entity.TeamEventParticipation
+		expectedAvailabilities map[string]expectedAvailability
+	}{
+		{
+			description: "should group the active members of each event by their RSVPs",
+			participations: []*entity.TeamEventParticipation{
+				teamEventRSVP("first-event", "another.player", entity.RSVPStatuses.Maybe),
+				teamEventRSVP("third-event", "some.player", entity.RSVPStatuses.Yes),
+				teamEventRSVP("third-event", "new.player", entity.RSVPStatuses.No),
+				teamEventRSVP("third-event", "some.coach", entity.RSVPStatuses.Maybe),
+				// The membership of another.player ended before the third event, so the RSVP no longer counts
+				teamEventRSVP("third-event", "another.player", entity.RSVPStatuses.Yes),
+			},
+			expectedAvailabilities: map[string]expectedAvailability{
+				"first-event": {
+					yes:        []string{},
+					no:         []string{},
+					maybe:      []string{"another.player"},
+					noResponse: []string{"some.coach", "some.player"},
+				},
+				"second-event": {
+					yes:        []string{},
+					no:         []string{},
+					maybe:      []string{},
+					noResponse: []string{"another.player", "new.player", "some.coach", "some.player"},
+				},
+				"third-event": {
+					yes:        []string{"some.player"},
+					no:         []string{"new.player"},
+					maybe:      []string{"some.coach"},
+					noResponse: []string{},
+				},
+			},
+		},
+		{
+			description: "should not take an attendance without RSVP as an answer",
+			participations: []*entity.TeamEventParticipation{
+				teamEventAttendance("first-event", "some.player", entity.AttendanceStatuses.Present),
+			},
+			expectedAvailabilities: map[string]expectedAvailability{
+				"first-event": {
+					yes:        []string{},
+					no:         []string{},
+					maybe:      []string{},
+					noResponse: []string{"another.player", "some.coach", "some.player"},
+				},
+				"second-event": {
+					yes:        []string{},
+					no:         []string{},
+					maybe:      []string{},
+					noResponse: []string{"another.player", "new.player", "some.coach", "some.player"},
+				},
+				"third-event": {
+					yes:        []string{},
+					no:         []string{},
+					maybe:      []string{},
+					noResponse: []string{"new.player", "some.coach", "some.player"},
+				},
+			},
+		},
+	}
+
+	userNames := func(people []*entity.Person) []string {
+		names := make([]string, 0, len(people))
+		for _, person := range people {
+			names = append(names, person.UserName)
+		}
+
+		return names
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := domainService.GetTeamAvailability(context.Background(), domainServiceParam.GetTeamAvailability{
+				TeamSlug: "bra-sp-my-team-slug",
+
+				TeamEventRepository: &fakeTeamEventRepository{
+					events:         teamEventTestEvents(),
+					participations: scenario.participations,
+				},
+				MembershipRepository: &fakeMembershipRepository{memberships: teamEventTestMemberships()},
+			})
+			require.NoError(t, err)
+
+			obtainedAvailabilities := map[string]expectedAvailability{}
+			for _, availability := range result.Availabilities {
+				obtainedAvailabilities[availability.Event.ID] = expectedAvailability{
+					yes:        userNames(availability.Yes),
+					no:         userNames(availability.No),
+					maybe:      userNames(availability.Maybe),
+					noResponse: userNames(availability.NoResponse),
+				}
+			}
+			require.Equal(t, scenario.expectedAvailabilities, obtainedAvailabilities)
+		})
+	}
+}
+
+func TestRespondToTeamEvent(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		description    string
+		eventID        string
+		personUserName string
+		expectedErr    error
+		expectedEvent  bool
+	}{
+		{
+			description:    "should save the RSVP of an active member",
+			eventID:        "third-event",
+			personUserName: "some.player",
+			expectedEvent:  true,
+		},
+		{
+			description:    "should save the RSVP of a member that joins the team on the event date",
+			eventID:        "second-event",
+			personUserName: "new.player",
+			expectedEvent:  true,
+		},
+		{
+			description:    "should save the RSVP of a member that leaves the team on the event date",
+			eventID:        "second-event",
+			personUserName: "another.player",
+			expectedEvent:  true,
+		},
+		{
+			description:    "should not save the RSVP of a member that left the team before the event date",
+			eventID:        "third-event",
+			personUserName: "another.player",
+			expectedErr:    failure.ErrNotActiveMember,
+			expectedEvent:  true,
+		},
+		{
+			description:    "should not save the RSVP of a member that joins the team after the event date",
+			eventID:        "first-event",
+			personUserName: "new.player",
+			expectedErr:    failure.ErrNotActiveMember,
+			expectedEvent:  true,
+		},
+		{
+			description:    "should not save the RSVP of a member of another team",
+			eventID:        "third-event",
+			personUserName: "rival.player",
+			expectedErr:    failure.ErrNotActiveMember,
+			expectedEvent:  true,
+		},
+		{
+			description:    "should not find an event of another team",
+			eventID:        "rival-event",
+			personUserName: "some.player",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			teamEventRepository := &fakeTeamEventRepository{events: teamEventTestEvents()}
+			result, err := domainService.RespondToTeamEvent(context.Background(), domainServiceParam.RespondToTeamEvent{
+				TeamSlug:       "bra-sp-my-team-slug",
+				EventID:        scenario.eventID,
+				PersonUserName: scenario.personUserName,
+				RSVP:           entity.RSVPStatuses.Yes,
+
+				TeamEventRepository:  teamEventRepository,
+				MembershipRepository: &fakeMembershipRepository{memberships: teamEventTestMemberships()},
+			})
+			require.Equal(t, scenario.expectedEvent, result.Event != nil)
+
+			if scenario.expectedErr != nil || !scenario.expectedEvent {
+				require.ErrorIs(t, err, scenario.expectedErr)
+				require.Empty(t, teamEventRepository.savedParticipations)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, teamEventRepository.savedParticipations, 1)
+			require.Equal(t, scenario.personUserName, result.Participation.Person.UserName)
+		})
+	}
+}
+
+func TestRecordTeamEventAttendance(t *testing.T) {
+	t.Parallel()
+
+	present, absent := entity.AttendanceStatuses.Present, entity.AttendanceStatuses.Absent
+
+	scenarios := []struct {
+		description     string
+		eventID         string
+		recordedBy      string
+		attendance      map[string]entity.AttendanceStatus
+		expectedErr     error
+		expectedMessage string
+	}{
+		{
+			description: "should save the attendance of the members that were active on the event date",
+			eventID:     "second-event",
+			recordedBy:  "some.coach",
+			attendance: map[string]entity.AttendanceStatus{
+				"some.player":    present,
+				"another.player": absent,
+				"new.player":     present,
+				"some.coach":     present,
+			},
+		},
+		{
+			description: "should save nothing and tell every person that was not an active member on the event date",
+			eventID:     "third-event",
+			recordedBy:  "some.coach",
+			attendance: map[string]entity.AttendanceStatus{
+				"some.player":    present,
+				"rival.player":   present,
+				"another.player": absent,
+			},
+			expectedErr: failure.ErrNotActiveMember,
+			expectedMessage: "the following people are not active members of team 'bra-sp-my-team-slug' on the event date: " +
+				"another.player, rival.player",
+		},
+		{
+			description: "should save nothing when the attendance is not recorded by the staff of the team",
+			eventID:     "second-event",
+			recordedBy:  "some.player",
+			attendance: map[string]entity.AttendanceStatus{
+				"some.player": present,
+			},
+			expectedErr:     failure.ErrNotStaff,
+			expectedMessage: "'some.player' is not part of the staff of team 'bra-sp-my-team-slug'",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.description, func(t *testing.T) {
+			t.Parallel()
+
+			teamEventRepository := &fakeTeamEventRepository{events: teamEventTestEvents()}
+			result, err := domainService.RecordTeamEventAttendance(context.Background(), domainServiceParam.RecordTeamEventAttendance{
+				TeamSlug:   "bra-sp-my-team-slug",
+				EventID:    scenario.eventID,
+				Attendance: scenario.attendance,
+				RecordedBy: scenario.recordedBy,
+
+				TeamEventRepository:  teamEventRepository,
+				MembershipRepository: &fakeMembershipRepository{memberships: teamEventTestMemberships()},
+			})
+
+			if scenario.expectedErr != nil {
+				require.ErrorIs(t, err, scenario.expectedErr)
+				require.Equal(t, scenario.expectedMessage, failure.Of(err).Message)
+				require.Empty(t, teamEventRepository.savedParticipations)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result.Participations, len(scenario.attendance))
+			for _, participation := range result.Participations {
+				require.Equal(t, scenario.attendance[participation.Person.UserName], participation.Attendance)
+				require.Equal(t, "some.coach", participation.AttendanceRecordedBy)
+			}
+			require.Len(t, teamEventRepository.savedParticipations, len(scenario.attendance))
+		})
+	}
+}
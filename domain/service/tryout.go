@@ -0,0 +1,470 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+	domainServiceResult "github.com/leeohaddad/ultimate-frisbee-api/domain/service/result"
+)
+
+func GetTryouts(
+	context context.Context,
+	param domainServiceParam.GetTryouts,
+) (domainServiceResult.GetTryouts, error) {
+	tryouts, err := param.Repository.GetTryoutsByTeamSlug(context, param.TeamSlug)
+	if err != nil {
+		return domainServiceResult.GetTryouts{
+			Tryouts: []*entity.Tryout{},
+		}, fmt.Errorf("failed to fetch tryouts of team '%s' from repository: %w", param.TeamSlug, err)
+	}
+
+	return domainServiceResult.GetTryouts{
+		Tryouts: tryouts,
+	}, nil
+}
+
+func CreateTryout(
+	context context.Context,
+	param domainServiceParam.CreateTryout,
+) (domainServiceResult.CreateTryout, error) {
+	tryout, err := param.Repository.CreateTryout(context, param.Tryout)
+	if err != nil {
+		return domainServiceResult.CreateTryout{
+			Tryout: tryout,
+		}, fmt.Errorf("failed to create tryout '%s' in repository: %w", param.Tryout.Title, err)
+	}
+
+	return domainServiceResult.CreateTryout{
+		Tryout: tryout,
+	}, nil
+}
+
+// SignUpForTryout registers a candidate for a tryout of the team. The candidate is either an existing person or a new
+// one, in which case the person and the registration are created together. When the tryout does not belong to the
+// team, the result holds a nil Tryout; when the existing person is not found, it holds a nil Person. Signing up twice
+// fails with failure.ErrAlreadySignedUp, and creating a person whose unique attributes are taken fails with
+// failure.ErrAlreadyExists, while creating one from an unknown country fails with failure.ErrInvalidInput.
+func SignUpForTryout(
+	ctx context.Context,
+	param domainServiceParam.SignUpForTryout,
+) (domainServiceResult.SignUpForTryout, error) {
+	tryout, err := getTryoutOfTeam(ctx, param.TeamSlug, param.TryoutID, param.TryoutRepository)
+	if err != nil || tryout == nil {
+		return domainServiceResult.SignUpForTryout{}, err
+	}
+
+	if param.NewPerson == nil {
+		person, err := param.PersonRepository.GetPersonByUserName(ctx, param.PersonUserName)
+		if err != nil {
+			return domainServiceResult.SignUpForTryout{
+				Tryout: tryout,
+			}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.PersonUserName, err)
+		}
+		if person == nil {
+			return domainServiceResult.SignUpForTryout{
+				Tryout: tryout,
+			}, nil
+		}
+
+		candidate, err := createTryoutCandidate(ctx, tryout, person, param.TryoutRepository)
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return domainServiceResult.SignUpForTryout{
+				Tryout: tryout,
+				Person: person,
+			}, failure.ErrAlreadySignedUp.WithMessage(fmt.Sprintf("'%s' already signed up for tryout %s", person.UserName, tryout.ID))
+		}
+
+		return domainServiceResult.SignUpForTryout{
+			Tryout:    tryout,
+			Person:    person,
+			Candidate: candidate,
+		}, err
+	}
+
+	originCountry, err := getCountryCode(
+		ctx, param.NewPerson.OriginCountry, "person.originCountry", "Person", "OriginCountry", param.CountryRepository,
+	)
+	if err != nil {
+		return domainServiceResult.SignUpForTryout{
+			Tryout: tryout,
+		}, err
+	}
+	newPerson := param.NewPerson.WithOriginCountry(originCountry)
+
+	var person *entity.Person
+	var candidate *entity.TryoutCandidate
+	err = param.TransactionManager.RunInTransaction(ctx, func(transactionContext context.Context) error {
+		person, err = param.PersonRepository.CreatePerson(transactionContext, newPerson)
+		if err != nil {
+			return fmt.Errorf("failed to create person '%s' in repository: %w", param.NewPerson.UserName, err)
+		}
+
+		candidate, err = createTryoutCandidate(transactionContext, tryout, person, param.TryoutRepository)
+
+		return err
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.SignUpForTryout{
+			Tryout: tryout,
+		}, failure.ErrAlreadyExists.WithMessage("a person with the same username, name, email or WFDF number already exists")
+	}
+	if err != nil {
+		return domainServiceResult.SignUpForTryout{
+			Tryout: tryout,
+		}, err
+	}
+
+	return domainServiceResult.SignUpForTryout{
+		Tryout:    tryout,
+		Person:    person,
+		Candidate: candidate,
+	}, nil
+}
+
+func GetTryoutCandidates(
+	context context.Context,
+	param domainServiceParam.GetTryoutCandidates,
+) (domainServiceResult.GetTryoutCandidates, error) {
+	tryout, err := getTryoutOfTeam(context, param.TeamSlug, param.TryoutID, param.Repository)
+	if err != nil || tryout == nil {
+		return domainServiceResult.GetTryoutCandidates{
+			Candidates: []*entity.TryoutCandidate{},
+		}, err
+	}
+
+	candidates, err := param.Repository.GetTryoutCandidates(context, tryout.ID)
+	if err != nil {
+		return domainServiceResult.GetTryoutCandidates{
+			Tryout:     tryout,
+			Candidates: []*entity.TryoutCandidate{},
+		}, fmt.Errorf("failed to fetch candidates of tryout '%s' from repository: %w", tryout.ID, err)
+	}
+
+	return domainServiceResult.GetTryoutCandidates{
+		Tryout:     tryout,
+		Candidates: candidates,
+	}, nil
+}
+
+// EvaluateTryoutCandidate saves the scores given by an evaluator to a candidate. Only the staff of the team can
+// evaluate candidates, failing with failure.ErrNotStaff otherwise, and every score must refer to one of the criteria of
+// the tryout, failing with failure.ErrUnknownTryoutCriteria otherwise. When the candidate did not sign up for the
+// tryout, the result holds a nil Candidate.
+func EvaluateTryoutCandidate(
+	context context.Context,
+	param domainServiceParam.EvaluateTryoutCandidate,
+) (domainServiceResult.EvaluateTryoutCandidate, error) {
+	tryout, err := getTryoutOfTeamForStaff(
+		context,
+		param.TeamSlug,
+		param.TryoutID,
+		param.EvaluatorUserName,
+		param.TryoutRepository,
+		param.MembershipRepository,
+	)
+	if err != nil || tryout == nil {
+		return domainServiceResult.EvaluateTryoutCandidate{
+			Tryout: tryout,
+		}, err
+	}
+
+	candidate, err := param.TryoutRepository.GetTryoutCandidate(context, tryout.ID, param.CandidateUserName)
+	if err != nil || candidate == nil {
+		return domainServiceResult.EvaluateTryoutCandidate{
+			Tryout: tryout,
+		}, wrapTryoutCandidateError(err, tryout.ID, param.CandidateUserName)
+	}
+
+	unknownCriteria := []string{}
+	evaluations := make([]*entity.TryoutEvaluation, 0, len(param.Scores))
+	for criterion, score := range param.Scores {
+		if !tryout.HasCriterion(criterion) {
+			unknownCriteria = append(unknownCriteria, criterion)
+
+			continue
+		}
+
+		evaluations = append(evaluations, &entity.TryoutEvaluation{
+			Tryout:    tryout,
+			Candidate: candidate.Person,
+			Evaluator: &entity.Person{UserName: param.EvaluatorUserName},
+			Criterion: criterion,
+			Score:     score,
+		})
+	}
+	if len(unknownCriteria) > 0 {
+		sort.Strings(unknownCriteria)
+
+		return domainServiceResult.EvaluateTryoutCandidate{
+			Tryout:    tryout,
+			Candidate: candidate,
+		}, failure.ErrUnknownTryoutCriteria.WithMessage(fmt.Sprintf(
+			"the following criteria are not part of tryout %s: %s", tryout.ID, strings.Join(unknownCriteria, ", "),
+		))
+	}
+	sort.Slice(evaluations, func(i, j int) bool {
+		return evaluations[i].Criterion < evaluations[j].Criterion
+	})
+
+	err = param.TryoutRepository.SaveTryoutEvaluations(context, evaluations)
+	if err != nil {
+		return domainServiceResult.EvaluateTryoutCandidate{
+			Tryout:    tryout,
+			Candidate: candidate,
+		}, fmt.Errorf("failed to save evaluations of '%s' in repository: %w", param.CandidateUserName, err)
+	}
+
+	return domainServiceResult.EvaluateTryoutCandidate{
+		Tryout:      tryout,
+		Candidate:   candidate,
+		Evaluations: evaluations,
+	}, nil
+}
+
+// GetTryoutRankings aggregates the evaluations of a tryout into a ranking of candidates. Evaluations are private to
+// the staff of the team, failing with failure.ErrNotStaff for anyone else.
+func GetTryoutRankings(
+	context context.Context,
+	param domainServiceParam.GetTryoutRankings,
+) (domainServiceResult.GetTryoutRankings, error) {
+	tryout, err := getTryoutOfTeamForStaff(
+		context,
+		param.TeamSlug,
+		param.TryoutID,
+		param.RequestedBy,
+		param.TryoutRepository,
+		param.MembershipRepository,
+	)
+	if err != nil || tryout == nil {
+		return domainServiceResult.GetTryoutRankings{
+			Tryout:   tryout,
+			Rankings: []*entity.TryoutRanking{},
+		}, err
+	}
+
+	evaluations, err := param.TryoutRepository.GetTryoutEvaluations(context, tryout.ID)
+	if err != nil {
+		return domainServiceResult.GetTryoutRankings{
+			Tryout:   tryout,
+			Rankings: []*entity.TryoutRanking{},
+		}, fmt.Errorf("failed to fetch evaluations of tryout '%s' from repository: %w", tryout.ID, err)
+	}
+
+	return domainServiceResult.GetTryoutRankings{
+		Tryout:   tryout,
+		Rankings: tryout.RankCandidates(evaluations),
+	}, nil
+}
+
+// DecideTryoutCandidate records whether a candidate was selected or rejected. Only the staff of the team can decide,
+// failing with failure.ErrNotStaff otherwise. Decisions are final, failing with failure.ErrCandidateAlreadyDecided, and
+// selecting a candidate creates the membership of the candidate in the team within the same transaction. A minor can
+// only be selected with the consents of a guardian valid on the start date of the membership, failing with
+// failure.ErrMissingConsent otherwise. Nothing is saved either when the membership would overlap another playing
+// membership of the candidate, failing with failure.ErrOverlappingPeriod.
+func DecideTryoutCandidate(
+	ctx context.Context,
+	param domainServiceParam.DecideTryoutCandidate,
+) (domainServiceResult.DecideTryoutCandidate, error) {
+	tryout, err := getTryoutOfTeamForStaff(
+		ctx,
+		param.TeamSlug,
+		param.TryoutID,
+		param.DecidedBy,
+		param.TryoutRepository,
+		param.MembershipRepository,
+	)
+	if err != nil || tryout == nil {
+		return domainServiceResult.DecideTryoutCandidate{
+			Tryout: tryout,
+		}, err
+	}
+
+	candidate, err := param.TryoutRepository.GetTryoutCandidate(ctx, tryout.ID, param.CandidateUserName)
+	if err != nil || candidate == nil {
+		return domainServiceResult.DecideTryoutCandidate{
+			Tryout: tryout,
+		}, wrapTryoutCandidateError(err, tryout.ID, param.CandidateUserName)
+	}
+	if candidate.Status.IsDecision() {
+		return domainServiceResult.DecideTryoutCandidate{
+			Tryout:    tryout,
+			Candidate: candidate,
+		}, failure.ErrCandidateAlreadyDecided.WithMessage(fmt.Sprintf(
+			"'%s' was already %s in tryout %s", param.CandidateUserName, strings.ToLower(string(candidate.Status)), tryout.ID,
+		))
+	}
+
+	if param.Decision == entity.TryoutCandidateStatuses.Selected {
+		person, err := param.PersonRepository.GetPersonByUserName(ctx, param.CandidateUserName)
+		if err != nil {
+			return domainServiceResult.DecideTryoutCandidate{
+				Tryout: tryout,
+			}, fmt.Errorf("failed to fetch person '%s' from repository: %w", param.CandidateUserName, err)
+		}
+		if person != nil {
+			err = checkGuardianConsent(ctx, person, param.MembershipStartDate, param.GuardianRepository)
+			if err != nil {
+				return domainServiceResult.DecideTryoutCandidate{
+					Tryout: tryout,
+				}, err
+			}
+		}
+	}
+
+	newMembership := &entity.Membership{
+		Team:      tryout.Team,
+		Person:    &entity.Person{UserName: param.CandidateUserName},
+		Role:      param.Role,
+		StartDate: param.MembershipStartDate,
+		CreatedBy: param.DecidedBy,
+	}
+	if param.Decision == entity.TryoutCandidateStatuses.Selected && entity.IsPlayingRole(param.Role) {
+		memberships, err := param.MembershipRepository.GetMembershipsByPersonUserName(ctx, param.CandidateUserName)
+		if err != nil {
+			return domainServiceResult.DecideTryoutCandidate{
+				Tryout: tryout,
+			}, fmt.Errorf("failed to fetch all memberships of '%s' from repository: %w", param.CandidateUserName, err)
+		}
+
+		otherMemberships := make([]*entity.Membership, 0, len(memberships))
+		for index := range memberships {
+			otherMemberships = append(otherMemberships, &memberships[index])
+		}
+		if conflictingMembership := entity.FindOverlappingPlayingMembership(otherMemberships, newMembership); conflictingMembership != nil {
+			return domainServiceResult.DecideTryoutCandidate{
+				Tryout:    tryout,
+				Candidate: candidate,
+			}, overlappingPlayingMembership(conflictingMembership)
+		}
+	}
+
+	candidate.Tryout = tryout
+	candidate.Status = param.Decision
+	candidate.DecidedBy = param.DecidedBy
+
+	var membership *entity.Membership
+	err = param.TransactionManager.RunInTransaction(ctx, func(transactionContext context.Context) error {
+		candidate, err = param.TryoutRepository.SaveTryoutDecision(transactionContext, candidate)
+		if err != nil {
+			return fmt.Errorf("failed to save decision about '%s' in repository: %w", param.CandidateUserName, err)
+		}
+
+		if param.Decision != entity.TryoutCandidateStatuses.Selected {
+			return nil
+		}
+
+		membership, err = param.MembershipRepository.CreateMembership(transactionContext, newMembership)
+		if err != nil {
+			return fmt.Errorf("failed to create membership of '%s' in repository: %w", param.CandidateUserName, err)
+		}
+
+		return nil
+	})
+	// Another membership of the candidate was saved between the check and the creation
+	if errors.Is(err, repository.ErrOverlappingPeriod) {
+		return domainServiceResult.DecideTryoutCandidate{
+			Tryout: tryout,
+		}, failure.ErrOverlappingPeriod.WithMessage(fmt.Sprintf(
+			"the membership of '%s' overlaps another playing membership", param.CandidateUserName,
+		))
+	}
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return domainServiceResult.DecideTryoutCandidate{
+			Tryout: tryout,
+		}, failure.ErrAlreadyExists.WithMessage(fmt.Sprintf(
+			"'%s' already has a membership as '%s' in team '%s' starting on the same date",
+			param.CandidateUserName, param.Role, tryout.Team.Slug,
+		))
+	}
+	if err != nil {
+		return domainServiceResult.DecideTryoutCandidate{
+			Tryout: tryout,
+		}, err
+	}
+
+	return domainServiceResult.DecideTryoutCandidate{
+		Tryout:     tryout,
+		Candidate:  candidate,
+		Membership: membership,
+	}, nil
+}
+
+// getTryoutOfTeam fetches a tryout, returning nil when it does not exist or belongs to another team.
+func getTryoutOfTeam(
+	context context.Context,
+	teamSlug string,
+	tryoutID string,
+	tryoutRepository repository.Tryout,
+) (*entity.Tryout, error) {
+	tryout, err := tryoutRepository.GetTryoutByID(context, tryoutID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tryout '%s' from repository: %w", tryoutID, err)
+	}
+	if tryout == nil || tryout.Team == nil || tryout.Team.Slug != teamSlug {
+		return nil, nil
+	}
+
+	return tryout, nil
+}
+
+// getTryoutOfTeamForStaff fetches a tryout of the team, failing with failure.ErrNotStaff when the person does not
+// currently hold a staff role in it.
+func getTryoutOfTeamForStaff(
+	context context.Context,
+	teamSlug string,
+	tryoutID string,
+	personUserName string,
+	tryoutRepository repository.Tryout,
+	membershipRepository repository.Membership,
+) (*entity.Tryout, error) {
+	tryout, err := getTryoutOfTeam(context, teamSlug, tryoutID, tryoutRepository)
+	if err != nil || tryout == nil {
+		return nil, err
+	}
+
+	memberships, err := membershipRepository.GetMembershipsByTeamSlug(context, teamSlug)
+	if err != nil {
+		return tryout, fmt.Errorf("failed to fetch all memberships of team '%s' from repository: %w", teamSlug, err)
+	}
+
+	if isActiveStaffMember(memberships, personUserName, time.Now()) {
+		return tryout, nil
+	}
+
+	return tryout, failure.ErrNotStaff.WithMessage(fmt.Sprintf("'%s' is not part of the staff of team '%s'", personUserName, teamSlug))
+}
+
+func createTryoutCandidate(
+	context context.Context,
+	tryout *entity.Tryout,
+	person *entity.Person,
+	tryoutRepository repository.Tryout,
+) (*entity.TryoutCandidate, error) {
+	candidate, err := tryoutRepository.CreateTryoutCandidate(context, &entity.TryoutCandidate{
+		Tryout: tryout,
+		Person: person,
+		Status: entity.TryoutCandidateStatuses.Registered,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign up '%s' for tryout '%s' in repository: %w", person.UserName, tryout.ID, err)
+	}
+
+	return candidate, nil
+}
+
+func wrapTryoutCandidateError(err error, tryoutID, candidateUserName string) error {
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("failed to fetch candidate '%s' of tryout '%s' from repository: %w", candidateUserName, tryoutID, err)
+}
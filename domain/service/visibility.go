@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+// requesterAudiences tells how close the requester is to each person they share a team with on the given date. There
+// is no authentication yet, so the requester is whoever the caller claims to be; when they do not say, everyone is
+// Public to them.
+func requesterAudiences(
+	context context.Context,
+	requestedBy string,
+	membershipRepository repository.Membership,
+	date time.Time,
+) (map[string]entity.Audience, error) {
+	if requestedBy == "" {
+		return map[string]entity.Audience{}, nil
+	}
+
+	requesterMemberships, err := membershipRepository.GetMembershipsByPersonUserNameAt(context, requestedBy, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch memberships of '%s' from repository: %w", requestedBy, err)
+	}
+
+	teamMemberships := []entity.Membership{}
+	for _, teamSlug := range membershipTeamSlugs(requesterMemberships) {
+		memberships, err := membershipRepository.GetMembershipsByTeamSlugAt(context, teamSlug, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch memberships of team '%s' from repository: %w", teamSlug, err)
+		}
+		teamMemberships = append(teamMemberships, memberships...)
+	}
+
+	return entity.AudiencesOf(requestedBy, requesterMemberships, teamMemberships, date), nil
+}
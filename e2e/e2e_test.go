@@ -30,6 +30,12 @@ type Team struct {
 	UpdatedAt     *string `json:"updatedAt,omitempty"`
 }
 
+// TeamPage represents the API page of teams payload structure
+type TeamPage struct {
+	Items      []Team  `json:"items"`
+	NextCursor *string `json:"nextCursor"`
+}
+
 // CreateTeamRequest represents the payload for creating a team
 type CreateTeamRequest struct {
 	Slug          string `json:"slug"`
@@ -124,7 +130,7 @@ func testTeamCRUDOperations(t *testing.T) {
 			Slug:          "test-e2e-team",
 			Name:          testTeamName,
 			Description:   "End-to-end test team for API testing",
-			OriginCountry: "Canada",
+			OriginCountry: "CA",
 			CreatedBy:     "e2e-test",
 		}
 
@@ -157,9 +163,10 @@ func testTeamCRUDOperations(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode, "Get all teams should return 200 OK")
 
-		var teams []Team
-		err = parseJSONResponse(resp, &teams)
+		var page TeamPage
+		err = parseJSONResponse(resp, &page)
 		require.NoError(t, err, "Should be able to parse teams response")
+		teams := page.Items
 
 		assert.GreaterOrEqual(t, len(teams), 4, "Should have at least 4 teams (3 seeded + 1 test team)")
 
@@ -203,7 +210,7 @@ func testTeamCRUDOperations(t *testing.T) {
 
 		updateRequest := UpdateTeamRequest{
 			Description:   "Updated description for end-to-end test team",
-			OriginCountry: "United States",
+			OriginCountry: "US",
 			UpdatedBy:     "e2e-test-updater",
 		}
 
@@ -260,11 +267,14 @@ func testErrorHandling(t *testing.T) {
 		defer resp.Body.Close()
 
 		assert.Equal(t, http.StatusNotFound, resp.StatusCode, "Non-existent team should return 404 Not Found")
+		assert.Equal(t, "application/problem+json", resp.Header.Get("Content-Type"), "Errors should be problems")
 
 		body, err := getStringResponse(resp)
 		require.NoError(t, err, "Should be able to read error response")
 
 		assert.Contains(t, body, "no team with name 'non-existent-team' was found", "Error message should be descriptive")
+		assert.Contains(t, body, `"code":"team_not_found"`, "Error code should tell the failure apart")
+		assert.Contains(t, body, resp.Header.Get("X-Request-ID"), "Error should tell its correlation ID")
 
 		t.Logf("Correctly handled non-existent team error: %s", body)
 	})
@@ -274,7 +284,7 @@ func testErrorHandling(t *testing.T) {
 
 		updateRequest := UpdateTeamRequest{
 			Description:   "This should fail",
-			OriginCountry: "Canada",
+			OriginCountry: "CA",
 			UpdatedBy:     "test",
 		}
 
@@ -311,6 +321,11 @@ func testDataValidation(t *testing.T) {
 		body, err := getStringResponse(resp)
 		require.NoError(t, err, "Should be able to read error response")
 
+		assert.Contains(t, body, `"code":"invalid_input"`, "Error code should tell the failure apart")
+		for _, field := range []string{"name", "description", "originCountry", "createdBy"} {
+			assert.Contains(t, body, fmt.Sprintf(`"field":"%s"`, field), "Error should tell every invalid field")
+		}
+
 		t.Logf("Correctly handled validation error: %s", body)
 	})
 
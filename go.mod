@@ -10,6 +10,7 @@ require (
 	github.com/spf13/viper v1.7.1
 	github.com/stretchr/testify v1.11.1
 	go.uber.org/zap v1.18.1
+	golang.org/x/text v0.3.6
 )
 
 require (
@@ -62,7 +63,6 @@ require (
 	golang.org/x/net v0.0.0-20210525063256-abc453219eb5 // indirect
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c // indirect
 	golang.org/x/sys v0.0.0-20210603125802-9665404d3644 // indirect
-	golang.org/x/text v0.3.6 // indirect
 	google.golang.org/genproto v0.0.0-20210603172842-58e84a565dcf // indirect
 	google.golang.org/grpc v1.38.0 // indirect
 	google.golang.org/protobuf v1.26.0 // indirect
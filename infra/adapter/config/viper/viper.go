@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/config"
 	"github.com/spf13/viper"
 )
@@ -43,7 +44,10 @@ func buildConfigurations(configFile string) (*config.Application, error) {
 		return nil, err
 	}
 
-	appConfig := mapConfigurations(viperConfig)
+	appConfig, err := mapConfigurations(viperConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	return appConfig, nil
 }
@@ -65,11 +69,29 @@ func loadConfiguration(configFile string) (*viper.Viper, error) {
 	return viperConfig, nil
 }
 
-func mapConfigurations(viperConfig *viper.Viper) *config.Application {
+func mapConfigurations(viperConfig *viper.Viper) (*config.Application, error) {
 	// set defaults if needed
 	viperConfig.SetDefault("api.host", "0.0.0.0")
 	viperConfig.SetDefault("api.port", "42000")
 	viperConfig.SetDefault("zap.level", "ERROR")
+	viperConfig.SetDefault("memberships.transferWindows", []string{})
+	viperConfig.SetDefault("archive.retentionDays", 365)
+	viperConfig.SetDefault("storage.driver", "local")
+	viperConfig.SetDefault("storage.maxUploadBytes", 2*1024*1024)
+	viperConfig.SetDefault("storage.local.directory", "./media")
+	viperConfig.SetDefault("storage.s3.region", "us-east-1")
+	viperConfig.SetDefault("pagination.defaultLimit", 50)
+	viperConfig.SetDefault("pagination.maxLimit", 200)
+	viperConfig.SetDefault("privacy.admins", []string{})
+
+	transferWindows := make([]entity.TransferWindow, 0)
+	for _, value := range viperConfig.GetStringSlice("memberships.transferWindows") {
+		transferWindow, err := entity.ParseTransferWindow(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid memberships configuration: %w", err)
+		}
+		transferWindows = append(transferWindows, transferWindow)
+	}
 
 	// map Viper structure into an Application config, encapsulating Viper logic here
 	return &config.Application{
@@ -84,5 +106,32 @@ func mapConfigurations(viperConfig *viper.Viper) *config.Application {
 			Level: viperConfig.GetString("zap.level"),
 			Mode:  viperConfig.GetString("zap.mode"),
 		},
-	}
+		Memberships: &config.MembershipsSection{
+			TransferWindows: transferWindows,
+		},
+		Archive: &config.ArchiveSection{
+			RetentionDays: viperConfig.GetInt("archive.retentionDays"),
+		},
+		Storage: &config.StorageSection{
+			Driver:         viperConfig.GetString("storage.driver"),
+			MaxUploadBytes: viperConfig.GetInt64("storage.maxUploadBytes"),
+			Local: &config.LocalStorageSection{
+				Directory: viperConfig.GetString("storage.local.directory"),
+			},
+			S3: &config.S3StorageSection{
+				Endpoint:        viperConfig.GetString("storage.s3.endpoint"),
+				Region:          viperConfig.GetString("storage.s3.region"),
+				Bucket:          viperConfig.GetString("storage.s3.bucket"),
+				AccessKeyID:     viperConfig.GetString("storage.s3.accessKeyID"),
+				SecretAccessKey: viperConfig.GetString("storage.s3.secretAccessKey"),
+			},
+		},
+		Pagination: &config.PaginationSection{
+			DefaultLimit: viperConfig.GetInt("pagination.defaultLimit"),
+			MaxLimit:     viperConfig.GetInt("pagination.maxLimit"),
+		},
+		Privacy: &config.PrivacySection{
+			Admins: viperConfig.GetStringSlice("privacy.admins"),
+		},
+	}, nil
 }
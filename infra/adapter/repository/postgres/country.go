@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that CountryRepository implements the repositoryPort.Country interface.
+var _ repositoryPort.Country = (*CountryRepository)(nil)
+
+type CountryRepository struct {
+	client postgresDatabase.Client
+}
+
+// countryName is a representation on how a country is retrieved from the database, with one of its localized names
+// per row.
+type countryName struct {
+	Alpha2Code    string `pg:"alpha2_code"`
+	Alpha3Code    string `pg:"alpha3_code"`
+	Name          string `pg:"name"`
+	Locale        string `pg:"locale"`
+	LocalizedName string `pg:"localized_name"`
+}
+
+const countryNameColumns = `countries.alpha2_code,
+              countries.alpha3_code,
+              countries.name,
+              country_names.locale,
+              country_names.name as localized_name`
+
+// NewCountryRepository instantiates a new country repository for postgres.
+func NewCountryRepository(client postgresDatabase.Client) *CountryRepository {
+	return &CountryRepository{
+		client: client,
+	}
+}
+
+func (repository *CountryRepository) GetAllCountries(context context.Context) ([]*entity.Country, error) {
+	query := `select
+              ` + countryNameColumns + `
+            from
+              countries
+              left join country_names on country_names.country_code = countries.alpha2_code
+            order by
+              countries.alpha2_code`
+
+	// Execute query in DB
+	var fetchedCountryNames []countryName
+	_, err := repository.client.ExecuteQuery(context, &fetchedCountryNames, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve countries: %w", err)
+	}
+
+	return countryNamesToCountryEntities(fetchedCountryNames), nil
+}
+
+func (repository *CountryRepository) FindCountry(context context.Context, value string) (*entity.Country, error) {
+	query := `select
+              ` + countryNameColumns + `
+            from
+              countries
+              left join country_names on country_names.country_code = countries.alpha2_code
+            where
+              countries.alpha2_code = (
+                select country_code from (
+                  select alpha2_code as country_code, 1 as priority from countries
+                  where lower(alpha2_code) = ? or lower(alpha3_code) = ?
+                  union all
+                  select country_code, 2 from country_names where lower(name) = ?
+                  union all
+                  select country_code, 3 from country_aliases where lower(alias) = ?
+                ) matches
+                order by priority
+                limit 1
+              )`
+
+	// Execute query in DB
+	key := strings.ToLower(strings.TrimSpace(value))
+	var fetchedCountryNames []countryName
+	_, err := repository.client.ExecuteQuery(context, &fetchedCountryNames, query, key, key, key, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find country '%s': %w", value, err)
+	}
+
+	countries := countryNamesToCountryEntities(fetchedCountryNames)
+	if len(countries) == 0 {
+		return nil, nil
+	}
+
+	return countries[0], nil
+}
+
+// countryNamesToCountryEntities gathers the localized names of each country, which come in consecutive rows.
+func countryNamesToCountryEntities(countryNames []countryName) []*entity.Country {
+	countryEntities := make([]*entity.Country, 0)
+
+	var current *entity.Country
+	for _, countryName := range countryNames {
+		if current == nil || current.Alpha2Code != countryName.Alpha2Code {
+			current = &entity.Country{
+				Alpha2Code:     countryName.Alpha2Code,
+				Alpha3Code:     countryName.Alpha3Code,
+				Name:           countryName.Name,
+				LocalizedNames: map[entity.CountryLocale]string{},
+			}
+			countryEntities = append(countryEntities, current)
+		}
+		if countryName.Locale != "" {
+			current.LocalizedNames[entity.CountryLocale(countryName.Locale)] = countryName.LocalizedName
+		}
+	}
+
+	return countryEntities
+}
@@ -0,0 +1,122 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/repository/postgres"
+	databasePostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test/fixture"
+)
+
+func TestCountryRepository_GetAllCountries(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should return every country seeded by the migrations with its localized names",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{},
+			OutputData:     map[string]interface{}{},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			countryRepository := repositoryPostgres.NewCountryRepository(client)
+
+			countries, err := countryRepository.GetAllCountries(testContext)
+			require.NoError(t, err)
+			require.Len(t, countries, 249)
+			require.Equal(t, "AD", countries[0].Alpha2Code)
+			require.Equal(t, "ZW", countries[len(countries)-1].Alpha2Code)
+
+			for _, country := range countries {
+				require.Len(t, country.LocalizedNames, 5, country.Alpha2Code)
+			}
+		},
+	)
+}
+
+func TestCountryRepository_FindCountry(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should find a country by its alpha-2 code",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{"value": "BR"},
+			OutputData:     map[string]interface{}{"expectedAlpha2Code": "BR"},
+		},
+		{
+			Description:    "should find a country by its alpha-3 code ignoring the case",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{"value": "usa"},
+			OutputData:     map[string]interface{}{"expectedAlpha2Code": "US"},
+		},
+		{
+			Description:    "should find a country by its English name",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{"value": "Germany"},
+			OutputData:     map[string]interface{}{"expectedAlpha2Code": "DE"},
+		},
+		{
+			Description:    "should find a country by its localized name",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{"value": " Alemanha "},
+			OutputData:     map[string]interface{}{"expectedAlpha2Code": "DE"},
+		},
+		{
+			Description:    "should find a country by a common alias",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{"value": "UK"},
+			OutputData:     map[string]interface{}{"expectedAlpha2Code": "GB"},
+		},
+		{
+			Description:    "should not find an unknown country",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{"value": "Atlantis"},
+			OutputData:     map[string]interface{}{"expectedAlpha2Code": ""},
+		},
+		{
+			Description:    "should not find a country without a value",
+			FixtureQueries: []fixture.Query{},
+			InputData:      map[string]interface{}{"value": ""},
+			OutputData:     map[string]interface{}{"expectedAlpha2Code": ""},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			countryRepository := repositoryPostgres.NewCountryRepository(client)
+
+			value, ok := scenario.InputData["value"].(string)
+			require.True(t, ok)
+			expectedAlpha2Code, ok := scenario.OutputData["expectedAlpha2Code"].(string)
+			require.True(t, ok)
+
+			country, err := countryRepository.FindCountry(testContext, value)
+			require.NoError(t, err)
+
+			if expectedAlpha2Code == "" {
+				require.Nil(t, country)
+
+				return
+			}
+			require.NotNil(t, country)
+			require.Equal(t, expectedAlpha2Code, country.Alpha2Code)
+			require.Equal(t, country.Name, country.LocalizedName(entity.CountryLocales.English))
+		},
+	)
+}
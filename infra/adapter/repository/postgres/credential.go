@@ -0,0 +1,380 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that CredentialRepository implements the repositoryPort.Credential interface.
+var _ repositoryPort.Credential = (*CredentialRepository)(nil)
+
+type CredentialRepository struct {
+	client postgresDatabase.Client
+}
+
+// wfdfAccreditation is a representation on how the WFDF accreditation is retrieved from the database.
+type wfdfAccreditation struct {
+	PersonUserName string    `pg:"person_username"`
+	Level          string    `pg:"level"`
+	IssueDate      time.Time `pg:"issue_date"`
+	ExpiryDate     time.Time `pg:"expiry_date"`
+	CreatedAt      time.Time `pg:"created_at"`
+	CreatedBy      string    `pg:"created_by"`
+	UpdatedAt      time.Time `pg:"updated_at"`
+	UpdatedBy      string    `pg:"updated_by"`
+}
+
+// federationMembership is a representation on how the federation membership is retrieved from the database.
+type federationMembership struct {
+	PersonUserName string    `pg:"person_username"`
+	FederationSlug string    `pg:"federation_slug"`
+	FederationName string    `pg:"federation_name"`
+	Season         string    `pg:"season"`
+	ValidFrom      time.Time `pg:"valid_from"`
+	ValidUntil     time.Time `pg:"valid_until"`
+	CreatedAt      time.Time `pg:"created_at"`
+	CreatedBy      string    `pg:"created_by"`
+	UpdatedAt      time.Time `pg:"updated_at"`
+	UpdatedBy      string    `pg:"updated_by"`
+}
+
+// coachCertification is a representation on how the coach certification is retrieved from the database.
+type coachCertification struct {
+	PersonUserName string    `pg:"person_username"`
+	Name           string    `pg:"name"`
+	IssuedBy       string    `pg:"issued_by"`
+	IssueDate      time.Time `pg:"issue_date"`
+	ExpiryDate     time.Time `pg:"expiry_date"`
+	CreatedAt      time.Time `pg:"created_at"`
+	CreatedBy      string    `pg:"created_by"`
+	UpdatedAt      time.Time `pg:"updated_at"`
+	UpdatedBy      string    `pg:"updated_by"`
+}
+
+const wfdfAccreditationColumns = `person_username,
+              level,
+              issue_date,
+              expiry_date,
+              created_at,
+              created_by,
+              updated_at,
+              updated_by`
+
+const coachCertificationColumns = `person_username,
+              name,
+              issued_by,
+              issue_date,
+              expiry_date,
+              created_at,
+              created_by,
+              updated_at,
+              updated_by`
+
+// NewCredentialRepository instantiates a new credential repository for postgres.
+func NewCredentialRepository(client postgresDatabase.Client) *CredentialRepository {
+	return &CredentialRepository{
+		client: client,
+	}
+}
+
+func (repository *CredentialRepository) GetWFDFAccreditationsByPersonUserName(
+	context context.Context,
+	personUserName string,
+) ([]*entity.WFDFAccreditation, error) {
+	return repository.GetWFDFAccreditationsByPersonUserNames(context, []string{personUserName})
+}
+
+func (repository *CredentialRepository) GetWFDFAccreditationsByPersonUserNames(
+	context context.Context,
+	personUserNames []string,
+) ([]*entity.WFDFAccreditation, error) {
+	// An empty list would render an invalid "in ()" clause
+	if len(personUserNames) == 0 {
+		return []*entity.WFDFAccreditation{}, nil
+	}
+
+	query := `select
+              ` + wfdfAccreditationColumns + `
+            from
+              wfdf_accreditations
+            where
+              person_username in (?)
+            order by
+              issue_date desc,
+              person_username,
+              level`
+
+	// Execute query in DB
+	var fetchedAccreditations []wfdfAccreditation
+	_, err := repository.client.ExecuteQuery(context, &fetchedAccreditations, query, personUserNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve WFDF accreditations of %s: %w", stringJoin(personUserNames, ", "), err)
+	}
+
+	accreditationEntities := make([]*entity.WFDFAccreditation, 0, len(fetchedAccreditations))
+	for _, fetchedAccreditation := range fetchedAccreditations {
+		accreditationEntities = append(accreditationEntities, wfdfAccreditationToWFDFAccreditationEntity(fetchedAccreditation))
+	}
+
+	return accreditationEntities, nil
+}
+
+func (repository *CredentialRepository) CreateWFDFAccreditation(
+	context context.Context,
+	accreditationEntity *entity.WFDFAccreditation,
+) (*entity.WFDFAccreditation, error) {
+	query := `insert into wfdf_accreditations (
+	 person_username,
+	 level,
+	 issue_date,
+	 expiry_date,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?) returning
+	 ` + wfdfAccreditationColumns
+
+	var inserted wfdfAccreditation
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		accreditationEntity.Person.UserName,
+		string(accreditationEntity.Level),
+		accreditationEntity.IssueDate,
+		accreditationEntity.ExpiryDate,
+		accreditationEntity.CreatedBy,
+		accreditationEntity.CreatedBy,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf("failed to create WFDF accreditation of '%s': %w", accreditationEntity.Person.UserName, err)
+	}
+
+	return wfdfAccreditationToWFDFAccreditationEntity(inserted), nil
+}
+
+func (repository *CredentialRepository) GetFederationMembershipsByPersonUserName(
+	context context.Context,
+	personUserName string,
+) ([]*entity.FederationMembership, error) {
+	query := `select
+              membership.person_username,
+              membership.federation_slug,
+              federation.name as federation_name,
+              membership.season,
+              membership.valid_from,
+              membership.valid_until,
+              membership.created_at,
+              membership.created_by,
+              membership.updated_at,
+              membership.updated_by
+            from
+              federation_memberships membership
+              join legal_entities federation on federation.slug = membership.federation_slug
+            where
+              membership.person_username = ?
+            order by
+              membership.valid_from desc,
+              membership.federation_slug`
+
+	// Execute query in DB
+	var fetchedMemberships []federationMembership
+	_, err := repository.client.ExecuteQuery(context, &fetchedMemberships, query, personUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve federation memberships of '%s': %w", personUserName, err)
+	}
+
+	membershipEntities := make([]*entity.FederationMembership, 0, len(fetchedMemberships))
+	for _, fetchedMembership := range fetchedMemberships {
+		membershipEntities = append(membershipEntities, federationMembershipToFederationMembershipEntity(fetchedMembership))
+	}
+
+	return membershipEntities, nil
+}
+
+func (repository *CredentialRepository) CreateFederationMembership(
+	context context.Context,
+	membershipEntity *entity.FederationMembership,
+) (*entity.FederationMembership, error) {
+	query := `insert into federation_memberships (
+	 person_username,
+	 federation_slug,
+	 season,
+	 valid_from,
+	 valid_until,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?, ?) returning
+	 person_username,
+	 federation_slug,
+	 season,
+	 valid_from,
+	 valid_until,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by`
+
+	var inserted federationMembership
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		membershipEntity.Person.UserName,
+		membershipEntity.Federation.Slug,
+		membershipEntity.Season,
+		membershipEntity.ValidFrom,
+		membershipEntity.ValidUntil,
+		membershipEntity.CreatedBy,
+		membershipEntity.CreatedBy,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf(
+			"failed to create membership of '%s' in federation %s: %w",
+			membershipEntity.Person.UserName,
+			membershipEntity.Federation.Slug,
+			err,
+		)
+	}
+
+	// The federation name is not returned by the insert statement
+	inserted.FederationName = membershipEntity.Federation.Name
+
+	return federationMembershipToFederationMembershipEntity(inserted), nil
+}
+
+func (repository *CredentialRepository) GetCoachCertificationsByPersonUserName(
+	context context.Context,
+	personUserName string,
+) ([]*entity.CoachCertification, error) {
+	query := `select
+              ` + coachCertificationColumns + `
+            from
+              coach_certifications
+            where
+              person_username = ?
+            order by
+              issue_date desc,
+              name`
+
+	// Execute query in DB
+	var fetchedCertifications []coachCertification
+	_, err := repository.client.ExecuteQuery(context, &fetchedCertifications, query, personUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve coach certifications of '%s': %w", personUserName, err)
+	}
+
+	certificationEntities := make([]*entity.CoachCertification, 0, len(fetchedCertifications))
+	for _, fetchedCertification := range fetchedCertifications {
+		certificationEntities = append(certificationEntities, coachCertificationToCoachCertificationEntity(fetchedCertification))
+	}
+
+	return certificationEntities, nil
+}
+
+func (repository *CredentialRepository) CreateCoachCertification(
+	context context.Context,
+	certificationEntity *entity.CoachCertification,
+) (*entity.CoachCertification, error) {
+	query := `insert into coach_certifications (
+	 person_username,
+	 name,
+	 issued_by,
+	 issue_date,
+	 expiry_date,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?, ?) returning
+	 ` + coachCertificationColumns
+
+	// A certification that does not expire is stored with a null expiry date
+	var expiryDate interface{}
+	if !certificationEntity.ExpiryDate.IsZero() {
+		expiryDate = certificationEntity.ExpiryDate
+	}
+
+	var inserted coachCertification
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		certificationEntity.Person.UserName,
+		certificationEntity.Name,
+		certificationEntity.IssuedBy,
+		certificationEntity.IssueDate,
+		expiryDate,
+		certificationEntity.CreatedBy,
+		certificationEntity.CreatedBy,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf("failed to create coach certification of '%s': %w", certificationEntity.Person.UserName, err)
+	}
+
+	return coachCertificationToCoachCertificationEntity(inserted), nil
+}
+
+func wfdfAccreditationToWFDFAccreditationEntity(accreditation wfdfAccreditation) *entity.WFDFAccreditation {
+	return &entity.WFDFAccreditation{
+		Person: &entity.Person{UserName: accreditation.PersonUserName},
+		Level:  entity.WFDFAccreditationLevel(accreditation.Level),
+
+		IssueDate:  accreditation.IssueDate,
+		ExpiryDate: accreditation.ExpiryDate,
+
+		CreatedAt: accreditation.CreatedAt,
+		CreatedBy: accreditation.CreatedBy,
+		UpdatedAt: accreditation.UpdatedAt,
+		UpdatedBy: accreditation.UpdatedBy,
+	}
+}
+
+func federationMembershipToFederationMembershipEntity(membership federationMembership) *entity.FederationMembership {
+	return &entity.FederationMembership{
+		Person:     &entity.Person{UserName: membership.PersonUserName},
+		Federation: &entity.LegalEntity{Slug: membership.FederationSlug, Name: membership.FederationName},
+		Season:     membership.Season,
+
+		ValidFrom:  membership.ValidFrom,
+		ValidUntil: membership.ValidUntil,
+
+		CreatedAt: membership.CreatedAt,
+		CreatedBy: membership.CreatedBy,
+		UpdatedAt: membership.UpdatedAt,
+		UpdatedBy: membership.UpdatedBy,
+	}
+}
+
+func coachCertificationToCoachCertificationEntity(certification coachCertification) *entity.CoachCertification {
+	// A null expiry_date is scanned as the zero time, which is how the entity represents a certification that does
+	// not expire.
+	return &entity.CoachCertification{
+		Person:   &entity.Person{UserName: certification.PersonUserName},
+		Name:     certification.Name,
+		IssuedBy: certification.IssuedBy,
+
+		IssueDate:  certification.IssueDate,
+		ExpiryDate: certification.ExpiryDate,
+
+		CreatedAt: certification.CreatedAt,
+		CreatedBy: certification.CreatedBy,
+		UpdatedAt: certification.UpdatedAt,
+		UpdatedBy: certification.UpdatedBy,
+	}
+}
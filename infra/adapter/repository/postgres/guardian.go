@@ -0,0 +1,270 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that GuardianRepository implements the repositoryPort.Guardian interface.
+var _ repositoryPort.Guardian = (*GuardianRepository)(nil)
+
+type GuardianRepository struct {
+	client postgresDatabase.Client
+}
+
+// guardianship is a representation on how the guardianship is retrieved from the database.
+type guardianship struct {
+	GuardianUserName  string    `pg:"guardian_username"`
+	DependentUserName string    `pg:"dependent_username"`
+	Relationship      string    `pg:"relationship"`
+	CreatedAt         time.Time `pg:"created_at"`
+	CreatedBy         string    `pg:"created_by"`
+}
+
+// consent is a representation on how the consent is retrieved from the database.
+type consent struct {
+	PersonUserName string    `pg:"person_username"`
+	Kind           string    `pg:"kind"`
+	GrantedBy      string    `pg:"granted_by"`
+	GrantDate      time.Time `pg:"grant_date"`
+	ExpiryDate     time.Time `pg:"expiry_date"`
+	CreatedAt      time.Time `pg:"created_at"`
+	CreatedBy      string    `pg:"created_by"`
+}
+
+const guardianshipColumns = `guardian_username,
+              dependent_username,
+              relationship,
+              created_at,
+              created_by`
+
+const consentColumns = `person_username,
+              kind,
+              granted_by,
+              grant_date,
+              expiry_date,
+              created_at,
+              created_by`
+
+// NewGuardianRepository instantiates a new guardian repository for postgres.
+func NewGuardianRepository(client postgresDatabase.Client) *GuardianRepository {
+	return &GuardianRepository{
+		client: client,
+	}
+}
+
+func (repository *GuardianRepository) GetGuardianshipsByDependentUserName(
+	context context.Context,
+	dependentUserName string,
+) ([]*entity.Guardianship, error) {
+	query := `select
+              ` + guardianshipColumns + `
+            from
+              guardianships
+            where
+              dependent_username = ?
+            order by
+              guardian_username`
+
+	// Execute query in DB
+	var fetchedGuardianships []guardianship
+	_, err := repository.client.ExecuteQuery(context, &fetchedGuardianships, query, dependentUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve guardians of '%s': %w", dependentUserName, err)
+	}
+
+	return guardianshipsToGuardianshipEntities(fetchedGuardianships), nil
+}
+
+func (repository *GuardianRepository) GetGuardianshipsByGuardianUserName(
+	context context.Context,
+	guardianUserName string,
+) ([]*entity.Guardianship, error) {
+	query := `select
+              ` + guardianshipColumns + `
+            from
+              guardianships
+            where
+              guardian_username = ?
+            order by
+              dependent_username`
+
+	// Execute query in DB
+	var fetchedGuardianships []guardianship
+	_, err := repository.client.ExecuteQuery(context, &fetchedGuardianships, query, guardianUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve dependents of '%s': %w", guardianUserName, err)
+	}
+
+	return guardianshipsToGuardianshipEntities(fetchedGuardianships), nil
+}
+
+func (repository *GuardianRepository) CreateGuardianship(
+	context context.Context,
+	guardianshipEntity *entity.Guardianship,
+) (*entity.Guardianship, error) {
+	query := `insert into guardianships (
+	 guardian_username,
+	 dependent_username,
+	 relationship,
+	 created_by
+   ) values (?, ?, ?, ?) returning
+	 ` + guardianshipColumns
+
+	var inserted guardianship
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		guardianshipEntity.Guardian.UserName,
+		guardianshipEntity.Dependent.UserName,
+		guardianshipEntity.Relationship,
+		guardianshipEntity.CreatedBy,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf(
+			"failed to create guardianship of '%s' over '%s': %w",
+			guardianshipEntity.Guardian.UserName,
+			guardianshipEntity.Dependent.UserName,
+			err,
+		)
+	}
+
+	return guardianshipToGuardianshipEntity(inserted), nil
+}
+
+func (repository *GuardianRepository) DeleteGuardianship(
+	context context.Context,
+	guardianUserName string,
+	dependentUserName string,
+) (*entity.Guardianship, error) {
+	query := `delete from guardianships
+   where
+	 guardian_username = ? and dependent_username = ?
+   returning
+	 ` + guardianshipColumns
+
+	var deleted guardianship
+	queryResult, err := repository.client.ExecuteQuery(context, &deleted, query, guardianUserName, dependentUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete guardianship of '%s' over '%s': %w", guardianUserName, dependentUserName, err)
+	}
+
+	// Query executed successfully but the person is not a guardian of the dependent
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return guardianshipToGuardianshipEntity(deleted), nil
+}
+
+func (repository *GuardianRepository) GetConsentsByPersonUserName(
+	context context.Context,
+	personUserName string,
+) ([]*entity.Consent, error) {
+	query := `select
+              ` + consentColumns + `
+            from
+              consents
+            where
+              person_username = ?
+            order by
+              grant_date desc,
+              kind`
+
+	// Execute query in DB
+	var fetchedConsents []consent
+	_, err := repository.client.ExecuteQuery(context, &fetchedConsents, query, personUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve consents of '%s': %w", personUserName, err)
+	}
+
+	consentEntities := make([]*entity.Consent, 0, len(fetchedConsents))
+	for _, fetchedConsent := range fetchedConsents {
+		consentEntities = append(consentEntities, consentToConsentEntity(fetchedConsent))
+	}
+
+	return consentEntities, nil
+}
+
+func (repository *GuardianRepository) CreateConsent(
+	context context.Context,
+	consentEntity *entity.Consent,
+) (*entity.Consent, error) {
+	query := `insert into consents (
+	 person_username,
+	 kind,
+	 granted_by,
+	 grant_date,
+	 expiry_date,
+	 created_by
+   ) values (?, ?, ?, ?, ?, ?) returning
+	 ` + consentColumns
+
+	var inserted consent
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		consentEntity.Person.UserName,
+		string(consentEntity.Kind),
+		consentEntity.GrantedBy.UserName,
+		consentEntity.GrantDate,
+		consentEntity.ExpiryDate,
+		consentEntity.CreatedBy,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf("failed to create consent of '%s': %w", consentEntity.Person.UserName, err)
+	}
+
+	return consentToConsentEntity(inserted), nil
+}
+
+func guardianshipToGuardianshipEntity(guardianship guardianship) *entity.Guardianship {
+	return &entity.Guardianship{
+		Guardian:     &entity.Person{UserName: guardianship.GuardianUserName},
+		Dependent:    &entity.Person{UserName: guardianship.DependentUserName},
+		Relationship: guardianship.Relationship,
+
+		CreatedAt: guardianship.CreatedAt,
+		CreatedBy: guardianship.CreatedBy,
+	}
+}
+
+func guardianshipsToGuardianshipEntities(guardianships []guardianship) []*entity.Guardianship {
+	guardianshipEntities := make([]*entity.Guardianship, 0, len(guardianships))
+
+	for _, guardianship := range guardianships {
+		guardianshipEntities = append(guardianshipEntities, guardianshipToGuardianshipEntity(guardianship))
+	}
+
+	return guardianshipEntities
+}
+
+func consentToConsentEntity(consent consent) *entity.Consent {
+	return &entity.Consent{
+		Person:    &entity.Person{UserName: consent.PersonUserName},
+		Kind:      entity.ConsentKind(consent.Kind),
+		GrantedBy: &entity.Person{UserName: consent.GrantedBy},
+
+		GrantDate:  consent.GrantDate,
+		ExpiryDate: consent.ExpiryDate,
+
+		CreatedAt: consent.CreatedAt,
+		CreatedBy: consent.CreatedBy,
+	}
+}
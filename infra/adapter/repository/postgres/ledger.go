@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that LedgerRepository implements the repositoryPort.Ledger interface.
+var _ repositoryPort.Ledger = (*LedgerRepository)(nil)
+
+type LedgerRepository struct {
+	client postgresDatabase.Client
+}
+
+// ledgerTransaction is a representation on how the ledger transaction is retrieved from the database.
+type ledgerTransaction struct {
+	ID            string    `pg:"id"`
+	TeamSlug      string    `pg:"team_slug"`
+	Kind          string    `pg:"kind"`
+	Description   string    `pg:"description"`
+	EffectiveDate time.Time `pg:"effective_date"`
+
+	CreatedAt time.Time `pg:"created_at"`
+	CreatedBy string    `pg:"created_by"`
+}
+
+// ledgerEntry is a representation on how the ledger entry is retrieved from the database.
+type ledgerEntry struct {
+	TransactionID  string `pg:"transaction_id"`
+	Account        string `pg:"account"`
+	PersonUserName string `pg:"person_username"`
+	AmountInCents  int64  `pg:"amount_in_cents"`
+}
+
+// ledgerBalance is a representation on how the balance of a person is aggregated by the database.
+type ledgerBalance struct {
+	PersonUserName string `pg:"person_username"`
+	BalanceInCents int64  `pg:"balance_in_cents"`
+}
+
+// NewLedgerRepository instantiates a new ledger repository for postgres.
+func NewLedgerRepository(client postgresDatabase.Client) *LedgerRepository {
+	return &LedgerRepository{
+		client: client,
+	}
+}
+
+func (repository *LedgerRepository) GetLedgerTransactionsByTeamSlug(
+	context context.Context,
+	teamSlug string,
+) ([]*entity.LedgerTransaction, error) {
+	query := `select
+              id,
+              team_slug,
+              kind,
+              description,
+              effective_date,
+              created_at,
+              created_by
+            from
+              ledger_transactions
+            where
+              team_slug = ?
+            order by
+              effective_date desc, created_at desc`
+
+	// Execute query in DB
+	var fetchedTransactions []ledgerTransaction
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedTransactions, query, teamSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ledger transactions from team %s: %w", teamSlug, err)
+	}
+
+	// Query executed successfully but no entity found for this team
+	if queryResult.RowsReturned == 0 {
+		return []*entity.LedgerTransaction{}, nil
+	}
+
+	transactionIDs := make([]string, 0, len(fetchedTransactions))
+	for _, transaction := range fetchedTransactions {
+		transactionIDs = append(transactionIDs, transaction.ID)
+	}
+
+	entriesQuery := `select
+              transaction_id,
+              account,
+              person_username,
+              amount_in_cents
+            from
+              ledger_entries
+            where
+              transaction_id in (?)`
+
+	var fetchedEntries []ledgerEntry
+	_, err = repository.client.ExecuteQuery(context, &fetchedEntries, entriesQuery, transactionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ledger entries from team %s: %w", teamSlug, err)
+	}
+
+	return ledgerTransactionsToLedgerTransactionEntities(fetchedTransactions, fetchedEntries), nil
+}
+
+func (repository *LedgerRepository) GetLedgerBalancesByTeamSlug(
+	context context.Context,
+	teamSlug string,
+) ([]*entity.LedgerBalance, error) {
+	query := `select
+              ledger_entries.person_username,
+              sum(ledger_entries.amount_in_cents) as balance_in_cents
+            from
+              ledger_entries
+              join ledger_transactions on ledger_transactions.id = ledger_entries.transaction_id
+            where
+              ledger_transactions.team_slug = ?
+              and ledger_entries.account = ?
+            group by
+              ledger_entries.person_username
+            order by
+              ledger_entries.person_username`
+
+	// Execute query in DB
+	var fetchedBalances []ledgerBalance
+	_, err := repository.client.ExecuteQuery(context, &fetchedBalances, query, teamSlug, string(entity.LedgerAccounts.Receivable))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ledger balances from team %s: %w", teamSlug, err)
+	}
+
+	balanceEntities := make([]*entity.LedgerBalance, 0, len(fetchedBalances))
+	for _, balance := range fetchedBalances {
+		balanceEntities = append(balanceEntities, &entity.LedgerBalance{
+			Team:           &entity.Team{Slug: teamSlug},
+			Person:         &entity.Person{UserName: balance.PersonUserName},
+			BalanceInCents: balance.BalanceInCents,
+		})
+	}
+
+	return balanceEntities, nil
+}
+
+func (repository *LedgerRepository) CreateLedgerTransaction(
+	ctx context.Context,
+	transactionEntity *entity.LedgerTransaction,
+) (*entity.LedgerTransaction, error) {
+	if !transactionEntity.IsBalanced() {
+		return nil, fmt.Errorf("ledger transaction entries do not sum up to zero")
+	}
+
+	transactionQuery := `insert into ledger_transactions (
+	 team_slug,
+	 kind,
+	 description,
+	 effective_date,
+	 created_by
+   ) values (?, ?, ?, ?, ?) returning
+	 id,
+	 team_slug,
+	 kind,
+	 description,
+	 effective_date,
+	 created_at,
+	 created_by`
+
+	entryQuery := `insert into ledger_entries (
+	 transaction_id,
+	 account,
+	 person_username,
+	 amount_in_cents
+   ) values (?, ?, ?, ?)`
+
+	// The transaction and all of its entries must be persisted together to keep the ledger balanced
+	var inserted ledgerTransaction
+	err := runInTransaction(ctx, repository.client, func(transactionContext context.Context) error {
+		queryResult, err := repository.client.ExecuteQuery(
+			transactionContext,
+			&inserted,
+			transactionQuery,
+			transactionEntity.Team.Slug,
+			string(transactionEntity.Kind),
+			transactionEntity.Description,
+			transactionEntity.EffectiveDate,
+			transactionEntity.CreatedBy,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create ledger transaction: %w", err)
+		}
+		if queryResult == nil || queryResult.RowsReturned == 0 {
+			return fmt.Errorf("no rows were returned after inserting ledger transaction")
+		}
+
+		for _, entry := range transactionEntity.Entries {
+			var personUserName interface{}
+			if entry.Person != nil {
+				personUserName = entry.Person.UserName
+			}
+
+			_, err = repository.client.ExecuteCommand(
+				transactionContext,
+				entryQuery,
+				inserted.ID,
+				string(entry.Account),
+				personUserName,
+				entry.AmountInCents,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to create ledger entry: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	createdTransaction := ledgerTransactionToLedgerTransactionEntity(inserted)
+	createdTransaction.Entries = transactionEntity.Entries
+
+	return createdTransaction, nil
+}
+
+func ledgerTransactionsToLedgerTransactionEntities(
+	transactions []ledgerTransaction,
+	entries []ledgerEntry,
+) []*entity.LedgerTransaction {
+	entriesByTransactionID := map[string][]entity.LedgerEntry{}
+	for _, entry := range entries {
+		entriesByTransactionID[entry.TransactionID] = append(
+			entriesByTransactionID[entry.TransactionID],
+			ledgerEntryToLedgerEntryEntity(entry),
+		)
+	}
+
+	transactionEntities := make([]*entity.LedgerTransaction, 0)
+	for _, transaction := range transactions {
+		transactionEntity := ledgerTransactionToLedgerTransactionEntity(transaction)
+		transactionEntity.Entries = entriesByTransactionID[transaction.ID]
+		transactionEntities = append(transactionEntities, transactionEntity)
+	}
+
+	return transactionEntities
+}
+
+func ledgerTransactionToLedgerTransactionEntity(transaction ledgerTransaction) *entity.LedgerTransaction {
+	return &entity.LedgerTransaction{
+		ID:            transaction.ID,
+		Team:          &entity.Team{Slug: transaction.TeamSlug},
+		Kind:          entity.LedgerTransactionKind(transaction.Kind),
+		Description:   transaction.Description,
+		EffectiveDate: transaction.EffectiveDate,
+		Entries:       []entity.LedgerEntry{},
+
+		CreatedAt: transaction.CreatedAt,
+		CreatedBy: transaction.CreatedBy,
+	}
+}
+
+func ledgerEntryToLedgerEntryEntity(entry ledgerEntry) entity.LedgerEntry {
+	var person *entity.Person
+	if entry.PersonUserName != "" {
+		person = &entity.Person{UserName: entry.PersonUserName}
+	}
+
+	return entity.LedgerEntry{
+		Account:       entity.LedgerAccount(entry.Account),
+		Person:        person,
+		AmountInCents: entry.AmountInCents,
+	}
+}
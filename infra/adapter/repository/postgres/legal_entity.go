@@ -0,0 +1,506 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that LegalEntityRepository implements the repositoryPort.LegalEntity interface.
+var _ repositoryPort.LegalEntity = (*LegalEntityRepository)(nil)
+
+type LegalEntityRepository struct {
+	client postgresDatabase.Client
+}
+
+// legalEntity is a representation on how the legal entity is retrieved from the database.
+type legalEntity struct {
+	Slug               string    `pg:"slug"`
+	Name               string    `pg:"name"`
+	Kind               string    `pg:"kind"`
+	RegistrationNumber string    `pg:"registration_number"`
+	Country            string    `pg:"country"`
+	ContactName        string    `pg:"contact_name"`
+	ContactEmail       string    `pg:"contact_email"`
+	ContactPhoneNumber string    `pg:"contact_phone_number"`
+	Website            string    `pg:"website"`
+	CreatedAt          time.Time `pg:"created_at"`
+	CreatedBy          string    `pg:"created_by"`
+	UpdatedAt          time.Time `pg:"updated_at"`
+	UpdatedBy          string    `pg:"updated_by"`
+}
+
+// legalEntityAffiliation is a representation on how the affiliation of a team or a person to a legal entity is
+// retrieved from the database. Only one of TeamSlug and PersonUserName is filled.
+type legalEntityAffiliation struct {
+	LegalEntitySlug string    `pg:"legal_entity_slug"`
+	LegalEntityName string    `pg:"legal_entity_name"`
+	TeamSlug        string    `pg:"team_slug"`
+	TeamName        string    `pg:"team_name"`
+	PersonUserName  string    `pg:"person_username"`
+	StartDate       time.Time `pg:"start_date"`
+	EndDate         time.Time `pg:"end_date"`
+	CreatedAt       time.Time `pg:"created_at"`
+	CreatedBy       string    `pg:"created_by"`
+	UpdatedAt       time.Time `pg:"updated_at"`
+	UpdatedBy       string    `pg:"updated_by"`
+}
+
+const legalEntityColumns = `slug,
+              name,
+              kind,
+              registration_number,
+              country,
+              contact_name,
+              contact_email,
+              contact_phone_number,
+              website,
+              created_at,
+              created_by,
+              updated_at,
+              updated_by`
+
+// legalEntityAffiliationsQuery merges the affiliations of teams and people so they can be filtered and sorted together.
+var legalEntityAffiliationsQuery = `select
+              *
+            from (
+              select
+                affiliation.legal_entity_slug,
+                legal_entity.name as legal_entity_name,
+                affiliation.team_slug,
+                ` + teamNameAtColumn("affiliation.team_slug", "affiliation.start_date") + `,
+                null as person_username,
+                affiliation.start_date,
+                affiliation.end_date,
+                affiliation.created_at,
+                affiliation.created_by,
+                affiliation.updated_at,
+                affiliation.updated_by
+              from
+                team_legal_entity_affiliations affiliation
+                join legal_entities legal_entity on legal_entity.slug = affiliation.legal_entity_slug
+              union all
+              select
+                affiliation.legal_entity_slug,
+                legal_entity.name as legal_entity_name,
+                null as team_slug,
+                null as team_name,
+                affiliation.person_username,
+                affiliation.start_date,
+                affiliation.end_date,
+                affiliation.created_at,
+                affiliation.created_by,
+                affiliation.updated_at,
+                affiliation.updated_by
+              from
+                person_legal_entity_affiliations affiliation
+                join legal_entities legal_entity on legal_entity.slug = affiliation.legal_entity_slug
+            ) affiliations`
+
+// legalEntitiesListing lists the legal entities by name, which is unique.
+var legalEntitiesListing = pagedListing{
+	table:     "legal_entities",
+	keyColumn: "name",
+	columns:   map[string]string{},
+}
+
+// NewLegalEntityRepository instantiates a new legal entity repository for postgres.
+func NewLegalEntityRepository(client postgresDatabase.Client) *LegalEntityRepository {
+	return &LegalEntityRepository{
+		client: client,
+	}
+}
+
+func (repository *LegalEntityRepository) GetAllLegalEntities(
+	context context.Context,
+	page entity.PageRequest,
+) ([]*entity.LegalEntity, error) {
+	query := `select
+              ` + legalEntityColumns + `
+            from
+              legal_entities`
+	query, params, err := pagedQuery(legalEntitiesListing, query, []string{}, []interface{}{}, entity.Criteria{}, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve all legal entities: %w", err)
+	}
+
+	// Execute query in DB
+	var fetchedLegalEntities []legalEntity
+	_, err = repository.client.ExecuteQuery(context, &fetchedLegalEntities, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve all legal entities: %w", err)
+	}
+
+	legalEntityEntities := make([]*entity.LegalEntity, 0, len(fetchedLegalEntities))
+	for _, fetchedLegalEntity := range fetchedLegalEntities {
+		legalEntityEntities = append(legalEntityEntities, legalEntityToLegalEntityEntity(fetchedLegalEntity))
+	}
+
+	return legalEntityEntities, nil
+}
+
+func (repository *LegalEntityRepository) GetLegalEntityBySlug(context context.Context, slug string) (*entity.LegalEntity, error) {
+	query := `select
+              ` + legalEntityColumns + `
+            from
+              legal_entities
+            where
+              slug = ? limit 1`
+
+	// Execute query in DB
+	var fetchedLegalEntity legalEntity
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedLegalEntity, query, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve legal entity %s: %w", slug, err)
+	}
+
+	// Query executed successfully but no entity found for this slug
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return legalEntityToLegalEntityEntity(fetchedLegalEntity), nil
+}
+
+func (repository *LegalEntityRepository) CreateLegalEntity(
+	context context.Context,
+	legalEntityEntity *entity.LegalEntity,
+) (*entity.LegalEntity, error) {
+	query := `insert into legal_entities (
+	 slug,
+	 name,
+	 kind,
+	 registration_number,
+	 country,
+	 contact_name,
+	 contact_email,
+	 contact_phone_number,
+	 website,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) returning
+	 ` + legalEntityColumns
+
+	var inserted legalEntity
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		legalEntityEntity.Slug,
+		legalEntityEntity.Name,
+		string(legalEntityEntity.Kind),
+		nullIfEmpty(legalEntityEntity.RegistrationNumber),
+		legalEntityEntity.Country,
+		nullIfEmpty(legalEntityEntity.ContactName),
+		nullIfEmpty(legalEntityEntity.ContactEmail),
+		nullIfEmpty(legalEntityEntity.ContactPhoneNumber),
+		nullIfEmpty(legalEntityEntity.Website),
+		legalEntityEntity.CreatedBy,
+		legalEntityEntity.CreatedBy,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf("failed to create legal entity '%s': %w", legalEntityEntity.Name, err)
+	}
+
+	return legalEntityToLegalEntityEntity(inserted), nil
+}
+
+func (repository *LegalEntityRepository) UpdateLegalEntity(
+	context context.Context,
+	legalEntityEntity *entity.LegalEntity,
+	updatedAttributes []entity.LegalEntityAttribute,
+) (*entity.LegalEntity, error) {
+	// Build update query dynamically based on updatedAttributes
+	setClauses := []string{}
+	params := []interface{}{}
+	for _, attribute := range updatedAttributes {
+		switch attribute {
+		case entity.LegalEntityAttributes.Name:
+			setClauses = append(setClauses, "name = ?")
+			params = append(params, legalEntityEntity.Name)
+		case entity.LegalEntityAttributes.Kind:
+			setClauses = append(setClauses, "kind = ?")
+			params = append(params, string(legalEntityEntity.Kind))
+		case entity.LegalEntityAttributes.RegistrationNumber:
+			setClauses = append(setClauses, "registration_number = ?")
+			params = append(params, nullIfEmpty(legalEntityEntity.RegistrationNumber))
+		case entity.LegalEntityAttributes.Country:
+			setClauses = append(setClauses, "country = ?")
+			params = append(params, legalEntityEntity.Country)
+		case entity.LegalEntityAttributes.ContactName:
+			setClauses = append(setClauses, "contact_name = ?")
+			params = append(params, nullIfEmpty(legalEntityEntity.ContactName))
+		case entity.LegalEntityAttributes.ContactEmail:
+			setClauses = append(setClauses, "contact_email = ?")
+			params = append(params, nullIfEmpty(legalEntityEntity.ContactEmail))
+		case entity.LegalEntityAttributes.ContactPhoneNumber:
+			setClauses = append(setClauses, "contact_phone_number = ?")
+			params = append(params, nullIfEmpty(legalEntityEntity.ContactPhoneNumber))
+		case entity.LegalEntityAttributes.Website:
+			setClauses = append(setClauses, "website = ?")
+			params = append(params, nullIfEmpty(legalEntityEntity.Website))
+		case entity.LegalEntityAttributes.UpdatedBy:
+			setClauses = append(setClauses, "updated_by = ?")
+			params = append(params, legalEntityEntity.UpdatedBy)
+		}
+	}
+	// Always set updated_at to now()
+	setClauses = append(setClauses, "updated_at = now()")
+	query := "update legal_entities set " + stringJoin(setClauses, ", ") + " where slug = ?"
+	params = append(params, legalEntityEntity.Slug)
+	res, err := repository.client.ExecuteCommand(context, query, params...)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf("failed to update legal entity: %w", err)
+	}
+	// If nothing was updated, return nil so handler can return 404
+	if res == nil || res.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return repository.GetLegalEntityBySlug(context, legalEntityEntity.Slug)
+}
+
+func (repository *LegalEntityRepository) GetLegalEntityAffiliationsByLegalEntitySlug(
+	context context.Context,
+	legalEntitySlug string,
+) ([]*entity.LegalEntityAffiliation, error) {
+	return repository.getLegalEntityAffiliations(context, "legal_entity_slug", legalEntitySlug)
+}
+
+func (repository *LegalEntityRepository) GetLegalEntityAffiliationsByTeamSlug(
+	context context.Context,
+	teamSlug string,
+) ([]*entity.LegalEntityAffiliation, error) {
+	return repository.getLegalEntityAffiliations(context, "team_slug", teamSlug)
+}
+
+func (repository *LegalEntityRepository) GetLegalEntityAffiliationsByPersonUserName(
+	context context.Context,
+	personUserName string,
+) ([]*entity.LegalEntityAffiliation, error) {
+	return repository.getLegalEntityAffiliations(context, "person_username", personUserName)
+}
+
+func (repository *LegalEntityRepository) CreateLegalEntityAffiliation(
+	context context.Context,
+	affiliationEntity *entity.LegalEntityAffiliation,
+) (*entity.LegalEntityAffiliation, error) {
+	table, column, value := legalEntityAffiliationTarget(affiliationEntity)
+	query := `insert into ` + table + ` (
+	 legal_entity_slug,
+	 ` + column + `,
+	 start_date,
+	 end_date,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?) returning
+	 legal_entity_slug,
+	 ` + column + `,
+	 start_date,
+	 end_date,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by`
+
+	// An ongoing affiliation is stored with a null end date
+	var endDate interface{}
+	if !affiliationEntity.EndDate.IsZero() {
+		endDate = affiliationEntity.EndDate
+	}
+
+	var inserted legalEntityAffiliation
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		affiliationEntity.LegalEntity.Slug,
+		value,
+		affiliationEntity.StartDate,
+		endDate,
+		affiliationEntity.CreatedBy,
+		affiliationEntity.CreatedBy,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf(
+			"failed to create affiliation of '%s' to legal entity %s: %w",
+			value,
+			affiliationEntity.LegalEntity.Slug,
+			err,
+		)
+	}
+
+	return legalEntityAffiliationWithStoredDates(affiliationEntity, inserted), nil
+}
+
+func (repository *LegalEntityRepository) EndLegalEntityAffiliation(
+	context context.Context,
+	affiliationEntity *entity.LegalEntityAffiliation,
+) (*entity.LegalEntityAffiliation, error) {
+	table, column, value := legalEntityAffiliationTarget(affiliationEntity)
+	query := `update ` + table + ` set
+	 end_date = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 legal_entity_slug = ? and ` + column + ` = ? and start_date = ?
+   returning
+	 legal_entity_slug,
+	 ` + column + `,
+	 start_date,
+	 end_date,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by`
+
+	var updated legalEntityAffiliation
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		affiliationEntity.EndDate,
+		affiliationEntity.UpdatedBy,
+		affiliationEntity.LegalEntity.Slug,
+		value,
+		affiliationEntity.StartDate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to end affiliation of '%s' to legal entity %s: %w",
+			value,
+			affiliationEntity.LegalEntity.Slug,
+			err,
+		)
+	}
+
+	// Query executed successfully but no affiliation started on this date
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return legalEntityAffiliationWithStoredDates(affiliationEntity, updated), nil
+}
+
+func (repository *LegalEntityRepository) getLegalEntityAffiliations(
+	context context.Context,
+	column string,
+	value string,
+) ([]*entity.LegalEntityAffiliation, error) {
+	query := legalEntityAffiliationsQuery + `
+            where
+              ` + column + ` = ?
+            order by
+              start_date desc,
+              legal_entity_slug,
+              team_slug,
+              person_username`
+
+	// Execute query in DB
+	var fetchedAffiliations []legalEntityAffiliation
+	_, err := repository.client.ExecuteQuery(context, &fetchedAffiliations, query, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve legal entity affiliations by %s %s: %w", column, value, err)
+	}
+
+	affiliationEntities := make([]*entity.LegalEntityAffiliation, 0, len(fetchedAffiliations))
+	for _, fetchedAffiliation := range fetchedAffiliations {
+		affiliationEntities = append(affiliationEntities, legalEntityAffiliationToLegalEntityAffiliationEntity(fetchedAffiliation))
+	}
+
+	return affiliationEntities, nil
+}
+
+// legalEntityAffiliationTarget returns the table and column that store the affiliation, depending on whether it
+// belongs to a team or to a person, together with the value that identifies the affiliated team or person.
+func legalEntityAffiliationTarget(affiliation *entity.LegalEntityAffiliation) (string, string, string) {
+	if affiliation.Team != nil {
+		return "team_legal_entity_affiliations", "team_slug", affiliation.Team.Slug
+	}
+
+	return "person_legal_entity_affiliations", "person_username", affiliation.Person.UserName
+}
+
+// nullIfEmpty stores optional text columns as null, so that unique constraints ignore them when they are not filled.
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
+func legalEntityToLegalEntityEntity(legalEntity legalEntity) *entity.LegalEntity {
+	return &entity.LegalEntity{
+		Slug:               legalEntity.Slug,
+		Name:               legalEntity.Name,
+		Kind:               entity.LegalEntityKind(legalEntity.Kind),
+		RegistrationNumber: legalEntity.RegistrationNumber,
+		Country:            legalEntity.Country,
+		ContactName:        legalEntity.ContactName,
+		ContactEmail:       legalEntity.ContactEmail,
+		ContactPhoneNumber: legalEntity.ContactPhoneNumber,
+		Website:            legalEntity.Website,
+
+		CreatedAt: legalEntity.CreatedAt,
+		CreatedBy: legalEntity.CreatedBy,
+		UpdatedAt: legalEntity.UpdatedAt,
+		UpdatedBy: legalEntity.UpdatedBy,
+	}
+}
+
+func legalEntityAffiliationToLegalEntityAffiliationEntity(affiliation legalEntityAffiliation) *entity.LegalEntityAffiliation {
+	// A null end_date is scanned as the zero time, which is how the entity represents an ongoing affiliation.
+	affiliationEntity := &entity.LegalEntityAffiliation{
+		LegalEntity: &entity.LegalEntity{Slug: affiliation.LegalEntitySlug, Name: affiliation.LegalEntityName},
+
+		StartDate: affiliation.StartDate,
+		EndDate:   affiliation.EndDate,
+
+		CreatedAt: affiliation.CreatedAt,
+		CreatedBy: affiliation.CreatedBy,
+		UpdatedAt: affiliation.UpdatedAt,
+		UpdatedBy: affiliation.UpdatedBy,
+	}
+	if affiliation.TeamSlug != "" {
+		affiliationEntity.Team = &entity.Team{Slug: affiliation.TeamSlug, Name: affiliation.TeamName}
+	} else {
+		affiliationEntity.Person = &entity.Person{UserName: affiliation.PersonUserName}
+	}
+
+	return affiliationEntity
+}
+
+// legalEntityAffiliationWithStoredDates keeps the legal entity, team and person of the given affiliation, which are
+// not returned by the insert and update statements.
+func legalEntityAffiliationWithStoredDates(
+	affiliationEntity *entity.LegalEntityAffiliation,
+	stored legalEntityAffiliation,
+) *entity.LegalEntityAffiliation {
+	storedAffiliation := affiliationEntity.Clone()
+	storedAffiliation.StartDate = stored.StartDate
+	storedAffiliation.EndDate = stored.EndDate
+	storedAffiliation.CreatedAt = stored.CreatedAt
+	storedAffiliation.CreatedBy = stored.CreatedBy
+	storedAffiliation.UpdatedAt = stored.UpdatedAt
+	storedAffiliation.UpdatedBy = stored.UpdatedBy
+
+	return storedAffiliation
+}
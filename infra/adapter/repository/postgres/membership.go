@@ -3,10 +3,9 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
-
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
 	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
@@ -21,18 +20,39 @@ type MembershipRepository struct {
 
 // membership is a representation on how the membership is retrieved from the database.
 type membership struct {
-	TeamSlug       string `pg:"team_slug"`
-	PersonUserName string `pg:"person_user_name"`
-	Role           string `pg:"role"`
-	StartDate      string `pg:"start_date"`
-	EndDate        string `pg:"end_date"`
-
-	CreatedAt string `pg:"created_at"`
-	CreatedBy string `pg:"created_by"`
-	UpdatedAt string `pg:"updated_at"`
-	UpdatedBy string `pg:"updated_by"`
+	TeamSlug       string    `pg:"team_slug"`
+	TeamName       string    `pg:"team_name"`
+	PersonUserName string    `pg:"person_username"`
+	Role           string    `pg:"role"`
+	JerseyNumber   string    `pg:"jersey_number"`
+	StartDate      time.Time `pg:"start_date"`
+	EndDate        time.Time `pg:"end_date"`
+
+	CreatedAt time.Time `pg:"created_at"`
+	CreatedBy string    `pg:"created_by"`
+	UpdatedAt time.Time `pg:"updated_at"`
+	UpdatedBy string    `pg:"updated_by"`
 }
 
+var membershipsQuery = `select
+              team_slug,
+              ` + teamNameAtColumn("memberships.team_slug", "memberships.start_date") + `,
+              person_username,
+              role,
+              jersey_number,
+              start_date,
+              end_date,
+              created_at,
+              created_by,
+              updated_at,
+              updated_by
+            from
+              memberships`
+
+// membershipInEffectAtCondition keeps the memberships that were in effect on the date given twice as parameter. An
+// ongoing membership has a null end date.
+const membershipInEffectAtCondition = `start_date <= ? and (end_date is null or end_date >= ?)`
+
 // NewMembershipRepository instantiates a new membership repository for postgres.
 func NewMembershipRepository(client postgresDatabase.Client) *MembershipRepository {
 	return &MembershipRepository{
@@ -41,12 +61,7 @@ func NewMembershipRepository(client postgresDatabase.Client) *MembershipReposito
 }
 
 func (repository *MembershipRepository) GetMembershipsByTeamSlug(context context.Context, teamSlug string) ([]entity.Membership, error) {
-	query := `select
-              team_slug,
-              person_username,
-              role
-            from
-              memberships
+	query := membershipsQuery + `
             where
               team_slug = ?`
 
@@ -54,7 +69,7 @@ func (repository *MembershipRepository) GetMembershipsByTeamSlug(context context
 	var fetchedMemberships []membership
 	queryResult, err := repository.client.ExecuteQuery(context, &fetchedMemberships, query, teamSlug)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve game captains from team %s: %w", teamSlug, err)
+		return nil, fmt.Errorf("failed to retrieve memberships from team %s: %w", teamSlug, err)
 	}
 
 	// Query executed successfully but no entity found for this ID
@@ -62,53 +77,291 @@ func (repository *MembershipRepository) GetMembershipsByTeamSlug(context context
 		return []entity.Membership{}, nil
 	}
 
-	return membershipsToMembershipEntities(fetchedMemberships)
+	return membershipsToMembershipEntities(fetchedMemberships), nil
 }
 
-func membershipsToMembershipEntities(memberships []membership) ([]entity.Membership, error) {
-	var membershipEntities []entity.Membership
+func (repository *MembershipRepository) GetMembershipsByTeamSlugAt(
+	context context.Context,
+	teamSlug string,
+	date time.Time,
+) ([]entity.Membership, error) {
+	query := membershipsQuery + `
+            where
+              team_slug = ? and ` + membershipInEffectAtCondition + `
+            order by
+              start_date,
+              person_username,
+              role`
 
-	for _, membership := range memberships {
-		membershipEntity, err := membershipToMembershipEntity(membership)
-		if err != nil {
-			return []entity.Membership{}, fmt.Errorf("failed to convert membership to membership entity: %w", err)
-		}
-		membershipEntities = append(membershipEntities, *membershipEntity)
+	// Execute query in DB
+	var fetchedMemberships []membership
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedMemberships, query, teamSlug, date, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve memberships from team %s in effect on a date: %w", teamSlug, err)
 	}
 
-	return membershipEntities, nil
+	// Query executed successfully but the team had no members on this date
+	if queryResult.RowsReturned == 0 {
+		return []entity.Membership{}, nil
+	}
+
+	return membershipsToMembershipEntities(fetchedMemberships), nil
 }
 
-func membershipToMembershipEntity(membership membership) (*entity.Membership, error) {
-	startDate, err := time.Parse(helper.DefaultTimeLayout, membership.StartDate)
+func (repository *MembershipRepository) GetMembershipsByPersonUserName(
+	context context.Context,
+	personUserName string,
+) ([]entity.Membership, error) {
+	query := membershipsQuery + `
+            where
+              person_username = ?
+            order by
+              start_date,
+              team_slug,
+              role`
+
+	// Execute query in DB
+	var fetchedMemberships []membership
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedMemberships, query, personUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve memberships of '%s': %w", personUserName, err)
+	}
+
+	// Query executed successfully but the person has never been a member of a team
+	if queryResult.RowsReturned == 0 {
+		return []entity.Membership{}, nil
+	}
+
+	return membershipsToMembershipEntities(fetchedMemberships), nil
+}
+
+func (repository *MembershipRepository) GetMembershipsByPersonUserNameAt(
+	context context.Context,
+	personUserName string,
+	date time.Time,
+) ([]entity.Membership, error) {
+	query := membershipsQuery + `
+            where
+              person_username = ? and ` + membershipInEffectAtCondition + `
+            order by
+              start_date,
+              team_slug,
+              role`
+
+	// Execute query in DB
+	var fetchedMemberships []membership
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedMemberships, query, personUserName, date, date)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse start date: %w", err)
+		return nil, fmt.Errorf("failed to retrieve memberships of '%s' in effect on a date: %w", personUserName, err)
+	}
+
+	// Query executed successfully but the person was not a member of any team on this date
+	if queryResult.RowsReturned == 0 {
+		return []entity.Membership{}, nil
+	}
+
+	return membershipsToMembershipEntities(fetchedMemberships), nil
+}
+
+func (repository *MembershipRepository) CreateMembership(
+	context context.Context,
+	membershipEntity *entity.Membership,
+) (*entity.Membership, error) {
+	query := `insert into memberships (
+	 team_slug,
+	 person_username,
+	 role,
+	 start_date,
+	 end_date,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?, ?) returning
+	 team_slug,
+	 ` + teamNameAtColumn("memberships.team_slug", "memberships.start_date") + `,
+	 person_username,
+	 role,
+	 jersey_number,
+	 start_date,
+	 end_date,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by`
+
+	// An ongoing membership is stored with a null end date
+	var endDate interface{}
+	if !membershipEntity.EndDate.IsZero() {
+		endDate = membershipEntity.EndDate
 	}
-	endDate, err := time.Parse(helper.DefaultTimeLayout, membership.EndDate)
+
+	var inserted membership
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		membershipEntity.Team.Slug,
+		membershipEntity.Person.UserName,
+		membershipEntity.Role,
+		membershipEntity.StartDate,
+		endDate,
+		membershipEntity.CreatedBy,
+		membershipEntity.CreatedBy,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse end date: %w", err)
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+		// Another playing membership of the person in a different team overlaps this one
+		if strings.Contains(err.Error(), "memberships_playing_period_excl") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrOverlappingPeriod, err)
+		}
+
+		return nil, fmt.Errorf(
+			"failed to create membership of '%s' in team %s: %w",
+			membershipEntity.Person.UserName,
+			membershipEntity.Team.Slug,
+			err,
+		)
 	}
 
-	createdAt, err := time.Parse(helper.DefaultTimeLayout, membership.CreatedAt)
+	return membershipToMembershipEntity(inserted), nil
+}
+
+func (repository *MembershipRepository) EndMembership(
+	context context.Context,
+	membershipEntity *entity.Membership,
+) (*entity.Membership, error) {
+	query := `update memberships set
+	 end_date = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 team_slug = ? and person_username = ? and role = ? and start_date = ?
+   returning
+	 team_slug,
+	 ` + teamNameAtColumn("memberships.team_slug", "memberships.start_date") + `,
+	 person_username,
+	 role,
+	 jersey_number,
+	 start_date,
+	 end_date,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by`
+
+	var updated membership
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		membershipEntity.EndDate,
+		membershipEntity.UpdatedBy,
+		membershipEntity.Team.Slug,
+		membershipEntity.Person.UserName,
+		membershipEntity.Role,
+		membershipEntity.StartDate,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse created at: %w", err)
+		return nil, fmt.Errorf(
+			"failed to end membership of '%s' in team %s: %w",
+			membershipEntity.Person.UserName,
+			membershipEntity.Team.Slug,
+			err,
+		)
+	}
+
+	// Query executed successfully but no membership started on this date
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
 	}
-	updatedAt, err := time.Parse(helper.DefaultTimeLayout, membership.UpdatedAt)
+
+	return membershipToMembershipEntity(updated), nil
+}
+
+func (repository *MembershipRepository) UpdateMembershipJerseyNumber(
+	context context.Context,
+	membershipEntity *entity.Membership,
+) (*entity.Membership, error) {
+	query := `update memberships set
+	 jersey_number = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 team_slug = ? and person_username = ? and role = ? and start_date = ?
+   returning
+	 team_slug,
+	 ` + teamNameAtColumn("memberships.team_slug", "memberships.start_date") + `,
+	 person_username,
+	 role,
+	 jersey_number,
+	 start_date,
+	 end_date,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by`
+
+	var updated membership
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		nullableString(membershipEntity.JerseyNumber),
+		membershipEntity.UpdatedBy,
+		membershipEntity.Team.Slug,
+		membershipEntity.Person.UserName,
+		membershipEntity.Role,
+		membershipEntity.StartDate,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse updated at: %w", err)
+		// Another person of the team wears the number in an overlapping membership
+		if strings.Contains(err.Error(), "memberships_jersey_number_excl") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf(
+			"failed to update jersey number of '%s' in team %s: %w",
+			membershipEntity.Person.UserName,
+			membershipEntity.Team.Slug,
+			err,
+		)
 	}
 
+	// Query executed successfully but no membership started on this date
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return membershipToMembershipEntity(updated), nil
+}
+
+func membershipsToMembershipEntities(memberships []membership) []entity.Membership {
+	membershipEntities := make([]entity.Membership, 0)
+
+	for _, membership := range memberships {
+		membershipEntities = append(membershipEntities, *membershipToMembershipEntity(membership))
+	}
+
+	return membershipEntities
+}
+
+func membershipToMembershipEntity(membership membership) *entity.Membership {
+	// Rows are scanned directly into Go types by the DB client. A null end_date is scanned as the zero time,
+	// which is how the entity represents an ongoing membership.
 	return &entity.Membership{
-		Team:   &entity.Team{Slug: membership.TeamSlug},
+		Team:   &entity.Team{Slug: membership.TeamSlug, Name: membership.TeamName},
 		Person: &entity.Person{UserName: membership.PersonUserName},
 		Role:   membership.Role,
 
-		StartDate: startDate,
-		EndDate:   endDate,
+		JerseyNumber: membership.JerseyNumber,
 
-		CreatedAt: createdAt,
+		StartDate: membership.StartDate,
+		EndDate:   membership.EndDate,
+
+		CreatedAt: membership.CreatedAt,
 		CreatedBy: membership.CreatedBy,
-		UpdatedAt: updatedAt,
+		UpdatedAt: membership.UpdatedAt,
 		UpdatedBy: membership.UpdatedBy,
-	}, nil
+	}
 }
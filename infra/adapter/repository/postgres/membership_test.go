@@ -0,0 +1,275 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	repositoryPostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/repository/postgres"
+	databasePostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test/fixture"
+)
+
+func membershipTestDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// membershipKeys tells each membership apart by its team and role, in the order they were returned.
+func membershipKeys(memberships []entity.Membership) []string {
+	keys := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		keys = append(keys, membership.Team.Slug+"/"+membership.Person.UserName+"/"+membership.Role)
+	}
+
+	return keys
+}
+
+// getTeamMembershipPeriodsFixtureQueries fills the database with a membership of the default team from 2024-03-01 to
+// 2024-06-30, an ongoing one that starts on 2024-06-30 and an ongoing one of another team.
+func getTeamMembershipPeriodsFixtureQueries() []fixture.Query {
+	team := fixture.GetDefaultFixtureTeam()
+	anotherTeam := fixture.GetAnotherFixtureTeam()
+	closedPlayer := fixture.GetFakePerson("closed.player")
+	openPlayer := fixture.GetFakePerson("open.player")
+	anotherPlayer := fixture.GetFakePerson("another.player")
+
+	return fixture.MergeQueries(
+		fixture.GenerateTeamQueries(team, anotherTeam),
+		fixture.GeneratePersonQueries(closedPlayer, openPlayer, anotherPlayer),
+		fixture.GenerateMembershipQueries(
+			fixture.GetFakeMembership(team, closedPlayer).
+				WithStartDate(membershipTestDate(2024, time.March, 1)).
+				WithEndDate(membershipTestDate(2024, time.June, 30)),
+			fixture.GetFakeMembership(team, openPlayer).WithStartDate(membershipTestDate(2024, time.June, 30)),
+			fixture.GetFakeMembership(anotherTeam, anotherPlayer).WithStartDate(membershipTestDate(2024, time.January, 1)),
+		),
+	)
+}
+
+func TestMembershipRepository_GetMembershipsByTeamSlugAt(t *testing.T) {
+	t.Parallel()
+
+	teamSlug := fixture.GetDefaultFixtureTeam().Slug
+	closedPlayer := teamSlug + "/closed.player/" + entity.MembershipRoles.Player
+	openPlayer := teamSlug + "/open.player/" + entity.MembershipRoles.Player
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should return no membership on the day before the first one starts",
+			FixtureQueries: getTeamMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2024, time.February, 29)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{}},
+		},
+		{
+			Description:    "should return a membership on its start date",
+			FixtureQueries: getTeamMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2024, time.March, 1)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{closedPlayer}},
+		},
+		{
+			Description:    "should return a membership on its end date along with one that starts on the same date",
+			FixtureQueries: getTeamMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2024, time.June, 30)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{closedPlayer, openPlayer}},
+		},
+		{
+			Description:    "should leave a membership out on the day after its end date",
+			FixtureQueries: getTeamMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2024, time.July, 1)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{openPlayer}},
+		},
+		{
+			Description:    "should return an open-ended membership long after it started",
+			FixtureQueries: getTeamMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2030, time.January, 1)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{openPlayer}},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			membershipRepository := repositoryPostgres.NewMembershipRepository(client)
+
+			inEffectAt, ok := scenario.InputData["date"].(time.Time)
+			require.True(t, ok)
+			expectedMemberships, ok := scenario.OutputData["expectedMemberships"].([]string)
+			require.True(t, ok)
+
+			memberships, err := membershipRepository.GetMembershipsByTeamSlugAt(testContext, teamSlug, inEffectAt)
+			require.NoError(t, err)
+			require.Equal(t, expectedMemberships, membershipKeys(memberships))
+		},
+	)
+}
+
+// getPersonMembershipPeriodsFixtureQueries fills the database with a person who played for the default team during
+// 2024 and for another team since 2025-01-01, and who coached the default team from 2024-06-01 to 2025-06-30.
+func getPersonMembershipPeriodsFixtureQueries() []fixture.Query {
+	team := fixture.GetDefaultFixtureTeam()
+	anotherTeam := fixture.GetAnotherFixtureTeam()
+	person := fixture.GetFakePerson("moving.player")
+
+	return fixture.MergeQueries(
+		fixture.GenerateTeamQueries(team, anotherTeam),
+		fixture.GeneratePersonQueries(person),
+		fixture.GenerateMembershipQueries(
+			fixture.GetFakeMembership(team, person).
+				WithStartDate(membershipTestDate(2024, time.January, 1)).
+				WithEndDate(membershipTestDate(2024, time.December, 31)),
+			fixture.GetFakeMembership(team, person).
+				WithRole(entity.MembershipRoles.Coach).
+				WithStartDate(membershipTestDate(2024, time.June, 1)).
+				WithEndDate(membershipTestDate(2025, time.June, 30)),
+			fixture.GetFakeMembership(anotherTeam, person).WithStartDate(membershipTestDate(2025, time.January, 1)),
+		),
+	)
+}
+
+func TestMembershipRepository_GetMembershipsByPersonUserNameAt(t *testing.T) {
+	t.Parallel()
+
+	teamSlug := fixture.GetDefaultFixtureTeam().Slug
+	anotherTeamSlug := fixture.GetAnotherFixtureTeam().Slug
+	player := teamSlug + "/moving.player/" + entity.MembershipRoles.Player
+	coach := teamSlug + "/moving.player/" + entity.MembershipRoles.Coach
+	anotherTeamPlayer := anotherTeamSlug + "/moving.player/" + entity.MembershipRoles.Player
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should return no membership on the day before the first one starts",
+			FixtureQueries: getPersonMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2023, time.December, 31)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{}},
+		},
+		{
+			Description:    "should return a membership on its start date",
+			FixtureQueries: getPersonMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2024, time.January, 1)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{player}},
+		},
+		{
+			Description:    "should return a membership on its end date along with the overlapping ones",
+			FixtureQueries: getPersonMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2024, time.December, 31)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{player, coach}},
+		},
+		{
+			Description:    "should leave a membership out on the day after its end date",
+			FixtureQueries: getPersonMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2025, time.January, 1)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{coach, anotherTeamPlayer}},
+		},
+		{
+			Description:    "should return only the open-ended membership once every other one has ended",
+			FixtureQueries: getPersonMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2025, time.July, 1)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{anotherTeamPlayer}},
+		},
+		{
+			Description:    "should return an open-ended membership long after it started",
+			FixtureQueries: getPersonMembershipPeriodsFixtureQueries(),
+			InputData:      map[string]interface{}{"date": membershipTestDate(2030, time.January, 1)},
+			OutputData:     map[string]interface{}{"expectedMemberships": []string{anotherTeamPlayer}},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			membershipRepository := repositoryPostgres.NewMembershipRepository(client)
+
+			inEffectAt, ok := scenario.InputData["date"].(time.Time)
+			require.True(t, ok)
+			expectedMemberships, ok := scenario.OutputData["expectedMemberships"].([]string)
+			require.True(t, ok)
+
+			memberships, err := membershipRepository.GetMembershipsByPersonUserNameAt(testContext, "moving.player", inEffectAt)
+			require.NoError(t, err)
+			require.Equal(t, expectedMemberships, membershipKeys(memberships))
+		},
+	)
+}
+
+func TestMembershipRepository_CreateMembership(t *testing.T) {
+	t.Parallel()
+
+	getFixtureQueries := func() []fixture.Query {
+		team := fixture.GetDefaultFixtureTeam()
+		player := fixture.GetFakePerson("playing.member")
+
+		return fixture.MergeQueries(
+			fixture.GenerateTeamQueries(team, fixture.GetAnotherFixtureTeam()),
+			fixture.GeneratePersonQueries(player),
+			fixture.GenerateMembershipQueries(fixture.GetFakeMembership(team, player)),
+		)
+	}
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should save another playing role in the same team over an ongoing playing membership",
+			FixtureQueries: getFixtureQueries(),
+			InputData: map[string]interface{}{
+				"team": fixture.GetDefaultFixtureTeam(),
+				"role": entity.MembershipRoles.Captain,
+			},
+			OutputData: map[string]interface{}{"expectedErr": nil},
+		},
+		{
+			Description:    "should not save a playing membership in another team over an ongoing playing membership",
+			FixtureQueries: getFixtureQueries(),
+			InputData: map[string]interface{}{
+				"team": fixture.GetAnotherFixtureTeam(),
+				"role": entity.MembershipRoles.Player,
+			},
+			OutputData: map[string]interface{}{"expectedErr": repositoryPort.ErrOverlappingPeriod},
+		},
+		{
+			Description:    "should save a staff membership in another team over an ongoing playing membership",
+			FixtureQueries: getFixtureQueries(),
+			InputData: map[string]interface{}{
+				"team": fixture.GetAnotherFixtureTeam(),
+				"role": entity.MembershipRoles.Coach,
+			},
+			OutputData: map[string]interface{}{"expectedErr": nil},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			membershipRepository := repositoryPostgres.NewMembershipRepository(client)
+
+			team, ok := scenario.InputData["team"].(*entity.Team)
+			require.True(t, ok)
+			role, ok := scenario.InputData["role"].(string)
+			require.True(t, ok)
+			expectedErr, _ := scenario.OutputData["expectedErr"].(error)
+
+			membership, err := membershipRepository.CreateMembership(testContext, &entity.Membership{
+				Team:      team,
+				Person:    &entity.Person{UserName: "playing.member"},
+				Role:      role,
+				StartDate: membershipTestDate(2025, time.March, 1),
+			})
+			if expectedErr != nil {
+				require.ErrorIs(t, err, expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, role, membership.Role)
+		},
+	)
+}
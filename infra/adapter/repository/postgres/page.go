@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// pagedListing describes a table that is listed a page at a time.
+type pagedListing struct {
+	table string
+	// keyColumn must be unique so that the order is stable across pages
+	keyColumn string
+	// columns maps the criteria attributes of the listed entity to the expressions of their columns, which never
+	// evaluate to null so that the pages can follow them. Only these expressions reach the query, never the criteria.
+	columns map[string]string
+}
+
+// filterOperators maps the operators of the filters to the ones of SQL, except for Contains, which is an ilike.
+var filterOperators = map[entity.FilterOperator]string{
+	entity.FilterOperators.Equal:              "=",
+	entity.FilterOperators.NotEqual:           "<>",
+	entity.FilterOperators.GreaterThan:        ">",
+	entity.FilterOperators.GreaterThanOrEqual: ">=",
+	entity.FilterOperators.LessThan:           "<",
+	entity.FilterOperators.LessThanOrEqual:    "<=",
+}
+
+// likePattern escapes the wildcards of ilike in the value.
+var likePattern = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// pagedQuery completes a listing query, which selects from the table of the listing, with its conditions, the
+// criteria and the requested page. The page follows the entity whose key is After in the order of the criteria, whose
+// values are taken from the table, so an entity deleted in the meantime leaves an empty page. The returned params are
+// the ones of the conditions along with the ones of the criteria and the page.
+func pagedQuery(
+	listing pagedListing,
+	query string,
+	conditions []string,
+	params []interface{},
+	criteria entity.Criteria,
+	page entity.PageRequest,
+) (string, []interface{}, error) {
+	for _, filter := range criteria.Filters {
+		column, ok := listing.columns[filter.Attribute]
+		if !ok {
+			return "", nil, fmt.Errorf("cannot filter %s by '%s'", listing.table, filter.Attribute)
+		}
+
+		if filter.Operator == entity.FilterOperators.Contains {
+			conditions = append(conditions, column+" ilike ?")
+			params = append(params, "%"+likePattern.Replace(filter.Value)+"%")
+
+			continue
+		}
+
+		operator, ok := filterOperators[filter.Operator]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown filter operator '%s'", filter.Operator)
+		}
+		conditions = append(conditions, column+" "+operator+" ?")
+		params = append(params, filter.Value)
+	}
+
+	orderColumns := make([]string, 0, len(criteria.Sorts)+1)
+	orderDirections := make([]string, 0, len(criteria.Sorts)+1)
+	for _, sort := range criteria.Sorts {
+		column, ok := listing.columns[sort.Attribute]
+		if !ok {
+			return "", nil, fmt.Errorf("cannot sort %s by '%s'", listing.table, sort.Attribute)
+		}
+
+		direction := "asc"
+		if sort.Descending {
+			direction = "desc"
+		}
+		orderColumns = append(orderColumns, column)
+		orderDirections = append(orderDirections, direction)
+	}
+	orderColumns = append(orderColumns, listing.keyColumn)
+	orderDirections = append(orderDirections, "asc")
+
+	if page.After != "" {
+		cursorColumns := make([]string, 0, len(orderColumns))
+		for index, column := range orderColumns {
+			cursorColumns = append(cursorColumns, column+" as "+cursorColumn(index))
+		}
+
+		// The values of the entity that the page follows are joined to every row
+		query += `,
+              (
+                select
+                  ` + strings.Join(cursorColumns, `,
+                  `) + `
+                from
+                  ` + listing.table + `
+                where
+                  ` + listing.keyColumn + ` = ?
+              ) page_cursor`
+		params = append([]interface{}{page.After}, params...)
+
+		conditions = append(conditions, afterCursorCondition(orderColumns, orderDirections))
+	}
+
+	if len(conditions) > 0 {
+		query += `
+            where
+              ` + strings.Join(conditions, `
+              and `)
+	}
+
+	orderBy := make([]string, 0, len(orderColumns))
+	for index, column := range orderColumns {
+		orderBy = append(orderBy, column+" "+orderDirections[index])
+	}
+	query += `
+            order by
+              ` + strings.Join(orderBy, ", ")
+
+	if !page.IsUnbounded() {
+		query += `
+            limit ?`
+		params = append(params, page.Limit)
+	}
+
+	return query, params, nil
+}
+
+// afterCursorCondition keeps the rows that come after the page cursor in the order of the columns, which is the case
+// when the first column that differs from the cursor comes after it in its direction.
+func afterCursorCondition(orderColumns []string, orderDirections []string) string {
+	alternatives := make([]string, 0, len(orderColumns))
+	for index, column := range orderColumns {
+		comparisons := make([]string, 0, index+1)
+		for previousIndex, previousColumn := range orderColumns[:index] {
+			comparisons = append(comparisons, previousColumn+" = page_cursor."+cursorColumn(previousIndex))
+		}
+
+		operator := ">"
+		if orderDirections[index] == "desc" {
+			operator = "<"
+		}
+		comparisons = append(comparisons, column+" "+operator+" page_cursor."+cursorColumn(index))
+
+		alternatives = append(alternatives, "("+strings.Join(comparisons, " and ")+")")
+	}
+
+	return "(" + strings.Join(alternatives, " or ") + ")"
+}
+
+func cursorColumn(index int) string {
+	return "cursor_" + strconv.Itoa(index)
+}
@@ -2,7 +2,9 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
@@ -19,18 +21,48 @@ type PersonRepository struct {
 
 // person is a representation on how the person is retrieved from the database.
 type person struct {
-	Username      string `pg:"username"`
-	ID            string `pg:"id"` // TODO: remove me
-	Name          string `pg:"name"`
-	Email         string `pg:"email"`
-	PhoneNumber   string `pg:"phone_number"`
-	WFDFNumber    string `pg:"wfdf_number"`
-	OriginCountry string `pg:"origin_country"`
+	Username      string    `pg:"username"`
+	ID            string    `pg:"id"` // TODO: remove me
+	Name          string    `pg:"name"`
+	Email         string    `pg:"email"`
+	PhoneNumber   string    `pg:"phone_number"`
+	WFDFNumber    string    `pg:"wfdf_number"`
+	OriginCountry string    `pg:"origin_country"`
+	BirthDate     time.Time `pg:"birth_date"`
+
+	EmailVisibility       string `pg:"email_visibility"`
+	PhoneNumberVisibility string `pg:"phone_number_visibility"`
+
+	AvatarKey          string `pg:"avatar_key"`
+	AvatarThumbnailKey string `pg:"avatar_thumbnail_key"`
 
 	CreatedAt time.Time `pg:"created_at"`
 	CreatedBy string    `pg:"created_by"`
 	UpdatedAt time.Time `pg:"updated_at"`
 	UpdatedBy string    `pg:"updated_by"`
+	DeletedAt time.Time `pg:"deleted_at"`
+	DeletedBy string    `pg:"deleted_by"`
+}
+
+// searchedPerson is a representation on how a person found by a search is retrieved from the database.
+type searchedPerson struct {
+	person
+	Rank float64 `pg:"rank"`
+}
+
+// peopleListing lists the people by username, filtered and sorted by the PersonCriteriaAttributes.
+var peopleListing = pagedListing{
+	table:     "people",
+	keyColumn: "username",
+	columns: map[string]string{
+		string(entity.PersonAttributes.UserName):      "username",
+		string(entity.PersonAttributes.Name):          "name",
+		string(entity.PersonAttributes.OriginCountry): "coalesce(origin_country, '')",
+		string(entity.PersonAttributes.CreatedAt):     "created_at",
+		string(entity.PersonAttributes.CreatedBy):     "coalesce(created_by, '')",
+		string(entity.PersonAttributes.UpdatedAt):     "updated_at",
+		string(entity.PersonAttributes.UpdatedBy):     "coalesce(updated_by, '')",
+	},
 }
 
 // NewPersonRepository instantiates a new person repository for postgres.
@@ -40,7 +72,12 @@ func NewPersonRepository(client postgresDatabase.Client) *PersonRepository {
 	}
 }
 
-func (repository *PersonRepository) GetAllPeople(context context.Context) ([]*entity.Person, error) {
+func (repository *PersonRepository) GetAllPeople(
+	context context.Context,
+	includeArchived bool,
+	criteria entity.Criteria,
+	page entity.PageRequest,
+) ([]*entity.Person, error) {
 	query := `select
               username,
 			  name,
@@ -48,17 +85,32 @@ func (repository *PersonRepository) GetAllPeople(context context.Context) ([]*en
 			  phone_number,
 			  wfdf_number,
 			  origin_country,
+			  birth_date,
+			  email_visibility,
+			  phone_number_visibility,
+			  avatar_key,
+			  avatar_thumbnail_key,
 
 			  created_by,
               created_at,
               updated_at,
-              updated_by
+              updated_by,
+              deleted_at,
+              deleted_by
             from
               people`
+	conditions := []string{}
+	if !includeArchived {
+		conditions = append(conditions, "deleted_at is null")
+	}
+	query, params, err := pagedQuery(peopleListing, query, conditions, []interface{}{}, criteria, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve all people: %w", err)
+	}
 
 	// Execute query in DB
 	var fetchedPeople []person
-	queryResult, err := repository.client.ExecuteQuery(context, &fetchedPeople, query)
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedPeople, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve all people: %w", err)
 	}
@@ -79,11 +131,18 @@ func (repository *PersonRepository) GetPersonByUserName(context context.Context,
 			  phone_number,
 			  wfdf_number,
 			  origin_country,
+			  birth_date,
+			  email_visibility,
+			  phone_number_visibility,
+			  avatar_key,
+			  avatar_thumbnail_key,
 
 			  created_by,
 			  created_at,
 			  updated_at,
-			  updated_by
+			  updated_by,
+			  deleted_at,
+			  deleted_by
 			from
 			  people
 			where
@@ -111,14 +170,22 @@ func (repository *PersonRepository) CreatePerson(context context.Context, person
 			  phone_number,
 			  wfdf_number,
 			  origin_country,
+			  birth_date,
 
 			  created_by,
 			  created_at,
 			  updated_at,
 			  updated_by
-			) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+			) select ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			where not exists (select 1 from person_aliases where alias_username = ?)`
+
+	// A person whose birth date is unknown has none saved
+	var birthDate interface{}
+	if !personEntity.BirthDate.IsZero() {
+		birthDate = personEntity.BirthDate
+	}
 
-	_, err := repository.client.ExecuteCommand(
+	result, err := repository.client.ExecuteCommand(
 		context,
 		query,
 
@@ -128,19 +195,740 @@ func (repository *PersonRepository) CreatePerson(context context.Context, person
 		personEntity.PhoneNumber,
 		personEntity.WFDFNumber,
 		personEntity.OriginCountry,
+		birthDate,
 
 		personEntity.CreatedBy,
 		personEntity.CreatedAt,
 		personEntity.UpdatedAt,
 		personEntity.UpdatedBy,
+
+		personEntity.UserName,
 	)
 	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
 		return nil, fmt.Errorf("failed to create person: %w", err)
 	}
 
+	// The username still addresses a person that absorbed it in a merge
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("%w: username %s is an alias", repositoryPort.ErrAlreadyExists, personEntity.UserName)
+	}
+
 	return personEntity, nil
 }
 
+func (repository *PersonRepository) ArchivePerson(context context.Context, personEntity *entity.Person) (*entity.Person, error) {
+	query := `update people set
+	 deleted_at = now(),
+	 deleted_by = ?
+   where
+	 username = ? and deleted_at is null
+   returning
+	 ` + personReturningColumns
+
+	var archived person
+	queryResult, err := repository.client.ExecuteQuery(context, &archived, query, personEntity.DeletedBy, personEntity.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive person %s: %w", personEntity.UserName, err)
+	}
+
+	// Query executed successfully but the person does not exist or was already archived
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return personToPersonEntity(archived), nil
+}
+
+func (repository *PersonRepository) RestorePerson(context context.Context, personEntity *entity.Person) (*entity.Person, error) {
+	query := `update people set
+	 deleted_at = null,
+	 deleted_by = null,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 username = ? and deleted_at is not null
+   returning
+	 ` + personReturningColumns
+
+	var restored person
+	queryResult, err := repository.client.ExecuteQuery(context, &restored, query, personEntity.UpdatedBy, personEntity.UserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore person %s: %w", personEntity.UserName, err)
+	}
+
+	// Query executed successfully but the person does not exist or is not archived
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return personToPersonEntity(restored), nil
+}
+
+func (repository *PersonRepository) GetPeopleArchivedBefore(context context.Context, date time.Time) ([]*entity.Person, error) {
+	query := `select
+	 ` + personReturningColumns + `
+   from
+	 people
+   where
+	 deleted_at < ?
+   order by
+	 deleted_at`
+
+	var fetchedPeople []person
+	_, err := repository.client.ExecuteQuery(context, &fetchedPeople, query, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve archived people: %w", err)
+	}
+
+	return peopleToPersonEntities(fetchedPeople), nil
+}
+
+func (repository *PersonRepository) DeletePerson(context context.Context, userName string) error {
+	_, err := repository.client.ExecuteCommand(context, `delete from people where username = ?`, userName)
+	if err != nil {
+		if strings.Contains(err.Error(), "violates foreign key constraint") {
+			return fmt.Errorf("%w: %v", repositoryPort.ErrStillReferenced, err)
+		}
+
+		return fmt.Errorf("failed to delete person %s: %w", userName, err)
+	}
+
+	return nil
+}
+
+func (repository *PersonRepository) GetPersonByAlias(context context.Context, alias string) (*entity.Person, error) {
+	query := `select
+	 ` + personReturningColumns + `
+   from
+	 people
+   where
+	 username = (select person_username from person_aliases where alias_username = ?)`
+
+	var fetchedPerson person
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedPerson, query, alias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve person by alias %s: %w", alias, err)
+	}
+
+	// Query executed successfully but the username is not an alias
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return personToPersonEntity(fetchedPerson), nil
+}
+
+func (repository *PersonRepository) UpdatePersonBirthDate(context context.Context, personEntity *entity.Person) (*entity.Person, error) {
+	query := `update people set
+	 birth_date = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 username = ?
+   returning
+	 ` + personReturningColumns
+
+	var updated person
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		personEntity.BirthDate,
+		personEntity.UpdatedBy,
+		personEntity.UserName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update birth date of person %s: %w", personEntity.UserName, err)
+	}
+
+	// Query executed successfully but the person does not exist
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return personToPersonEntity(updated), nil
+}
+
+func (repository *PersonRepository) UpdatePersonVisibility(context context.Context, personEntity *entity.Person) (*entity.Person, error) {
+	query := `update people set
+	 email_visibility = ?,
+	 phone_number_visibility = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 username = ?
+   returning
+	 ` + personReturningColumns
+
+	var updated person
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		nullableAudience(personEntity.EmailVisibility),
+		nullableAudience(personEntity.PhoneNumberVisibility),
+		personEntity.UpdatedBy,
+		personEntity.UserName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update visibility of person %s: %w", personEntity.UserName, err)
+	}
+
+	// Query executed successfully but the person does not exist
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return personToPersonEntity(updated), nil
+}
+
+func (repository *PersonRepository) UpdatePersonAvatar(context context.Context, personEntity *entity.Person) (*entity.Person, error) {
+	query := `update people set
+	 avatar_key = ?,
+	 avatar_thumbnail_key = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 username = ?
+   returning
+	 ` + personReturningColumns
+
+	var updated person
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		nullableString(personEntity.AvatarKey),
+		nullableString(personEntity.AvatarThumbnailKey),
+		personEntity.UpdatedBy,
+		personEntity.UserName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update avatar of person %s: %w", personEntity.UserName, err)
+	}
+
+	// Query executed successfully but the person does not exist
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return personToPersonEntity(updated), nil
+}
+
+// nullableAudience saves an empty visibility as null, which keeps the default audience.
+func nullableAudience(audience entity.Audience) interface{} {
+	if audience == "" {
+		return nil
+	}
+
+	return string(audience)
+}
+
+// personReference is a column that references a person. Rows that only differ by the person are the same record, so
+// the key columns tell which records of a duplicate the survivor of a merge already has. The records that would still
+// identify a person, or let them act for someone else, are deleted when their personal data is erased.
+type personReference struct {
+	table            string
+	column           string
+	keyColumns       []string
+	deletedOnErasure bool
+}
+
+// personReferences lists every column that references a person, in the order that they are moved by a merge.
+var personReferences = []personReference{
+	{table: "memberships", column: "person_username", keyColumns: []string{"team_slug", "role", "start_date"}},
+	{table: "ledger_entries", column: "person_username"},
+	{table: "team_event_participations", column: "person_username", keyColumns: []string{"event_id"}},
+	{table: "tryout_candidates", column: "person_username", keyColumns: []string{"tryout_id"}},
+	{table: "tryout_evaluations", column: "evaluator_username", keyColumns: []string{"tryout_id", "candidate_username", "criterion"}},
+	{table: "person_legal_entity_affiliations", column: "person_username", keyColumns: []string{"legal_entity_slug", "start_date"}},
+	{table: "wfdf_accreditations", column: "person_username", keyColumns: []string{"level", "issue_date"}},
+	{table: "federation_memberships", column: "person_username", keyColumns: []string{"federation_slug", "season"}},
+	{table: "coach_certifications", column: "person_username", keyColumns: []string{"name", "issue_date"}},
+	{table: "person_aliases", column: "person_username", deletedOnErasure: true},
+	{table: "guardianships", column: "guardian_username", keyColumns: []string{"dependent_username"}, deletedOnErasure: true},
+	{table: "guardianships", column: "dependent_username", keyColumns: []string{"guardian_username"}, deletedOnErasure: true},
+	{table: "consents", column: "person_username", keyColumns: []string{"kind", "grant_date"}, deletedOnErasure: true},
+	{table: "consents", column: "granted_by"},
+}
+
+func (repository *PersonRepository) MergePeople(
+	ctx context.Context,
+	survivor *entity.Person,
+	duplicate *entity.Person,
+) (*entity.Person, error) {
+	aliasQuery := `insert into person_aliases (
+	 alias_username,
+	 person_username,
+	 created_by
+   ) values (?, ?, ?)`
+	survivorQuery := `update people set
+	 phone_number = ?,
+	 wfdf_number = ?,
+	 origin_country = ?,
+	 birth_date = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 username = ?
+   returning
+	 ` + personReturningColumns
+
+	var birthDate interface{}
+	if !survivor.BirthDate.IsZero() {
+		birthDate = survivor.BirthDate
+	}
+
+	// A merge that stops halfway would split the history of the person between both usernames
+	var merged person
+	err := runInTransaction(ctx, repository.client, func(transactionContext context.Context) error {
+		err := repository.resolveOverlappingPlayingMemberships(transactionContext, duplicate.UserName, survivor.UserName)
+		if err != nil {
+			return err
+		}
+
+		for _, reference := range personReferences {
+			err := repository.moveReferences(transactionContext, reference, duplicate.UserName, survivor.UserName)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = repository.client.ExecuteCommand(transactionContext, aliasQuery, duplicate.UserName, survivor.UserName, survivor.UpdatedBy)
+		if err != nil {
+			return fmt.Errorf("failed to leave %s as an alias of %s: %w", duplicate.UserName, survivor.UserName, err)
+		}
+
+		// The duplicate is deleted before the survivor takes its contact details, which are unique
+		_, err = repository.client.ExecuteCommand(transactionContext, `delete from people where username = ?`, duplicate.UserName)
+		if err != nil {
+			return fmt.Errorf("failed to delete person %s: %w", duplicate.UserName, err)
+		}
+
+		_, err = repository.client.ExecuteQuery(
+			transactionContext,
+			&merged,
+			survivorQuery,
+			survivor.PhoneNumber,
+			survivor.WFDFNumber,
+			survivor.OriginCountry,
+			birthDate,
+			survivor.UpdatedBy,
+			survivor.UserName,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update person %s: %w", survivor.UserName, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return personToPersonEntity(merged), nil
+}
+
+// overlappingPlayingMemberships identifies a playing membership of one person in a team along with the playing
+// membership of the other person in another team that starts on or after it while the first one is ongoing.
+type overlappingPlayingMemberships struct {
+	EarlierTeamSlug       string    `pg:"earlier_team_slug"`
+	EarlierPersonUserName string    `pg:"earlier_person_username"`
+	EarlierRole           string    `pg:"earlier_role"`
+	EarlierStartDate      time.Time `pg:"earlier_start_date"`
+	LaterTeamSlug         string    `pg:"later_team_slug"`
+	LaterPersonUserName   string    `pg:"later_person_username"`
+	LaterRole             string    `pg:"later_role"`
+	LaterStartDate        time.Time `pg:"later_start_date"`
+}
+
+// resolveOverlappingPlayingMemberships makes the playing memberships of both people fit a single person, who may only
+// play for one team at a time, before the ones of the duplicate are moved. Of two memberships in different teams that
+// overlap, the earlier one ends the day before the later one starts, as a transfer would have done. When both start
+// on the same day, the one of the duplicate is dropped in favor of the one of the survivor.
+func (repository *PersonRepository) resolveOverlappingPlayingMemberships(
+	context context.Context,
+	duplicateUserName string,
+	survivorUserName string,
+) error {
+	query := `select
+              earlier.team_slug as earlier_team_slug,
+              earlier.person_username as earlier_person_username,
+              earlier.role as earlier_role,
+              earlier.start_date as earlier_start_date,
+              later.team_slug as later_team_slug,
+              later.person_username as later_person_username,
+              later.role as later_role,
+              later.start_date as later_start_date
+            from
+              memberships earlier
+              join memberships later on later.person_username <> earlier.person_username
+                and later.team_slug <> earlier.team_slug
+                and later.start_date >= earlier.start_date
+                and later.role in (?)
+                and daterange(later.start_date, later.end_date, '[]') && daterange(earlier.start_date, earlier.end_date, '[]')
+            where
+              earlier.person_username in (?)
+              and later.person_username in (?)
+              and earlier.role in (?)
+            order by
+              earlier.start_date,
+              later.start_date
+            limit 1`
+	endQuery := `update memberships set
+	 end_date = ?,
+	 updated_at = now()
+   where
+	 team_slug = ? and person_username = ? and role = ? and start_date = ?`
+	deleteQuery := `delete from memberships where team_slug = ? and person_username = ? and role = ? and start_date = ?`
+
+	userNames := []string{duplicateUserName, survivorUserName}
+	playingRoles := []string{entity.MembershipRoles.Player, entity.MembershipRoles.GameCaptain, entity.MembershipRoles.Captain}
+	for {
+		var fetchedOverlaps []overlappingPlayingMemberships
+		_, err := repository.client.ExecuteQuery(context, &fetchedOverlaps, query, playingRoles, userNames, userNames, playingRoles)
+		if err != nil {
+			return fmt.Errorf("failed to find overlapping playing memberships of %s and %s: %w", duplicateUserName, survivorUserName, err)
+		}
+		if len(fetchedOverlaps) == 0 {
+			return nil
+		}
+		overlap := fetchedOverlaps[0]
+
+		if overlap.LaterStartDate.Equal(overlap.EarlierStartDate) {
+			teamSlug, role := overlap.EarlierTeamSlug, overlap.EarlierRole
+			if overlap.LaterPersonUserName == duplicateUserName {
+				teamSlug, role = overlap.LaterTeamSlug, overlap.LaterRole
+			}
+			_, err = repository.client.ExecuteCommand(context, deleteQuery, teamSlug, duplicateUserName, role, overlap.EarlierStartDate)
+			if err != nil {
+				return fmt.Errorf("failed to drop membership of %s in team %s: %w", duplicateUserName, teamSlug, err)
+			}
+
+			continue
+		}
+
+		_, err = repository.client.ExecuteCommand(
+			context,
+			endQuery,
+			overlap.LaterStartDate.AddDate(0, 0, -1),
+			overlap.EarlierTeamSlug,
+			overlap.EarlierPersonUserName,
+			overlap.EarlierRole,
+			overlap.EarlierStartDate,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to end membership of %s in team %s: %w", overlap.EarlierPersonUserName, overlap.EarlierTeamSlug, err,
+			)
+		}
+	}
+}
+
+// moveReferences points the references to the duplicate at the survivor. The records that the survivor already has
+// are deleted from the duplicate instead, since they would be repeated.
+func (repository *PersonRepository) moveReferences(
+	context context.Context,
+	reference personReference,
+	duplicateUserName string,
+	survivorUserName string,
+) error {
+	updateQuery := "update " + reference.table + " set " + reference.column + " = ? where " + reference.column + " = ?"
+	params := []interface{}{survivorUserName, duplicateUserName}
+	if len(reference.keyColumns) > 0 {
+		conditions := []string{"existing." + reference.column + " = ?"}
+		for _, keyColumn := range reference.keyColumns {
+			conditions = append(conditions, "existing."+keyColumn+" = "+reference.table+"."+keyColumn)
+		}
+		updateQuery += " and not exists (select 1 from " + reference.table + " existing where " + stringJoin(conditions, " and ") + ")"
+		params = append(params, survivorUserName)
+	}
+
+	_, err := repository.client.ExecuteCommand(context, updateQuery, params...)
+	if err != nil {
+		return fmt.Errorf("failed to move %s of %s to %s: %w", reference.table, duplicateUserName, survivorUserName, err)
+	}
+
+	if len(reference.keyColumns) > 0 {
+		deleteQuery := "delete from " + reference.table + " where " + reference.column + " = ?"
+		_, err = repository.client.ExecuteCommand(context, deleteQuery, duplicateUserName)
+		if err != nil {
+			return fmt.Errorf("failed to delete repeated %s of %s: %w", reference.table, duplicateUserName, err)
+		}
+	}
+
+	return nil
+}
+
+// personActorColumn is an audit column that keeps who did something to a record, along with the column that keeps
+// when it was done. The actors are not foreign keys, so they are not moved along with the personReferences.
+type personActorColumn struct {
+	table      string
+	column     string
+	timeColumn string
+	action     entity.AuditAction
+}
+
+// personActorColumns lists every audit column that may hold the username of a person.
+var personActorColumns = []personActorColumn{
+	{table: "teams", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "teams", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "teams", column: "deleted_by", timeColumn: "deleted_at", action: entity.AuditActions.Archived},
+	{table: "people", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "people", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "people", column: "deleted_by", timeColumn: "deleted_at", action: entity.AuditActions.Archived},
+	{table: "memberships", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "memberships", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "ledger_transactions", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "team_events", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "team_events", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "team_event_participations", column: "attendance_recorded_by", timeColumn: "attendance_recorded_at", action: entity.AuditActions.AttendanceRecorded},
+	{table: "tryouts", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "tryouts", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "tryout_candidates", column: "decided_by", timeColumn: "decided_at", action: entity.AuditActions.Decided},
+	{table: "legal_entities", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "legal_entities", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "team_legal_entity_affiliations", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "team_legal_entity_affiliations", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "person_legal_entity_affiliations", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "person_legal_entity_affiliations", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "wfdf_accreditations", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "wfdf_accreditations", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "federation_memberships", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "federation_memberships", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "coach_certifications", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "coach_certifications", column: "updated_by", timeColumn: "updated_at", action: entity.AuditActions.Updated},
+	{table: "team_names", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "person_aliases", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "guardianships", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+	{table: "consents", column: "created_by", timeColumn: "created_at", action: entity.AuditActions.Created},
+}
+
+// personalDataRows is a representation on how the rows of a table are retrieved as a JSON array.
+type personalDataRows struct {
+	Data string `pg:"data"`
+}
+
+// auditEntry is a representation on how an audit entry is retrieved from the database.
+type auditEntry struct {
+	TableName  string    `pg:"table_name"`
+	Action     string    `pg:"action"`
+	HappenedAt time.Time `pg:"happened_at"`
+}
+
+func (repository *PersonRepository) GetPersonalData(context context.Context, personEntity *entity.Person) (*entity.PersonalData, error) {
+	// The evaluations of a candidate follow their candidacy through a cascade, so merges do not need to move them
+	references := append([]personReference{}, personReferences...)
+	references = append(references, personReference{table: "tryout_evaluations", column: "candidate_username"})
+
+	records := make([]*entity.PersonalDataRecord, 0, len(references))
+	for _, reference := range references {
+		query := "select coalesce(json_agg(record), '[]') as data from " + reference.table + " record where " + reference.column + " = ?"
+
+		var fetchedRows personalDataRows
+		_, err := repository.client.ExecuteQuery(context, &fetchedRows, query, personEntity.UserName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve %s of person %s: %w", reference.table, personEntity.UserName, err)
+		}
+
+		rows := []map[string]interface{}{}
+		err = json.Unmarshal([]byte(fetchedRows.Data), &rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s of person %s: %w", reference.table, personEntity.UserName, err)
+		}
+
+		records = append(records, &entity.PersonalDataRecord{
+			Table:  reference.table,
+			Column: reference.column,
+			Rows:   rows,
+		})
+	}
+
+	auditQueries := make([]string, 0, len(personActorColumns))
+	auditParams := make([]interface{}, 0, len(personActorColumns))
+	for _, actorColumn := range personActorColumns {
+		auditQueries = append(auditQueries, "select '"+actorColumn.table+"' as table_name, '"+string(actorColumn.action)+"' as action, "+
+			actorColumn.timeColumn+" as happened_at from "+actorColumn.table+" where "+actorColumn.column+" = ?")
+		auditParams = append(auditParams, personEntity.UserName)
+	}
+	auditQuery := stringJoin(auditQueries, " union all ") + " order by happened_at, table_name"
+
+	var fetchedAuditEntries []auditEntry
+	_, err := repository.client.ExecuteQuery(context, &fetchedAuditEntries, auditQuery, auditParams...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve audit entries of person %s: %w", personEntity.UserName, err)
+	}
+
+	auditEntries := make([]*entity.AuditEntry, 0, len(fetchedAuditEntries))
+	for _, fetchedAuditEntry := range fetchedAuditEntries {
+		auditEntries = append(auditEntries, &entity.AuditEntry{
+			Table:  fetchedAuditEntry.TableName,
+			Action: entity.AuditAction(fetchedAuditEntry.Action),
+			At:     fetchedAuditEntry.HappenedAt,
+		})
+	}
+
+	return &entity.PersonalData{
+		Person:       personEntity,
+		Records:      records,
+		AuditEntries: auditEntries,
+	}, nil
+}
+
+func (repository *PersonRepository) ErasePerson(
+	ctx context.Context,
+	personEntity *entity.Person,
+	anonymized *entity.Person,
+) (*entity.Person, error) {
+	anonymizedQuery := `insert into people (
+	 username,
+	 name,
+	 email,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by,
+	 deleted_at,
+	 deleted_by
+   ) values (?, ?, ?, ?, ?, now(), ?, now(), ?)`
+
+	// An erasure that stops halfway would leave personal data behind while the history already points elsewhere
+	var erased person
+	err := runInTransaction(ctx, repository.client, func(transactionContext context.Context) error {
+		_, err := repository.client.ExecuteCommand(
+			transactionContext,
+			anonymizedQuery,
+			anonymized.UserName,
+			anonymized.Name,
+			anonymized.Email,
+			anonymized.CreatedAt,
+			anonymized.CreatedBy,
+			anonymized.UpdatedBy,
+			anonymized.DeletedBy,
+		)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key value") {
+				return fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+			}
+
+			return fmt.Errorf("failed to create anonymized person %s: %w", anonymized.UserName, err)
+		}
+
+		for _, reference := range personReferences {
+			query := "update " + reference.table + " set " + reference.column + " = ? where " + reference.column + " = ?"
+			params := []interface{}{anonymized.UserName, personEntity.UserName}
+			if reference.deletedOnErasure {
+				query = "delete from " + reference.table + " where " + reference.column + " = ?"
+				params = params[1:]
+			}
+
+			_, err = repository.client.ExecuteCommand(transactionContext, query, params...)
+			if err != nil {
+				return fmt.Errorf("failed to erase %s of person %s: %w", reference.table, personEntity.UserName, err)
+			}
+		}
+
+		for _, actorColumn := range personActorColumns {
+			query := "update " + actorColumn.table + " set " + actorColumn.column + " = ? where " + actorColumn.column + " = ?"
+			_, err = repository.client.ExecuteCommand(transactionContext, query, anonymized.UserName, personEntity.UserName)
+			if err != nil {
+				return fmt.Errorf("failed to erase %s.%s of person %s: %w", actorColumn.table, actorColumn.column, personEntity.UserName, err)
+			}
+		}
+
+		_, err = repository.client.ExecuteCommand(transactionContext, `delete from people where username = ?`, personEntity.UserName)
+		if err != nil {
+			return fmt.Errorf("failed to delete person %s: %w", personEntity.UserName, err)
+		}
+
+		// The anonymized person is fetched last, since it may have taken the place of the original one as an actor
+		_, err = repository.client.ExecuteQuery(
+			transactionContext,
+			&erased,
+			`select `+personReturningColumns+` from people where username = ?`,
+			anonymized.UserName,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve anonymized person %s: %w", anonymized.UserName, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return personToPersonEntity(erased), nil
+}
+
+func (repository *PersonRepository) SearchPeople(
+	context context.Context,
+	searchedText string,
+	limit int,
+) ([]*entity.SearchResult, error) {
+	document := searchDocument("name")
+	query := `select
+	 ` + personReturningColumns + `,
+	 ` + searchColumns(document, "name") + `
+   from
+	 people
+   where
+	 deleted_at is null
+	 and ` + searchCondition(document, "name") + `
+   order by
+	 rank desc,
+	 username
+   limit ?`
+
+	var fetchedPeople []searchedPerson
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&fetchedPeople,
+		query,
+		searchedText,
+		searchedText,
+		searchedText,
+		searchedText,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search people: %w", err)
+	}
+
+	results := make([]*entity.SearchResult, 0, len(fetchedPeople))
+	for _, fetchedPerson := range fetchedPeople {
+		results = append(results, &entity.SearchResult{
+			Person: personToPersonEntity(fetchedPerson.person),
+			Rank:   fetchedPerson.Rank,
+		})
+	}
+
+	return results, nil
+}
+
+// personReturningColumns lists the columns that are scanned into a person.
+const personReturningColumns = `username,
+	 name,
+	 email,
+	 phone_number,
+	 wfdf_number,
+	 origin_country,
+	 birth_date,
+	 email_visibility,
+	 phone_number_visibility,
+	 avatar_key,
+	 avatar_thumbnail_key,
+	 created_by,
+	 created_at,
+	 updated_at,
+	 updated_by,
+	 deleted_at,
+	 deleted_by`
+
 func personToPersonEntity(person person) *entity.Person {
 	// Rows are scanned directly into Go types by the DB client. createdAt/updatedAt
 	// are already time.Time so we can use them as-is.
@@ -151,11 +939,20 @@ func personToPersonEntity(person person) *entity.Person {
 		PhoneNumber:   person.PhoneNumber,
 		WFDFNumber:    person.WFDFNumber,
 		OriginCountry: person.OriginCountry,
+		BirthDate:     person.BirthDate,
+
+		EmailVisibility:       entity.Audience(person.EmailVisibility),
+		PhoneNumberVisibility: entity.Audience(person.PhoneNumberVisibility),
+
+		AvatarKey:          person.AvatarKey,
+		AvatarThumbnailKey: person.AvatarThumbnailKey,
 
 		CreatedAt: person.CreatedAt,
 		CreatedBy: person.CreatedBy,
 		UpdatedAt: person.UpdatedAt,
 		UpdatedBy: person.UpdatedBy,
+		DeletedAt: person.DeletedAt,
+		DeletedBy: person.DeletedBy,
 	}
 }
 
@@ -0,0 +1,137 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/repository/postgres"
+	databasePostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test/fixture"
+)
+
+// membershipPeriods tells each membership apart by its team and role along with its period, in the order they were
+// returned. Ongoing memberships have no end date.
+func membershipPeriods(memberships []entity.Membership) []string {
+	periods := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		period := membership.Team.Slug + "/" + membership.Role + "/" + membership.StartDate.Format(time.DateOnly) + ".."
+		if !membership.EndDate.IsZero() {
+			period += membership.EndDate.Format(time.DateOnly)
+		}
+		periods = append(periods, period)
+	}
+
+	return periods
+}
+
+func TestPersonRepository_MergePeople(t *testing.T) {
+	t.Parallel()
+
+	team := fixture.GetDefaultFixtureTeam()
+	anotherTeam := fixture.GetAnotherFixtureTeam()
+	survivor := fixture.GetFakePerson("merge.survivor")
+	duplicate := fixture.GetFakePerson("merge.duplicate")
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description: "should end the playing membership of the duplicate the day before the one of the survivor starts",
+			FixtureQueries: fixture.MergeQueries(
+				fixture.GenerateTeamQueries(team, anotherTeam),
+				fixture.GeneratePersonQueries(survivor, duplicate),
+				fixture.GenerateMembershipQueries(
+					fixture.GetFakeMembership(team, survivor).WithStartDate(membershipTestDate(2024, time.June, 1)),
+					fixture.GetFakeMembership(anotherTeam, duplicate).WithStartDate(membershipTestDate(2024, time.January, 1)),
+				),
+			),
+			OutputData: map[string]interface{}{
+				"expectedMemberships": []string{
+					anotherTeam.Slug + "/" + entity.MembershipRoles.Player + "/2024-01-01..2024-05-31",
+					team.Slug + "/" + entity.MembershipRoles.Player + "/2024-06-01..",
+				},
+			},
+		},
+		{
+			Description: "should end the playing membership of the survivor the day before the one of the duplicate starts",
+			FixtureQueries: fixture.MergeQueries(
+				fixture.GenerateTeamQueries(team, anotherTeam),
+				fixture.GeneratePersonQueries(survivor, duplicate),
+				fixture.GenerateMembershipQueries(
+					fixture.GetFakeMembership(team, survivor).WithStartDate(membershipTestDate(2024, time.January, 1)),
+					fixture.GetFakeMembership(anotherTeam, duplicate).
+						WithRole(entity.MembershipRoles.Captain).
+						WithStartDate(membershipTestDate(2024, time.June, 1)).
+						WithEndDate(membershipTestDate(2024, time.December, 31)),
+				),
+			),
+			OutputData: map[string]interface{}{
+				"expectedMemberships": []string{
+					team.Slug + "/" + entity.MembershipRoles.Player + "/2024-01-01..2024-05-31",
+					anotherTeam.Slug + "/" + entity.MembershipRoles.Captain + "/2024-06-01..2024-12-31",
+				},
+			},
+		},
+		{
+			Description: "should keep the playing membership of the survivor when both start on the same day",
+			FixtureQueries: fixture.MergeQueries(
+				fixture.GenerateTeamQueries(team, anotherTeam),
+				fixture.GeneratePersonQueries(survivor, duplicate),
+				fixture.GenerateMembershipQueries(
+					fixture.GetFakeMembership(team, survivor),
+					fixture.GetFakeMembership(anotherTeam, duplicate),
+				),
+			),
+			OutputData: map[string]interface{}{
+				"expectedMemberships": []string{
+					team.Slug + "/" + entity.MembershipRoles.Player + "/2024-01-01..",
+				},
+			},
+		},
+		{
+			Description: "should keep overlapping playing memberships of both people in the same team",
+			FixtureQueries: fixture.MergeQueries(
+				fixture.GenerateTeamQueries(team),
+				fixture.GeneratePersonQueries(survivor, duplicate),
+				fixture.GenerateMembershipQueries(
+					fixture.GetFakeMembership(team, survivor),
+					fixture.GetFakeMembership(team, duplicate).
+						WithRole(entity.MembershipRoles.Captain).
+						WithStartDate(membershipTestDate(2024, time.June, 1)),
+				),
+			),
+			OutputData: map[string]interface{}{
+				"expectedMemberships": []string{
+					team.Slug + "/" + entity.MembershipRoles.Player + "/2024-01-01..",
+					team.Slug + "/" + entity.MembershipRoles.Captain + "/2024-06-01..",
+				},
+			},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			personRepository := repositoryPostgres.NewPersonRepository(client)
+			membershipRepository := repositoryPostgres.NewMembershipRepository(client)
+
+			expectedMemberships, ok := scenario.OutputData["expectedMemberships"].([]string)
+			require.True(t, ok)
+
+			merged, err := personRepository.MergePeople(testContext, survivor.WithUpdatedBy("some.admin"), duplicate)
+			require.NoError(t, err)
+			require.Equal(t, survivor.UserName, merged.UserName)
+
+			memberships, err := membershipRepository.GetMembershipsByPersonUserName(testContext, survivor.UserName)
+			require.NoError(t, err)
+			require.Equal(t, expectedMemberships, membershipPeriods(memberships))
+		},
+	)
+}
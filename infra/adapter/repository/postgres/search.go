@@ -0,0 +1,33 @@
+package postgres
+
+// searchDocument is the full-text search document of the text expression, which ignores accents and casing. It must
+// match the expressions of the search indexes so that they are used.
+func searchDocument(text string) string {
+	return `to_tsvector('simple', immutable_unaccent(` + text + `))`
+}
+
+// searchTrigrams is the text expression without accents and casing, which must match the expressions of the trigram
+// indexes so that they are used.
+func searchTrigrams(text string) string {
+	return `immutable_unaccent(lower(` + text + `))`
+}
+
+// searchQuery is the full-text search query of the searched text.
+const searchQuery = `plainto_tsquery('simple', immutable_unaccent(?))`
+
+// searchColumns selects, as rank, how well the document and the name match the searched text, which is the greatest
+// of the full-text rank and of the similarity of the text to a part of the name, so names with typos rank as well.
+// It takes the searched text twice as params.
+func searchColumns(document string, nameColumn string) string {
+	return `greatest(
+	   ts_rank(` + document + `, ` + searchQuery + `),
+	   word_similarity(` + searchTrigrams("?") + `, ` + searchTrigrams(nameColumn) + `)
+	 ) as rank`
+}
+
+// searchCondition matches the rows whose document contains the words of the searched text or whose name has a part
+// similar enough to it. It takes the searched text twice as params.
+func searchCondition(document string, nameColumn string) string {
+	return `(` + document + ` @@ ` + searchQuery + `
+	   or ` + searchTrigrams("?") + ` <% ` + searchTrigrams(nameColumn) + `)`
+}
@@ -28,6 +28,43 @@ type team struct {
 	CreatedAt     time.Time `pg:"created_at"`
 	UpdatedBy     string    `pg:"updated_by"`
 	UpdatedAt     time.Time `pg:"updated_at"`
+	DeletedBy     string    `pg:"deleted_by"`
+	DeletedAt     time.Time `pg:"deleted_at"`
+
+	LogoKey          string `pg:"logo_key"`
+	LogoThumbnailKey string `pg:"logo_thumbnail_key"`
+}
+
+// searchedTeam is a representation on how a team found by a search is retrieved from the database.
+type searchedTeam struct {
+	team
+	Rank float64 `pg:"rank"`
+}
+
+// teamName is a representation on how a previous name of a team is retrieved from the database.
+type teamName struct {
+	TeamSlug       string    `pg:"team_slug"`
+	Name           string    `pg:"name"`
+	Slug           string    `pg:"slug"`
+	EffectiveFrom  time.Time `pg:"effective_from"`
+	EffectiveUntil time.Time `pg:"effective_until"`
+	CreatedAt      time.Time `pg:"created_at"`
+	CreatedBy      string    `pg:"created_by"`
+}
+
+// teamsListing lists the teams by slug, filtered and sorted by the TeamCriteriaAttributes.
+var teamsListing = pagedListing{
+	table:     "teams",
+	keyColumn: "slug",
+	columns: map[string]string{
+		string(entity.TeamAttributes.Slug):          "slug",
+		string(entity.TeamAttributes.Name):          "name",
+		string(entity.TeamAttributes.OriginCountry): "origin_country",
+		string(entity.TeamAttributes.CreatedAt):     "created_at",
+		string(entity.TeamAttributes.CreatedBy):     "coalesce(created_by, '')",
+		string(entity.TeamAttributes.UpdatedAt):     "updated_at",
+		string(entity.TeamAttributes.UpdatedBy):     "coalesce(updated_by, '')",
+	},
 }
 
 // NewTeamRepository instantiates a new team repository for postgres.
@@ -37,22 +74,39 @@ func NewTeamRepository(client postgresDatabase.Client) *TeamRepository {
 	}
 }
 
-func (repository *TeamRepository) GetAllTeams(context context.Context) ([]*entity.Team, error) {
+func (repository *TeamRepository) GetAllTeams(
+	context context.Context,
+	includeArchived bool,
+	criteria entity.Criteria,
+	page entity.PageRequest,
+) ([]*entity.Team, error) {
 	query := `select
               slug,
               name,
               description,
               origin_country,
+              logo_key,
+              logo_thumbnail_key,
               created_at,
               created_by,
               updated_at,
-              updated_by
+              updated_by,
+              deleted_at,
+              deleted_by
             from
               teams`
+	conditions := []string{}
+	if !includeArchived {
+		conditions = append(conditions, "deleted_at is null")
+	}
+	query, params, err := pagedQuery(teamsListing, query, conditions, []interface{}{}, criteria, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve all teams: %w", err)
+	}
 
 	// Execute query in DB
 	var fetchedTeams []team
-	queryResult, err := repository.client.ExecuteQuery(context, &fetchedTeams, query)
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedTeams, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve all teams: %w", err)
 	}
@@ -71,10 +125,14 @@ func (repository *TeamRepository) GetTeamByName(context context.Context, name st
               name,
               description,
               origin_country,
+              logo_key,
+              logo_thumbnail_key,
               created_at,
               created_by,
               updated_at,
-              updated_by
+              updated_by,
+              deleted_at,
+              deleted_by
             from
               teams
             where
@@ -123,10 +181,14 @@ func (repository *TeamRepository) CreateTeam(
 	 name,
 	 description,
 	 origin_country,
+	 logo_key,
+	 logo_thumbnail_key,
 	 created_at,
 	 created_by,
 	 updated_at,
-	 updated_by`
+	 updated_by,
+	 deleted_at,
+	 deleted_by`
 
 	var inserted team
 	queryResult, err := repository.client.ExecuteQuery(
@@ -193,6 +255,326 @@ func (repository *TeamRepository) UpdateTeam(
 	return repository.GetTeamByName(context, teamEntity.Name)
 }
 
+func (repository *TeamRepository) ArchiveTeam(context context.Context, teamEntity *entity.Team) (*entity.Team, error) {
+	query := `update teams set
+	 deleted_at = now(),
+	 deleted_by = ?
+   where
+	 slug = ? and deleted_at is null
+   returning
+	 ` + teamReturningColumns
+
+	var archived team
+	queryResult, err := repository.client.ExecuteQuery(context, &archived, query, teamEntity.DeletedBy, teamEntity.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive team %s: %w", teamEntity.Name, err)
+	}
+
+	// Query executed successfully but the team does not exist or was already archived
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return teamToTeamEntity(archived), nil
+}
+
+func (repository *TeamRepository) RestoreTeam(context context.Context, teamEntity *entity.Team) (*entity.Team, error) {
+	query := `update teams set
+	 deleted_at = null,
+	 deleted_by = null,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 slug = ? and deleted_at is not null
+   returning
+	 ` + teamReturningColumns
+
+	var restored team
+	queryResult, err := repository.client.ExecuteQuery(context, &restored, query, teamEntity.UpdatedBy, teamEntity.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore team %s: %w", teamEntity.Name, err)
+	}
+
+	// Query executed successfully but the team does not exist or is not archived
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return teamToTeamEntity(restored), nil
+}
+
+func (repository *TeamRepository) GetTeamsArchivedBefore(context context.Context, date time.Time) ([]*entity.Team, error) {
+	query := `select
+	 ` + teamReturningColumns + `
+   from
+	 teams
+   where
+	 deleted_at < ?
+   order by
+	 deleted_at`
+
+	var fetchedTeams []team
+	_, err := repository.client.ExecuteQuery(context, &fetchedTeams, query, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve archived teams: %w", err)
+	}
+
+	return teamsToTeamEntities(fetchedTeams), nil
+}
+
+func (repository *TeamRepository) DeleteTeam(context context.Context, slug string) error {
+	_, err := repository.client.ExecuteCommand(context, `delete from teams where slug = ?`, slug)
+	if err != nil {
+		if strings.Contains(err.Error(), "violates foreign key constraint") {
+			return fmt.Errorf("%w: %v", repositoryPort.ErrStillReferenced, err)
+		}
+
+		return fmt.Errorf("failed to delete team %s: %w", slug, err)
+	}
+
+	return nil
+}
+
+func (repository *TeamRepository) GetTeamByPreviousName(context context.Context, nameOrSlug string) (*entity.Team, error) {
+	query := `select
+	 ` + teamReturningColumns + `
+   from
+	 teams
+	 join team_names on team_names.team_slug = teams.slug
+   where
+	 (team_names.name = ? or team_names.slug = ?)
+	 and not exists (select 1 from teams current_team where current_team.name = ?)
+   order by
+	 team_names.effective_until desc
+   limit 1`
+
+	var fetchedTeam team
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedTeam, query, nameOrSlug, nameOrSlug, nameOrSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve team by previous name %s: %w", nameOrSlug, err)
+	}
+
+	// Query executed successfully but no team was ever known by this name
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return teamToTeamEntity(fetchedTeam), nil
+}
+
+func (repository *TeamRepository) GetPreviousTeamNames(context context.Context, slug string) ([]*entity.TeamName, error) {
+	query := `select
+	 ` + teamNameReturningColumns + `
+   from
+	 team_names
+   where
+	 team_slug = ?
+   order by
+	 effective_until`
+
+	var fetchedTeamNames []teamName
+	_, err := repository.client.ExecuteQuery(context, &fetchedTeamNames, query, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve previous names of team %s: %w", slug, err)
+	}
+
+	return teamNamesToTeamNameEntities(fetchedTeamNames), nil
+}
+
+func (repository *TeamRepository) RenameTeam(
+	ctx context.Context,
+	slug string,
+	teamEntity *entity.Team,
+	previousNameEntity *entity.TeamName,
+) (*entity.Team, error) {
+	renameQuery := `update teams set
+	 slug = ?,
+	 name = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 slug = ?
+   returning
+	 ` + teamReturningColumns
+	previousNameQuery := `insert into team_names (
+	 team_slug,
+	 name,
+	 slug,
+	 effective_from,
+	 effective_until,
+	 created_by
+   ) values (?, ?, ?, ?, ?, ?)`
+
+	// The name the team was created with has been in effect since the beginning, so it is stored without a start
+	var effectiveFrom interface{}
+	if !previousNameEntity.EffectiveFrom.IsZero() {
+		effectiveFrom = previousNameEntity.EffectiveFrom
+	}
+
+	// The previous name must be recorded along with the rename, otherwise lookups by it would be lost
+	var renamed team
+	err := runInTransaction(ctx, repository.client, func(transactionContext context.Context) error {
+		// The records that reference the team, including its previous names, follow the new slug on update
+		queryResult, err := repository.client.ExecuteQuery(
+			transactionContext,
+			&renamed,
+			renameQuery,
+			teamEntity.Slug,
+			teamEntity.Name,
+			teamEntity.UpdatedBy,
+			slug,
+		)
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key value") {
+				return fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+			}
+
+			return fmt.Errorf("failed to rename team %s: %w", slug, err)
+		}
+		if queryResult.RowsReturned == 0 {
+			return nil
+		}
+
+		_, err = repository.client.ExecuteCommand(
+			transactionContext,
+			previousNameQuery,
+			renamed.Slug,
+			previousNameEntity.Name,
+			previousNameEntity.Slug,
+			effectiveFrom,
+			previousNameEntity.EffectiveUntil,
+			previousNameEntity.CreatedBy,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record previous name of team %s: %w", slug, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The team does not exist
+	if renamed.Slug == "" {
+		return nil, nil
+	}
+
+	return teamToTeamEntity(renamed), nil
+}
+
+func (repository *TeamRepository) UpdateTeamLogo(context context.Context, teamEntity *entity.Team) (*entity.Team, error) {
+	query := `update teams set
+	 logo_key = ?,
+	 logo_thumbnail_key = ?,
+	 updated_at = now(),
+	 updated_by = ?
+   where
+	 slug = ?
+   returning
+	 ` + teamReturningColumns
+
+	var updated team
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		nullableString(teamEntity.LogoKey),
+		nullableString(teamEntity.LogoThumbnailKey),
+		teamEntity.UpdatedBy,
+		teamEntity.Slug,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update logo of team %s: %w", teamEntity.Name, err)
+	}
+
+	// Query executed successfully but the team does not exist
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return teamToTeamEntity(updated), nil
+}
+
+func (repository *TeamRepository) SearchTeams(
+	context context.Context,
+	searchedText string,
+	limit int,
+) ([]*entity.SearchResult, error) {
+	document := searchDocument(`name || ' ' || coalesce(description, '')`)
+	query := `select
+	 ` + teamReturningColumns + `,
+	 ` + searchColumns(document, "name") + `
+   from
+	 teams
+   where
+	 deleted_at is null
+	 and ` + searchCondition(document, "name") + `
+   order by
+	 rank desc,
+	 slug
+   limit ?`
+
+	var fetchedTeams []searchedTeam
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&fetchedTeams,
+		query,
+		searchedText,
+		searchedText,
+		searchedText,
+		searchedText,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search teams: %w", err)
+	}
+
+	results := make([]*entity.SearchResult, 0, len(fetchedTeams))
+	for _, fetchedTeam := range fetchedTeams {
+		results = append(results, &entity.SearchResult{
+			Team: teamToTeamEntity(fetchedTeam.team),
+			Rank: fetchedTeam.Rank,
+		})
+	}
+
+	return results, nil
+}
+
+// teamReturningColumns lists the columns that are scanned into a team.
+const teamReturningColumns = `slug,
+	 name,
+	 description,
+	 origin_country,
+	 logo_key,
+	 logo_thumbnail_key,
+	 created_at,
+	 created_by,
+	 updated_at,
+	 updated_by,
+	 deleted_at,
+	 deleted_by`
+
+// teamNameReturningColumns lists the columns that are scanned into a previous name of a team.
+const teamNameReturningColumns = `team_slug,
+	 name,
+	 slug,
+	 effective_from,
+	 effective_until,
+	 created_at,
+	 created_by`
+
+// teamNameAtColumn selects, as team_name, the name that the team referenced by slugColumn used on the date in
+// dateColumn: the previous name that was still in effect on that date or, when there is none, its current name.
+func teamNameAtColumn(slugColumn, dateColumn string) string {
+	return `coalesce(
+	   (select team_names.name from team_names
+	     where team_names.team_slug = ` + slugColumn + ` and team_names.effective_until > ` + dateColumn + `
+	     order by team_names.effective_until limit 1),
+	   (select teams.name from teams where teams.slug = ` + slugColumn + `)
+	 ) as team_name`
+}
+
 // stringJoin is a helper to join []string with a separator
 func stringJoin(elems []string, sep string) string {
 	if len(elems) == 0 {
@@ -205,6 +587,15 @@ func stringJoin(elems []string, sep string) string {
 	return result
 }
 
+// nullableString saves an empty string as null.
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
 func teamsToTeamEntities(teams []team) []*entity.Team {
 	teamEntities := make([]*entity.Team, 0)
 
@@ -227,6 +618,36 @@ func teamToTeamEntity(team team) *entity.Team {
 		CreatedBy:     team.CreatedBy,
 		UpdatedAt:     team.UpdatedAt,
 		UpdatedBy:     team.UpdatedBy,
+		DeletedAt:     team.DeletedAt,
+		DeletedBy:     team.DeletedBy,
+
+		LogoKey:          team.LogoKey,
+		LogoThumbnailKey: team.LogoThumbnailKey,
+	}
+}
+
+func teamNamesToTeamNameEntities(teamNames []teamName) []*entity.TeamName {
+	teamNameEntities := make([]*entity.TeamName, 0, len(teamNames))
+
+	for _, teamName := range teamNames {
+		teamNameEntities = append(teamNameEntities, teamNameToTeamNameEntity(teamName))
+	}
+
+	return teamNameEntities
+}
+
+func teamNameToTeamNameEntity(teamName teamName) *entity.TeamName {
+	// A null effective_from, for the name the team was created with, is scanned as the zero time
+	return &entity.TeamName{
+		Team: &entity.Team{Slug: teamName.TeamSlug},
+		Name: teamName.Name,
+		Slug: teamName.Slug,
+
+		EffectiveFrom:  teamName.EffectiveFrom,
+		EffectiveUntil: teamName.EffectiveUntil,
+
+		CreatedAt: teamName.CreatedAt,
+		CreatedBy: teamName.CreatedBy,
 	}
 }
 
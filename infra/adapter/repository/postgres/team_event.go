@@ -0,0 +1,331 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that TeamEventRepository implements the repositoryPort.TeamEvent interface.
+var _ repositoryPort.TeamEvent = (*TeamEventRepository)(nil)
+
+type TeamEventRepository struct {
+	client postgresDatabase.Client
+}
+
+// teamEvent is a representation on how the team event is retrieved from the database.
+type teamEvent struct {
+	ID          string    `pg:"id"`
+	TeamSlug    string    `pg:"team_slug"`
+	Kind        string    `pg:"kind"`
+	Title       string    `pg:"title"`
+	Description string    `pg:"description"`
+	Location    string    `pg:"location"`
+	StartTime   time.Time `pg:"start_time"`
+	EndTime     time.Time `pg:"end_time"`
+
+	CreatedAt time.Time `pg:"created_at"`
+	CreatedBy string    `pg:"created_by"`
+	UpdatedAt time.Time `pg:"updated_at"`
+	UpdatedBy string    `pg:"updated_by"`
+}
+
+// teamEventParticipation is a representation on how the participation in a team event is retrieved from the database.
+type teamEventParticipation struct {
+	EventID        string    `pg:"event_id"`
+	EventStartTime time.Time `pg:"event_start_time"`
+	PersonUserName string    `pg:"person_username"`
+
+	RSVP        string    `pg:"rsvp"`
+	RespondedAt time.Time `pg:"responded_at"`
+
+	Attendance           string    `pg:"attendance"`
+	AttendanceRecordedAt time.Time `pg:"attendance_recorded_at"`
+	AttendanceRecordedBy string    `pg:"attendance_recorded_by"`
+}
+
+const teamEventColumns = `id,
+              team_slug,
+              kind,
+              title,
+              description,
+              location,
+              start_time,
+              end_time,
+              created_at,
+              created_by,
+              updated_at,
+              updated_by`
+
+// NewTeamEventRepository instantiates a new team event repository for postgres.
+func NewTeamEventRepository(client postgresDatabase.Client) *TeamEventRepository {
+	return &TeamEventRepository{
+		client: client,
+	}
+}
+
+func (repository *TeamEventRepository) GetTeamEventsByTeamSlug(
+	context context.Context,
+	teamSlug string,
+	from, to time.Time,
+) ([]*entity.TeamEvent, error) {
+	conditions, params := buildTeamEventPeriodConditions("team_events", teamSlug, from, to)
+	query := `select
+              ` + teamEventColumns + `
+            from
+              team_events
+            where
+              ` + strings.Join(conditions, " and ") + `
+            order by
+              start_time`
+
+	// Execute query in DB
+	var fetchedEvents []teamEvent
+	_, err := repository.client.ExecuteQuery(context, &fetchedEvents, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve events from team %s: %w", teamSlug, err)
+	}
+
+	return teamEventsToTeamEventEntities(fetchedEvents), nil
+}
+
+func (repository *TeamEventRepository) GetTeamEventByID(context context.Context, id string) (*entity.TeamEvent, error) {
+	query := `select
+              ` + teamEventColumns + `
+            from
+              team_events
+            where
+              id = ? limit 1`
+
+	// Execute query in DB
+	var fetchedEvent teamEvent
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedEvent, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve team event %s: %w", id, err)
+	}
+
+	// Query executed successfully but no entity found for this ID
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return teamEventToTeamEventEntity(fetchedEvent), nil
+}
+
+func (repository *TeamEventRepository) CreateTeamEvent(
+	context context.Context,
+	eventEntity *entity.TeamEvent,
+) (*entity.TeamEvent, error) {
+	query := `insert into team_events (
+	 team_slug,
+	 kind,
+	 title,
+	 description,
+	 location,
+	 start_time,
+	 end_time,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?, ?, ?, ?) returning
+	 ` + teamEventColumns
+
+	var inserted teamEvent
+	queryResult, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		eventEntity.Team.Slug,
+		string(eventEntity.Kind),
+		eventEntity.Title,
+		eventEntity.Description,
+		eventEntity.Location,
+		eventEntity.StartTime,
+		eventEntity.EndTime,
+		eventEntity.CreatedBy,
+		eventEntity.CreatedBy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create team event: %w", err)
+	}
+	if queryResult == nil || queryResult.RowsReturned == 0 {
+		return nil, fmt.Errorf("no rows were returned after inserting team event '%s'", eventEntity.Title)
+	}
+
+	return teamEventToTeamEventEntity(inserted), nil
+}
+
+func (repository *TeamEventRepository) GetTeamEventParticipationsByTeamSlug(
+	context context.Context,
+	teamSlug string,
+	from, to time.Time,
+) ([]*entity.TeamEventParticipation, error) {
+	conditions, params := buildTeamEventPeriodConditions("team_events", teamSlug, from, to)
+	query := `select
+              team_event_participations.event_id,
+              team_events.start_time as event_start_time,
+              team_event_participations.person_username,
+              team_event_participations.rsvp,
+              team_event_participations.responded_at,
+              team_event_participations.attendance,
+              team_event_participations.attendance_recorded_at,
+              team_event_participations.attendance_recorded_by
+            from
+              team_event_participations
+              join team_events on team_events.id = team_event_participations.event_id
+            where
+              ` + strings.Join(conditions, " and ")
+
+	// Execute query in DB
+	var fetchedParticipations []teamEventParticipation
+	_, err := repository.client.ExecuteQuery(context, &fetchedParticipations, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve event participations from team %s: %w", teamSlug, err)
+	}
+
+	participationEntities := make([]*entity.TeamEventParticipation, 0, len(fetchedParticipations))
+	for _, participation := range fetchedParticipations {
+		participationEntities = append(participationEntities, teamEventParticipationToTeamEventParticipationEntity(participation))
+	}
+
+	return participationEntities, nil
+}
+
+func (repository *TeamEventRepository) SaveTeamEventRSVP(
+	context context.Context,
+	participationEntity *entity.TeamEventParticipation,
+) (*entity.TeamEventParticipation, error) {
+	query := `insert into team_event_participations (
+	 event_id,
+	 person_username,
+	 rsvp,
+	 responded_at
+   ) values (?, ?, ?, now())
+   on conflict (event_id, person_username) do update set
+	 rsvp = excluded.rsvp,
+	 responded_at = excluded.responded_at
+   returning
+	 event_id,
+	 person_username,
+	 rsvp,
+	 responded_at,
+	 attendance,
+	 attendance_recorded_at,
+	 attendance_recorded_by`
+
+	var saved teamEventParticipation
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&saved,
+		query,
+		participationEntity.Event.ID,
+		participationEntity.Person.UserName,
+		string(participationEntity.RSVP),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save RSVP of '%s' to event %s: %w", participationEntity.Person.UserName, participationEntity.Event.ID, err)
+	}
+	saved.EventStartTime = participationEntity.Event.StartTime
+
+	return teamEventParticipationToTeamEventParticipationEntity(saved), nil
+}
+
+func (repository *TeamEventRepository) SaveTeamEventAttendance(
+	ctx context.Context,
+	participationEntities []*entity.TeamEventParticipation,
+) error {
+	query := `insert into team_event_participations (
+	 event_id,
+	 person_username,
+	 attendance,
+	 attendance_recorded_at,
+	 attendance_recorded_by
+   ) values (?, ?, ?, now(), ?)
+   on conflict (event_id, person_username) do update set
+	 attendance = excluded.attendance,
+	 attendance_recorded_at = excluded.attendance_recorded_at,
+	 attendance_recorded_by = excluded.attendance_recorded_by`
+
+	// The attendance sheet of an event is recorded as a whole
+	return runInTransaction(ctx, repository.client, func(transactionContext context.Context) error {
+		for _, participationEntity := range participationEntities {
+			_, err := repository.client.ExecuteCommand(
+				transactionContext,
+				query,
+				participationEntity.Event.ID,
+				participationEntity.Person.UserName,
+				string(participationEntity.Attendance),
+				participationEntity.AttendanceRecordedBy,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to save attendance of '%s' to event %s: %w", participationEntity.Person.UserName, participationEntity.Event.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// buildTeamEventPeriodConditions builds the where clause conditions to filter the events of a team in a period.
+func buildTeamEventPeriodConditions(table, teamSlug string, from, to time.Time) ([]string, []interface{}) {
+	conditions := []string{table + ".team_slug = ?"}
+	params := []interface{}{teamSlug}
+
+	if !from.IsZero() {
+		conditions = append(conditions, table+".start_time >= ?")
+		params = append(params, from)
+	}
+
+	if !to.IsZero() {
+		conditions = append(conditions, table+".start_time < ?")
+		params = append(params, to)
+	}
+
+	return conditions, params
+}
+
+func teamEventsToTeamEventEntities(events []teamEvent) []*entity.TeamEvent {
+	eventEntities := make([]*entity.TeamEvent, 0)
+
+	for _, event := range events {
+		eventEntities = append(eventEntities, teamEventToTeamEventEntity(event))
+	}
+
+	return eventEntities
+}
+
+func teamEventToTeamEventEntity(event teamEvent) *entity.TeamEvent {
+	return &entity.TeamEvent{
+		ID:          event.ID,
+		Team:        &entity.Team{Slug: event.TeamSlug},
+		Kind:        entity.TeamEventKind(event.Kind),
+		Title:       event.Title,
+		Description: event.Description,
+		Location:    event.Location,
+		StartTime:   event.StartTime,
+		EndTime:     event.EndTime,
+
+		CreatedAt: event.CreatedAt,
+		CreatedBy: event.CreatedBy,
+		UpdatedAt: event.UpdatedAt,
+		UpdatedBy: event.UpdatedBy,
+	}
+}
+
+func teamEventParticipationToTeamEventParticipationEntity(participation teamEventParticipation) *entity.TeamEventParticipation {
+	return &entity.TeamEventParticipation{
+		Event:  &entity.TeamEvent{ID: participation.EventID, StartTime: participation.EventStartTime},
+		Person: &entity.Person{UserName: participation.PersonUserName},
+
+		RSVP:        entity.RSVPStatus(participation.RSVP),
+		RespondedAt: participation.RespondedAt,
+
+		Attendance:           entity.AttendanceStatus(participation.Attendance),
+		AttendanceRecordedAt: participation.AttendanceRecordedAt,
+		AttendanceRecordedBy: participation.AttendanceRecordedBy,
+	}
+}
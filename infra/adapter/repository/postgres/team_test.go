@@ -6,6 +6,7 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -70,3 +71,267 @@ func TestTeamRepository_GetTeamByName(t *testing.T) {
 		},
 	)
 }
+
+func TestTeamRepository_ArchiveAndRestoreTeam(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description: "should leave an archived team out of the listing until it is restored",
+			FixtureQueries: fixture.GenerateTeamQueries(
+				fixture.GetDefaultFixtureTeam(),
+				fixture.GetAnotherFixtureTeam(),
+			),
+			InputData:  map[string]interface{}{},
+			OutputData: map[string]interface{}{},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			teamRepository := repositoryPostgres.NewTeamRepository(client)
+			team := fixture.GetDefaultFixtureTeam()
+
+			archivedTeam, err := teamRepository.ArchiveTeam(testContext, team.WithDeletedBy("someone"))
+			require.NoError(t, err)
+			require.NotNil(t, archivedTeam)
+			require.True(t, archivedTeam.IsArchived())
+			require.Equal(t, "someone", archivedTeam.DeletedBy)
+
+			// Archiving it again does nothing
+			archivedAgain, err := teamRepository.ArchiveTeam(testContext, team.WithDeletedBy("someone else"))
+			require.NoError(t, err)
+			require.Nil(t, archivedAgain)
+
+			listedTeams, err := teamRepository.GetAllTeams(testContext, false, entity.Criteria{}, entity.PageRequest{})
+			require.NoError(t, err)
+			require.Len(t, listedTeams, 1)
+			require.Equal(t, fixture.GetAnotherFixtureTeam().Slug, listedTeams[0].Slug)
+
+			allTeams, err := teamRepository.GetAllTeams(testContext, true, entity.Criteria{}, entity.PageRequest{})
+			require.NoError(t, err)
+			require.Len(t, allTeams, 2)
+
+			// The archived team is still found by its name
+			foundTeam, err := teamRepository.GetTeamByName(testContext, team.Name)
+			require.NoError(t, err)
+			require.True(t, foundTeam.IsArchived())
+
+			restoredTeam, err := teamRepository.RestoreTeam(testContext, team.WithUpdatedBy("someone"))
+			require.NoError(t, err)
+			require.NotNil(t, restoredTeam)
+			require.False(t, restoredTeam.IsArchived())
+			require.Empty(t, restoredTeam.DeletedBy)
+
+			listedTeams, err = teamRepository.GetAllTeams(testContext, false, entity.Criteria{}, entity.PageRequest{})
+			require.NoError(t, err)
+			require.Len(t, listedTeams, 2)
+		},
+	)
+}
+
+func TestTeamRepository_GetAllTeams_Pages(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description: "should list the teams by slug a page at a time",
+			FixtureQueries: fixture.GenerateTeamQueries(
+				fixture.GetDefaultFixtureTeam(),
+				fixture.GetAnotherFixtureTeam(),
+			),
+			InputData:  map[string]interface{}{},
+			OutputData: map[string]interface{}{},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			teamRepository := repositoryPostgres.NewTeamRepository(client)
+
+			firstPage, err := teamRepository.GetAllTeams(testContext, false, entity.Criteria{}, entity.PageRequest{Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, firstPage, 1)
+			require.Equal(t, fixture.FakeTeamAnotherSlug, firstPage[0].Slug)
+
+			secondPage, err := teamRepository.GetAllTeams(testContext, false, entity.Criteria{}, entity.PageRequest{Limit: 1, After: firstPage[0].Slug})
+			require.NoError(t, err)
+			require.Len(t, secondPage, 1)
+			require.Equal(t, fixture.FakeTeamDefaultSlug, secondPage[0].Slug)
+
+			lastPage, err := teamRepository.GetAllTeams(testContext, false, entity.Criteria{}, entity.PageRequest{Limit: 1, After: secondPage[0].Slug})
+			require.NoError(t, err)
+			require.Empty(t, lastPage)
+		},
+	)
+}
+
+func TestTeamRepository_GetAllTeams_Criteria(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description: "should filter and sort the teams, following the sort across pages",
+			FixtureQueries: fixture.GenerateTeamQueries(
+				fixture.GetDefaultFixtureTeam(),
+				fixture.GetAnotherFixtureTeam(),
+			),
+			InputData:  map[string]interface{}{},
+			OutputData: map[string]interface{}{},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			teamRepository := repositoryPostgres.NewTeamRepository(client)
+			byNameDescending := entity.Criteria{
+				Filters: []entity.Filter{
+					{Attribute: string(entity.TeamAttributes.OriginCountry), Operator: entity.FilterOperators.Equal, Value: "BR"},
+				},
+				Sorts: []entity.Sort{
+					{Attribute: string(entity.TeamAttributes.Name), Descending: true},
+				},
+			}
+
+			firstPage, err := teamRepository.GetAllTeams(testContext, false, byNameDescending, entity.PageRequest{Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, firstPage, 1)
+			require.Equal(t, fixture.FakeTeamDefaultName, firstPage[0].Name)
+
+			secondPage, err := teamRepository.GetAllTeams(
+				testContext, false, byNameDescending, entity.PageRequest{Limit: 1, After: firstPage[0].Slug},
+			)
+			require.NoError(t, err)
+			require.Len(t, secondPage, 1)
+			require.Equal(t, fixture.FakeTeamAnotherName, secondPage[0].Name)
+
+			// The wildcards of the value are matched literally
+			containing, err := teamRepository.GetAllTeams(testContext, false, entity.Criteria{
+				Filters: []entity.Filter{
+					{Attribute: string(entity.TeamAttributes.Name), Operator: entity.FilterOperators.Contains, Value: "another"},
+				},
+			}, entity.PageRequest{})
+			require.NoError(t, err)
+			require.Len(t, containing, 1)
+			require.Equal(t, fixture.FakeTeamAnotherSlug, containing[0].Slug)
+
+			wildcard, err := teamRepository.GetAllTeams(testContext, false, entity.Criteria{
+				Filters: []entity.Filter{
+					{Attribute: string(entity.TeamAttributes.Name), Operator: entity.FilterOperators.Contains, Value: "%"},
+				},
+			}, entity.PageRequest{})
+			require.NoError(t, err)
+			require.Empty(t, wildcard)
+
+			_, err = teamRepository.GetAllTeams(testContext, false, entity.Criteria{
+				Sorts: []entity.Sort{{Attribute: "slug; drop table teams"}},
+			}, entity.PageRequest{})
+			require.Error(t, err)
+		},
+	)
+}
+
+func TestTeamRepository_SearchTeams(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description: "should find the teams by their name or description, ignoring accents and tolerating typos",
+			FixtureQueries: fixture.GenerateTeamQueries(
+				fixture.GetDefaultFixtureTeam().WithName("São Paulo Team"),
+				fixture.GetAnotherFixtureTeam(),
+			),
+			InputData:  map[string]interface{}{},
+			OutputData: map[string]interface{}{},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			teamRepository := repositoryPostgres.NewTeamRepository(client)
+
+			withoutAccents, err := teamRepository.SearchTeams(testContext, "sao paulo", 10)
+			require.NoError(t, err)
+			require.Len(t, withoutAccents, 1)
+			require.Equal(t, fixture.FakeTeamDefaultSlug, withoutAccents[0].Team.Slug)
+
+			withTypo, err := teamRepository.SearchTeams(testContext, "Anothr", 10)
+			require.NoError(t, err)
+			require.Len(t, withTypo, 1)
+			require.Equal(t, fixture.FakeTeamAnotherSlug, withTypo[0].Team.Slug)
+
+			byDescription, err := teamRepository.SearchTeams(testContext, "awesome", 10)
+			require.NoError(t, err)
+			require.Len(t, byDescription, 2)
+			require.Greater(t, byDescription[0].Rank, 0.0)
+
+			limited, err := teamRepository.SearchTeams(testContext, "awesome", 1)
+			require.NoError(t, err)
+			require.Len(t, limited, 1)
+		},
+	)
+}
+
+func TestTeamRepository_RenameTeam(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description: "should keep the previous name of a renamed team and find the team by it",
+			FixtureQueries: fixture.GenerateTeamQueries(
+				fixture.GetDefaultFixtureTeam(),
+			),
+			InputData:  map[string]interface{}{},
+			OutputData: map[string]interface{}{},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+			teamRepository := repositoryPostgres.NewTeamRepository(client)
+			team := fixture.GetDefaultFixtureTeam()
+			effectiveDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+			renamedTeam, err := teamRepository.RenameTeam(
+				testContext,
+				team.Slug,
+				team.WithName("Renamed Team").WithSlug("renamed-team").WithUpdatedBy("someone"),
+				&entity.TeamName{Name: team.Name, Slug: team.Slug, EffectiveUntil: effectiveDate, CreatedBy: "someone"},
+			)
+			require.NoError(t, err)
+			require.NotNil(t, renamedTeam)
+			require.Equal(t, "Renamed Team", renamedTeam.Name)
+			require.Equal(t, "renamed-team", renamedTeam.Slug)
+
+			previousNames, err := teamRepository.GetPreviousTeamNames(testContext, "renamed-team")
+			require.NoError(t, err)
+			require.Len(t, previousNames, 1)
+			require.Equal(t, team.Name, previousNames[0].Name)
+			require.Equal(t, team.Slug, previousNames[0].Slug)
+			require.True(t, previousNames[0].EffectiveFrom.IsZero())
+			require.True(t, effectiveDate.Equal(previousNames[0].EffectiveUntil))
+
+			for _, previousNameOrSlug := range []string{team.Name, team.Slug} {
+				foundTeam, err := teamRepository.GetTeamByPreviousName(testContext, previousNameOrSlug)
+				require.NoError(t, err)
+				require.NotNil(t, foundTeam)
+				require.Equal(t, "renamed-team", foundTeam.Slug)
+			}
+
+			// The current name is not a previous name
+			foundTeam, err := teamRepository.GetTeamByPreviousName(testContext, "Renamed Team")
+			require.NoError(t, err)
+			require.Nil(t, foundTeam)
+		},
+	)
+}
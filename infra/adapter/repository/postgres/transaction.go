@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// runInTransaction executes the operation inside a database transaction. When the context already carries a
+// transaction, the operation joins it and leaves committing to whoever started it.
+func runInTransaction(
+	context context.Context,
+	client postgresDatabase.Client,
+	operation func(transactionContext context.Context) error,
+) error {
+	transaction, transactionContext, err := client.StartContextualTransaction(context)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// The client hands back the same context when it reuses an ongoing transaction
+	if transactionContext == context {
+		return operation(transactionContext)
+	}
+	defer transaction.Close(transactionContext)
+
+	err = operation(transactionContext)
+	if err != nil {
+		return err
+	}
+
+	err = transaction.Commit(transactionContext)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that TransactionManager implements the repositoryPort.TransactionManager interface.
+var _ repositoryPort.TransactionManager = (*TransactionManager)(nil)
+
+type TransactionManager struct {
+	client postgresDatabase.Client
+}
+
+// NewTransactionManager instantiates a new transaction manager for postgres.
+func NewTransactionManager(client postgresDatabase.Client) *TransactionManager {
+	return &TransactionManager{
+		client: client,
+	}
+}
+
+func (manager *TransactionManager) RunInTransaction(
+	context context.Context,
+	operation func(transactionContext context.Context) error,
+) error {
+	return runInTransaction(context, manager.client, operation)
+}
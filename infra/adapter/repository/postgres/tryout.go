@@ -0,0 +1,486 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+)
+
+// Enforce that TryoutRepository implements the repositoryPort.Tryout interface.
+var _ repositoryPort.Tryout = (*TryoutRepository)(nil)
+
+type TryoutRepository struct {
+	client postgresDatabase.Client
+}
+
+// tryout is a representation on how the tryout is retrieved from the database.
+type tryout struct {
+	ID        string    `pg:"id"`
+	TeamSlug  string    `pg:"team_slug"`
+	Title     string    `pg:"title"`
+	Location  string    `pg:"location"`
+	StartDate time.Time `pg:"start_date"`
+	EndDate   time.Time `pg:"end_date"`
+
+	CreatedAt time.Time `pg:"created_at"`
+	CreatedBy string    `pg:"created_by"`
+	UpdatedAt time.Time `pg:"updated_at"`
+	UpdatedBy string    `pg:"updated_by"`
+}
+
+// tryoutCriterion is a representation on how the criterion of a tryout is retrieved from the database.
+type tryoutCriterion struct {
+	TryoutID string  `pg:"tryout_id"`
+	Name     string  `pg:"name"`
+	Weight   float64 `pg:"weight"`
+}
+
+// tryoutCandidate is a representation on how the candidate of a tryout is retrieved from the database.
+type tryoutCandidate struct {
+	TryoutID       string    `pg:"tryout_id"`
+	PersonUserName string    `pg:"person_username"`
+	Status         string    `pg:"status"`
+	RegisteredAt   time.Time `pg:"registered_at"`
+	DecidedAt      time.Time `pg:"decided_at"`
+	DecidedBy      string    `pg:"decided_by"`
+}
+
+// tryoutEvaluation is a representation on how the evaluation of a tryout candidate is retrieved from the database.
+type tryoutEvaluation struct {
+	TryoutID          string    `pg:"tryout_id"`
+	CandidateUserName string    `pg:"candidate_username"`
+	EvaluatorUserName string    `pg:"evaluator_username"`
+	Criterion         string    `pg:"criterion"`
+	Score             int       `pg:"score"`
+	CreatedAt         time.Time `pg:"created_at"`
+	UpdatedAt         time.Time `pg:"updated_at"`
+}
+
+const tryoutColumns = `id,
+              team_slug,
+              title,
+              location,
+              start_date,
+              end_date,
+              created_at,
+              created_by,
+              updated_at,
+              updated_by`
+
+const tryoutCandidateColumns = `tryout_id,
+              person_username,
+              status,
+              registered_at,
+              decided_at,
+              decided_by`
+
+// NewTryoutRepository instantiates a new tryout repository for postgres.
+func NewTryoutRepository(client postgresDatabase.Client) *TryoutRepository {
+	return &TryoutRepository{
+		client: client,
+	}
+}
+
+func (repository *TryoutRepository) GetTryoutsByTeamSlug(context context.Context, teamSlug string) ([]*entity.Tryout, error) {
+	query := `select
+              ` + tryoutColumns + `
+            from
+              tryouts
+            where
+              team_slug = ?
+            order by
+              start_date desc`
+
+	// Execute query in DB
+	var fetchedTryouts []tryout
+	_, err := repository.client.ExecuteQuery(context, &fetchedTryouts, query, teamSlug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tryouts from team %s: %w", teamSlug, err)
+	}
+
+	tryoutIDs := make([]string, 0, len(fetchedTryouts))
+	for _, fetchedTryout := range fetchedTryouts {
+		tryoutIDs = append(tryoutIDs, fetchedTryout.ID)
+	}
+	criteriaByTryoutID, err := repository.getTryoutCriteria(context, tryoutIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tryoutEntities := make([]*entity.Tryout, 0, len(fetchedTryouts))
+	for _, fetchedTryout := range fetchedTryouts {
+		tryoutEntities = append(tryoutEntities, tryoutToTryoutEntity(fetchedTryout, criteriaByTryoutID[fetchedTryout.ID]))
+	}
+
+	return tryoutEntities, nil
+}
+
+func (repository *TryoutRepository) GetTryoutByID(context context.Context, id string) (*entity.Tryout, error) {
+	query := `select
+              ` + tryoutColumns + `
+            from
+              tryouts
+            where
+              id = ? limit 1`
+
+	// Execute query in DB
+	var fetchedTryout tryout
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedTryout, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tryout %s: %w", id, err)
+	}
+
+	// Query executed successfully but no entity found for this ID
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	criteriaByTryoutID, err := repository.getTryoutCriteria(context, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return tryoutToTryoutEntity(fetchedTryout, criteriaByTryoutID[id]), nil
+}
+
+func (repository *TryoutRepository) CreateTryout(ctx context.Context, tryoutEntity *entity.Tryout) (*entity.Tryout, error) {
+	tryoutQuery := `insert into tryouts (
+	 team_slug,
+	 title,
+	 location,
+	 start_date,
+	 end_date,
+	 created_by,
+	 updated_by
+   ) values (?, ?, ?, ?, ?, ?, ?) returning
+	 ` + tryoutColumns
+	criterionQuery := `insert into tryout_criteria (
+	 tryout_id,
+	 name,
+	 weight,
+	 position
+   ) values (?, ?, ?, ?)`
+
+	// The tryout and its criteria are created as a whole
+	var inserted tryout
+	err := runInTransaction(ctx, repository.client, func(transactionContext context.Context) error {
+		queryResult, err := repository.client.ExecuteQuery(
+			transactionContext,
+			&inserted,
+			tryoutQuery,
+			tryoutEntity.Team.Slug,
+			tryoutEntity.Title,
+			tryoutEntity.Location,
+			tryoutEntity.StartDate,
+			tryoutEntity.EndDate,
+			tryoutEntity.CreatedBy,
+			tryoutEntity.CreatedBy,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create tryout: %w", err)
+		}
+		if queryResult == nil || queryResult.RowsReturned == 0 {
+			return fmt.Errorf("no rows were returned after inserting tryout '%s'", tryoutEntity.Title)
+		}
+
+		for position, criterion := range tryoutEntity.Criteria {
+			_, err = repository.client.ExecuteCommand(
+				transactionContext,
+				criterionQuery,
+				inserted.ID,
+				criterion.Name,
+				criterion.Weight,
+				position,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to create criterion '%s' of tryout %s: %w", criterion.Name, inserted.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tryoutToTryoutEntity(inserted, tryoutEntity.Criteria), nil
+}
+
+func (repository *TryoutRepository) GetTryoutCandidates(
+	context context.Context,
+	tryoutID string,
+) ([]*entity.TryoutCandidate, error) {
+	query := `select
+              ` + tryoutCandidateColumns + `
+            from
+              tryout_candidates
+            where
+              tryout_id = ?
+            order by
+              registered_at`
+
+	// Execute query in DB
+	var fetchedCandidates []tryoutCandidate
+	_, err := repository.client.ExecuteQuery(context, &fetchedCandidates, query, tryoutID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve candidates of tryout %s: %w", tryoutID, err)
+	}
+
+	candidateEntities := make([]*entity.TryoutCandidate, 0, len(fetchedCandidates))
+	for _, fetchedCandidate := range fetchedCandidates {
+		candidateEntities = append(candidateEntities, tryoutCandidateToTryoutCandidateEntity(fetchedCandidate))
+	}
+
+	return candidateEntities, nil
+}
+
+func (repository *TryoutRepository) GetTryoutCandidate(
+	context context.Context,
+	tryoutID, personUserName string,
+) (*entity.TryoutCandidate, error) {
+	query := `select
+              ` + tryoutCandidateColumns + `
+            from
+              tryout_candidates
+            where
+              tryout_id = ? and person_username = ? limit 1`
+
+	// Execute query in DB
+	var fetchedCandidate tryoutCandidate
+	queryResult, err := repository.client.ExecuteQuery(context, &fetchedCandidate, query, tryoutID, personUserName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve candidate '%s' of tryout %s: %w", personUserName, tryoutID, err)
+	}
+
+	// Query executed successfully but no entity found for this person
+	if queryResult.RowsReturned == 0 {
+		return nil, nil
+	}
+
+	return tryoutCandidateToTryoutCandidateEntity(fetchedCandidate), nil
+}
+
+func (repository *TryoutRepository) CreateTryoutCandidate(
+	context context.Context,
+	candidateEntity *entity.TryoutCandidate,
+) (*entity.TryoutCandidate, error) {
+	query := `insert into tryout_candidates (
+	 tryout_id,
+	 person_username,
+	 status
+   ) values (?, ?, ?) returning
+	 ` + tryoutCandidateColumns
+
+	var inserted tryoutCandidate
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&inserted,
+		query,
+		candidateEntity.Tryout.ID,
+		candidateEntity.Person.UserName,
+		string(candidateEntity.Status),
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value") {
+			return nil, fmt.Errorf("%w: %v", repositoryPort.ErrAlreadyExists, err)
+		}
+
+		return nil, fmt.Errorf(
+			"failed to sign up '%s' for tryout %s: %w",
+			candidateEntity.Person.UserName,
+			candidateEntity.Tryout.ID,
+			err,
+		)
+	}
+
+	return tryoutCandidateToTryoutCandidateEntity(inserted), nil
+}
+
+func (repository *TryoutRepository) SaveTryoutDecision(
+	context context.Context,
+	candidateEntity *entity.TryoutCandidate,
+) (*entity.TryoutCandidate, error) {
+	query := `update tryout_candidates set
+	 status = ?,
+	 decided_at = now(),
+	 decided_by = ?
+   where
+	 tryout_id = ? and person_username = ?
+   returning
+	 ` + tryoutCandidateColumns
+
+	var updated tryoutCandidate
+	_, err := repository.client.ExecuteQuery(
+		context,
+		&updated,
+		query,
+		string(candidateEntity.Status),
+		candidateEntity.DecidedBy,
+		candidateEntity.Tryout.ID,
+		candidateEntity.Person.UserName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to save decision about '%s' in tryout %s: %w",
+			candidateEntity.Person.UserName,
+			candidateEntity.Tryout.ID,
+			err,
+		)
+	}
+
+	return tryoutCandidateToTryoutCandidateEntity(updated), nil
+}
+
+func (repository *TryoutRepository) GetTryoutEvaluations(
+	context context.Context,
+	tryoutID string,
+) ([]*entity.TryoutEvaluation, error) {
+	query := `select
+              tryout_id,
+              candidate_username,
+              evaluator_username,
+              criterion,
+              score,
+              created_at,
+              updated_at
+            from
+              tryout_evaluations
+            where
+              tryout_id = ?`
+
+	// Execute query in DB
+	var fetchedEvaluations []tryoutEvaluation
+	_, err := repository.client.ExecuteQuery(context, &fetchedEvaluations, query, tryoutID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve evaluations of tryout %s: %w", tryoutID, err)
+	}
+
+	evaluationEntities := make([]*entity.TryoutEvaluation, 0, len(fetchedEvaluations))
+	for _, fetchedEvaluation := range fetchedEvaluations {
+		evaluationEntities = append(evaluationEntities, &entity.TryoutEvaluation{
+			Tryout:    &entity.Tryout{ID: fetchedEvaluation.TryoutID},
+			Candidate: &entity.Person{UserName: fetchedEvaluation.CandidateUserName},
+			Evaluator: &entity.Person{UserName: fetchedEvaluation.EvaluatorUserName},
+			Criterion: fetchedEvaluation.Criterion,
+			Score:     fetchedEvaluation.Score,
+
+			CreatedAt: fetchedEvaluation.CreatedAt,
+			UpdatedAt: fetchedEvaluation.UpdatedAt,
+		})
+	}
+
+	return evaluationEntities, nil
+}
+
+func (repository *TryoutRepository) SaveTryoutEvaluations(
+	ctx context.Context,
+	evaluationEntities []*entity.TryoutEvaluation,
+) error {
+	query := `insert into tryout_evaluations (
+	 tryout_id,
+	 candidate_username,
+	 evaluator_username,
+	 criterion,
+	 score
+   ) values (?, ?, ?, ?, ?)
+   on conflict (tryout_id, candidate_username, evaluator_username, criterion) do update set
+	 score = excluded.score,
+	 updated_at = now()`
+
+	// The scores given by an evaluator to a candidate are saved as a whole
+	return runInTransaction(ctx, repository.client, func(transactionContext context.Context) error {
+		for _, evaluationEntity := range evaluationEntities {
+			_, err := repository.client.ExecuteCommand(
+				transactionContext,
+				query,
+				evaluationEntity.Tryout.ID,
+				evaluationEntity.Candidate.UserName,
+				evaluationEntity.Evaluator.UserName,
+				evaluationEntity.Criterion,
+				evaluationEntity.Score,
+			)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to save evaluation of '%s' on '%s' in tryout %s: %w",
+					evaluationEntity.Candidate.UserName,
+					evaluationEntity.Criterion,
+					evaluationEntity.Tryout.ID,
+					err,
+				)
+			}
+		}
+
+		return nil
+	})
+}
+
+// getTryoutCriteria retrieves the criteria of the given tryouts, grouped by tryout ID and kept in their original order.
+func (repository *TryoutRepository) getTryoutCriteria(
+	context context.Context,
+	tryoutIDs []string,
+) (map[string][]entity.TryoutCriterion, error) {
+	criteriaByTryoutID := map[string][]entity.TryoutCriterion{}
+	if len(tryoutIDs) == 0 {
+		return criteriaByTryoutID, nil
+	}
+
+	query := `select
+              tryout_id,
+              name,
+              weight
+            from
+              tryout_criteria
+            where
+              tryout_id in (?)
+            order by
+              tryout_id, position`
+
+	// Execute query in DB
+	var fetchedCriteria []tryoutCriterion
+	_, err := repository.client.ExecuteQuery(context, &fetchedCriteria, query, tryoutIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tryout criteria: %w", err)
+	}
+
+	for _, fetchedCriterion := range fetchedCriteria {
+		criteriaByTryoutID[fetchedCriterion.TryoutID] = append(criteriaByTryoutID[fetchedCriterion.TryoutID], entity.TryoutCriterion{
+			Name:   fetchedCriterion.Name,
+			Weight: fetchedCriterion.Weight,
+		})
+	}
+
+	return criteriaByTryoutID, nil
+}
+
+func tryoutToTryoutEntity(tryout tryout, criteria []entity.TryoutCriterion) *entity.Tryout {
+	return &entity.Tryout{
+		ID:        tryout.ID,
+		Team:      &entity.Team{Slug: tryout.TeamSlug},
+		Title:     tryout.Title,
+		Location:  tryout.Location,
+		StartDate: tryout.StartDate,
+		EndDate:   tryout.EndDate,
+		Criteria:  append([]entity.TryoutCriterion{}, criteria...),
+
+		CreatedAt: tryout.CreatedAt,
+		CreatedBy: tryout.CreatedBy,
+		UpdatedAt: tryout.UpdatedAt,
+		UpdatedBy: tryout.UpdatedBy,
+	}
+}
+
+func tryoutCandidateToTryoutCandidateEntity(candidate tryoutCandidate) *entity.TryoutCandidate {
+	return &entity.TryoutCandidate{
+		Tryout:       &entity.Tryout{ID: candidate.TryoutID},
+		Person:       &entity.Person{UserName: candidate.PersonUserName},
+		Status:       entity.TryoutCandidateStatus(candidate.Status),
+		RegisteredAt: candidate.RegisteredAt,
+		DecidedAt:    candidate.DecidedAt,
+		DecidedBy:    candidate.DecidedBy,
+	}
+}
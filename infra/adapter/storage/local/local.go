@@ -0,0 +1,116 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+)
+
+// Enforce that Local implements the storage.Storage interface.
+var _ storage.Storage = (*Local)(nil)
+
+// Local keeps the objects as files of a directory of the local filesystem, which suits development and single
+// instance deployments.
+type Local struct {
+	directory string
+}
+
+// NewStorage instantiates a new storage that keeps the objects in the directory.
+func NewStorage(directory string) *Local {
+	return &Local{
+		directory: directory,
+	}
+}
+
+func (local *Local) PutObject(_ context.Context, object *entity.MediaObject) error {
+	filePath, err := local.filePath(object.Key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create directory of object %s: %w", object.Key, err)
+	}
+
+	// The content is written aside and then moved into place, so readers never see a partial object
+	temporaryFile, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file of object %s: %w", object.Key, err)
+	}
+	defer os.Remove(temporaryFile.Name())
+
+	_, err = temporaryFile.Write(object.Content)
+	if closeErr := temporaryFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write object %s: %w", object.Key, err)
+	}
+
+	err = os.Rename(temporaryFile.Name(), filePath)
+	if err != nil {
+		return fmt.Errorf("failed to save object %s: %w", object.Key, err)
+	}
+
+	return nil
+}
+
+func (local *Local) GetObject(_ context.Context, key string) (*entity.MediaObject, error) {
+	filePath, err := local.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read object %s: %w", key, err)
+	}
+
+	// Files keep no metadata, so the content type comes from the extension of the key
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return &entity.MediaObject{
+		Key:         key,
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+func (local *Local) DeleteObject(_ context.Context, key string) error {
+	filePath, err := local.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filePath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete object %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// filePath returns where the object saved under the key is kept, refusing the keys that would leave the directory.
+func (local *Local) filePath(key string) (string, error) {
+	if !entity.IsValidMediaKey(key) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+
+	return filepath.Join(local.directory, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,232 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/config"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+)
+
+// Enforce that S3 implements the storage.Storage interface.
+var _ storage.Storage = (*S3)(nil)
+
+// requestTimeout bounds how long a request to the storage may take, uploads included.
+const requestTimeout = 30 * time.Second
+
+// amzDateLayout is the layout of the timestamps that sign the requests.
+const amzDateLayout = "20060102T150405Z"
+
+// S3 keeps the objects in a bucket of an S3-compatible storage, such as AWS S3 or MinIO. The requests are signed with
+// AWS Signature Version 4 and address the bucket in path style, which every compatible storage supports.
+type S3 struct {
+	client          *http.Client
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+}
+
+// NewStorage instantiates a new storage that keeps the objects in the configured bucket.
+func NewStorage(section *config.S3StorageSection) (*S3, error) {
+	endpoint, err := url.Parse(section.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", section.Endpoint)
+	}
+
+	if section.Bucket == "" {
+		return nil, fmt.Errorf("missing S3 bucket")
+	}
+
+	return &S3{
+		client:          &http.Client{Timeout: requestTimeout},
+		endpoint:        endpoint,
+		region:          section.Region,
+		bucket:          section.Bucket,
+		accessKeyID:     section.AccessKeyID,
+		secretAccessKey: section.SecretAccessKey,
+	}, nil
+}
+
+func (s3 *S3) PutObject(context context.Context, object *entity.MediaObject) error {
+	request, err := s3.newRequest(context, http.MethodPut, object.Key, object.Content)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", object.ContentType)
+
+	response, err := s3.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to put object %s: %w", object.Key, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put object %s: %w", object.Key, responseError(response))
+	}
+
+	return nil
+}
+
+func (s3 *S3) GetObject(context context.Context, key string) (*entity.MediaObject, error) {
+	request, err := s3.newRequest(context, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s3.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object %s: %w", key, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get object %s: %w", key, responseError(response))
+	}
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object %s: %w", key, err)
+	}
+
+	return &entity.MediaObject{
+		Key:         key,
+		ContentType: response.Header.Get("Content-Type"),
+		Content:     content,
+	}, nil
+}
+
+func (s3 *S3) DeleteObject(context context.Context, key string) error {
+	request, err := s3.newRequest(context, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := s3.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to delete object %s: %w", key, err)
+	}
+	defer response.Body.Close()
+
+	// Deleting an object that does not exist succeeds on S3, but not on every compatible storage
+	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete object %s: %w", key, responseError(response))
+	}
+
+	return nil
+}
+
+// newRequest builds a signed request for the object saved under the key.
+func (s3 *S3) newRequest(context context.Context, method string, key string, content []byte) (*http.Request, error) {
+	if !entity.IsValidMediaKey(key) {
+		return nil, fmt.Errorf("invalid object key %q", key)
+	}
+
+	objectURL := *s3.endpoint
+	objectURL.Path = strings.TrimSuffix(s3.endpoint.Path, "/") + "/" + s3.bucket + "/" + key
+	objectURL.RawPath = strings.TrimSuffix(s3.endpoint.EscapedPath(), "/") + "/" + uriEncode(s3.bucket) + "/" + uriEncode(key)
+
+	request, err := http.NewRequestWithContext(context, method, objectURL.String(), bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request for object %s: %w", key, err)
+	}
+
+	s3.sign(request, content, time.Now().UTC())
+
+	return request, nil
+}
+
+// sign adds the headers of AWS Signature Version 4 to the request, signing the host, the date and the content.
+func (s3 *S3) sign(request *http.Request, content []byte, now time.Time) {
+	amzDate := now.Format(amzDateLayout)
+	day := amzDate[:8]
+	contentHash := hashHex(content)
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", contentHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		"host:" + request.URL.Host,
+		"x-amz-content-sha256:" + contentHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		contentHash,
+	}, "\n")
+
+	scope := day + "/" + s3.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s3.secretAccessKey), day)
+	signingKey = hmacSHA256(signingKey, s3.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3.accessKeyID,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+// uriEncode escapes everything but the unreserved characters and the slashes, as Signature Version 4 expects.
+func uriEncode(value string) string {
+	builder := strings.Builder{}
+	for _, character := range []byte(value) {
+		isUnreserved := (character >= 'A' && character <= 'Z') || (character >= 'a' && character <= 'z') ||
+			(character >= '0' && character <= '9') || strings.IndexByte("-_.~/", character) >= 0
+		if isUnreserved {
+			builder.WriteByte(character)
+		} else {
+			builder.WriteString(fmt.Sprintf("%%%02X", character))
+		}
+	}
+
+	return builder.String()
+}
+
+func hashHex(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, value string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+
+	return mac.Sum(nil)
+}
+
+// responseError describes an unexpected response, whose body holds the error message of the storage.
+func responseError(response *http.Response) error {
+	message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+
+	return fmt.Errorf("unexpected status %d: %s", response.StatusCode, strings.TrimSpace(string(message)))
+}
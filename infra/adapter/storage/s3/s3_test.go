@@ -0,0 +1,66 @@
+//go:build integration
+// +build integration
+
+package s3_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	storageS3 "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/storage/s3"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test"
+)
+
+func TestS3_Objects(t *testing.T) {
+	t.Parallel()
+
+	storage, err := storageS3.NewStorage(test.GetS3StandIn(t))
+	require.NoError(t, err)
+
+	object := &entity.MediaObject{
+		Key:         "teams/sao-paulo/logo-0123456789abcdef.png",
+		ContentType: "image/png",
+		Content:     []byte("\x89PNG\r\n\x1a\nnot really an image"),
+	}
+
+	missingObject, err := storage.GetObject(context.Background(), object.Key)
+	require.NoError(t, err)
+	require.Nil(t, missingObject)
+
+	err = storage.PutObject(context.Background(), object)
+	require.NoError(t, err)
+
+	obtainedObject, err := storage.GetObject(context.Background(), object.Key)
+	require.NoError(t, err)
+	require.Equal(t, object, obtainedObject)
+
+	err = storage.DeleteObject(context.Background(), object.Key)
+	require.NoError(t, err)
+
+	deletedObject, err := storage.GetObject(context.Background(), object.Key)
+	require.NoError(t, err)
+	require.Nil(t, deletedObject)
+
+	// Deleting an object that is already gone is not an error
+	err = storage.DeleteObject(context.Background(), object.Key)
+	require.NoError(t, err)
+}
+
+func TestS3_RejectedCredentials(t *testing.T) {
+	t.Parallel()
+
+	section := test.GetS3StandIn(t)
+	section.AccessKeyID = "someone_else"
+	storage, err := storageS3.NewStorage(section)
+	require.NoError(t, err)
+
+	err = storage.PutObject(context.Background(), &entity.MediaObject{
+		Key:         "people/joao.silva/avatar-0123456789abcdef.png",
+		ContentType: "image/png",
+		Content:     []byte("content"),
+	})
+	require.ErrorContains(t, err, "unexpected status 403")
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler"
 
 	echo "github.com/labstack/echo/v4"
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/config"
@@ -17,12 +19,14 @@ type App struct {
 	config       *config.Application
 	logger       logger.Logger
 	repositories repository.Collection
+	storage      storage.Storage
 }
 
 func NewApp(
 	logger logger.Logger,
 	config *config.Application,
 	repositories repository.Collection,
+	storage storage.Storage,
 ) *App {
 	logger.Info("initializing the HTTP server...")
 
@@ -31,6 +35,7 @@ func NewApp(
 		config:       config,
 		logger:       logger,
 		repositories: repositories,
+		storage:      storage,
 	}
 
 	app.configure()
@@ -39,6 +44,9 @@ func NewApp(
 }
 
 func (app *App) configure() {
+	// Every error is answered as a problem that tells the correlation ID of its request
+	app.router.Use(handler.ProblemsEchoMiddlewareV1(app.logger))
+
 	app.configureRoutes()
 }
 
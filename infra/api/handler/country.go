@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetAllCountriesEchoHandlerV1 is the adapter from the Echo ecosystem to the GetAllCountries handler.
+func GetAllCountriesEchoHandlerV1(param handlerParam.GetAllCountriesHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Locale = echoContext.QueryParam("locale")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetAllCountriesHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetAllCountriesHandlerV1 is the entry point to the application's logic for listing the known countries.
+func GetAllCountriesHandlerV1(
+	context context.Context,
+	param handlerParam.GetAllCountriesHandlerV1,
+) handlerResult.GetAllCountriesHandlerV1 {
+	invalidInput := payload.ValidateCountryLocale(param.Locale)
+	if invalidInput != nil {
+		return handlerResult.GetAllCountriesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	locale := payload.CountryLocale(param.Locale)
+	result, err := domainService.GetAllCountries(context, domainServiceParam.GetAllCountries{
+		Locale:     locale,
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.GetAllCountriesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get all countries from domain service: %w", err),
+			},
+		}
+	}
+
+	return handlerResult.GetAllCountriesHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.CountryEntitiesToCountries(result.Countries, locale),
+		},
+	}
+}
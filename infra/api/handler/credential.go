@@ -0,0 +1,340 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetPersonCredentialsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetPersonCredentials handler.
+func GetPersonCredentialsEchoHandlerV1(param handlerParam.GetPersonCredentialsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetPersonCredentialsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetPersonCredentialsHandlerV1 is the entry point to the application's logic of listing the WFDF accreditations,
+// federation memberships and coach certifications of a person.
+func GetPersonCredentialsHandlerV1(
+	context context.Context,
+	param handlerParam.GetPersonCredentialsHandlerV1,
+) handlerResult.GetPersonCredentialsHandlerV1 {
+	result, err := applicationService.GetPersonCredentials(context, applicationParam.GetPersonCredentials{
+		PersonUserName: param.PersonUserName,
+
+		PersonRepository:     param.PersonRepository,
+		CredentialRepository: param.CredentialRepository,
+	})
+	if err != nil {
+		return handlerResult.GetPersonCredentialsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get credentials of '%s' from application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.GetPersonCredentialsHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.GetPersonCredentialsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonCredentials{
+				PersonUserName:        result.Person.UserName,
+				WFDFAccreditations:    payload.WFDFAccreditationEntitiesToWFDFAccreditations(result.WFDFAccreditations),
+				FederationMemberships: payload.FederationMembershipEntitiesToFederationMemberships(result.FederationMemberships),
+				CoachCertifications:   payload.CoachCertificationEntitiesToCoachCertifications(result.CoachCertifications),
+			},
+		},
+	}
+}
+
+// CreateWFDFAccreditationEchoHandlerV1 is the adapter from the Echo ecosystem to the CreateWFDFAccreditation handler.
+func CreateWFDFAccreditationEchoHandlerV1(param handlerParam.CreateWFDFAccreditationHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		var input payload.WFDFAccreditationInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, CreateWFDFAccreditationHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// CreateWFDFAccreditationHandlerV1 is the entry point to the application's logic of registering the WFDF rules
+// accreditation of a person.
+func CreateWFDFAccreditationHandlerV1(
+	context context.Context,
+	param handlerParam.CreateWFDFAccreditationHandlerV1,
+) handlerResult.CreateWFDFAccreditationHandlerV1 {
+	invalidInput := payload.ValidateWFDFAccreditationInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.CreateWFDFAccreditationHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.CreateWFDFAccreditation(context, applicationParam.CreateWFDFAccreditation{
+		PersonUserName: param.PersonUserName,
+		Level:          entity.WFDFAccreditationLevel(*param.Payload.Level),
+		IssueDate:      payload.ParseDate(param.Payload.IssueDate),
+		ExpiryDate:     payload.ParseDate(param.Payload.ExpiryDate),
+		CreatedBy:      *param.Payload.CreatedBy,
+
+		PersonRepository:     param.PersonRepository,
+		CredentialRepository: param.CredentialRepository,
+	})
+	if err != nil {
+		return handlerResult.CreateWFDFAccreditationHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to create WFDF accreditation of '%s' in application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.CreateWFDFAccreditationHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.CreateWFDFAccreditationHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.WFDFAccreditationEntityToWFDFAccreditation(result.Accreditation),
+		},
+	}
+}
+
+// CreateFederationMembershipEchoHandlerV1 is the adapter from the Echo ecosystem to the CreateFederationMembership
+// handler.
+func CreateFederationMembershipEchoHandlerV1(param handlerParam.CreateFederationMembershipHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		var input payload.FederationMembershipInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, CreateFederationMembershipHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// CreateFederationMembershipHandlerV1 is the entry point to the application's logic of registering the membership of
+// a person in a national federation for a season.
+func CreateFederationMembershipHandlerV1(
+	context context.Context,
+	param handlerParam.CreateFederationMembershipHandlerV1,
+) handlerResult.CreateFederationMembershipHandlerV1 {
+	invalidInput := payload.ValidateFederationMembershipInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.CreateFederationMembershipHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	federationSlug := *param.Payload.FederationSlug
+	result, err := applicationService.CreateFederationMembership(context, applicationParam.CreateFederationMembership{
+		PersonUserName: param.PersonUserName,
+		FederationSlug: federationSlug,
+		Season:         *param.Payload.Season,
+		ValidFrom:      payload.ParseDate(param.Payload.ValidFrom),
+		ValidUntil:     payload.ParseDate(param.Payload.ValidUntil),
+		CreatedBy:      *param.Payload.CreatedBy,
+
+		PersonRepository:      param.PersonRepository,
+		LegalEntityRepository: param.LegalEntityRepository,
+		CredentialRepository:  param.CredentialRepository,
+	})
+	if err != nil {
+		return handlerResult.CreateFederationMembershipHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to create federation membership of '%s' in application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.CreateFederationMembershipHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	if result.Federation == nil {
+		return handlerResult.CreateFederationMembershipHandlerV1{
+			HTTP: legalEntityNotFoundHTTPResult(federationSlug),
+		}
+	}
+
+	return handlerResult.CreateFederationMembershipHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.FederationMembershipEntityToFederationMembership(result.Membership),
+		},
+	}
+}
+
+// CreateCoachCertificationEchoHandlerV1 is the adapter from the Echo ecosystem to the CreateCoachCertification handler.
+func CreateCoachCertificationEchoHandlerV1(param handlerParam.CreateCoachCertificationHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		var input payload.CoachCertificationInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, CreateCoachCertificationHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// CreateCoachCertificationHandlerV1 is the entry point to the application's logic of registering a coaching
+// certification of a person.
+func CreateCoachCertificationHandlerV1(
+	context context.Context,
+	param handlerParam.CreateCoachCertificationHandlerV1,
+) handlerResult.CreateCoachCertificationHandlerV1 {
+	invalidInput := payload.ValidateCoachCertificationInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.CreateCoachCertificationHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.CreateCoachCertification(context, applicationParam.CreateCoachCertification{
+		PersonUserName: param.PersonUserName,
+		Name:           *param.Payload.Name,
+		IssuedBy:       *param.Payload.IssuedBy,
+		IssueDate:      payload.ParseDate(param.Payload.IssueDate),
+		ExpiryDate:     payload.ParseDate(param.Payload.ExpiryDate),
+		CreatedBy:      *param.Payload.CreatedBy,
+
+		PersonRepository:     param.PersonRepository,
+		CredentialRepository: param.CredentialRepository,
+	})
+	if err != nil {
+		return handlerResult.CreateCoachCertificationHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to create coach certification of '%s' in application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.CreateCoachCertificationHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.CreateCoachCertificationHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.CoachCertificationEntityToCoachCertification(result.Certification),
+		},
+	}
+}
+
+// GetTeamExpiringWFDFAccreditationsEchoHandlerV1 is the adapter from the Echo ecosystem to the
+// GetTeamExpiringWFDFAccreditations handler.
+func GetTeamExpiringWFDFAccreditationsEchoHandlerV1(param handlerParam.GetTeamExpiringWFDFAccreditationsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.Date = echoContext.QueryParam("date")
+		param.Roster = echoContext.QueryParams()["roster"]
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamExpiringWFDFAccreditationsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamExpiringWFDFAccreditationsHandlerV1 is the entry point to the application's logic of listing the players of
+// a team whose WFDF accreditation is missing or expires before the given date. The players are the ones of the
+// 'roster' query params or, when there are none, the active members of the team on that date.
+func GetTeamExpiringWFDFAccreditationsHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamExpiringWFDFAccreditationsHandlerV1,
+) handlerResult.GetTeamExpiringWFDFAccreditationsHandlerV1 {
+	invalidInput := payload.JoinInvalidInputs(
+		payload.ValidateExpiringWFDFAccreditationsDate(param.Date),
+		payload.ValidateRosterParams(param.Roster),
+	)
+	if invalidInput != nil {
+		return handlerResult.GetTeamExpiringWFDFAccreditationsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.GetTeamExpiringWFDFAccreditations(context, applicationParam.GetTeamExpiringWFDFAccreditations{
+		TeamName: param.TeamName,
+		Date:     payload.ParseDate(&param.Date),
+		Roster:   param.Roster,
+
+		TeamRepository:       param.TeamRepository,
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		CredentialRepository: param.CredentialRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamExpiringWFDFAccreditationsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get expiring WFDF accreditations of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamExpiringWFDFAccreditationsHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamExpiringWFDFAccreditationsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.WFDFAccreditationStatusEntitiesToWFDFAccreditationStatuses(result.Statuses),
+		},
+	}
+}
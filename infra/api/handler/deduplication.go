@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// FindDuplicatePeopleEchoHandlerV1 is the adapter from the Echo ecosystem to the FindDuplicatePeople handler.
+func FindDuplicatePeopleEchoHandlerV1(param handlerParam.FindDuplicatePeopleHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.MinimumScore = echoContext.QueryParam("minimumScore")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, FindDuplicatePeopleHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// FindDuplicatePeopleHandlerV1 is the entry point to the application's logic of detecting people that were registered
+// more than once. The pairs are compared by name, email, phone number and WFDF number and ranked by their score.
+func FindDuplicatePeopleHandlerV1(
+	context context.Context,
+	param handlerParam.FindDuplicatePeopleHandlerV1,
+) handlerResult.FindDuplicatePeopleHandlerV1 {
+	invalidInput := payload.ValidateMinimumScore(param.MinimumScore)
+	if invalidInput != nil {
+		return handlerResult.FindDuplicatePeopleHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.FindDuplicatePeople(context, domainServiceParam.FindDuplicatePeople{
+		MinimumScore: payload.ParseMinimumScore(param.MinimumScore),
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.FindDuplicatePeopleHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to find duplicate people in domain service: %w", err),
+			},
+		}
+	}
+
+	return handlerResult.FindDuplicatePeopleHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.DuplicatePairsToDuplicatePeople(result.Pairs),
+		},
+	}
+}
+
+// MergePeopleEchoHandlerV1 is the adapter from the Echo ecosystem to the MergePeople handler.
+func MergePeopleEchoHandlerV1(param handlerParam.MergePeopleHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+
+		var input payload.PersonMergeInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, MergePeopleHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// MergePeopleHandlerV1 is the entry point to the application's logic of merging a duplicate into the person. The
+// records of the duplicate are moved to the person and its username is left as an alias, which is redirected to the
+// person.
+func MergePeopleHandlerV1(context context.Context, param handlerParam.MergePeopleHandlerV1) handlerResult.MergePeopleHandlerV1 {
+	invalidInput := payload.ValidatePersonMergeInput(&param.Payload, param.UserName)
+	if invalidInput != nil {
+		return handlerResult.MergePeopleHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.MergePeople(context, domainServiceParam.MergePeople{
+		UserName:          param.UserName,
+		DuplicateUserName: *param.Payload.DuplicateUserName,
+		UpdatedBy:         *param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.MergePeopleHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf(
+					"failed to merge person '%s' into '%s' in domain service: %w",
+					*param.Payload.DuplicateUserName,
+					param.UserName,
+					err,
+				),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.MergePeopleHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	if result.Duplicate == nil {
+		return handlerResult.MergePeopleHandlerV1{
+			HTTP: personNotFoundHTTPResult(*param.Payload.DuplicateUserName),
+		}
+	}
+
+	return handlerResult.MergePeopleHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.MergedPerson, entity.Audiences.Public),
+		},
+	}
+}
+
+// RedirectPersonAliasesEchoMiddlewareV1 redirects the requests that address a person by the username of a duplicate
+// merged into them to the same route under their own username.
+func RedirectPersonAliasesEchoMiddlewareV1(param handlerParam.RedirectPersonAliasesMiddlewareV1) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(echoContext echo.Context) error {
+			userName := echoContext.Param("username")
+			if userName == "" {
+				return next(echoContext)
+			}
+
+			result, err := domainService.GetPersonByAlias(echoContext.Request().Context(), domainServiceParam.GetPersonByAlias{
+				Alias: userName,
+
+				Repository: param.Repository,
+			})
+			if err != nil {
+				return DispatchEchoResponseFromString(
+					echoContext,
+					http.StatusInternalServerError,
+					fmt.Sprintf("failed to get person by alias '%s' from domain service: %s", userName, err.Error()),
+				)
+			}
+			if result.Person == nil {
+				return next(echoContext)
+			}
+
+			return redirectToParamValue(echoContext, "username", result.Person.UserName)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CheckRosterEligibilityEchoHandlerV1 is the adapter from the Echo ecosystem to the CheckRosterEligibility handler.
+func CheckRosterEligibilityEchoHandlerV1(param handlerParam.CheckRosterEligibilityHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		var input payload.RosterEligibilityInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, CheckRosterEligibilityHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// CheckRosterEligibilityHandlerV1 is the entry point to the application's logic of checking the roster of a team for
+// an event against the rules of the event. Every violation of every player is answered, so that the roster can be
+// fixed at once.
+func CheckRosterEligibilityHandlerV1(
+	context context.Context,
+	param handlerParam.CheckRosterEligibilityHandlerV1,
+) handlerResult.CheckRosterEligibilityHandlerV1 {
+	invalidInput := payload.ValidateRosterEligibilityInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.CheckRosterEligibilityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	ruleNames, settings := payload.ParseRosterEligibilityRules(&param.Payload)
+	result, err := applicationService.CheckRosterEligibility(context, applicationParam.CheckRosterEligibility{
+		TeamName:       param.TeamName,
+		Roster:         param.Payload.Roster,
+		EventStartDate: payload.ParseDate(param.Payload.EventStartDate),
+		EventEndDate:   payload.ParseDate(param.Payload.EventEndDate),
+		RuleNames:      ruleNames,
+		Settings:       settings,
+
+		TeamRepository:       param.TeamRepository,
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		CredentialRepository: param.CredentialRepository,
+		GuardianRepository:   param.GuardianRepository,
+	})
+	if err != nil {
+		return handlerResult.CheckRosterEligibilityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to check roster eligibility of team '%s' in application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.CheckRosterEligibilityHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.CheckRosterEligibilityHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.EligibilityViolationsToRosterEligibility(result.Eligible, result.Violations),
+		},
+	}
+}
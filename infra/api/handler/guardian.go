@@ -0,0 +1,363 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetGuardiansEchoHandlerV1 is the adapter from the Echo ecosystem to the GetGuardians handler.
+func GetGuardiansEchoHandlerV1(param handlerParam.GetGuardiansHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.DependentUserName = echoContext.Param("username")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetGuardiansHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetGuardiansHandlerV1 is the entry point to the application's logic of listing the guardians of a person.
+func GetGuardiansHandlerV1(
+	context context.Context,
+	param handlerParam.GetGuardiansHandlerV1,
+) handlerResult.GetGuardiansHandlerV1 {
+	result, err := applicationService.GetGuardians(context, applicationParam.GetGuardians{
+		DependentUserName: param.DependentUserName,
+
+		PersonRepository:   param.PersonRepository,
+		GuardianRepository: param.GuardianRepository,
+	})
+	if err != nil {
+		return handlerResult.GetGuardiansHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get guardians of '%s' from application service: %w", param.DependentUserName, err),
+			},
+		}
+	}
+
+	if result.Dependent == nil {
+		return handlerResult.GetGuardiansHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.DependentUserName),
+		}
+	}
+
+	return handlerResult.GetGuardiansHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.GuardianshipEntitiesToGuardianships(result.Guardianships),
+		},
+	}
+}
+
+// AddGuardianEchoHandlerV1 is the adapter from the Echo ecosystem to the AddGuardian handler.
+func AddGuardianEchoHandlerV1(param handlerParam.AddGuardianHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.DependentUserName = echoContext.Param("username")
+
+		var input payload.GuardianInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, AddGuardianHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// AddGuardianHandlerV1 is the entry point to the application's logic of registering a person as a guardian of a
+// minor, who can then grant consents on their behalf and follow their schedule.
+func AddGuardianHandlerV1(
+	context context.Context,
+	param handlerParam.AddGuardianHandlerV1,
+) handlerResult.AddGuardianHandlerV1 {
+	invalidInput := payload.ValidateGuardianInput(&param.Payload, param.DependentUserName)
+	if invalidInput != nil {
+		return handlerResult.AddGuardianHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	guardianUserName := *param.Payload.GuardianUserName
+	result, err := applicationService.AddGuardian(context, applicationParam.AddGuardian{
+		DependentUserName: param.DependentUserName,
+		GuardianUserName:  guardianUserName,
+		Relationship:      *param.Payload.Relationship,
+		CreatedBy:         *param.Payload.CreatedBy,
+
+		PersonRepository:   param.PersonRepository,
+		GuardianRepository: param.GuardianRepository,
+	})
+	if err != nil {
+		return handlerResult.AddGuardianHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to add guardian of '%s' in application service: %w", param.DependentUserName, err),
+			},
+		}
+	}
+
+	if result.Dependent == nil {
+		return handlerResult.AddGuardianHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.DependentUserName),
+		}
+	}
+
+	if result.Guardian == nil {
+		return handlerResult.AddGuardianHandlerV1{
+			HTTP: personNotFoundHTTPResult(guardianUserName),
+		}
+	}
+
+	return handlerResult.AddGuardianHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.GuardianshipEntityToGuardianship(result.Guardianship),
+		},
+	}
+}
+
+// RemoveGuardianEchoHandlerV1 is the adapter from the Echo ecosystem to the RemoveGuardian handler.
+func RemoveGuardianEchoHandlerV1(param handlerParam.RemoveGuardianHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.DependentUserName = echoContext.Param("username")
+		param.GuardianUserName = echoContext.Param("guardianUsername")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, RemoveGuardianHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// RemoveGuardianHandlerV1 is the entry point to the application's logic of stopping a person from being a guardian of
+// a minor. The consents they already granted are kept.
+func RemoveGuardianHandlerV1(
+	context context.Context,
+	param handlerParam.RemoveGuardianHandlerV1,
+) handlerResult.RemoveGuardianHandlerV1 {
+	result, err := applicationService.RemoveGuardian(context, applicationParam.RemoveGuardian{
+		DependentUserName: param.DependentUserName,
+		GuardianUserName:  param.GuardianUserName,
+
+		PersonRepository:   param.PersonRepository,
+		GuardianRepository: param.GuardianRepository,
+	})
+	if err != nil {
+		return handlerResult.RemoveGuardianHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to remove guardian of '%s' in application service: %w", param.DependentUserName, err),
+			},
+		}
+	}
+
+	if result.Dependent == nil {
+		return handlerResult.RemoveGuardianHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.DependentUserName),
+		}
+	}
+
+	if result.Guardianship == nil {
+		return handlerResult.RemoveGuardianHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: failure.ErrGuardianshipNotFound.WithMessage(fmt.Sprintf("'%s' is not a guardian of '%s'", param.GuardianUserName, param.DependentUserName)),
+			},
+		}
+	}
+
+	return handlerResult.RemoveGuardianHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.GuardianshipEntityToGuardianship(result.Guardianship),
+		},
+	}
+}
+
+// GetConsentsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetConsents handler.
+func GetConsentsEchoHandlerV1(param handlerParam.GetConsentsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetConsentsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetConsentsHandlerV1 is the entry point to the application's logic of listing the consents granted on behalf of a
+// person.
+func GetConsentsHandlerV1(
+	context context.Context,
+	param handlerParam.GetConsentsHandlerV1,
+) handlerResult.GetConsentsHandlerV1 {
+	result, err := applicationService.GetConsents(context, applicationParam.GetConsents{
+		PersonUserName: param.PersonUserName,
+
+		PersonRepository:   param.PersonRepository,
+		GuardianRepository: param.GuardianRepository,
+	})
+	if err != nil {
+		return handlerResult.GetConsentsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get consents of '%s' from application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.GetConsentsHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.GetConsentsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.ConsentEntitiesToConsents(result.Consents),
+		},
+	}
+}
+
+// GrantConsentEchoHandlerV1 is the adapter from the Echo ecosystem to the GrantConsent handler.
+func GrantConsentEchoHandlerV1(param handlerParam.GrantConsentHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		var input payload.ConsentInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GrantConsentHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GrantConsentHandlerV1 is the entry point to the application's logic of recording a consent that a guardian granted
+// on behalf of a minor.
+func GrantConsentHandlerV1(
+	context context.Context,
+	param handlerParam.GrantConsentHandlerV1,
+) handlerResult.GrantConsentHandlerV1 {
+	invalidInput := payload.ValidateConsentInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.GrantConsentHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	grantedBy := *param.Payload.GrantedBy
+	result, err := applicationService.GrantConsent(context, applicationParam.GrantConsent{
+		PersonUserName:    param.PersonUserName,
+		Kind:              entity.ConsentKind(*param.Payload.Kind),
+		GrantedByUserName: grantedBy,
+		GrantDate:         payload.ParseDate(param.Payload.GrantDate),
+		ExpiryDate:        payload.ParseDate(param.Payload.ExpiryDate),
+		CreatedBy:         *param.Payload.CreatedBy,
+
+		PersonRepository:   param.PersonRepository,
+		GuardianRepository: param.GuardianRepository,
+	})
+	if err != nil {
+		return handlerResult.GrantConsentHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to grant consent of '%s' in application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.GrantConsentHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.GrantConsentHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.ConsentEntityToConsent(result.Consent),
+		},
+	}
+}
+
+// GetDependentsScheduleEchoHandlerV1 is the adapter from the Echo ecosystem to the GetDependentsSchedule handler.
+func GetDependentsScheduleEchoHandlerV1(param handlerParam.GetDependentsScheduleHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.GuardianUserName = echoContext.Param("username")
+		param.From = echoContext.QueryParam("from")
+		param.To = echoContext.QueryParam("to")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetDependentsScheduleHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetDependentsScheduleHandlerV1 is the entry point to the application's logic of listing the team events of the
+// dependents of a guardian.
+func GetDependentsScheduleHandlerV1(
+	context context.Context,
+	param handlerParam.GetDependentsScheduleHandlerV1,
+) handlerResult.GetDependentsScheduleHandlerV1 {
+	invalidInput := payload.ValidateTeamEventPeriod(param.From, param.To)
+	if invalidInput != nil {
+		return handlerResult.GetDependentsScheduleHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	from, to := payload.ParseTeamEventPeriod(param.From, param.To)
+	result, err := applicationService.GetDependentsSchedule(context, applicationParam.GetDependentsSchedule{
+		GuardianUserName: param.GuardianUserName,
+		From:             from,
+		To:               to,
+
+		PersonRepository:     param.PersonRepository,
+		GuardianRepository:   param.GuardianRepository,
+		MembershipRepository: param.MembershipRepository,
+		TeamEventRepository:  param.TeamEventRepository,
+	})
+	if err != nil {
+		return handlerResult.GetDependentsScheduleHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get the schedule of the dependents of '%s' from application service: %w", param.GuardianUserName, err),
+			},
+		}
+	}
+
+	if result.Guardian == nil {
+		return handlerResult.GetDependentsScheduleHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.GuardianUserName),
+		}
+	}
+
+	return handlerResult.GetDependentsScheduleHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.DependentTeamEventEntitiesToDependentTeamEvents(result.Events),
+		},
+	}
+}
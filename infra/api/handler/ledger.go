@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetTeamLedgerEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamLedger handler.
+func GetTeamLedgerEchoHandlerV1(param handlerParam.GetTeamLedgerHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamLedgerHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamLedgerHandlerV1 is the entry point to the application's logic of listing the transactions of a team ledger.
+func GetTeamLedgerHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamLedgerHandlerV1,
+) handlerResult.GetTeamLedgerHandlerV1 {
+	result, err := applicationService.GetTeamLedger(context, applicationParam.GetTeamLedger{
+		TeamName: param.TeamName,
+
+		TeamRepository:   param.TeamRepository,
+		LedgerRepository: param.LedgerRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamLedgerHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get ledger of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamLedgerHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamLedgerHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LedgerTransactionEntitiesToLedgerTransactions(result.Transactions),
+		},
+	}
+}
+
+// GetTeamLedgerBalancesEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamLedgerBalances handler.
+func GetTeamLedgerBalancesEchoHandlerV1(param handlerParam.GetTeamLedgerBalancesHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamLedgerBalancesHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamLedgerBalancesHandlerV1 is the entry point to the application's logic of reporting outstanding balances of a team.
+func GetTeamLedgerBalancesHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamLedgerBalancesHandlerV1,
+) handlerResult.GetTeamLedgerBalancesHandlerV1 {
+	result, err := applicationService.GetTeamLedgerBalances(context, applicationParam.GetTeamLedgerBalances{
+		TeamName: param.TeamName,
+
+		TeamRepository:   param.TeamRepository,
+		LedgerRepository: param.LedgerRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamLedgerBalancesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get ledger balances of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamLedgerBalancesHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamLedgerBalancesHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LedgerBalanceEntitiesToLedgerBalanceReport(
+				result.Team.Slug,
+				result.Balances,
+				result.TotalOutstandingInCents,
+			),
+		},
+	}
+}
+
+// ChargeTeamDuesEchoHandlerV1 is the adapter from the Echo ecosystem to the ChargeTeamDues handler.
+func ChargeTeamDuesEchoHandlerV1(param handlerParam.ChargeTeamDuesHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		var input payload.ChargeDuesInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, ChargeTeamDuesHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// ChargeTeamDuesHandlerV1 is the entry point to the application's logic of charging dues to the active members of a team.
+func ChargeTeamDuesHandlerV1(
+	context context.Context,
+	param handlerParam.ChargeTeamDuesHandlerV1,
+) handlerResult.ChargeTeamDuesHandlerV1 {
+	invalidInput := payload.ValidateChargeDuesInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.ChargeTeamDuesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.ChargeTeamDues(context, applicationParam.ChargeTeamDues{
+		TeamName:      param.TeamName,
+		AmountInCents: *param.Payload.AmountInCents,
+		Description:   payload.StringValue(param.Payload.Description),
+		EffectiveDate: payload.ParseDate(param.Payload.EffectiveDate),
+		CreatedBy:     *param.Payload.CreatedBy,
+
+		TeamRepository:       param.TeamRepository,
+		MembershipRepository: param.MembershipRepository,
+		LedgerRepository:     param.LedgerRepository,
+	})
+	if err != nil {
+		return handlerResult.ChargeTeamDuesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to charge dues of team '%s' in application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.ChargeTeamDuesHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.ChargeTeamDuesHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LedgerTransactionEntityToLedgerTransaction(result.Transaction),
+		},
+	}
+}
+
+// ChargeTeamTournamentFeeEchoHandlerV1 is the adapter from the Echo ecosystem to the ChargeTeamTournamentFee handler.
+func ChargeTeamTournamentFeeEchoHandlerV1(param handlerParam.ChargeTeamTournamentFeeHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		var input payload.ChargeTournamentFeeInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, ChargeTeamTournamentFeeHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// ChargeTeamTournamentFeeHandlerV1 is the entry point to the application's logic of splitting a tournament fee among rostered players.
+func ChargeTeamTournamentFeeHandlerV1(
+	context context.Context,
+	param handlerParam.ChargeTeamTournamentFeeHandlerV1,
+) handlerResult.ChargeTeamTournamentFeeHandlerV1 {
+	invalidInput := payload.ValidateChargeTournamentFeeInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.ChargeTeamTournamentFeeHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.ChargeTeamTournamentFee(context, applicationParam.ChargeTeamTournamentFee{
+		TeamName:           param.TeamName,
+		PersonUserNames:    param.Payload.PersonUserNames,
+		TotalAmountInCents: *param.Payload.TotalAmountInCents,
+		Description:        payload.StringValue(param.Payload.Description),
+		EffectiveDate:      payload.ParseDate(param.Payload.EffectiveDate),
+		CreatedBy:          *param.Payload.CreatedBy,
+
+		TeamRepository:       param.TeamRepository,
+		MembershipRepository: param.MembershipRepository,
+		LedgerRepository:     param.LedgerRepository,
+	})
+	if err != nil {
+		return handlerResult.ChargeTeamTournamentFeeHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to charge tournament fee of team '%s' in application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.ChargeTeamTournamentFeeHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.ChargeTeamTournamentFeeHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LedgerTransactionEntityToLedgerTransaction(result.Transaction),
+		},
+	}
+}
+
+// RecordTeamPaymentEchoHandlerV1 is the adapter from the Echo ecosystem to the RecordTeamPayment handler.
+func RecordTeamPaymentEchoHandlerV1(param handlerParam.RecordTeamPaymentHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		var input payload.RecordPaymentInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, RecordTeamPaymentHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// RecordTeamPaymentHandlerV1 is the entry point to the application's logic of recording a payment made by a team member.
+func RecordTeamPaymentHandlerV1(
+	context context.Context,
+	param handlerParam.RecordTeamPaymentHandlerV1,
+) handlerResult.RecordTeamPaymentHandlerV1 {
+	invalidInput := payload.ValidateRecordPaymentInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.RecordTeamPaymentHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.RecordTeamPayment(context, applicationParam.RecordTeamPayment{
+		TeamName:       param.TeamName,
+		PersonUserName: param.Payload.PersonUserName,
+		AmountInCents:  *param.Payload.AmountInCents,
+		Description:    payload.StringValue(param.Payload.Description),
+		EffectiveDate:  payload.ParseDate(param.Payload.EffectiveDate),
+		CreatedBy:      *param.Payload.CreatedBy,
+
+		TeamRepository:       param.TeamRepository,
+		MembershipRepository: param.MembershipRepository,
+		LedgerRepository:     param.LedgerRepository,
+	})
+	if err != nil {
+		return handlerResult.RecordTeamPaymentHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to record payment to team '%s' in application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.RecordTeamPaymentHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.RecordTeamPaymentHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LedgerTransactionEntityToLedgerTransaction(result.Transaction),
+		},
+	}
+}
+
+func teamNotFoundHTTPResult(teamName string) handlerResult.HTTP {
+	return handlerResult.HTTP{
+		Error: failure.ErrTeamNotFound.WithMessage(fmt.Sprintf("no team with name '%s' was found in the repository", teamName)),
+	}
+}
@@ -0,0 +1,548 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetAllLegalEntitiesEchoHandlerV1 is the adapter from the Echo ecosystem to the GetAllLegalEntities handler.
+func GetAllLegalEntitiesEchoHandlerV1(param handlerParam.GetAllLegalEntitiesHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Limit = echoContext.QueryParam("limit")
+		param.Cursor = echoContext.QueryParam("cursor")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetAllLegalEntitiesHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetAllLegalEntitiesHandlerV1 is the entry point to the application's logic for fetching a list of existing legal
+// entities, a page at a time.
+func GetAllLegalEntitiesHandlerV1(
+	context context.Context,
+	param handlerParam.GetAllLegalEntitiesHandlerV1,
+) handlerResult.GetAllLegalEntitiesHandlerV1 {
+	invalidInput := payload.ValidatePageParams(param.Limit, param.Cursor, param.MaxPageLimit)
+	if invalidInput != nil {
+		return handlerResult.GetAllLegalEntitiesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.GetAllLegalEntities(context, domainServiceParam.GetAllLegalEntities{
+		Page: payload.ParsePageParams(param.Limit, param.Cursor, param.DefaultPageLimit),
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.GetAllLegalEntitiesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get all legal entities from domain service: %w", err),
+			},
+		}
+	}
+
+	return handlerResult.GetAllLegalEntitiesHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityPage{
+				Items:      payload.LegalEntityEntitiesToLegalEntities(result.LegalEntities),
+				NextCursor: payload.NextCursor(result.NextAfter),
+			},
+		},
+	}
+}
+
+// GetLegalEntityBySlugEchoHandlerV1 is the adapter from the Echo ecosystem to the GetLegalEntityBySlug handler.
+func GetLegalEntityBySlugEchoHandlerV1(param handlerParam.GetLegalEntityBySlugHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Slug = echoContext.Param("slug")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetLegalEntityBySlugHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetLegalEntityBySlugHandlerV1 is the entry point to the application's logic for fetching a legal entity by its slug.
+func GetLegalEntityBySlugHandlerV1(
+	context context.Context,
+	param handlerParam.GetLegalEntityBySlugHandlerV1,
+) handlerResult.GetLegalEntityBySlugHandlerV1 {
+	result, err := domainService.GetLegalEntityBySlug(context, domainServiceParam.GetLegalEntityBySlug{
+		Slug:       param.Slug,
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.GetLegalEntityBySlugHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get legal entity with slug '%s' from domain service: %w", param.Slug, err),
+			},
+		}
+	}
+
+	if result.LegalEntity == nil {
+		return handlerResult.GetLegalEntityBySlugHandlerV1{
+			HTTP: legalEntityNotFoundHTTPResult(param.Slug),
+		}
+	}
+
+	return handlerResult.GetLegalEntityBySlugHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityEntityToLegalEntity(result.LegalEntity),
+		},
+	}
+}
+
+// CreateLegalEntityEchoHandlerV1 is the adapter from the Echo ecosystem to the CreateLegalEntity handler.
+func CreateLegalEntityEchoHandlerV1(param handlerParam.CreateLegalEntityHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+
+		var legalEntity payload.LegalEntity
+		err := echoContext.Bind(&legalEntity)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = legalEntity
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, CreateLegalEntityHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// CreateLegalEntityHandlerV1 is the entry point to the application's logic of registering a new legal entity.
+func CreateLegalEntityHandlerV1(
+	context context.Context,
+	param handlerParam.CreateLegalEntityHandlerV1,
+) handlerResult.CreateLegalEntityHandlerV1 {
+	invalidInput := payload.ValidateCreateLegalEntityInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.CreateLegalEntityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.CreateLegalEntity(context, domainServiceParam.CreateLegalEntity{
+		LegalEntity:       payload.LegalEntityToLegalEntityEntity(param.Payload),
+		Repository:        param.Repository,
+		CountryRepository: param.CountryRepository,
+	})
+	if err != nil {
+		return handlerResult.CreateLegalEntityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to create legal entity with slug '%s' in domain service: %w", param.Payload.Slug, err),
+			},
+		}
+	}
+
+	return handlerResult.CreateLegalEntityHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityEntityToLegalEntity(result.LegalEntity),
+		},
+	}
+}
+
+// UpdateLegalEntityEchoHandlerV1 is the adapter from the Echo ecosystem to the UpdateLegalEntity handler.
+func UpdateLegalEntityEchoHandlerV1(param handlerParam.UpdateLegalEntityHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Slug = echoContext.Param("slug")
+
+		var legalEntity payload.LegalEntity
+		err := echoContext.Bind(&legalEntity)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = legalEntity
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, UpdateLegalEntityHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// UpdateLegalEntityHandlerV1 is the entry point to the application's logic of updating info of an existing legal entity.
+func UpdateLegalEntityHandlerV1(
+	context context.Context,
+	param handlerParam.UpdateLegalEntityHandlerV1,
+) handlerResult.UpdateLegalEntityHandlerV1 {
+	invalidInput := payload.ValidateUpdateLegalEntityInput(&param.Payload, param.Slug)
+	if invalidInput != nil {
+		return handlerResult.UpdateLegalEntityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+	param.Payload.Slug = param.Slug
+
+	result, err := domainService.UpdateLegalEntity(context, domainServiceParam.UpdateLegalEntity{
+		LegalEntity:       payload.LegalEntityToLegalEntityEntity(param.Payload),
+		UpdatedAttributes: payload.GetFilledLegalEntityAttributesForUpdate(&param.Payload),
+		Repository:        param.Repository,
+		CountryRepository: param.CountryRepository,
+	})
+	if err != nil {
+		return handlerResult.UpdateLegalEntityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to update legal entity with slug '%s' in domain service: %w", param.Slug, err),
+			},
+		}
+	}
+
+	if result.LegalEntity == nil {
+		return handlerResult.UpdateLegalEntityHandlerV1{
+			HTTP: legalEntityNotFoundHTTPResult(param.Slug),
+		}
+	}
+
+	return handlerResult.UpdateLegalEntityHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityEntityToLegalEntity(result.LegalEntity),
+		},
+	}
+}
+
+// GetLegalEntityAffiliationsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetLegalEntityAffiliations handler.
+func GetLegalEntityAffiliationsEchoHandlerV1(param handlerParam.GetLegalEntityAffiliationsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.LegalEntitySlug = echoContext.Param("slug")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetLegalEntityAffiliationsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetLegalEntityAffiliationsHandlerV1 is the entry point to the application's logic of listing the affiliation
+// history of the teams and people of a legal entity.
+func GetLegalEntityAffiliationsHandlerV1(
+	context context.Context,
+	param handlerParam.GetLegalEntityAffiliationsHandlerV1,
+) handlerResult.GetLegalEntityAffiliationsHandlerV1 {
+	result, err := applicationService.GetLegalEntityAffiliations(context, applicationParam.GetLegalEntityAffiliations{
+		LegalEntitySlug: param.LegalEntitySlug,
+
+		LegalEntityRepository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return handlerResult.GetLegalEntityAffiliationsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get affiliations of legal entity '%s' from application service: %w", param.LegalEntitySlug, err),
+			},
+		}
+	}
+
+	if result.LegalEntity == nil {
+		return handlerResult.GetLegalEntityAffiliationsHandlerV1{
+			HTTP: legalEntityNotFoundHTTPResult(param.LegalEntitySlug),
+		}
+	}
+
+	return handlerResult.GetLegalEntityAffiliationsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityAffiliationEntitiesToLegalEntityAffiliations(result.Affiliations),
+		},
+	}
+}
+
+// AffiliateToLegalEntityEchoHandlerV1 is the adapter from the Echo ecosystem to the AffiliateToLegalEntity handler.
+func AffiliateToLegalEntityEchoHandlerV1(param handlerParam.AffiliateToLegalEntityHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.LegalEntitySlug = echoContext.Param("slug")
+
+		var input payload.LegalEntityAffiliationInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, AffiliateToLegalEntityHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// AffiliateToLegalEntityHandlerV1 is the entry point to the application's logic of affiliating a team or a person
+// to a legal entity.
+func AffiliateToLegalEntityHandlerV1(
+	context context.Context,
+	param handlerParam.AffiliateToLegalEntityHandlerV1,
+) handlerResult.AffiliateToLegalEntityHandlerV1 {
+	invalidInput := payload.ValidateLegalEntityAffiliationInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.AffiliateToLegalEntityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	teamName := payload.StringValue(param.Payload.TeamName)
+	personUserName := payload.StringValue(param.Payload.PersonUserName)
+	result, err := applicationService.AffiliateToLegalEntity(context, applicationParam.AffiliateToLegalEntity{
+		LegalEntitySlug: param.LegalEntitySlug,
+		TeamName:        teamName,
+		PersonUserName:  personUserName,
+		StartDate:       payload.ParseDate(param.Payload.StartDate),
+		EndDate:         payload.ParseDate(param.Payload.EndDate),
+		CreatedBy:       *param.Payload.CreatedBy,
+
+		TeamRepository:        param.TeamRepository,
+		PersonRepository:      param.PersonRepository,
+		LegalEntityRepository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return handlerResult.AffiliateToLegalEntityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to affiliate to legal entity '%s' in application service: %w", param.LegalEntitySlug, err),
+			},
+		}
+	}
+
+	if result.LegalEntity == nil {
+		return handlerResult.AffiliateToLegalEntityHandlerV1{
+			HTTP: legalEntityNotFoundHTTPResult(param.LegalEntitySlug),
+		}
+	}
+
+	if result.Team == nil && result.Person == nil {
+		return handlerResult.AffiliateToLegalEntityHandlerV1{
+			HTTP: affiliateNotFoundHTTPResult(teamName, personUserName),
+		}
+	}
+
+	return handlerResult.AffiliateToLegalEntityHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityAffiliationEntityToLegalEntityAffiliation(result.Affiliation),
+		},
+	}
+}
+
+// EndTeamLegalEntityAffiliationEchoHandlerV1 is the adapter from the Echo ecosystem to the EndLegalEntityAffiliation
+// handler for the affiliation of a team.
+func EndTeamLegalEntityAffiliationEchoHandlerV1(param handlerParam.EndLegalEntityAffiliationHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		param.TeamName = echoContext.Param("name")
+
+		return dispatchEndLegalEntityAffiliation(echoContext, param)
+	}
+}
+
+// EndPersonLegalEntityAffiliationEchoHandlerV1 is the adapter from the Echo ecosystem to the EndLegalEntityAffiliation
+// handler for the affiliation of a person.
+func EndPersonLegalEntityAffiliationEchoHandlerV1(param handlerParam.EndLegalEntityAffiliationHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		param.PersonUserName = echoContext.Param("username")
+
+		return dispatchEndLegalEntityAffiliation(echoContext, param)
+	}
+}
+
+func dispatchEndLegalEntityAffiliation(echoContext echo.Context, param handlerParam.EndLegalEntityAffiliationHandlerV1) error {
+	requestContext := echoContext.Request().Context()
+	param.LegalEntitySlug = echoContext.Param("slug")
+
+	var input payload.EndLegalEntityAffiliationInput
+	err := echoContext.Bind(&input)
+	if err != nil {
+		return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+	}
+	param.Payload = input
+
+	return DispatchEchoResponseFromHandlerResult(echoContext, EndLegalEntityAffiliationHandlerV1(requestContext, param).HTTP)
+}
+
+// EndLegalEntityAffiliationHandlerV1 is the entry point to the application's logic of ending the ongoing
+// affiliation of a team or a person to a legal entity.
+func EndLegalEntityAffiliationHandlerV1(
+	context context.Context,
+	param handlerParam.EndLegalEntityAffiliationHandlerV1,
+) handlerResult.EndLegalEntityAffiliationHandlerV1 {
+	invalidInput := payload.ValidateEndLegalEntityAffiliationInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.EndLegalEntityAffiliationHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.EndLegalEntityAffiliation(context, applicationParam.EndLegalEntityAffiliation{
+		LegalEntitySlug: param.LegalEntitySlug,
+		TeamName:        param.TeamName,
+		PersonUserName:  param.PersonUserName,
+		EndDate:         payload.ParseDate(param.Payload.EndDate),
+		UpdatedBy:       *param.Payload.UpdatedBy,
+
+		TeamRepository:        param.TeamRepository,
+		PersonRepository:      param.PersonRepository,
+		LegalEntityRepository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return handlerResult.EndLegalEntityAffiliationHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to end affiliation to legal entity '%s' in application service: %w", param.LegalEntitySlug, err),
+			},
+		}
+	}
+
+	if result.LegalEntity == nil {
+		return handlerResult.EndLegalEntityAffiliationHandlerV1{
+			HTTP: legalEntityNotFoundHTTPResult(param.LegalEntitySlug),
+		}
+	}
+
+	if result.Team == nil && result.Person == nil {
+		return handlerResult.EndLegalEntityAffiliationHandlerV1{
+			HTTP: affiliateNotFoundHTTPResult(param.TeamName, param.PersonUserName),
+		}
+	}
+
+	return handlerResult.EndLegalEntityAffiliationHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityAffiliationEntityToLegalEntityAffiliation(result.Affiliation),
+		},
+	}
+}
+
+// GetTeamLegalEntityAffiliationsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamLegalEntityAffiliations handler.
+func GetTeamLegalEntityAffiliationsEchoHandlerV1(param handlerParam.GetTeamLegalEntityAffiliationsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamLegalEntityAffiliationsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamLegalEntityAffiliationsHandlerV1 is the entry point to the application's logic of listing the legal entity
+// affiliation history of a team.
+func GetTeamLegalEntityAffiliationsHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamLegalEntityAffiliationsHandlerV1,
+) handlerResult.GetTeamLegalEntityAffiliationsHandlerV1 {
+	result, err := applicationService.GetTeamLegalEntityAffiliations(context, applicationParam.GetTeamLegalEntityAffiliations{
+		TeamName: param.TeamName,
+
+		TeamRepository:        param.TeamRepository,
+		LegalEntityRepository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamLegalEntityAffiliationsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get legal entity affiliations of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamLegalEntityAffiliationsHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamLegalEntityAffiliationsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityAffiliationEntitiesToLegalEntityAffiliations(result.Affiliations),
+		},
+	}
+}
+
+// GetPersonLegalEntityAffiliationsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetPersonLegalEntityAffiliations handler.
+func GetPersonLegalEntityAffiliationsEchoHandlerV1(param handlerParam.GetPersonLegalEntityAffiliationsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetPersonLegalEntityAffiliationsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetPersonLegalEntityAffiliationsHandlerV1 is the entry point to the application's logic of listing the legal
+// entity affiliation history of a person.
+func GetPersonLegalEntityAffiliationsHandlerV1(
+	context context.Context,
+	param handlerParam.GetPersonLegalEntityAffiliationsHandlerV1,
+) handlerResult.GetPersonLegalEntityAffiliationsHandlerV1 {
+	result, err := applicationService.GetPersonLegalEntityAffiliations(context, applicationParam.GetPersonLegalEntityAffiliations{
+		PersonUserName: param.PersonUserName,
+
+		PersonRepository:      param.PersonRepository,
+		LegalEntityRepository: param.LegalEntityRepository,
+	})
+	if err != nil {
+		return handlerResult.GetPersonLegalEntityAffiliationsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get legal entity affiliations of '%s' from application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.GetPersonLegalEntityAffiliationsHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.GetPersonLegalEntityAffiliationsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.LegalEntityAffiliationEntitiesToLegalEntityAffiliations(result.Affiliations),
+		},
+	}
+}
+
+func legalEntityNotFoundHTTPResult(slug string) handlerResult.HTTP {
+	return handlerResult.HTTP{
+		Error: failure.ErrLegalEntityNotFound.WithMessage(fmt.Sprintf("no legal entity with slug '%s' was found in the repository", slug)),
+	}
+}
+
+func personNotFoundHTTPResult(userName string) handlerResult.HTTP {
+	return handlerResult.HTTP{
+		Error: failure.ErrPersonNotFound.WithMessage(fmt.Sprintf("no person with username '%s' was found in the repository", userName)),
+	}
+}
+
+// affiliateNotFoundHTTPResult is returned when the team or, when teamName is empty, the person to affiliate does not exist.
+func affiliateNotFoundHTTPResult(teamName, personUserName string) handlerResult.HTTP {
+	if teamName != "" {
+		return teamNotFoundHTTPResult(teamName)
+	}
+
+	return personNotFoundHTTPResult(personUserName)
+}
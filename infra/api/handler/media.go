@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UploadTeamLogoEchoHandlerV1 is the adapter from the Echo ecosystem to the UploadTeamLogo handler.
+func UploadTeamLogoEchoHandlerV1(param handlerParam.UploadTeamLogoHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		upload, err := readMediaUpload(echoContext, param.MaxUploadBytes)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = upload
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, UploadTeamLogoHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// UploadTeamLogoHandlerV1 is the entry point to the application's logic of replacing the logo of a team.
+func UploadTeamLogoHandlerV1(context context.Context, param handlerParam.UploadTeamLogoHandlerV1) handlerResult.UploadTeamLogoHandlerV1 {
+	invalidInput := payload.ValidateMediaUploadInput(&param.Payload, param.MaxUploadBytes)
+	if invalidInput != nil {
+		return handlerResult.UploadTeamLogoHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.UploadTeamLogo(context, domainServiceParam.UploadTeamLogo{
+		TeamName:    param.TeamName,
+		ContentType: param.Payload.ContentType,
+		Content:     param.Payload.Content,
+		UpdatedBy:   param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+		Storage:    param.Storage,
+	})
+	if err != nil {
+		return handlerResult.UploadTeamLogoHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to upload logo of team '%s' in domain service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.UploadTeamLogoHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.UploadTeamLogoHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEntityToTeam(result.Team),
+		},
+	}
+}
+
+// UploadPersonAvatarEchoHandlerV1 is the adapter from the Echo ecosystem to the UploadPersonAvatar handler.
+func UploadPersonAvatarEchoHandlerV1(param handlerParam.UploadPersonAvatarHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+
+		upload, err := readMediaUpload(echoContext, param.MaxUploadBytes)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = upload
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, UploadPersonAvatarHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// UploadPersonAvatarHandlerV1 is the entry point to the application's logic of replacing the avatar of a person.
+func UploadPersonAvatarHandlerV1(context context.Context, param handlerParam.UploadPersonAvatarHandlerV1) handlerResult.UploadPersonAvatarHandlerV1 {
+	invalidInput := payload.ValidateMediaUploadInput(&param.Payload, param.MaxUploadBytes)
+	if invalidInput != nil {
+		return handlerResult.UploadPersonAvatarHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.UploadPersonAvatar(context, domainServiceParam.UploadPersonAvatar{
+		UserName:    param.UserName,
+		ContentType: param.Payload.ContentType,
+		Content:     param.Payload.Content,
+		UpdatedBy:   param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+		Storage:    param.Storage,
+	})
+	if err != nil {
+		return handlerResult.UploadPersonAvatarHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to upload avatar of person '%s' in domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.UploadPersonAvatarHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.UploadPersonAvatarHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.Person, entity.Audiences.Public),
+		},
+	}
+}
+
+// GetMediaObjectEchoHandlerV1 is the adapter from the Echo ecosystem to the GetMediaObject handler.
+func GetMediaObjectEchoHandlerV1(param handlerParam.GetMediaObjectHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Key = echoContext.Param("*")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetMediaObjectHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetMediaObjectHandlerV1 is the entry point to the application's logic of serving an object of the storage.
+func GetMediaObjectHandlerV1(context context.Context, param handlerParam.GetMediaObjectHandlerV1) handlerResult.GetMediaObjectHandlerV1 {
+	result, err := domainService.GetMediaObject(context, domainServiceParam.GetMediaObject{
+		Key: param.Key,
+
+		Storage: param.Storage,
+	})
+	if err != nil {
+		return handlerResult.GetMediaObjectHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get media '%s' in domain service: %w", param.Key, err),
+			},
+		}
+	}
+
+	if result.MediaObject == nil {
+		return handlerResult.GetMediaObjectHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: failure.ErrMediaNotFound.WithMessage(fmt.Sprintf("no media with key '%s' was found in the storage", param.Key)),
+			},
+		}
+	}
+
+	return handlerResult.GetMediaObjectHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.Blob,
+			BlobResponse: result.MediaObject.Content,
+			ContentType:  result.MediaObject.ContentType,
+		},
+	}
+}
+
+// readMediaUpload reads the raw body of the request, stopping right after maxUploadBytes so that the upload can be
+// told to be too large without reading all of it.
+func readMediaUpload(echoContext echo.Context, maxUploadBytes int64) (payload.MediaUpload, error) {
+	request := echoContext.Request()
+	content, err := io.ReadAll(io.LimitReader(request.Body, maxUploadBytes+1))
+	if err != nil {
+		return payload.MediaUpload{}, err
+	}
+
+	return payload.MediaUpload{
+		ContentType: payload.ParseMediaContentType(request.Header.Get(echo.HeaderContentType)),
+		Content:     content,
+		UpdatedBy:   echoContext.QueryParam("updatedBy"),
+	}, nil
+}
@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetTeamMembershipsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamMemberships handler.
+func GetTeamMembershipsEchoHandlerV1(param handlerParam.GetTeamMembershipsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.AsOf = echoContext.QueryParam("asOf")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamMembershipsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamMembershipsHandlerV1 is the entry point to the application's logic of listing the memberships of a team,
+// which is the roster of the team on a date when the 'asOf' query param is given.
+func GetTeamMembershipsHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamMembershipsHandlerV1,
+) handlerResult.GetTeamMembershipsHandlerV1 {
+	invalidInput := payload.ValidateMembershipsAsOf(param.AsOf)
+	if invalidInput != nil {
+		return handlerResult.GetTeamMembershipsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.GetTeamMemberships(context, applicationParam.GetTeamMemberships{
+		TeamName: param.TeamName,
+		AsOf:     payload.ParseDate(&param.AsOf),
+
+		TeamRepository:       param.TeamRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamMembershipsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get memberships of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamMembershipsHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamMembershipsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.MembershipEntitiesToMemberships(result.Memberships),
+		},
+	}
+}
+
+// GetPersonMembershipsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetPersonMemberships handler.
+func GetPersonMembershipsEchoHandlerV1(param handlerParam.GetPersonMembershipsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+		param.AsOf = echoContext.QueryParam("asOf")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetPersonMembershipsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetPersonMembershipsHandlerV1 is the entry point to the application's logic of listing the memberships of a
+// person in every team, which is the history of the person's clubs, or only the teams the person represented on a
+// date when the 'asOf' query param is given.
+func GetPersonMembershipsHandlerV1(
+	context context.Context,
+	param handlerParam.GetPersonMembershipsHandlerV1,
+) handlerResult.GetPersonMembershipsHandlerV1 {
+	invalidInput := payload.ValidateMembershipsAsOf(param.AsOf)
+	if invalidInput != nil {
+		return handlerResult.GetPersonMembershipsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.GetPersonMemberships(context, applicationParam.GetPersonMemberships{
+		PersonUserName: param.PersonUserName,
+		AsOf:           payload.ParseDate(&param.AsOf),
+
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.GetPersonMembershipsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get memberships of '%s' from application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.GetPersonMembershipsHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.GetPersonMembershipsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.MembershipEntitiesToMemberships(result.Memberships),
+		},
+	}
+}
+
+// TransferMembershipEchoHandlerV1 is the adapter from the Echo ecosystem to the TransferMembership handler.
+func TransferMembershipEchoHandlerV1(param handlerParam.TransferMembershipHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.PersonUserName = echoContext.Param("username")
+
+		var input payload.MembershipTransferInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, TransferMembershipHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// TransferMembershipHandlerV1 is the entry point to the application's logic of moving a player from one team to
+// another, ending the current playing membership and starting the new one atomically.
+func TransferMembershipHandlerV1(
+	context context.Context,
+	param handlerParam.TransferMembershipHandlerV1,
+) handlerResult.TransferMembershipHandlerV1 {
+	invalidInput := payload.ValidateMembershipTransferInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.TransferMembershipHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	fromTeamName := *param.Payload.FromTeamName
+	toTeamName := *param.Payload.ToTeamName
+	result, err := applicationService.TransferMembership(context, applicationParam.TransferMembership{
+		PersonUserName:  param.PersonUserName,
+		FromTeamName:    fromTeamName,
+		ToTeamName:      toTeamName,
+		Role:            payload.StringValue(param.Payload.Role),
+		TransferDate:    payload.ParseDate(param.Payload.TransferDate),
+		UpdatedBy:       *param.Payload.UpdatedBy,
+		TransferWindows: param.TransferWindows,
+
+		TeamRepository:       param.TeamRepository,
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		GuardianRepository:   param.GuardianRepository,
+		TransactionManager:   param.TransactionManager,
+	})
+	if err != nil {
+		return handlerResult.TransferMembershipHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to transfer '%s' in application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.TransferMembershipHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	if result.FromTeam == nil {
+		return handlerResult.TransferMembershipHandlerV1{
+			HTTP: teamNotFoundHTTPResult(fromTeamName),
+		}
+	}
+
+	if result.ToTeam == nil {
+		return handlerResult.TransferMembershipHandlerV1{
+			HTTP: teamNotFoundHTTPResult(toTeamName),
+		}
+	}
+
+	return handlerResult.TransferMembershipHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.MembershipTransfer{
+				EndedMembership: payload.MembershipEntityToMembership(result.EndedMembership),
+				Membership:      payload.MembershipEntityToMembership(result.Membership),
+			},
+		},
+	}
+}
+
+// AssignJerseyNumberEchoHandlerV1 is the adapter from the Echo ecosystem to the AssignJerseyNumber handler.
+func AssignJerseyNumberEchoHandlerV1(param handlerParam.AssignJerseyNumberHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.PersonUserName = echoContext.Param("username")
+
+		var input payload.JerseyNumberInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, AssignJerseyNumberHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// AssignJerseyNumberHandlerV1 is the entry point to the application's logic of setting the jersey number that a
+// person wears in a team, which no other member of the team may wear at the same time.
+func AssignJerseyNumberHandlerV1(
+	context context.Context,
+	param handlerParam.AssignJerseyNumberHandlerV1,
+) handlerResult.AssignJerseyNumberHandlerV1 {
+	invalidInput := payload.ValidateJerseyNumberInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.AssignJerseyNumberHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	jerseyNumber := *param.Payload.JerseyNumber
+	effectiveDate := payload.JerseyNumberInputEffectiveDate(&param.Payload)
+	result, err := applicationService.AssignJerseyNumber(context, applicationParam.AssignJerseyNumber{
+		TeamName:       param.TeamName,
+		PersonUserName: param.PersonUserName,
+		JerseyNumber:   jerseyNumber,
+		Date:           effectiveDate,
+		UpdatedBy:      *param.Payload.UpdatedBy,
+
+		TeamRepository:       param.TeamRepository,
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		TransactionManager:   param.TransactionManager,
+	})
+	if err != nil {
+		return handlerResult.AssignJerseyNumberHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to assign jersey number to '%s' in application service: %w", param.PersonUserName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.AssignJerseyNumberHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.AssignJerseyNumberHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.PersonUserName),
+		}
+	}
+
+	return handlerResult.AssignJerseyNumberHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.MembershipEntityPointersToMemberships(result.Memberships),
+		},
+	}
+}
+
+// GetTeamMemberByJerseyNumberEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamMemberByJerseyNumber
+// handler.
+func GetTeamMemberByJerseyNumberEchoHandlerV1(param handlerParam.GetTeamMemberByJerseyNumberHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.JerseyNumber = echoContext.Param("jerseyNumber")
+		param.AsOf = echoContext.QueryParam("asOf")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamMemberByJerseyNumberHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamMemberByJerseyNumberHandlerV1 is the entry point to the application's logic of telling who wore a jersey
+// number in a team, on the current day or on the date of the 'asOf' query param.
+func GetTeamMemberByJerseyNumberHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamMemberByJerseyNumberHandlerV1,
+) handlerResult.GetTeamMemberByJerseyNumberHandlerV1 {
+	invalidInput := payload.JoinInvalidInputs(
+		payload.ValidateJerseyNumber(param.JerseyNumber),
+		payload.ValidateMembershipsAsOf(param.AsOf),
+	)
+	if invalidInput != nil {
+		return handlerResult.GetTeamMemberByJerseyNumberHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	asOf := payload.MembershipsAsOfDate(param.AsOf)
+	result, err := applicationService.GetTeamMemberByJerseyNumber(context, applicationParam.GetTeamMemberByJerseyNumber{
+		TeamName:     param.TeamName,
+		JerseyNumber: param.JerseyNumber,
+		Date:         asOf,
+
+		TeamRepository:       param.TeamRepository,
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamMemberByJerseyNumberHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get member of team '%s' by jersey number from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamMemberByJerseyNumberHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Membership == nil || result.Person == nil {
+		return handlerResult.GetTeamMemberByJerseyNumberHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: failure.ErrMembershipNotFound.WithMessage(fmt.Sprintf(
+					"no member of team '%s' wore jersey number '%s' on %s",
+					param.TeamName,
+					param.JerseyNumber,
+					asOf.Format(helper.DefaultDateLayout),
+				)),
+			},
+		}
+	}
+
+	return handlerResult.GetTeamMemberByJerseyNumberHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamMember{
+				Person:     payload.PersonEntityToVisiblePerson(result.Person, entity.Audiences.Public),
+				Membership: payload.MembershipEntityToMembership(result.Membership),
+			},
+		},
+	}
+}
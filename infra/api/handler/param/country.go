@@ -0,0 +1,11 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type GetAllCountriesHandlerV1 struct {
+	Locale string
+
+	Repository repository.Country
+}
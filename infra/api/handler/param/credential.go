@@ -0,0 +1,49 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type GetPersonCredentialsHandlerV1 struct {
+	PersonUserName string
+
+	PersonRepository     repository.Person
+	CredentialRepository repository.Credential
+}
+
+type CreateWFDFAccreditationHandlerV1 struct {
+	PersonUserName string
+	Payload        payload.WFDFAccreditationInput
+
+	PersonRepository     repository.Person
+	CredentialRepository repository.Credential
+}
+
+type CreateFederationMembershipHandlerV1 struct {
+	PersonUserName string
+	Payload        payload.FederationMembershipInput
+
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+	CredentialRepository  repository.Credential
+}
+
+type CreateCoachCertificationHandlerV1 struct {
+	PersonUserName string
+	Payload        payload.CoachCertificationInput
+
+	PersonRepository     repository.Person
+	CredentialRepository repository.Credential
+}
+
+type GetTeamExpiringWFDFAccreditationsHandlerV1 struct {
+	TeamName string
+	Date     string
+	Roster   []string
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	CredentialRepository repository.Credential
+}
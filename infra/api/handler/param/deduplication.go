@@ -0,0 +1,23 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type FindDuplicatePeopleHandlerV1 struct {
+	MinimumScore string
+
+	Repository repository.Person
+}
+
+type MergePeopleHandlerV1 struct {
+	UserName string
+	Payload  payload.PersonMergeInput
+
+	Repository repository.Person
+}
+
+type RedirectPersonAliasesMiddlewareV1 struct {
+	Repository repository.Person
+}
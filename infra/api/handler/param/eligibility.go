@@ -0,0 +1,17 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type CheckRosterEligibilityHandlerV1 struct {
+	TeamName string
+	Payload  payload.RosterEligibilityInput
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	CredentialRepository repository.Credential
+	GuardianRepository   repository.Guardian
+}
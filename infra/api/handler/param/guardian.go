@@ -0,0 +1,55 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type GetGuardiansHandlerV1 struct {
+	DependentUserName string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type AddGuardianHandlerV1 struct {
+	DependentUserName string
+	Payload           payload.GuardianInput
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type RemoveGuardianHandlerV1 struct {
+	DependentUserName string
+	GuardianUserName  string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type GetConsentsHandlerV1 struct {
+	PersonUserName string
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type GrantConsentHandlerV1 struct {
+	PersonUserName string
+	Payload        payload.ConsentInput
+
+	PersonRepository   repository.Person
+	GuardianRepository repository.Guardian
+}
+
+type GetDependentsScheduleHandlerV1 struct {
+	GuardianUserName string
+	From             string
+	To               string
+
+	PersonRepository     repository.Person
+	GuardianRepository   repository.Guardian
+	MembershipRepository repository.Membership
+	TeamEventRepository  repository.TeamEvent
+}
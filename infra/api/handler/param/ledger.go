@@ -0,0 +1,47 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type GetTeamLedgerHandlerV1 struct {
+	TeamName string
+
+	TeamRepository   repository.Team
+	LedgerRepository repository.Ledger
+}
+
+type GetTeamLedgerBalancesHandlerV1 struct {
+	TeamName string
+
+	TeamRepository   repository.Team
+	LedgerRepository repository.Ledger
+}
+
+type ChargeTeamDuesHandlerV1 struct {
+	TeamName string
+	Payload  payload.ChargeDuesInput
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
+
+type ChargeTeamTournamentFeeHandlerV1 struct {
+	TeamName string
+	Payload  payload.ChargeTournamentFeeInput
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
+
+type RecordTeamPaymentHandlerV1 struct {
+	TeamName string
+	Payload  payload.RecordPaymentInput
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+	LedgerRepository     repository.Ledger
+}
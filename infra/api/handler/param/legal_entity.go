@@ -0,0 +1,78 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type GetAllLegalEntitiesHandlerV1 struct {
+	Limit            string
+	Cursor           string
+	DefaultPageLimit int
+	MaxPageLimit     int
+
+	Repository repository.LegalEntity
+}
+
+type GetLegalEntityBySlugHandlerV1 struct {
+	Slug string
+
+	Repository repository.LegalEntity
+}
+
+type CreateLegalEntityHandlerV1 struct {
+	Payload payload.LegalEntity
+
+	Repository        repository.LegalEntity
+	CountryRepository repository.Country
+}
+
+type UpdateLegalEntityHandlerV1 struct {
+	Slug    string
+	Payload payload.LegalEntity
+
+	Repository        repository.LegalEntity
+	CountryRepository repository.Country
+}
+
+type GetLegalEntityAffiliationsHandlerV1 struct {
+	LegalEntitySlug string
+
+	LegalEntityRepository repository.LegalEntity
+}
+
+type AffiliateToLegalEntityHandlerV1 struct {
+	LegalEntitySlug string
+	Payload         payload.LegalEntityAffiliationInput
+
+	TeamRepository        repository.Team
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+}
+
+// EndLegalEntityAffiliationHandlerV1 ends the affiliation of the team with TeamName or, when it is empty, of the
+// person with PersonUserName.
+type EndLegalEntityAffiliationHandlerV1 struct {
+	LegalEntitySlug string
+	TeamName        string
+	PersonUserName  string
+	Payload         payload.EndLegalEntityAffiliationInput
+
+	TeamRepository        repository.Team
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+}
+
+type GetTeamLegalEntityAffiliationsHandlerV1 struct {
+	TeamName string
+
+	TeamRepository        repository.Team
+	LegalEntityRepository repository.LegalEntity
+}
+
+type GetPersonLegalEntityAffiliationsHandlerV1 struct {
+	PersonUserName string
+
+	PersonRepository      repository.Person
+	LegalEntityRepository repository.LegalEntity
+}
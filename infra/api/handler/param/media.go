@@ -0,0 +1,33 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type UploadTeamLogoHandlerV1 struct {
+	TeamName string
+	Payload  payload.MediaUpload
+
+	MaxUploadBytes int64
+
+	Repository repository.Team
+	Storage    storage.Storage
+}
+
+type UploadPersonAvatarHandlerV1 struct {
+	UserName string
+	Payload  payload.MediaUpload
+
+	MaxUploadBytes int64
+
+	Repository repository.Person
+	Storage    storage.Storage
+}
+
+type GetMediaObjectHandlerV1 struct {
+	Key string
+
+	Storage storage.Storage
+}
@@ -0,0 +1,56 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type GetTeamMembershipsHandlerV1 struct {
+	TeamName string
+	AsOf     string
+
+	TeamRepository       repository.Team
+	MembershipRepository repository.Membership
+}
+
+type GetPersonMembershipsHandlerV1 struct {
+	PersonUserName string
+	AsOf           string
+
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+}
+
+type TransferMembershipHandlerV1 struct {
+	PersonUserName  string
+	Payload         payload.MembershipTransferInput
+	TransferWindows []entity.TransferWindow
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	GuardianRepository   repository.Guardian
+	TransactionManager   repository.TransactionManager
+}
+
+type AssignJerseyNumberHandlerV1 struct {
+	TeamName       string
+	PersonUserName string
+	Payload        payload.JerseyNumberInput
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	TransactionManager   repository.TransactionManager
+}
+
+type GetTeamMemberByJerseyNumberHandlerV1 struct {
+	TeamName     string
+	JerseyNumber string
+	AsOf         string
+
+	TeamRepository       repository.Team
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+}
@@ -2,8 +2,73 @@ package param
 
 import (
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
 )
 
 type GetAllPeopleHandlerV1 struct {
+	IncludeArchived  string
+	RequestedBy      string
+	Filters          []string
+	Sort             string
+	Limit            string
+	Cursor           string
+	DefaultPageLimit int
+	MaxPageLimit     int
+
+	Repository           repository.Person
+	MembershipRepository repository.Membership
+}
+
+type GetPersonByUserNameHandlerV1 struct {
+	UserName    string
+	RequestedBy string
+
+	Repository           repository.Person
+	MembershipRepository repository.Membership
+}
+
+type ArchivePersonHandlerV1 struct {
+	UserName string
+	Payload  payload.ArchiveInput
+
+	Repository repository.Person
+}
+
+type RestorePersonHandlerV1 struct {
+	UserName string
+	Payload  payload.RestoreInput
+
+	Repository repository.Person
+}
+
+type UpdatePersonBirthDateHandlerV1 struct {
+	UserName string
+	Payload  payload.PersonBirthDateInput
+
+	Repository repository.Person
+}
+
+type UpdatePersonVisibilityHandlerV1 struct {
+	UserName string
+	Payload  payload.PersonVisibilityInput
+
+	Repository repository.Person
+}
+
+type ExportPersonalDataHandlerV1 struct {
+	UserName    string
+	RequestedBy string
+	Admins      []string
+
+	Repository repository.Person
+}
+
+type ErasePersonHandlerV1 struct {
+	UserName string
+	Payload  payload.ErasureInput
+	Admins   []string
+
 	Repository repository.Person
+	Storage    storage.Storage
 }
@@ -0,0 +1,15 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+)
+
+type SearchHandlerV1 struct {
+	Query            string
+	Limit            string
+	DefaultPageLimit int
+	MaxPageLimit     int
+
+	TeamRepository   repository.Team
+	PersonRepository repository.Person
+}
@@ -6,6 +6,14 @@ import (
 )
 
 type GetAllTeamsHandlerV1 struct {
+	IncludeArchived  string
+	Filters          []string
+	Sort             string
+	Limit            string
+	Cursor           string
+	DefaultPageLimit int
+	MaxPageLimit     int
+
 	Repository repository.Team
 }
 
@@ -18,12 +26,45 @@ type GetTeamByNameHandlerV1 struct {
 type CreateTeamHandlerV1 struct {
 	Payload payload.Team
 
-	Repository repository.Team
+	Repository        repository.Team
+	CountryRepository repository.Country
 }
 
 type UpdateTeamHandlerV1 struct {
 	Name    string
 	Payload payload.Team
 
+	Repository        repository.Team
+	CountryRepository repository.Country
+}
+
+type ArchiveTeamHandlerV1 struct {
+	Name    string
+	Payload payload.ArchiveInput
+
+	Repository repository.Team
+}
+
+type RestoreTeamHandlerV1 struct {
+	Name    string
+	Payload payload.RestoreInput
+
+	Repository repository.Team
+}
+
+type RenameTeamHandlerV1 struct {
+	Name    string
+	Payload payload.TeamRenameInput
+
+	Repository repository.Team
+}
+
+type GetPreviousTeamNamesHandlerV1 struct {
+	Name string
+
+	Repository repository.Team
+}
+
+type RedirectPreviousTeamNamesMiddlewareV1 struct {
 	Repository repository.Team
 }
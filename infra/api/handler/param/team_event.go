@@ -0,0 +1,64 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type GetTeamEventsHandlerV1 struct {
+	TeamName string
+	From     string
+	To       string
+
+	TeamRepository      repository.Team
+	TeamEventRepository repository.TeamEvent
+}
+
+type CreateTeamEventHandlerV1 struct {
+	TeamName string
+	Payload  payload.CreateTeamEventInput
+
+	TeamRepository      repository.Team
+	TeamEventRepository repository.TeamEvent
+}
+
+type RespondToTeamEventHandlerV1 struct {
+	TeamName       string
+	EventID        string
+	PersonUserName string
+	Payload        payload.TeamEventRSVPInput
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type RecordTeamEventAttendanceHandlerV1 struct {
+	TeamName string
+	EventID  string
+	Payload  payload.TeamEventAttendanceInput
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type GetTeamAttendanceReportHandlerV1 struct {
+	TeamName string
+	From     string
+	To       string
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
+
+type GetTeamAvailabilityHandlerV1 struct {
+	TeamName string
+	From     string
+	To       string
+
+	TeamRepository       repository.Team
+	TeamEventRepository  repository.TeamEvent
+	MembershipRepository repository.Membership
+}
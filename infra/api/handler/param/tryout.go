@@ -0,0 +1,76 @@
+package param
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+)
+
+type GetTeamTryoutsHandlerV1 struct {
+	TeamName string
+
+	TeamRepository   repository.Team
+	TryoutRepository repository.Tryout
+}
+
+type CreateTeamTryoutHandlerV1 struct {
+	TeamName string
+	Payload  payload.CreateTryoutInput
+
+	TeamRepository   repository.Team
+	TryoutRepository repository.Tryout
+}
+
+type SignUpForTeamTryoutHandlerV1 struct {
+	TeamName string
+	TryoutID string
+	Payload  payload.TryoutSignUpInput
+
+	TeamRepository     repository.Team
+	PersonRepository   repository.Person
+	TryoutRepository   repository.Tryout
+	CountryRepository  repository.Country
+	TransactionManager repository.TransactionManager
+}
+
+type GetTeamTryoutCandidatesHandlerV1 struct {
+	TeamName string
+	TryoutID string
+
+	TeamRepository   repository.Team
+	TryoutRepository repository.Tryout
+}
+
+type EvaluateTeamTryoutCandidateHandlerV1 struct {
+	TeamName          string
+	TryoutID          string
+	CandidateUserName string
+	Payload           payload.TryoutEvaluationInput
+
+	TeamRepository       repository.Team
+	TryoutRepository     repository.Tryout
+	MembershipRepository repository.Membership
+}
+
+type GetTeamTryoutRankingsHandlerV1 struct {
+	TeamName    string
+	TryoutID    string
+	RequestedBy string
+
+	TeamRepository       repository.Team
+	TryoutRepository     repository.Tryout
+	MembershipRepository repository.Membership
+}
+
+type DecideTeamTryoutCandidateHandlerV1 struct {
+	TeamName          string
+	TryoutID          string
+	CandidateUserName string
+	Payload           payload.TryoutDecisionInput
+
+	TeamRepository       repository.Team
+	TryoutRepository     repository.Tryout
+	PersonRepository     repository.Person
+	MembershipRepository repository.Membership
+	GuardianRepository   repository.Guardian
+	TransactionManager   repository.TransactionManager
+}
@@ -8,6 +8,8 @@ import (
 	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
 	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
 
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+
 	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
@@ -21,25 +23,51 @@ import (
 func GetAllPeopleEchoHandlerV1(param handlerParam.GetAllPeopleHandlerV1) echo.HandlerFunc {
 	return func(echoContext echo.Context) error {
 		requestContext := echoContext.Request().Context()
+		param.IncludeArchived = echoContext.QueryParam("includeArchived")
+		param.RequestedBy = echoContext.QueryParam("requestedBy")
+		param.Filters = echoContext.QueryParams()["filter"]
+		param.Sort = echoContext.QueryParam("sort")
+		param.Limit = echoContext.QueryParam("limit")
+		param.Cursor = echoContext.QueryParam("cursor")
 
 		return DispatchEchoResponseFromHandlerResult(echoContext, GetAllPeopleHandlerV1(requestContext, param).HTTP)
 	}
 }
 
-// GetAllPeopleHandlerV1 is the entry point to the application's logic for fetching a list of existing People.
+// GetAllPeopleHandlerV1 is the entry point to the application's logic for fetching a list of existing People, a page
+// at a time, narrowed down by the 'filter' query params and ordered by the 'sort' query param. Archived people are left
+// out unless the 'includeArchived' query param is true. The personal details of each person are the ones that the
+// requester told by the 'requestedBy' query param can see, which are the public ones when it is left out.
 func GetAllPeopleHandlerV1(
 	context context.Context,
 	param handlerParam.GetAllPeopleHandlerV1,
 ) handlerResult.GetAllPeopleHandlerV1 {
+	invalidInput := payload.JoinInvalidInputs(
+		payload.ValidateIncludeArchived(param.IncludeArchived),
+		payload.ValidatePersonCriteriaParams(param.Filters, param.Sort),
+		payload.ValidatePageParams(param.Limit, param.Cursor, param.MaxPageLimit),
+	)
+	if invalidInput != nil {
+		return handlerResult.GetAllPeopleHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
 	result, err := domainService.GetAllPeople(context, domainServiceParam.GetAllPeople{
-		Repository: param.Repository,
+		IncludeArchived: payload.ParseIncludeArchived(param.IncludeArchived),
+		RequestedBy:     param.RequestedBy,
+		Criteria:        payload.ParsePersonCriteriaParams(param.Filters, param.Sort),
+		Page:            payload.ParsePageParams(param.Limit, param.Cursor, param.DefaultPageLimit),
+
+		Repository:           param.Repository,
+		MembershipRepository: param.MembershipRepository,
 	})
 	if err != nil {
 		return handlerResult.GetAllPeopleHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusInternalServerError,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("failed to get all People from domain service: %s", err.Error()),
+				Error: fmt.Errorf("failed to get all People from domain service: %w", err),
 			},
 		}
 	}
@@ -48,7 +76,408 @@ func GetAllPeopleHandlerV1(
 		HTTP: handlerResult.HTTP{
 			StatusCode:   http.StatusOK,
 			ResponseType: handlerResult.ResponseBodyTypes.JSON,
-			JSONResponse: payload.PersonEntitiesToPeople(result.People),
+			JSONResponse: payload.PersonPage{
+				Items:      payload.PersonEntitiesToVisiblePeople(result.People, result.Audiences),
+				NextCursor: payload.NextCursor(result.NextAfter),
+			},
+		},
+	}
+}
+
+// GetPersonByUserNameEchoHandlerV1 is the adapter from the Echo ecosystem to the GetPersonByUserName handler.
+func GetPersonByUserNameEchoHandlerV1(param handlerParam.GetPersonByUserNameHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+		param.RequestedBy = echoContext.QueryParam("requestedBy")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetPersonByUserNameHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetPersonByUserNameHandlerV1 is the entry point to the application's logic of fetching a person by their username,
+// archived or not, with the personal details that the requester told by the 'requestedBy' query param can see.
+func GetPersonByUserNameHandlerV1(
+	context context.Context,
+	param handlerParam.GetPersonByUserNameHandlerV1,
+) handlerResult.GetPersonByUserNameHandlerV1 {
+	result, err := domainService.GetPersonByUserName(context, domainServiceParam.GetPersonByUserName{
+		UserName:    param.UserName,
+		RequestedBy: param.RequestedBy,
+
+		Repository:           param.Repository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.GetPersonByUserNameHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get person '%s' from domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.GetPersonByUserNameHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.GetPersonByUserNameHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.Person, result.Audience),
+		},
+	}
+}
+
+// ArchivePersonEchoHandlerV1 is the adapter from the Echo ecosystem to the ArchivePerson handler.
+func ArchivePersonEchoHandlerV1(param handlerParam.ArchivePersonHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+
+		var input payload.ArchiveInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, ArchivePersonHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// ArchivePersonHandlerV1 is the entry point to the application's logic of soft deleting a person, which leaves the listings
+// but keeps the history of the person.
+func ArchivePersonHandlerV1(context context.Context, param handlerParam.ArchivePersonHandlerV1) handlerResult.ArchivePersonHandlerV1 {
+	invalidInput := payload.ValidateArchiveInput(&param.Payload, "Person")
+	if invalidInput != nil {
+		return handlerResult.ArchivePersonHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.ArchivePerson(context, domainServiceParam.ArchivePerson{
+		UserName:  param.UserName,
+		DeletedBy: *param.Payload.DeletedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.ArchivePersonHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to archive person '%s' in domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.ArchivePersonHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.ArchivePersonHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.Person, entity.Audiences.Public),
+		},
+	}
+}
+
+// RestorePersonEchoHandlerV1 is the adapter from the Echo ecosystem to the RestorePerson handler.
+func RestorePersonEchoHandlerV1(param handlerParam.RestorePersonHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+
+		var input payload.RestoreInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, RestorePersonHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// RestorePersonHandlerV1 is the entry point to the application's logic of bringing an archived person back to the listings.
+func RestorePersonHandlerV1(context context.Context, param handlerParam.RestorePersonHandlerV1) handlerResult.RestorePersonHandlerV1 {
+	invalidInput := payload.ValidateRestoreInput(&param.Payload, "Person")
+	if invalidInput != nil {
+		return handlerResult.RestorePersonHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.RestorePerson(context, domainServiceParam.RestorePerson{
+		UserName:  param.UserName,
+		UpdatedBy: *param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.RestorePersonHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to restore person '%s' in domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.RestorePersonHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.RestorePersonHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.Person, entity.Audiences.Public),
+		},
+	}
+}
+
+// UpdatePersonBirthDateEchoHandlerV1 is the adapter from the Echo ecosystem to the UpdatePersonBirthDate handler.
+func UpdatePersonBirthDateEchoHandlerV1(param handlerParam.UpdatePersonBirthDateHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+
+		var input payload.PersonBirthDateInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, UpdatePersonBirthDateHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// UpdatePersonBirthDateHandlerV1 is the entry point to the application's logic of setting the birth date of a person.
+func UpdatePersonBirthDateHandlerV1(
+	context context.Context,
+	param handlerParam.UpdatePersonBirthDateHandlerV1,
+) handlerResult.UpdatePersonBirthDateHandlerV1 {
+	invalidInput := payload.ValidatePersonBirthDateInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.UpdatePersonBirthDateHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.UpdatePersonBirthDate(context, domainServiceParam.UpdatePersonBirthDate{
+		UserName:  param.UserName,
+		BirthDate: payload.ParseDate(param.Payload.BirthDate),
+		UpdatedBy: *param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.UpdatePersonBirthDateHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to update birth date of person '%s' in domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.UpdatePersonBirthDateHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.UpdatePersonBirthDateHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.Person, entity.Audiences.Public),
+		},
+	}
+}
+
+func UpdatePersonVisibilityEchoHandlerV1(param handlerParam.UpdatePersonVisibilityHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+
+		var input payload.PersonVisibilityInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, UpdatePersonVisibilityHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// UpdatePersonVisibilityHandlerV1 is the entry point to the application's logic of restricting who can see the
+// contact details of a person.
+func UpdatePersonVisibilityHandlerV1(
+	context context.Context,
+	param handlerParam.UpdatePersonVisibilityHandlerV1,
+) handlerResult.UpdatePersonVisibilityHandlerV1 {
+	invalidInput := payload.ValidatePersonVisibilityInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.UpdatePersonVisibilityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.UpdatePersonVisibility(context, domainServiceParam.UpdatePersonVisibility{
+		UserName:              param.UserName,
+		EmailVisibility:       payload.ParseVisibility(param.Payload.EmailVisibility),
+		PhoneNumberVisibility: payload.ParseVisibility(param.Payload.PhoneNumberVisibility),
+		UpdatedBy:             *param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.UpdatePersonVisibilityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to update visibility of person '%s' in domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.UpdatePersonVisibilityHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.UpdatePersonVisibilityHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.Person, entity.Audiences.Public),
+		},
+	}
+}
+
+func ExportPersonalDataEchoHandlerV1(param handlerParam.ExportPersonalDataHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+		param.RequestedBy = echoContext.QueryParam("requestedBy")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, ExportPersonalDataHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// ExportPersonalDataHandlerV1 is the entry point to the application's logic of exporting everything that is known
+// about a person, on behalf of the requester told by the 'requestedBy' query param.
+func ExportPersonalDataHandlerV1(
+	context context.Context,
+	param handlerParam.ExportPersonalDataHandlerV1,
+) handlerResult.ExportPersonalDataHandlerV1 {
+	invalidInput := payload.ValidatePersonalDataRequestedBy(param.RequestedBy)
+	if invalidInput != nil {
+		return handlerResult.ExportPersonalDataHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.ExportPersonalData(context, domainServiceParam.ExportPersonalData{
+		UserName:    param.UserName,
+		RequestedBy: param.RequestedBy,
+		Admins:      param.Admins,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.ExportPersonalDataHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to export personal data of person '%s' in domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.PersonalData == nil {
+		return handlerResult.ExportPersonalDataHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.ExportPersonalDataHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonalDataEntityToPersonalData(result.PersonalData),
+		},
+	}
+}
+
+func ErasePersonEchoHandlerV1(param handlerParam.ErasePersonHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.UserName = echoContext.Param("username")
+
+		var input payload.ErasureInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, ErasePersonHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// ErasePersonHandlerV1 is the entry point to the application's logic of erasing the personal data of a person.
+func ErasePersonHandlerV1(context context.Context, param handlerParam.ErasePersonHandlerV1) handlerResult.ErasePersonHandlerV1 {
+	invalidInput := payload.ValidateErasureInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.ErasePersonHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.ErasePerson(context, domainServiceParam.ErasePerson{
+		UserName: param.UserName,
+		ErasedBy: *param.Payload.ErasedBy,
+		Admins:   param.Admins,
+
+		Repository: param.Repository,
+		Storage:    param.Storage,
+	})
+	if err != nil {
+		return handlerResult.ErasePersonHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to erase person '%s' in domain service: %w", param.UserName, err),
+			},
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.ErasePersonHandlerV1{
+			HTTP: personNotFoundHTTPResult(param.UserName),
+		}
+	}
+
+	return handlerResult.ErasePersonHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.PersonEntityToVisiblePerson(result.Person, entity.Audiences.Public),
 		},
 	}
 }
@@ -0,0 +1,213 @@
+//go:build integration
+// +build integration
+
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	repositoryPostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/repository/postgres"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler"
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+	databasePostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test/fixture"
+)
+
+// GetPersonVisibilityFixtureQueries fills the database with a player, a teammate of the player and someone from
+// another team, all of them with ongoing memberships.
+func GetPersonVisibilityFixtureQueries(t *testing.T) []fixture.Query {
+	t.Helper()
+
+	team := fixture.GetDefaultFixtureTeam()
+	anotherTeam := fixture.GetAnotherFixtureTeam()
+	player := fixture.GetFakePerson("visible.player")
+	teammate := fixture.GetFakePerson("visible.teammate")
+	outsider := fixture.GetFakePerson("visible.outsider")
+
+	return fixture.MergeQueries(
+		fixture.GenerateTeamQueries(team, anotherTeam),
+		fixture.GeneratePersonQueries(player, teammate, outsider),
+		fixture.GenerateMembershipQueries(
+			fixture.GetFakeMembership(team, player),
+			fixture.GetFakeMembership(team, teammate),
+			fixture.GetFakeMembership(anotherTeam, outsider),
+		),
+	)
+}
+
+func TestPersonHandler_GetPersonByUserName(t *testing.T) {
+	t.Parallel()
+
+	player := fixture.GetFakePerson("visible.player")
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should show the email of the person to a teammate",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": "visible.teammate"},
+			OutputData:     map[string]interface{}{"expectedEmail": &player.Email},
+		},
+		{
+			Description:    "should hide the email of the person from someone of another team",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": "visible.outsider"},
+			OutputData:     map[string]interface{}{"expectedEmail": (*string)(nil)},
+		},
+		{
+			Description:    "should hide the email of the person from the public",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": ""},
+			OutputData:     map[string]interface{}{"expectedEmail": (*string)(nil)},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+
+			requestedBy, ok := scenario.InputData["requestedBy"].(string)
+			require.True(t, ok)
+			expectedEmail, ok := scenario.OutputData["expectedEmail"].(*string)
+			require.True(t, ok)
+
+			result := handler.GetPersonByUserNameHandlerV1(testContext, handlerParam.GetPersonByUserNameHandlerV1{
+				UserName:    player.UserName,
+				RequestedBy: requestedBy,
+
+				Repository:           repositoryPostgres.NewPersonRepository(client),
+				MembershipRepository: repositoryPostgres.NewMembershipRepository(client),
+			})
+
+			require.NoError(t, result.Error)
+			require.Equal(t, http.StatusOK, handler.ResponseStatusCode(result.HTTP))
+			obtainedPerson, ok := result.JSONResponse.(payload.Person)
+			require.True(t, ok)
+			require.Equal(t, expectedEmail, obtainedPerson.Email)
+		},
+	)
+}
+
+func TestPersonHandler_GetAllPeople(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should show a teammate the emails that the public does not see",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": "visible.teammate"},
+			OutputData: map[string]interface{}{
+				"expectedVisibleEmails": []string{"visible.player", "visible.teammate"},
+			},
+		},
+		{
+			Description:    "should show the public no email",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": ""},
+			OutputData: map[string]interface{}{
+				"expectedVisibleEmails": []string{},
+			},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+
+			requestedBy, ok := scenario.InputData["requestedBy"].(string)
+			require.True(t, ok)
+			expectedVisibleEmails, ok := scenario.OutputData["expectedVisibleEmails"].([]string)
+			require.True(t, ok)
+
+			result := handler.GetAllPeopleHandlerV1(testContext, handlerParam.GetAllPeopleHandlerV1{
+				RequestedBy:      requestedBy,
+				DefaultPageLimit: 20,
+				MaxPageLimit:     100,
+
+				Repository:           repositoryPostgres.NewPersonRepository(client),
+				MembershipRepository: repositoryPostgres.NewMembershipRepository(client),
+			})
+
+			require.NoError(t, result.Error)
+			require.Equal(t, http.StatusOK, handler.ResponseStatusCode(result.HTTP))
+			obtainedPage, ok := result.JSONResponse.(payload.PersonPage)
+			require.True(t, ok)
+			require.Len(t, obtainedPage.Items, 3)
+
+			obtainedVisibleEmails := []string{}
+			for _, obtainedPerson := range obtainedPage.Items {
+				if obtainedPerson.Email != nil {
+					obtainedVisibleEmails = append(obtainedVisibleEmails, obtainedPerson.UserName)
+				}
+			}
+			require.Equal(t, expectedVisibleEmails, obtainedVisibleEmails)
+		},
+	)
+}
+
+func TestPersonHandler_ExportPersonalData(t *testing.T) {
+	t.Parallel()
+
+	player := fixture.GetFakePerson("visible.player")
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should export the personal data to the person themself",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": "visible.player"},
+			OutputData:     map[string]interface{}{"expectedStatusCode": http.StatusOK},
+		},
+		{
+			Description:    "should export the personal data to an admin",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": "some.admin"},
+			OutputData:     map[string]interface{}{"expectedStatusCode": http.StatusOK},
+		},
+		{
+			Description:    "should forbid a teammate to export the personal data of the person",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": "visible.teammate"},
+			OutputData:     map[string]interface{}{"expectedStatusCode": http.StatusForbidden},
+		},
+		{
+			Description:    "should reject the export when the requester is left out",
+			FixtureQueries: GetPersonVisibilityFixtureQueries(t),
+			InputData:      map[string]interface{}{"requestedBy": ""},
+			OutputData:     map[string]interface{}{"expectedStatusCode": http.StatusBadRequest},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+
+			requestedBy, ok := scenario.InputData["requestedBy"].(string)
+			require.True(t, ok)
+			expectedStatusCode, ok := scenario.OutputData["expectedStatusCode"].(int)
+			require.True(t, ok)
+
+			result := handler.ExportPersonalDataHandlerV1(testContext, handlerParam.ExportPersonalDataHandlerV1{
+				UserName:    player.UserName,
+				RequestedBy: requestedBy,
+				Admins:      []string{"some.admin"},
+
+				Repository: repositoryPostgres.NewPersonRepository(client),
+			})
+
+			require.Equal(t, expectedStatusCode, handler.ResponseStatusCode(result.HTTP))
+			if expectedStatusCode == http.StatusOK {
+				obtainedPersonalData, ok := result.JSONResponse.(payload.PersonalData)
+				require.True(t, ok)
+				require.Equal(t, player.UserName, obtainedPersonalData.Person.UserName)
+			}
+		},
+	)
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// correlationIDPattern tells which correlation IDs sent by the clients are kept, so that they are safe to log.
+var correlationIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// ProblemsEchoMiddlewareV1 tags each request with a correlation ID, the one in the X-Request-ID header when the client
+// sends a valid one, which is answered in the same header and within the problems. The errors left unhandled, such
+// as the ones of unknown routes, are answered as problems, and the server errors are logged along with the ID.
+func ProblemsEchoMiddlewareV1(log logger.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(echoContext echo.Context) error {
+			correlationID := echoContext.Request().Header.Get(echo.HeaderXRequestID)
+			if !correlationIDPattern.MatchString(correlationID) {
+				correlationID = newCorrelationID()
+			}
+			echoContext.Response().Header().Set(echo.HeaderXRequestID, correlationID)
+
+			err := next(echoContext)
+			if err != nil && !echoContext.Response().Committed {
+				statusCode, message := http.StatusInternalServerError, err.Error()
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					statusCode, message = httpError.Code, fmt.Sprint(httpError.Message)
+				}
+				err = DispatchEchoProblem(echoContext, statusCode, err, message)
+			}
+
+			if internalError, ok := echoContext.Get(internalErrorContextKey).(string); ok {
+				log.WithProperties([]logger.Property{
+					{Key: logger.CorrelationID, Type: logger.StringType, StringValue: correlationID},
+					{Key: logger.StatusCode, Type: logger.Int64Type, Int64Value: int64(echoContext.Response().Status)},
+				}).Error(internalError)
+			}
+
+			return err
+		}
+	}
+}
+
+// newCorrelationID generates a random correlation ID for the requests that do not bring one.
+func newCorrelationID() string {
+	randomBytes := make([]byte, 16)
+	_, _ = rand.Read(randomBytes)
+
+	return hex.EncodeToString(randomBytes)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// redirectToParamValue redirects the request to the same route with another value in the given route param. GET and
+// HEAD requests are moved permanently with a 301, while the other methods get a 308 so that clients repeat them with
+// the same method and body.
+func redirectToParamValue(echoContext echo.Context, paramName string, value string) error {
+	statusCode := http.StatusPermanentRedirect
+	if method := echoContext.Request().Method; method == http.MethodGet || method == http.MethodHead {
+		statusCode = http.StatusMovedPermanently
+	}
+
+	return echoContext.Redirect(statusCode, locationWithParamValue(echoContext, paramName, value))
+}
+
+// locationWithParamValue rebuilds the requested URL replacing the segment matched by the route param.
+func locationWithParamValue(echoContext echo.Context, paramName string, value string) string {
+	requestURL := echoContext.Request().URL
+	routeSegments := strings.Split(echoContext.Path(), "/")
+	pathSegments := strings.Split(requestURL.EscapedPath(), "/")
+	for index, routeSegment := range routeSegments {
+		if routeSegment == ":"+paramName && index < len(pathSegments) {
+			pathSegments[index] = url.PathEscape(value)
+		}
+	}
+
+	location := strings.Join(pathSegments, "/")
+	if requestURL.RawQuery != "" {
+		location += "?" + requestURL.RawQuery
+	}
+
+	return location
+}
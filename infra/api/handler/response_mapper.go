@@ -1,15 +1,95 @@
 package handler
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
 	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
 
 	"github.com/labstack/echo/v4"
 )
 
+// internalErrorContextKey keeps, in the Echo context, the message of a server error for ProblemsEchoMiddlewareV1 to
+// log, since it is left out of the response.
+const internalErrorContextKey = "internalError"
+
+// failureStatusCodes tells the status code with which each failure is answered. The errors without a failure, or
+// with one that is missing here, are answered as server errors.
+var failureStatusCodes = map[string]int{
+	failure.ErrInvalidInput.Code: http.StatusBadRequest,
+
+	failure.ErrNotStaff.Code:       http.StatusForbidden,
+	failure.ErrNotSelfOrAdmin.Code: http.StatusForbidden,
+
+	failure.ErrTeamNotFound.Code:         http.StatusNotFound,
+	failure.ErrPersonNotFound.Code:       http.StatusNotFound,
+	failure.ErrLegalEntityNotFound.Code:  http.StatusNotFound,
+	failure.ErrMembershipNotFound.Code:   http.StatusNotFound,
+	failure.ErrAffiliationNotFound.Code:  http.StatusNotFound,
+	failure.ErrGuardianshipNotFound.Code: http.StatusNotFound,
+	failure.ErrTeamEventNotFound.Code:    http.StatusNotFound,
+	failure.ErrTryoutNotFound.Code:       http.StatusNotFound,
+	failure.ErrCandidateNotFound.Code:    http.StatusNotFound,
+	failure.ErrMediaNotFound.Code:        http.StatusNotFound,
+
+	failure.ErrAlreadyExists.Code:           http.StatusConflict,
+	failure.ErrStillReferenced.Code:         http.StatusConflict,
+	failure.ErrAlreadyArchived.Code:         http.StatusConflict,
+	failure.ErrNotArchived.Code:             http.StatusConflict,
+	failure.ErrAlreadyErased.Code:           http.StatusConflict,
+	failure.ErrOverlappingPeriod.Code:       http.StatusConflict,
+	failure.ErrJerseyNumberTaken.Code:       http.StatusConflict,
+	failure.ErrAlreadySignedUp.Code:         http.StatusConflict,
+	failure.ErrCandidateAlreadyDecided.Code: http.StatusConflict,
+
+	failure.ErrContentTooLarge.Code:      http.StatusRequestEntityTooLarge,
+	failure.ErrUnsupportedMediaType.Code: http.StatusUnsupportedMediaType,
+
+	failure.ErrNotGuardian.Code:           http.StatusUnprocessableEntity,
+	failure.ErrNotFederation.Code:         http.StatusUnprocessableEntity,
+	failure.ErrNotActiveMember.Code:       http.StatusUnprocessableEntity,
+	failure.ErrNoActiveMembers.Code:       http.StatusUnprocessableEntity,
+	failure.ErrInvalidPeriod.Code:         http.StatusUnprocessableEntity,
+	failure.ErrOutsideTransferWindow.Code: http.StatusUnprocessableEntity,
+	failure.ErrUnknownTryoutCriteria.Code: http.StatusUnprocessableEntity,
+	failure.ErrInvalidImage.Code:          http.StatusUnprocessableEntity,
+	failure.ErrRenameBeforeLast.Code:      http.StatusUnprocessableEntity,
+	failure.ErrMissingConsent.Code:        http.StatusUnprocessableEntity,
+}
+
+// ResponseStatusCode is the status code with which the handler HTTP is answered, which follows the failure within its
+// Error when there is one.
+func ResponseStatusCode(param result.HTTP) int {
+	if param.Error == nil {
+		return param.StatusCode
+	}
+
+	if failureError := failure.Of(param.Error); failureError != nil {
+		if statusCode, ok := failureStatusCodes[failureError.Code]; ok {
+			return statusCode
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
 // DispatchEchoResponseFromHandlerResult dispatches an Echo Response using information fetched from the handler HTTP.
+// A handler HTTP with an Error is dispatched as a problem that tells the failure within the Error, whose status code
+// is mapped from the failure and whose detail is the message of the failure. Any other Error is a server error.
 func DispatchEchoResponseFromHandlerResult(echoContext echo.Context, param result.HTTP) error {
+	if param.Error != nil {
+		statusCode := ResponseStatusCode(param)
+		message := param.Error.Error()
+		if statusCode < http.StatusInternalServerError {
+			message = failure.Of(param.Error).Message
+		}
+
+		return DispatchEchoProblem(echoContext, statusCode, param.Error, message)
+	}
+
 	switch param.ResponseType {
 	case result.ResponseBodyTypes.JSON:
 		err := echoContext.JSON(param.StatusCode, param.JSONResponse)
@@ -20,12 +100,25 @@ func DispatchEchoResponseFromHandlerResult(echoContext echo.Context, param resul
 		return nil
 	case result.ResponseBodyTypes.String:
 		return DispatchEchoResponseFromString(echoContext, param.StatusCode, param.StringResponse)
+	case result.ResponseBodyTypes.Blob:
+		err := echoContext.Blob(param.StatusCode, param.ContentType, param.BlobResponse)
+		if err != nil {
+			return fmt.Errorf("failed to write blob response: %w", err)
+		}
+
+		return nil
 	}
 
 	return fmt.Errorf("unknown response body type: %s", param.ResponseType)
 }
 
+// DispatchEchoResponseFromString dispatches the string as the Echo Response or, for error responses, as the detail
+// of a problem.
 func DispatchEchoResponseFromString(echoContext echo.Context, statusCode int, stringResponse string) error {
+	if statusCode >= http.StatusBadRequest {
+		return DispatchEchoProblem(echoContext, statusCode, nil, stringResponse)
+	}
+
 	err := echoContext.String(statusCode, stringResponse)
 	if err != nil {
 		return fmt.Errorf("failed to write string response: %w", err)
@@ -33,3 +126,28 @@ func DispatchEchoResponseFromString(echoContext echo.Context, statusCode int, st
 
 	return nil
 }
+
+// DispatchEchoProblem dispatches an error response as a problem (RFC 7807) that describes the failure within err,
+// if any, with the message as its detail. The message of a server error is only logged, with the correlation ID that
+// the problem tells, since it may tell the internals of the API.
+func DispatchEchoProblem(echoContext echo.Context, statusCode int, err error, message string) error {
+	if statusCode >= http.StatusInternalServerError {
+		echoContext.Set(internalErrorContextKey, message)
+	}
+
+	problem := payload.NewProblem(statusCode, failure.Of(err), message)
+	problem.Instance = echoContext.Request().URL.Path
+	problem.CorrelationID = echoContext.Response().Header().Get(echo.HeaderXRequestID)
+
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return fmt.Errorf("failed to encode problem response: %w", err)
+	}
+
+	err = echoContext.Blob(statusCode, payload.ProblemContentType, body)
+	if err != nil {
+		return fmt.Errorf("failed to write problem response: %w", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,12 @@ type HTTP struct {
 	ResponseType   ResponseBodyType
 	JSONResponse   interface{}
 	StringResponse string
+	BlobResponse   []byte
+	// ContentType tells what the BlobResponse holds.
+	ContentType string
+	// Error is the failure behind an error response, which tells the status code and the detail of the response, so
+	// the StatusCode and the response body are left empty along with it.
+	Error error
 }
 
 // ResponseBodyType is the type of the response body.
@@ -14,10 +20,12 @@ type ResponseBodyType string
 type responseBodyTypeList struct {
 	JSON   ResponseBodyType
 	String ResponseBodyType
+	Blob   ResponseBodyType
 }
 
 // ResponseBodyTypes represents the names of possible types for response bodies.
 var ResponseBodyTypes = &responseBodyTypeList{
 	JSON:   "JSON",
 	String: "String",
+	Blob:   "Blob",
 }
@@ -0,0 +1,5 @@
+package result
+
+type GetAllCountriesHandlerV1 struct {
+	HTTP
+}
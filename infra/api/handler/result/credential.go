@@ -0,0 +1,21 @@
+package result
+
+type GetPersonCredentialsHandlerV1 struct {
+	HTTP
+}
+
+type CreateWFDFAccreditationHandlerV1 struct {
+	HTTP
+}
+
+type CreateFederationMembershipHandlerV1 struct {
+	HTTP
+}
+
+type CreateCoachCertificationHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamExpiringWFDFAccreditationsHandlerV1 struct {
+	HTTP
+}
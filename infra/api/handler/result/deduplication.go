@@ -0,0 +1,9 @@
+package result
+
+type FindDuplicatePeopleHandlerV1 struct {
+	HTTP
+}
+
+type MergePeopleHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,5 @@
+package result
+
+type CheckRosterEligibilityHandlerV1 struct {
+	HTTP
+}
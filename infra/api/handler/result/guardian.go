@@ -0,0 +1,25 @@
+package result
+
+type GetGuardiansHandlerV1 struct {
+	HTTP
+}
+
+type AddGuardianHandlerV1 struct {
+	HTTP
+}
+
+type RemoveGuardianHandlerV1 struct {
+	HTTP
+}
+
+type GetConsentsHandlerV1 struct {
+	HTTP
+}
+
+type GrantConsentHandlerV1 struct {
+	HTTP
+}
+
+type GetDependentsScheduleHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,21 @@
+package result
+
+type GetTeamLedgerHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamLedgerBalancesHandlerV1 struct {
+	HTTP
+}
+
+type ChargeTeamDuesHandlerV1 struct {
+	HTTP
+}
+
+type ChargeTeamTournamentFeeHandlerV1 struct {
+	HTTP
+}
+
+type RecordTeamPaymentHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,37 @@
+package result
+
+type GetAllLegalEntitiesHandlerV1 struct {
+	HTTP
+}
+
+type GetLegalEntityBySlugHandlerV1 struct {
+	HTTP
+}
+
+type CreateLegalEntityHandlerV1 struct {
+	HTTP
+}
+
+type UpdateLegalEntityHandlerV1 struct {
+	HTTP
+}
+
+type GetLegalEntityAffiliationsHandlerV1 struct {
+	HTTP
+}
+
+type AffiliateToLegalEntityHandlerV1 struct {
+	HTTP
+}
+
+type EndLegalEntityAffiliationHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamLegalEntityAffiliationsHandlerV1 struct {
+	HTTP
+}
+
+type GetPersonLegalEntityAffiliationsHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,13 @@
+package result
+
+type UploadTeamLogoHandlerV1 struct {
+	HTTP
+}
+
+type UploadPersonAvatarHandlerV1 struct {
+	HTTP
+}
+
+type GetMediaObjectHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,21 @@
+package result
+
+type GetTeamMembershipsHandlerV1 struct {
+	HTTP
+}
+
+type GetPersonMembershipsHandlerV1 struct {
+	HTTP
+}
+
+type TransferMembershipHandlerV1 struct {
+	HTTP
+}
+
+type AssignJerseyNumberHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamMemberByJerseyNumberHandlerV1 struct {
+	HTTP
+}
@@ -3,3 +3,31 @@ package result
 type GetAllPeopleHandlerV1 struct {
 	HTTP
 }
+
+type GetPersonByUserNameHandlerV1 struct {
+	HTTP
+}
+
+type ArchivePersonHandlerV1 struct {
+	HTTP
+}
+
+type RestorePersonHandlerV1 struct {
+	HTTP
+}
+
+type UpdatePersonBirthDateHandlerV1 struct {
+	HTTP
+}
+
+type UpdatePersonVisibilityHandlerV1 struct {
+	HTTP
+}
+
+type ExportPersonalDataHandlerV1 struct {
+	HTTP
+}
+
+type ErasePersonHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,5 @@
+package result
+
+type SearchHandlerV1 struct {
+	HTTP
+}
@@ -15,3 +15,19 @@ type CreateTeamHandlerV1 struct {
 type UpdateTeamHandlerV1 struct {
 	HTTP
 }
+
+type ArchiveTeamHandlerV1 struct {
+	HTTP
+}
+
+type RestoreTeamHandlerV1 struct {
+	HTTP
+}
+
+type RenameTeamHandlerV1 struct {
+	HTTP
+}
+
+type GetPreviousTeamNamesHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,25 @@
+package result
+
+type GetTeamEventsHandlerV1 struct {
+	HTTP
+}
+
+type CreateTeamEventHandlerV1 struct {
+	HTTP
+}
+
+type RespondToTeamEventHandlerV1 struct {
+	HTTP
+}
+
+type RecordTeamEventAttendanceHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamAttendanceReportHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamAvailabilityHandlerV1 struct {
+	HTTP
+}
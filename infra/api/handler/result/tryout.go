@@ -0,0 +1,29 @@
+package result
+
+type GetTeamTryoutsHandlerV1 struct {
+	HTTP
+}
+
+type CreateTeamTryoutHandlerV1 struct {
+	HTTP
+}
+
+type SignUpForTeamTryoutHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamTryoutCandidatesHandlerV1 struct {
+	HTTP
+}
+
+type EvaluateTeamTryoutCandidateHandlerV1 struct {
+	HTTP
+}
+
+type GetTeamTryoutRankingsHandlerV1 struct {
+	HTTP
+}
+
+type DecideTeamTryoutCandidateHandlerV1 struct {
+	HTTP
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+
+	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SearchEchoHandlerV1 is the adapter from the Echo ecosystem to the Search handler.
+func SearchEchoHandlerV1(param handlerParam.SearchHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Query = echoContext.QueryParam("q")
+		param.Limit = echoContext.QueryParam("limit")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, SearchHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// SearchHandlerV1 is the entry point to the application's logic for searching the teams and people that match the
+// 'q' query param, the best ranked first, with the matching words of each result highlighted.
+func SearchHandlerV1(context context.Context, param handlerParam.SearchHandlerV1) handlerResult.SearchHandlerV1 {
+	invalidInput := payload.ValidateSearchParams(param.Query, param.Limit, param.MaxPageLimit)
+	if invalidInput != nil {
+		return handlerResult.SearchHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	query, limit := payload.ParseSearchParams(param.Query, param.Limit, param.DefaultPageLimit)
+	result, err := domainService.Search(context, domainServiceParam.Search{
+		Query: query,
+		Limit: limit,
+
+		TeamRepository:   param.TeamRepository,
+		PersonRepository: param.PersonRepository,
+	})
+	if err != nil {
+		return handlerResult.SearchHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to search from domain service: %w", err),
+			},
+		}
+	}
+
+	return handlerResult.SearchHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.SearchResultEntitiesToSearchResults(result.Results),
+		},
+	}
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 
@@ -11,9 +10,10 @@ import (
 
 	domainServiceParam "github.com/leeohaddad/ultimate-frisbee-api/domain/service/param"
 
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
 	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
 
-	repositoryPort "github.com/leeohaddad/ultimate-frisbee-api/domain/port/repository"
 	domainService "github.com/leeohaddad/ultimate-frisbee-api/domain/service"
 
 	"github.com/labstack/echo/v4"
@@ -23,25 +23,46 @@ import (
 func GetAllTeamsEchoHandlerV1(param handlerParam.GetAllTeamsHandlerV1) echo.HandlerFunc {
 	return func(echoContext echo.Context) error {
 		requestContext := echoContext.Request().Context()
+		param.IncludeArchived = echoContext.QueryParam("includeArchived")
+		param.Filters = echoContext.QueryParams()["filter"]
+		param.Sort = echoContext.QueryParam("sort")
+		param.Limit = echoContext.QueryParam("limit")
+		param.Cursor = echoContext.QueryParam("cursor")
 
 		return DispatchEchoResponseFromHandlerResult(echoContext, GetAllTeamsHandlerV1(requestContext, param).HTTP)
 	}
 }
 
-// GetAllTeamsHandlerV1 is the entry point to the application's logic for fetching a list of existing teams.
+// GetAllTeamsHandlerV1 is the entry point to the application's logic for fetching a list of existing teams, a page at
+// a time, narrowed down by the 'filter' query params and ordered by the 'sort' query param. Archived teams are left out
+// unless the 'includeArchived' query param is true.
 func GetAllTeamsHandlerV1(
 	context context.Context,
 	param handlerParam.GetAllTeamsHandlerV1,
 ) handlerResult.GetAllTeamsHandlerV1 {
+	invalidInput := payload.JoinInvalidInputs(
+		payload.ValidateIncludeArchived(param.IncludeArchived),
+		payload.ValidateTeamCriteriaParams(param.Filters, param.Sort),
+		payload.ValidatePageParams(param.Limit, param.Cursor, param.MaxPageLimit),
+	)
+	if invalidInput != nil {
+		return handlerResult.GetAllTeamsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
 	result, err := domainService.GetAllTeams(context, domainServiceParam.GetAllTeams{
-		Repository: param.Repository,
+		IncludeArchived: payload.ParseIncludeArchived(param.IncludeArchived),
+		Criteria:        payload.ParseTeamCriteriaParams(param.Filters, param.Sort),
+		Page:            payload.ParsePageParams(param.Limit, param.Cursor, param.DefaultPageLimit),
+		Repository:      param.Repository,
 	})
 	if err != nil {
 		return handlerResult.GetAllTeamsHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusInternalServerError,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("failed to get all teams from domain service: %s", err.Error()),
+				Error: fmt.Errorf("failed to get all teams from domain service: %w", err),
 			},
 		}
 	}
@@ -50,7 +71,10 @@ func GetAllTeamsHandlerV1(
 		HTTP: handlerResult.HTTP{
 			StatusCode:   http.StatusOK,
 			ResponseType: handlerResult.ResponseBodyTypes.JSON,
-			JSONResponse: payload.TeamEntitiesToTeams(result.Teams),
+			JSONResponse: payload.TeamPage{
+				Items:      payload.TeamEntitiesToTeams(result.Teams),
+				NextCursor: payload.NextCursor(result.NextAfter),
+			},
 		},
 	}
 }
@@ -77,9 +101,7 @@ func GetTeamByNameHandlerV1(
 	if err != nil {
 		return handlerResult.GetTeamByNameHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusInternalServerError,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("failed to search team by name '%s' from domain service: %s", param.Name, err.Error()),
+				Error: fmt.Errorf("failed to search team by name '%s' from domain service: %w", param.Name, err),
 			},
 		}
 	}
@@ -87,9 +109,7 @@ func GetTeamByNameHandlerV1(
 	if result.Team == nil {
 		return handlerResult.GetTeamByNameHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusNotFound,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("no team with name '%s' was found in the repository", param.Name),
+				Error: failure.ErrTeamNotFound.WithMessage(fmt.Sprintf("no team with name '%s' was found in the repository", param.Name)),
 			},
 		}
 	}
@@ -121,47 +141,31 @@ func CreateTeamEchoHandlerV1(param handlerParam.CreateTeamHandlerV1) echo.Handle
 
 // CreateTeamHandlerV1 is the entry point to the application's logic of creating a new team.
 func CreateTeamHandlerV1(context context.Context, param handlerParam.CreateTeamHandlerV1) handlerResult.CreateTeamHandlerV1 {
-	paramsAreValid, invalidParamsMessage := payload.ValidateCreateTeamInput(&param.Payload)
-	if !paramsAreValid {
+	invalidInput := payload.ValidateCreateTeamInput(&param.Payload)
+	if invalidInput != nil {
 		return handlerResult.CreateTeamHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusBadRequest,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: invalidParamsMessage,
+				Error: invalidInput,
 			},
 		}
 	}
 
 	result, err := domainService.CreateTeam(context, domainServiceParam.CreateTeam{
-		Team:       payload.TeamToTeamEntity(param.Payload),
-		Repository: param.Repository,
+		Team:              payload.TeamToTeamEntity(param.Payload),
+		Repository:        param.Repository,
+		CountryRepository: param.CountryRepository,
 	})
 	if err != nil {
-		// map repository sentinel error to HTTP 409 Conflict
-		if errors.Is(err, repositoryPort.ErrAlreadyExists) {
-			return handlerResult.CreateTeamHandlerV1{
-				HTTP: handlerResult.HTTP{
-					StatusCode:     http.StatusConflict,
-					ResponseType:   handlerResult.ResponseBodyTypes.String,
-					StringResponse: fmt.Sprintf("team with name '%s' already exists", param.Payload.Name),
-				},
-			}
-		}
-
 		return handlerResult.CreateTeamHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusInternalServerError,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("failed to create team with name '%s' in domain service: %s", param.Payload.Name, err.Error()),
+				Error: fmt.Errorf("failed to create team with name '%s' in domain service: %w", param.Payload.Name, err),
 			},
 		}
 	}
 	if result.Team == nil {
 		return handlerResult.CreateTeamHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusInternalServerError,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("no team with name '%s' was created", param.Payload.Name),
+				Error: fmt.Errorf("no team with name '%s' was created", param.Payload.Name),
 			},
 		}
 	}
@@ -193,13 +197,11 @@ func UpdateTeamEchoHandlerV1(param handlerParam.UpdateTeamHandlerV1) echo.Handle
 
 // UpdateTeamHandlerV1 is the entry point to the application's logic of updating info of an existing team.
 func UpdateTeamHandlerV1(context context.Context, param handlerParam.UpdateTeamHandlerV1) handlerResult.UpdateTeamHandlerV1 {
-	paramsAreValid, invalidParamsMessage := payload.ValidateUpdateTeamInput(&param.Payload, param.Name)
-	if !paramsAreValid {
+	invalidInput := payload.ValidateUpdateTeamInput(&param.Payload, param.Name)
+	if invalidInput != nil {
 		return handlerResult.UpdateTeamHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusBadRequest,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: invalidParamsMessage,
+				Error: invalidInput,
 			},
 		}
 	}
@@ -209,13 +211,12 @@ func UpdateTeamHandlerV1(context context.Context, param handlerParam.UpdateTeamH
 		Team:              payload.TeamToTeamEntity(param.Payload),
 		UpdatedAttributes: payload.GetFilledTeamAttributesForUpdate(&param.Payload),
 		Repository:        param.Repository,
+		CountryRepository: param.CountryRepository,
 	})
 	if err != nil {
 		return handlerResult.UpdateTeamHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusInternalServerError,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("failed to update team with name '%s' in domain service: %s", param.Name, err.Error()),
+				Error: fmt.Errorf("failed to update team with name '%s' in domain service: %w", param.Name, err),
 			},
 		}
 	}
@@ -223,9 +224,7 @@ func UpdateTeamHandlerV1(context context.Context, param handlerParam.UpdateTeamH
 	if result.Team == nil {
 		return handlerResult.UpdateTeamHandlerV1{
 			HTTP: handlerResult.HTTP{
-				StatusCode:     http.StatusNotFound,
-				ResponseType:   handlerResult.ResponseBodyTypes.String,
-				StringResponse: fmt.Sprintf("no team with name '%s' was found", param.Name),
+				Error: failure.ErrTeamNotFound.WithMessage(fmt.Sprintf("no team with name '%s' was found", param.Name)),
 			},
 		}
 	}
@@ -237,3 +236,254 @@ func UpdateTeamHandlerV1(context context.Context, param handlerParam.UpdateTeamH
 		},
 	}
 }
+
+// ArchiveTeamEchoHandlerV1 is the adapter from the Echo ecosystem to the ArchiveTeam handler.
+func ArchiveTeamEchoHandlerV1(param handlerParam.ArchiveTeamHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Name = echoContext.Param("name")
+
+		var input payload.ArchiveInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, ArchiveTeamHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// ArchiveTeamHandlerV1 is the entry point to the application's logic of soft deleting a team, which leaves the listings
+// but keeps the history of the team.
+func ArchiveTeamHandlerV1(context context.Context, param handlerParam.ArchiveTeamHandlerV1) handlerResult.ArchiveTeamHandlerV1 {
+	invalidInput := payload.ValidateArchiveInput(&param.Payload, "Team")
+	if invalidInput != nil {
+		return handlerResult.ArchiveTeamHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.ArchiveTeam(context, domainServiceParam.ArchiveTeam{
+		Name:      param.Name,
+		DeletedBy: *param.Payload.DeletedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.ArchiveTeamHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to archive team '%s' in domain service: %w", param.Name, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.ArchiveTeamHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.Name),
+		}
+	}
+
+	return handlerResult.ArchiveTeamHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEntityToTeam(result.Team),
+		},
+	}
+}
+
+// RestoreTeamEchoHandlerV1 is the adapter from the Echo ecosystem to the RestoreTeam handler.
+func RestoreTeamEchoHandlerV1(param handlerParam.RestoreTeamHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Name = echoContext.Param("name")
+
+		var input payload.RestoreInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, RestoreTeamHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// RestoreTeamHandlerV1 is the entry point to the application's logic of bringing an archived team back to the listings.
+func RestoreTeamHandlerV1(context context.Context, param handlerParam.RestoreTeamHandlerV1) handlerResult.RestoreTeamHandlerV1 {
+	invalidInput := payload.ValidateRestoreInput(&param.Payload, "Team")
+	if invalidInput != nil {
+		return handlerResult.RestoreTeamHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.RestoreTeam(context, domainServiceParam.RestoreTeam{
+		Name:      param.Name,
+		UpdatedBy: *param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.RestoreTeamHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to restore team '%s' in domain service: %w", param.Name, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.RestoreTeamHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.Name),
+		}
+	}
+
+	return handlerResult.RestoreTeamHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEntityToTeam(result.Team),
+		},
+	}
+}
+
+// RenameTeamEchoHandlerV1 is the adapter from the Echo ecosystem to the RenameTeam handler.
+func RenameTeamEchoHandlerV1(param handlerParam.RenameTeamHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Name = echoContext.Param("name")
+
+		var input payload.TeamRenameInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, RenameTeamHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// RenameTeamHandlerV1 is the entry point to the application's logic of renaming a team. The current name and slug are
+// kept as previous names, so lookups by them are redirected to the renamed team.
+func RenameTeamHandlerV1(context context.Context, param handlerParam.RenameTeamHandlerV1) handlerResult.RenameTeamHandlerV1 {
+	invalidInput := payload.ValidateTeamRenameInput(&param.Payload, param.Name)
+	if invalidInput != nil {
+		return handlerResult.RenameTeamHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := domainService.RenameTeam(context, domainServiceParam.RenameTeam{
+		Name:          param.Name,
+		NewName:       *param.Payload.Name,
+		NewSlug:       *param.Payload.Slug,
+		EffectiveDate: payload.TeamRenameInputEffectiveDate(&param.Payload),
+		UpdatedBy:     *param.Payload.UpdatedBy,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.RenameTeamHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to rename team '%s' in domain service: %w", param.Name, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.RenameTeamHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.Name),
+		}
+	}
+
+	return handlerResult.RenameTeamHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEntityToTeam(result.Team),
+		},
+	}
+}
+
+// GetPreviousTeamNamesEchoHandlerV1 is the adapter from the Echo ecosystem to the GetPreviousTeamNames handler.
+func GetPreviousTeamNamesEchoHandlerV1(param handlerParam.GetPreviousTeamNamesHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.Name = echoContext.Param("name")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetPreviousTeamNamesHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetPreviousTeamNamesHandlerV1 is the entry point to the application's logic of listing the names that a team used
+// before being renamed, the oldest first.
+func GetPreviousTeamNamesHandlerV1(
+	context context.Context,
+	param handlerParam.GetPreviousTeamNamesHandlerV1,
+) handlerResult.GetPreviousTeamNamesHandlerV1 {
+	result, err := domainService.GetPreviousTeamNames(context, domainServiceParam.GetPreviousTeamNames{
+		Name: param.Name,
+
+		Repository: param.Repository,
+	})
+	if err != nil {
+		return handlerResult.GetPreviousTeamNamesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get previous names of team '%s' from domain service: %w", param.Name, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetPreviousTeamNamesHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.Name),
+		}
+	}
+
+	return handlerResult.GetPreviousTeamNamesHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamNameEntitiesToTeamNames(result.PreviousNames),
+		},
+	}
+}
+
+// RedirectPreviousTeamNamesEchoMiddlewareV1 redirects the requests that address a team by a name or slug it used
+// before being renamed to the same route under its current name.
+func RedirectPreviousTeamNamesEchoMiddlewareV1(param handlerParam.RedirectPreviousTeamNamesMiddlewareV1) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(echoContext echo.Context) error {
+			name := echoContext.Param("name")
+			if name == "" {
+				return next(echoContext)
+			}
+
+			result, err := domainService.GetTeamByPreviousName(echoContext.Request().Context(), domainServiceParam.GetTeamByPreviousName{
+				NameOrSlug: name,
+
+				Repository: param.Repository,
+			})
+			if err != nil {
+				return DispatchEchoResponseFromString(
+					echoContext,
+					http.StatusInternalServerError,
+					fmt.Sprintf("failed to get team by previous name '%s' from domain service: %s", name, err.Error()),
+				)
+			}
+			if result.Team == nil {
+				return next(echoContext)
+			}
+
+			return redirectToParamValue(echoContext, "name", result.Team.Name)
+		}
+	}
+}
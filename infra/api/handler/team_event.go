@@ -0,0 +1,426 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetTeamEventsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamEvents handler.
+func GetTeamEventsEchoHandlerV1(param handlerParam.GetTeamEventsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.From = echoContext.QueryParam("from")
+		param.To = echoContext.QueryParam("to")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamEventsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamEventsHandlerV1 is the entry point to the application's logic of listing the events of a team.
+func GetTeamEventsHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamEventsHandlerV1,
+) handlerResult.GetTeamEventsHandlerV1 {
+	invalidInput := payload.ValidateTeamEventPeriod(param.From, param.To)
+	if invalidInput != nil {
+		return handlerResult.GetTeamEventsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	from, to := payload.ParseTeamEventPeriod(param.From, param.To)
+	result, err := applicationService.GetTeamEvents(context, applicationParam.GetTeamEvents{
+		TeamName: param.TeamName,
+		From:     from,
+		To:       to,
+
+		TeamRepository:      param.TeamRepository,
+		TeamEventRepository: param.TeamEventRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamEventsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get events of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamEventsHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamEventsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEventEntitiesToTeamEvents(result.Events),
+		},
+	}
+}
+
+// CreateTeamEventEchoHandlerV1 is the adapter from the Echo ecosystem to the CreateTeamEvent handler.
+func CreateTeamEventEchoHandlerV1(param handlerParam.CreateTeamEventHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		var input payload.CreateTeamEventInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, CreateTeamEventHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// CreateTeamEventHandlerV1 is the entry point to the application's logic of scheduling an event for a team.
+func CreateTeamEventHandlerV1(
+	context context.Context,
+	param handlerParam.CreateTeamEventHandlerV1,
+) handlerResult.CreateTeamEventHandlerV1 {
+	invalidInput := payload.ValidateCreateTeamEventInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.CreateTeamEventHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.CreateTeamEvent(context, applicationParam.CreateTeamEvent{
+		TeamName: param.TeamName,
+		Event:    payload.CreateTeamEventInputToTeamEventEntity(&param.Payload),
+
+		TeamRepository:      param.TeamRepository,
+		TeamEventRepository: param.TeamEventRepository,
+	})
+	if err != nil {
+		return handlerResult.CreateTeamEventHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to create event of team '%s' in application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.CreateTeamEventHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.CreateTeamEventHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEventEntityToTeamEvent(result.Event),
+		},
+	}
+}
+
+// RespondToTeamEventEchoHandlerV1 is the adapter from the Echo ecosystem to the RespondToTeamEvent handler.
+func RespondToTeamEventEchoHandlerV1(param handlerParam.RespondToTeamEventHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.EventID = echoContext.Param("eventId")
+		param.PersonUserName = echoContext.Param("username")
+
+		var input payload.TeamEventRSVPInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, RespondToTeamEventHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// RespondToTeamEventHandlerV1 is the entry point to the application's logic of answering the invitation to a team event.
+func RespondToTeamEventHandlerV1(
+	context context.Context,
+	param handlerParam.RespondToTeamEventHandlerV1,
+) handlerResult.RespondToTeamEventHandlerV1 {
+	invalidInput := payload.ValidateTeamEventRSVPInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.RespondToTeamEventHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	// Unknown event IDs are not valid UUIDs most of the time, so they are answered before reaching the database
+	if !helper.IsUUID(param.EventID) {
+		return handlerResult.RespondToTeamEventHandlerV1{
+			HTTP: teamEventNotFoundHTTPResult(param.TeamName, param.EventID),
+		}
+	}
+
+	result, err := applicationService.RespondToTeamEvent(context, applicationParam.RespondToTeamEvent{
+		TeamName:       param.TeamName,
+		EventID:        param.EventID,
+		PersonUserName: param.PersonUserName,
+		RSVP:           entity.RSVPStatus(*param.Payload.Status),
+
+		TeamRepository:       param.TeamRepository,
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.RespondToTeamEventHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to respond to event %s of team '%s' in application service: %w", param.EventID, param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.RespondToTeamEventHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Event == nil {
+		return handlerResult.RespondToTeamEventHandlerV1{
+			HTTP: teamEventNotFoundHTTPResult(param.TeamName, param.EventID),
+		}
+	}
+
+	return handlerResult.RespondToTeamEventHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEventParticipationEntityToTeamEventRSVP(result.Participation),
+		},
+	}
+}
+
+// RecordTeamEventAttendanceEchoHandlerV1 is the adapter from the Echo ecosystem to the RecordTeamEventAttendance handler.
+func RecordTeamEventAttendanceEchoHandlerV1(param handlerParam.RecordTeamEventAttendanceHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.EventID = echoContext.Param("eventId")
+
+		var input payload.TeamEventAttendanceInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, RecordTeamEventAttendanceHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// RecordTeamEventAttendanceHandlerV1 is the entry point to the application's logic of recording who attended a team event.
+func RecordTeamEventAttendanceHandlerV1(
+	context context.Context,
+	param handlerParam.RecordTeamEventAttendanceHandlerV1,
+) handlerResult.RecordTeamEventAttendanceHandlerV1 {
+	invalidInput := payload.ValidateTeamEventAttendanceInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.RecordTeamEventAttendanceHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	if !helper.IsUUID(param.EventID) {
+		return handlerResult.RecordTeamEventAttendanceHandlerV1{
+			HTTP: teamEventNotFoundHTTPResult(param.TeamName, param.EventID),
+		}
+	}
+
+	result, err := applicationService.RecordTeamEventAttendance(context, applicationParam.RecordTeamEventAttendance{
+		TeamName:   param.TeamName,
+		EventID:    param.EventID,
+		Attendance: payload.TeamEventAttendanceInputToAttendanceStatuses(&param.Payload),
+		RecordedBy: *param.Payload.RecordedBy,
+
+		TeamRepository:       param.TeamRepository,
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.RecordTeamEventAttendanceHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to record attendance of event %s of team '%s' in application service: %w", param.EventID, param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.RecordTeamEventAttendanceHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Event == nil {
+		return handlerResult.RecordTeamEventAttendanceHandlerV1{
+			HTTP: teamEventNotFoundHTTPResult(param.TeamName, param.EventID),
+		}
+	}
+
+	return handlerResult.RecordTeamEventAttendanceHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEventParticipationEntitiesToTeamEventAttendance(
+				result.Event.ID,
+				*param.Payload.RecordedBy,
+				result.Participations,
+			),
+		},
+	}
+}
+
+// GetTeamAttendanceReportEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamAttendanceReport handler.
+func GetTeamAttendanceReportEchoHandlerV1(param handlerParam.GetTeamAttendanceReportHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.From = echoContext.QueryParam("from")
+		param.To = echoContext.QueryParam("to")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamAttendanceReportHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamAttendanceReportHandlerV1 is the entry point to the application's logic of reporting the attendance rate of
+// each member of a team.
+func GetTeamAttendanceReportHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamAttendanceReportHandlerV1,
+) handlerResult.GetTeamAttendanceReportHandlerV1 {
+	invalidInput := payload.ValidateTeamEventPeriod(param.From, param.To)
+	if invalidInput != nil {
+		return handlerResult.GetTeamAttendanceReportHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	from, to := payload.ParseTeamEventPeriod(param.From, param.To)
+	result, err := applicationService.GetTeamAttendanceReport(context, applicationParam.GetTeamAttendanceReport{
+		TeamName: param.TeamName,
+		From:     from,
+		To:       to,
+
+		TeamRepository:       param.TeamRepository,
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamAttendanceReportHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get attendance report of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamAttendanceReportHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamAttendanceReportHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamAttendanceRateEntitiesToTeamAttendanceRates(result.Rates),
+		},
+	}
+}
+
+// GetTeamAvailabilityEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamAvailability handler.
+func GetTeamAvailabilityEchoHandlerV1(param handlerParam.GetTeamAvailabilityHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.From = echoContext.QueryParam("from")
+		param.To = echoContext.QueryParam("to")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamAvailabilityHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamAvailabilityHandlerV1 is the entry point to the application's logic of summarizing the RSVPs of the members of
+// a team to its upcoming events.
+func GetTeamAvailabilityHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamAvailabilityHandlerV1,
+) handlerResult.GetTeamAvailabilityHandlerV1 {
+	invalidInput := payload.ValidateTeamEventPeriod(param.From, param.To)
+	if invalidInput != nil {
+		return handlerResult.GetTeamAvailabilityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	from, to := payload.ParseTeamEventPeriod(param.From, param.To)
+	result, err := applicationService.GetTeamAvailability(context, applicationParam.GetTeamAvailability{
+		TeamName: param.TeamName,
+		From:     from,
+		To:       to,
+
+		TeamRepository:       param.TeamRepository,
+		TeamEventRepository:  param.TeamEventRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamAvailabilityHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get availability of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamAvailabilityHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamAvailabilityHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TeamEventAvailabilityEntitiesToTeamEventAvailabilities(result.Availabilities),
+		},
+	}
+}
+
+func teamEventNotFoundHTTPResult(teamName, eventID string) handlerResult.HTTP {
+	return handlerResult.HTTP{
+		Error: failure.ErrTeamEventNotFound.WithMessage(fmt.Sprintf("no event with ID '%s' was found for team '%s'", eventID, teamName)),
+	}
+}
@@ -0,0 +1,304 @@
+//go:build integration
+// +build integration
+
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	repositoryPostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/repository/postgres"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler"
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+	databasePostgres "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/test/fixture"
+)
+
+const (
+	teamEventTestEventID        = "0b8e5f3a-6a55-4c84-9a3e-2f1d5c7b9e01"
+	teamEventTestUnknownEventID = "0b8e5f3a-6a55-4c84-9a3e-2f1d5c7b9eff"
+)
+
+func GetTeamEventFixtureTeam(t *testing.T) *entity.Team {
+	t.Helper()
+
+	return fixture.GetFakeTeam().
+		WithSlug("bra-sp-team-event-test-team").
+		WithName("Team Event Test Team").
+		WithDescription("A team with events.").
+		WithOriginCountry("BR")
+}
+
+// GetTeamEventFixtureQueries fills the database with an event of the team on 2025-03-10, its captain and people whose
+// memberships start or end around that date.
+func GetTeamEventFixtureQueries(t *testing.T) []fixture.Query {
+	t.Helper()
+
+	team := GetTeamEventFixtureTeam(t)
+	activePlayer := fixture.GetFakePerson("event.active.player")
+	leavingPlayer := fixture.GetFakePerson("event.leaving.player")
+	formerPlayer := fixture.GetFakePerson("event.former.player")
+	newPlayer := fixture.GetFakePerson("event.new.player")
+	futurePlayer := fixture.GetFakePerson("event.future.player")
+	outsider := fixture.GetFakePerson("event.outsider")
+	captain := fixture.GetFakePerson("event.captain")
+
+	return fixture.MergeQueries(
+		fixture.GenerateTeamQueries(team),
+		fixture.GeneratePersonQueries(activePlayer, leavingPlayer, formerPlayer, newPlayer, futurePlayer, outsider, captain),
+		fixture.GenerateMembershipQueries(
+			fixture.GetFakeMembership(team, activePlayer),
+			fixture.GetFakeMembership(team, leavingPlayer).WithEndDate(time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)),
+			fixture.GetFakeMembership(team, formerPlayer).WithEndDate(time.Date(2025, time.March, 9, 0, 0, 0, 0, time.UTC)),
+			fixture.GetFakeMembership(team, newPlayer).WithStartDate(time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)),
+			fixture.GetFakeMembership(team, futurePlayer).WithStartDate(time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC)),
+			fixture.GetFakeMembership(team, captain).WithRole(entity.MembershipRoles.Captain),
+		),
+		fixture.GenerateTeamEventQueries(
+			fixture.GetFakeTeamEvent(teamEventTestEventID, team, time.Date(2025, time.March, 10, 19, 0, 0, 0, time.UTC)),
+		),
+	)
+}
+
+func TestTeamEventHandler_RespondToTeamEvent(t *testing.T) {
+	t.Parallel()
+
+	team := GetTeamEventFixtureTeam(t)
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should save the RSVP of an open-ended member",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"eventID":        teamEventTestEventID,
+				"personUserName": "event.active.player",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusOK,
+				"expectedMessage":    "",
+			},
+		},
+		{
+			Description:    "should save the RSVP of a member whose membership ends on the event date",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"eventID":        teamEventTestEventID,
+				"personUserName": "event.leaving.player",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusOK,
+				"expectedMessage":    "",
+			},
+		},
+		{
+			Description:    "should save the RSVP of a member whose membership starts on the event date",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"eventID":        teamEventTestEventID,
+				"personUserName": "event.new.player",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusOK,
+				"expectedMessage":    "",
+			},
+		},
+		{
+			Description:    "should refuse the RSVP of a member whose membership ended the day before the event",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"eventID":        teamEventTestEventID,
+				"personUserName": "event.former.player",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusUnprocessableEntity,
+				"expectedMessage":    "'event.former.player' is not an active member of team 'bra-sp-team-event-test-team' on the event date",
+			},
+		},
+		{
+			Description:    "should refuse the RSVP of a member whose membership starts the day after the event",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"eventID":        teamEventTestEventID,
+				"personUserName": "event.future.player",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusUnprocessableEntity,
+				"expectedMessage":    "'event.future.player' is not an active member of team 'bra-sp-team-event-test-team' on the event date",
+			},
+		},
+		{
+			Description:    "should refuse the RSVP of a person who is not a member of the team",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"eventID":        teamEventTestEventID,
+				"personUserName": "event.outsider",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusUnprocessableEntity,
+				"expectedMessage":    "'event.outsider' is not an active member of team 'bra-sp-team-event-test-team' on the event date",
+			},
+		},
+		{
+			Description:    "should return not found when the event does not exist",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"eventID":        teamEventTestUnknownEventID,
+				"personUserName": "event.active.player",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusNotFound,
+				"expectedMessage":    "no event with ID '" + teamEventTestUnknownEventID + "' was found for team 'Team Event Test Team'",
+			},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+
+			expectedStatusCode, ok := scenario.OutputData["expectedStatusCode"].(int)
+			require.True(t, ok)
+			expectedMessage, ok := scenario.OutputData["expectedMessage"].(string)
+			require.True(t, ok)
+			eventID, ok := scenario.InputData["eventID"].(string)
+			require.True(t, ok)
+			personUserName, ok := scenario.InputData["personUserName"].(string)
+			require.True(t, ok)
+
+			status := string(entity.RSVPStatuses.Yes)
+			result := handler.RespondToTeamEventHandlerV1(testContext, handlerParam.RespondToTeamEventHandlerV1{
+				TeamName:       team.Name,
+				EventID:        eventID,
+				PersonUserName: personUserName,
+				Payload:        payload.TeamEventRSVPInput{Status: &status},
+
+				TeamRepository:       repositoryPostgres.NewTeamRepository(client),
+				TeamEventRepository:  repositoryPostgres.NewTeamEventRepository(client),
+				MembershipRepository: repositoryPostgres.NewMembershipRepository(client),
+			})
+
+			if result.ResponseType == handlerResult.ResponseBodyTypes.JSON {
+				obtainedRSVP, ok := result.JSONResponse.(payload.TeamEventRSVP)
+				require.True(t, ok)
+				require.Equal(t, eventID, obtainedRSVP.EventID)
+				require.Equal(t, personUserName, obtainedRSVP.PersonUserName)
+				require.Equal(t, status, obtainedRSVP.Status)
+			}
+			if result.Error != nil {
+				require.Contains(t, result.Error.Error(), expectedMessage)
+			}
+			require.Equal(t, expectedStatusCode, handler.ResponseStatusCode(result.HTTP))
+		},
+	)
+}
+
+func TestTeamEventHandler_RecordTeamEventAttendance(t *testing.T) {
+	t.Parallel()
+
+	team := GetTeamEventFixtureTeam(t)
+
+	scenarios := []test.FixtureScenario{
+		{
+			Description:    "should record the attendance of the members active on the event date",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"recordedBy": "event.captain",
+				"attendance": map[string]string{
+					"event.active.player":  string(entity.AttendanceStatuses.Present),
+					"event.leaving.player": string(entity.AttendanceStatuses.Absent),
+					"event.new.player":     string(entity.AttendanceStatuses.Present),
+				},
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusOK,
+				"expectedMessage":    "",
+			},
+		},
+		{
+			Description:    "should refuse the whole sheet and tell every person who is not active on the event date",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"recordedBy": "event.captain",
+				"attendance": map[string]string{
+					"event.active.player": string(entity.AttendanceStatuses.Present),
+					"event.future.player": string(entity.AttendanceStatuses.Present),
+					"event.former.player": string(entity.AttendanceStatuses.Absent),
+					"event.outsider":      string(entity.AttendanceStatuses.Present),
+				},
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusUnprocessableEntity,
+				"expectedMessage": "the following people are not active members of team 'bra-sp-team-event-test-team' " +
+					"on the event date: event.former.player, event.future.player, event.outsider",
+			},
+		},
+		{
+			Description:    "should refuse the sheet when it is not recorded by the staff of the team",
+			FixtureQueries: GetTeamEventFixtureQueries(t),
+			InputData: map[string]interface{}{
+				"recordedBy": "event.active.player",
+				"attendance": map[string]string{
+					"event.active.player": string(entity.AttendanceStatuses.Present),
+				},
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode": http.StatusForbidden,
+				"expectedMessage":    "'event.active.player' is not part of the staff of team 'bra-sp-team-event-test-team'",
+			},
+		},
+	}
+
+	test.RunFixtureScenarios(
+		t, scenarios,
+		func(t *testing.T, testContext context.Context, client databasePostgres.Client, scenario test.FixtureScenario) {
+			t.Helper()
+
+			expectedStatusCode, ok := scenario.OutputData["expectedStatusCode"].(int)
+			require.True(t, ok)
+			expectedMessage, ok := scenario.OutputData["expectedMessage"].(string)
+			require.True(t, ok)
+			recordedBy, ok := scenario.InputData["recordedBy"].(string)
+			require.True(t, ok)
+			attendance, ok := scenario.InputData["attendance"].(map[string]string)
+			require.True(t, ok)
+
+			records := []payload.TeamEventAttendanceRecord{}
+			for userName, status := range attendance {
+				records = append(records, payload.TeamEventAttendanceRecord{PersonUserName: userName, Status: status})
+			}
+			result := handler.RecordTeamEventAttendanceHandlerV1(testContext, handlerParam.RecordTeamEventAttendanceHandlerV1{
+				TeamName: team.Name,
+				EventID:  teamEventTestEventID,
+				Payload:  payload.TeamEventAttendanceInput{Records: records, RecordedBy: &recordedBy},
+
+				TeamRepository:       repositoryPostgres.NewTeamRepository(client),
+				TeamEventRepository:  repositoryPostgres.NewTeamEventRepository(client),
+				MembershipRepository: repositoryPostgres.NewMembershipRepository(client),
+			})
+
+			if result.ResponseType == handlerResult.ResponseBodyTypes.JSON {
+				obtainedAttendance, ok := result.JSONResponse.(payload.TeamEventAttendance)
+				require.True(t, ok)
+				require.Equal(t, teamEventTestEventID, obtainedAttendance.EventID)
+				require.Equal(t, recordedBy, obtainedAttendance.RecordedBy)
+				require.Len(t, obtainedAttendance.Records, len(attendance))
+				for _, obtainedRecord := range obtainedAttendance.Records {
+					require.Equal(t, attendance[obtainedRecord.PersonUserName], obtainedRecord.Status)
+				}
+			}
+			if result.Error != nil {
+				require.Contains(t, result.Error.Error(), expectedMessage)
+			}
+			require.Equal(t, expectedStatusCode, handler.ResponseStatusCode(result.HTTP))
+		},
+	)
+}
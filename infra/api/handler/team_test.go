@@ -32,7 +32,7 @@ func GetDefaultFixtureTeam(t *testing.T) *entity.Team {
 		WithSlug("bra-sp-some-test-team").
 		WithName("Some Test Team").
 		WithDescription("A test team.").
-		WithOriginCountry("BRA").
+		WithOriginCountry("BR").
 		WithCreatedBy("Someone who created the test team").
 		WithUpdatedBy("Someone who updated the test team")
 }
@@ -44,11 +44,22 @@ func GetAnotherFixtureTeam(t *testing.T) *entity.Team {
 		WithSlug("another-test-team").
 		WithName("Another Test Team").
 		WithDescription("Another test team.").
-		WithOriginCountry("BRA").
+		WithOriginCountry("BR").
 		WithCreatedBy("Someone who created the other test team").
 		WithUpdatedBy("Someone who updated the other test team")
 }
 
+// GetDefaultFixtureTeamFrom is the default fixture team with the origin country written as given, which may be any
+// of the codes or names of the country.
+func GetDefaultFixtureTeamFrom(t *testing.T, originCountry string) *entity.Team {
+	t.Helper()
+
+	team := GetDefaultFixtureTeam(t)
+	team.OriginCountry = originCountry
+
+	return team
+}
+
 func TestTeamHandler_GetAllTeams(t *testing.T) {
 	t.Parallel()
 
@@ -56,24 +67,30 @@ func TestTeamHandler_GetAllTeams(t *testing.T) {
 		{
 			Description:    "should return no team when database is clean",
 			FixtureQueries: []fixture.Query{},
-			InputData:      map[string]interface{}{},
+			InputData: map[string]interface{}{
+				"limit": "",
+			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusOK,
 				"expectedResponseType":   handlerResult.ResponseBodyTypes.JSON,
 				"expectedJSONResponse":   []payload.Team{},
+				"expectedNextCursor":     false,
 				"expectedStringResponse": "",
 			},
 		},
 		{
 			Description:    "should return one team when database is filled with one team",
 			FixtureQueries: fixture.GenerateTeamQueries(GetDefaultFixtureTeam(t)),
-			InputData:      map[string]interface{}{},
+			InputData: map[string]interface{}{
+				"limit": "",
+			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":   http.StatusOK,
 				"expectedResponseType": handlerResult.ResponseBodyTypes.JSON,
 				"expectedJSONResponse": []payload.Team{
 					payload.TeamEntityToTeam(GetDefaultFixtureTeam(t)),
 				},
+				"expectedNextCursor":     false,
 				"expectedStringResponse": "",
 			},
 		},
@@ -83,7 +100,9 @@ func TestTeamHandler_GetAllTeams(t *testing.T) {
 				GetDefaultFixtureTeam(t),
 				GetAnotherFixtureTeam(t),
 			),
-			InputData: map[string]interface{}{},
+			InputData: map[string]interface{}{
+				"limit": "",
+			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":   http.StatusOK,
 				"expectedResponseType": handlerResult.ResponseBodyTypes.JSON,
@@ -91,9 +110,43 @@ func TestTeamHandler_GetAllTeams(t *testing.T) {
 					payload.TeamEntityToTeam(GetDefaultFixtureTeam(t)),
 					payload.TeamEntityToTeam(GetAnotherFixtureTeam(t)),
 				},
+				"expectedNextCursor":     false,
 				"expectedStringResponse": "",
 			},
 		},
+		{
+			Description: "should return the first team by slug and a cursor to the next page when the limit is one",
+			FixtureQueries: fixture.GenerateTeamQueries(
+				GetDefaultFixtureTeam(t),
+				GetAnotherFixtureTeam(t),
+			),
+			InputData: map[string]interface{}{
+				"limit": "1",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode":   http.StatusOK,
+				"expectedResponseType": handlerResult.ResponseBodyTypes.JSON,
+				"expectedJSONResponse": []payload.Team{
+					payload.TeamEntityToTeam(GetAnotherFixtureTeam(t)),
+				},
+				"expectedNextCursor":     true,
+				"expectedStringResponse": "",
+			},
+		},
+		{
+			Description:    "should return bad request when the limit is above the maximum",
+			FixtureQueries: []fixture.Query{},
+			InputData: map[string]interface{}{
+				"limit": "1000",
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode":     http.StatusBadRequest,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
+				"expectedJSONResponse":   []payload.Team{},
+				"expectedNextCursor":     false,
+				"expectedStringResponse": "the 'limit' query param should be a number from 1 to 100",
+			},
+		},
 	}
 
 	test.RunFixtureScenarios(
@@ -107,19 +160,29 @@ func TestTeamHandler_GetAllTeams(t *testing.T) {
 			require.True(t, ok)
 			expectedTeams, ok := scenario.OutputData["expectedJSONResponse"].([]payload.Team)
 			require.True(t, ok)
+			expectedNextCursor, ok := scenario.OutputData["expectedNextCursor"].(bool)
+			require.True(t, ok)
 			expectedMessage, ok := scenario.OutputData["expectedStringResponse"].(string)
 			require.True(t, ok)
+			limit, ok := scenario.InputData["limit"].(string)
+			require.True(t, ok)
 
 			teamRepository := repositoryPostgres.NewTeamRepository(client)
 
 			result := handler.GetAllTeamsHandlerV1(testContext, handlerParam.GetAllTeamsHandlerV1{
+				Limit:            limit,
+				DefaultPageLimit: 10,
+				MaxPageLimit:     100,
+
 				Repository: teamRepository,
 			})
 
 			switch result.ResponseType {
 			case handlerResult.ResponseBodyTypes.JSON:
-				obtainedTeams, ok := result.JSONResponse.([]payload.Team)
+				obtainedPage, ok := result.JSONResponse.(payload.TeamPage)
 				require.True(t, ok)
+				require.Equal(t, expectedNextCursor, obtainedPage.NextCursor != nil)
+				obtainedTeams := obtainedPage.Items
 				require.Len(t, obtainedTeams, len(expectedTeams))
 
 				indexedObtainedTeams := map[string]payload.Team{}
@@ -138,11 +201,12 @@ func TestTeamHandler_GetAllTeams(t *testing.T) {
 					require.Equal(t, expectedTeam.UpdatedBy, obtainedTeam.UpdatedBy)
 					require.NotNil(t, obtainedTeam.UpdatedAt)
 				}
-			case handlerResult.ResponseBodyTypes.String:
-				require.Contains(t, result.StringResponse, expectedMessage)
+			}
+			if result.Error != nil {
+				require.Contains(t, result.Error.Error(), expectedMessage)
 			}
 			require.Equal(t, expectedResponseType, result.ResponseType)
-			require.Equal(t, expectedStatusCode, result.StatusCode)
+			require.Equal(t, expectedStatusCode, handler.ResponseStatusCode(result.HTTP))
 		},
 	)
 }
@@ -159,7 +223,7 @@ func TestTeamHandler_GetTeamByName(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusNotFound,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
 				"expectedStringResponse": "no team with name 'unknown-team' was found in the repository",
 			},
@@ -174,7 +238,7 @@ func TestTeamHandler_GetTeamByName(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusNotFound,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
 				"expectedStringResponse": "no team with name 'unknown-team' was found in the repository",
 			},
@@ -231,11 +295,12 @@ func TestTeamHandler_GetTeamByName(t *testing.T) {
 				require.NotNil(t, obtainedTeam.CreatedAt)
 				require.Equal(t, expectedTeam.UpdatedBy, obtainedTeam.UpdatedBy)
 				require.NotNil(t, obtainedTeam.UpdatedAt)
-			case handlerResult.ResponseBodyTypes.String:
-				require.Contains(t, result.StringResponse, expectedMessage)
+			}
+			if result.Error != nil {
+				require.Contains(t, result.Error.Error(), expectedMessage)
 			}
 			require.Equal(t, expectedResponseType, result.ResponseType)
-			require.Equal(t, expectedStatusCode, result.StatusCode)
+			require.Equal(t, expectedStatusCode, handler.ResponseStatusCode(result.HTTP))
 		},
 	)
 }
@@ -257,7 +322,7 @@ func TestTeamHandler_CreateTeam(t *testing.T) {
 				"expectedStringResponse": "",
 			},
 		},
-		// TODO(lhaddad): change this behavior to return HTTP 200 instead of HTTP 500 if it already exists.
+		// TODO(lhaddad): change this behavior to return HTTP 200 instead of HTTP 409 if it already exists.
 		{
 			Description:    "should fail to create a team if it already exists",
 			FixtureQueries: fixture.GenerateTeamQueries(GetDefaultFixtureTeam(t)),
@@ -265,10 +330,10 @@ func TestTeamHandler_CreateTeam(t *testing.T) {
 				"team": payload.TeamEntityToTeam(GetDefaultFixtureTeam(t)),
 			},
 			OutputData: map[string]interface{}{
-				"expectedStatusCode":     http.StatusInternalServerError,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedStatusCode":     http.StatusConflict,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.TeamEntityToTeam(GetDefaultFixtureTeam(t)),
-				"expectedStringResponse": fmt.Sprintf("failed to create team with name '%s' in application service", GetDefaultFixtureTeam(t).Name),
+				"expectedStringResponse": fmt.Sprintf("team with name '%s' already exists", GetDefaultFixtureTeam(t).Name),
 			},
 		},
 		{
@@ -278,10 +343,10 @@ func TestTeamHandler_CreateTeam(t *testing.T) {
 				"team": payload.TeamEntityToTeam(GetDefaultFixtureTeam(t)),
 			},
 			OutputData: map[string]interface{}{
-				"expectedStatusCode":     http.StatusInternalServerError,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedStatusCode":     http.StatusConflict,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
-				"expectedStringResponse": fmt.Sprintf("failed to create team with name '%s' in application service", GetDefaultFixtureTeam(t).Name),
+				"expectedStringResponse": fmt.Sprintf("team with name '%s' already exists", GetDefaultFixtureTeam(t).Name),
 			},
 		},
 		{
@@ -292,9 +357,9 @@ func TestTeamHandler_CreateTeam(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusBadRequest,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
-				"expectedStringResponse": "the team's 'name' should not be empty",
+				"expectedStringResponse": "the Team's 'Name' should not be empty",
 			},
 		},
 		{
@@ -305,9 +370,35 @@ func TestTeamHandler_CreateTeam(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusBadRequest,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
+				"expectedJSONResponse":   payload.Team{},
+				"expectedStringResponse": "the Team's 'Description' should not be empty",
+			},
+		},
+		{
+			Description:    "should create a team from the name of its origin country and store its alpha-2 code",
+			FixtureQueries: []fixture.Query{},
+			InputData: map[string]interface{}{
+				"team": payload.TeamEntityToTeam(GetDefaultFixtureTeamFrom(t, "Brasil")),
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode":     http.StatusCreated,
+				"expectedResponseType":   handlerResult.ResponseBodyTypes.JSON,
+				"expectedJSONResponse":   payload.TeamEntityToTeam(GetDefaultFixtureTeamFrom(t, "BR")),
+				"expectedStringResponse": "",
+			},
+		},
+		{
+			Description:    "should fail to create a team if the payload team origin country is unknown",
+			FixtureQueries: []fixture.Query{},
+			InputData: map[string]interface{}{
+				"team": payload.TeamEntityToTeam(GetDefaultFixtureTeamFrom(t, "Atlantis")),
+			},
+			OutputData: map[string]interface{}{
+				"expectedStatusCode":     http.StatusBadRequest,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
-				"expectedStringResponse": "the team 'Description' should not be empty",
+				"expectedStringResponse": "the Team's 'Origin Country' should be an ISO 3166-1 alpha-2 or alpha-3 code or the name of a country",
 			},
 		},
 	}
@@ -331,8 +422,9 @@ func TestTeamHandler_CreateTeam(t *testing.T) {
 			teamRepository := repositoryPostgres.NewTeamRepository(client)
 
 			result := handler.CreateTeamHandlerV1(testContext, handlerParam.CreateTeamHandlerV1{
-				Payload:    team,
-				Repository: teamRepository,
+				Payload:           team,
+				Repository:        teamRepository,
+				CountryRepository: repositoryPostgres.NewCountryRepository(client),
 			})
 
 			switch result.ResponseType {
@@ -347,11 +439,12 @@ func TestTeamHandler_CreateTeam(t *testing.T) {
 				require.NotNil(t, obtainedTeam.CreatedAt)
 				require.Equal(t, expectedTeam.UpdatedBy, obtainedTeam.UpdatedBy)
 				require.NotNil(t, obtainedTeam.UpdatedAt)
-			case handlerResult.ResponseBodyTypes.String:
-				require.Contains(t, result.StringResponse, expectedMessage)
+			}
+			if result.Error != nil {
+				require.Contains(t, result.Error.Error(), expectedMessage)
 			}
 			require.Equal(t, expectedResponseType, result.ResponseType)
-			require.Equal(t, expectedStatusCode, result.StatusCode)
+			require.Equal(t, expectedStatusCode, handler.ResponseStatusCode(result.HTTP))
 		},
 	)
 }
@@ -390,7 +483,7 @@ func TestTeamHandler_UpdateTeam(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusNotFound,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
 				"expectedStringResponse": "no team with name 'unknown-team' was found in the repository",
 			},
@@ -406,7 +499,7 @@ func TestTeamHandler_UpdateTeam(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusBadRequest,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
 				"expectedStringResponse": "updating the team name is not allowed",
 			},
@@ -422,9 +515,9 @@ func TestTeamHandler_UpdateTeam(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusBadRequest,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
-				"expectedStringResponse": "team name defined in the path variable is not valid",
+				"expectedStringResponse": "team name defined in the path variable is empty",
 			},
 		},
 		{
@@ -441,7 +534,7 @@ func TestTeamHandler_UpdateTeam(t *testing.T) {
 			},
 			OutputData: map[string]interface{}{
 				"expectedStatusCode":     http.StatusBadRequest,
-				"expectedResponseType":   handlerResult.ResponseBodyTypes.String,
+				"expectedResponseType":   handlerResult.ResponseBodyType(""),
 				"expectedJSONResponse":   payload.Team{},
 				"expectedStringResponse": "at least one of the following fields should not be empty: [Description, OriginCountry, UpdatedBy]",
 			},
@@ -478,9 +571,10 @@ func TestTeamHandler_UpdateTeam(t *testing.T) {
 			time.Sleep(50 * time.Millisecond)
 
 			result := handler.UpdateTeamHandlerV1(testContext, handlerParam.UpdateTeamHandlerV1{
-				Repository: teamRepository,
-				Name:       name,
-				Payload:    team,
+				Repository:        teamRepository,
+				CountryRepository: repositoryPostgres.NewCountryRepository(client),
+				Name:              name,
+				Payload:           team,
 			})
 
 			var updateAtAfterSave entryWithUpdatedAt
@@ -501,11 +595,12 @@ func TestTeamHandler_UpdateTeam(t *testing.T) {
 				require.NotNil(t, obtainedTeam.UpdatedAt)
 				require.True(t, updateAtAfterSave.UpdatedAt.After(updateAtBeforeSave.UpdatedAt),
 					"%s should be greater than %s", updateAtAfterSave.UpdatedAt, updateAtBeforeSave.UpdatedAt)
-			case handlerResult.ResponseBodyTypes.String:
-				require.Contains(t, result.StringResponse, expectedMessage)
+			}
+			if result.Error != nil {
+				require.Contains(t, result.Error.Error(), expectedMessage)
 			}
 			require.Equal(t, expectedResponseType, result.ResponseType)
-			require.Equal(t, expectedStatusCode, result.StatusCode)
+			require.Equal(t, expectedStatusCode, handler.ResponseStatusCode(result.HTTP))
 		},
 	)
 }
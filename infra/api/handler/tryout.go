@@ -0,0 +1,544 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	handlerParam "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/param"
+	handlerResult "github.com/leeohaddad/ultimate-frisbee-api/infra/api/handler/result"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/api/payload"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetTeamTryoutsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamTryouts handler.
+func GetTeamTryoutsEchoHandlerV1(param handlerParam.GetTeamTryoutsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamTryoutsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamTryoutsHandlerV1 is the entry point to the application's logic of listing the tryouts of a team.
+func GetTeamTryoutsHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamTryoutsHandlerV1,
+) handlerResult.GetTeamTryoutsHandlerV1 {
+	result, err := applicationService.GetTeamTryouts(context, applicationParam.GetTeamTryouts{
+		TeamName: param.TeamName,
+
+		TeamRepository:   param.TeamRepository,
+		TryoutRepository: param.TryoutRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamTryoutsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get tryouts of team '%s' from application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamTryoutsHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.GetTeamTryoutsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TryoutEntitiesToTryouts(result.Tryouts),
+		},
+	}
+}
+
+// CreateTeamTryoutEchoHandlerV1 is the adapter from the Echo ecosystem to the CreateTeamTryout handler.
+func CreateTeamTryoutEchoHandlerV1(param handlerParam.CreateTeamTryoutHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+
+		var input payload.CreateTryoutInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, CreateTeamTryoutHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// CreateTeamTryoutHandlerV1 is the entry point to the application's logic of scheduling a tryout for a team.
+func CreateTeamTryoutHandlerV1(
+	context context.Context,
+	param handlerParam.CreateTeamTryoutHandlerV1,
+) handlerResult.CreateTeamTryoutHandlerV1 {
+	invalidInput := payload.ValidateCreateTryoutInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.CreateTeamTryoutHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	result, err := applicationService.CreateTeamTryout(context, applicationParam.CreateTeamTryout{
+		TeamName: param.TeamName,
+		Tryout:   payload.CreateTryoutInputToTryoutEntity(&param.Payload),
+
+		TeamRepository:   param.TeamRepository,
+		TryoutRepository: param.TryoutRepository,
+	})
+	if err != nil {
+		return handlerResult.CreateTeamTryoutHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to create tryout of team '%s' in application service: %w", param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.CreateTeamTryoutHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	return handlerResult.CreateTeamTryoutHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TryoutEntityToTryout(result.Tryout),
+		},
+	}
+}
+
+// SignUpForTeamTryoutEchoHandlerV1 is the adapter from the Echo ecosystem to the SignUpForTeamTryout handler.
+func SignUpForTeamTryoutEchoHandlerV1(param handlerParam.SignUpForTeamTryoutHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.TryoutID = echoContext.Param("tryoutId")
+
+		var input payload.TryoutSignUpInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, SignUpForTeamTryoutHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// SignUpForTeamTryoutHandlerV1 is the entry point to the application's logic of signing up a candidate for a tryout,
+// either linking an existing person or registering a new one.
+func SignUpForTeamTryoutHandlerV1(
+	context context.Context,
+	param handlerParam.SignUpForTeamTryoutHandlerV1,
+) handlerResult.SignUpForTeamTryoutHandlerV1 {
+	invalidInput := payload.ValidateTryoutSignUpInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.SignUpForTeamTryoutHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	if !helper.IsUUID(param.TryoutID) {
+		return handlerResult.SignUpForTeamTryoutHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	applicationInput := applicationParam.SignUpForTeamTryout{
+		TeamName: param.TeamName,
+		TryoutID: param.TryoutID,
+
+		TeamRepository:     param.TeamRepository,
+		PersonRepository:   param.PersonRepository,
+		TryoutRepository:   param.TryoutRepository,
+		CountryRepository:  param.CountryRepository,
+		TransactionManager: param.TransactionManager,
+	}
+	if param.Payload.Person != nil {
+		applicationInput.NewPerson = payload.PersonToPersonEntity(*param.Payload.Person)
+		applicationInput.PersonUserName = param.Payload.Person.UserName
+	} else {
+		applicationInput.PersonUserName = *param.Payload.PersonUserName
+	}
+
+	result, err := applicationService.SignUpForTeamTryout(context, applicationInput)
+	if err != nil {
+		return handlerResult.SignUpForTeamTryoutHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to sign up for tryout %s of team '%s' in application service: %w", param.TryoutID, param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.SignUpForTeamTryoutHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Tryout == nil {
+		return handlerResult.SignUpForTeamTryoutHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	if result.Person == nil {
+		return handlerResult.SignUpForTeamTryoutHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: failure.ErrPersonNotFound.WithMessage(fmt.Sprintf("no person with username '%s' was found in the repository", applicationInput.PersonUserName)),
+			},
+		}
+	}
+
+	return handlerResult.SignUpForTeamTryoutHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusCreated,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TryoutCandidateEntityToTryoutCandidate(result.Candidate),
+		},
+	}
+}
+
+// GetTeamTryoutCandidatesEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamTryoutCandidates handler.
+func GetTeamTryoutCandidatesEchoHandlerV1(param handlerParam.GetTeamTryoutCandidatesHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.TryoutID = echoContext.Param("tryoutId")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamTryoutCandidatesHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamTryoutCandidatesHandlerV1 is the entry point to the application's logic of listing the candidates of a tryout.
+func GetTeamTryoutCandidatesHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamTryoutCandidatesHandlerV1,
+) handlerResult.GetTeamTryoutCandidatesHandlerV1 {
+	if !helper.IsUUID(param.TryoutID) {
+		return handlerResult.GetTeamTryoutCandidatesHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	result, err := applicationService.GetTeamTryoutCandidates(context, applicationParam.GetTeamTryoutCandidates{
+		TeamName: param.TeamName,
+		TryoutID: param.TryoutID,
+
+		TeamRepository:   param.TeamRepository,
+		TryoutRepository: param.TryoutRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamTryoutCandidatesHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get candidates of tryout %s of team '%s' from application service: %w", param.TryoutID, param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamTryoutCandidatesHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Tryout == nil {
+		return handlerResult.GetTeamTryoutCandidatesHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	return handlerResult.GetTeamTryoutCandidatesHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TryoutCandidateEntitiesToTryoutCandidates(result.Candidates),
+		},
+	}
+}
+
+// EvaluateTeamTryoutCandidateEchoHandlerV1 is the adapter from the Echo ecosystem to the EvaluateTeamTryoutCandidate handler.
+func EvaluateTeamTryoutCandidateEchoHandlerV1(param handlerParam.EvaluateTeamTryoutCandidateHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.TryoutID = echoContext.Param("tryoutId")
+		param.CandidateUserName = echoContext.Param("username")
+
+		var input payload.TryoutEvaluationInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, EvaluateTeamTryoutCandidateHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// EvaluateTeamTryoutCandidateHandlerV1 is the entry point to the application's logic of scoring a tryout candidate.
+func EvaluateTeamTryoutCandidateHandlerV1(
+	context context.Context,
+	param handlerParam.EvaluateTeamTryoutCandidateHandlerV1,
+) handlerResult.EvaluateTeamTryoutCandidateHandlerV1 {
+	invalidInput := payload.ValidateTryoutEvaluationInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.EvaluateTeamTryoutCandidateHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	if !helper.IsUUID(param.TryoutID) {
+		return handlerResult.EvaluateTeamTryoutCandidateHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	result, err := applicationService.EvaluateTeamTryoutCandidate(context, applicationParam.EvaluateTeamTryoutCandidate{
+		TeamName:          param.TeamName,
+		TryoutID:          param.TryoutID,
+		CandidateUserName: param.CandidateUserName,
+		EvaluatorUserName: *param.Payload.EvaluatorUserName,
+		Scores:            param.Payload.Scores,
+
+		TeamRepository:       param.TeamRepository,
+		TryoutRepository:     param.TryoutRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.EvaluateTeamTryoutCandidateHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to evaluate '%s' in tryout %s of team '%s' in application service: %w", param.CandidateUserName, param.TryoutID, param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.EvaluateTeamTryoutCandidateHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Tryout == nil {
+		return handlerResult.EvaluateTeamTryoutCandidateHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	if result.Candidate == nil {
+		return handlerResult.EvaluateTeamTryoutCandidateHandlerV1{
+			HTTP: tryoutCandidateNotFoundHTTPResult(param.CandidateUserName, param.TryoutID),
+		}
+	}
+
+	return handlerResult.EvaluateTeamTryoutCandidateHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TryoutEvaluationEntitiesToTryoutEvaluation(
+				result.Tryout.ID,
+				param.CandidateUserName,
+				*param.Payload.EvaluatorUserName,
+				result.Evaluations,
+			),
+		},
+	}
+}
+
+// GetTeamTryoutRankingsEchoHandlerV1 is the adapter from the Echo ecosystem to the GetTeamTryoutRankings handler.
+func GetTeamTryoutRankingsEchoHandlerV1(param handlerParam.GetTeamTryoutRankingsHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.TryoutID = echoContext.Param("tryoutId")
+		param.RequestedBy = echoContext.QueryParam("requestedBy")
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, GetTeamTryoutRankingsHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// GetTeamTryoutRankingsHandlerV1 is the entry point to the application's logic of ranking the candidates of a tryout
+// based on their normalized evaluations.
+func GetTeamTryoutRankingsHandlerV1(
+	context context.Context,
+	param handlerParam.GetTeamTryoutRankingsHandlerV1,
+) handlerResult.GetTeamTryoutRankingsHandlerV1 {
+	invalidInput := payload.ValidateTryoutRankingsRequestedBy(param.RequestedBy)
+	if invalidInput != nil {
+		return handlerResult.GetTeamTryoutRankingsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	if !helper.IsUUID(param.TryoutID) {
+		return handlerResult.GetTeamTryoutRankingsHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	result, err := applicationService.GetTeamTryoutRankings(context, applicationParam.GetTeamTryoutRankings{
+		TeamName:    param.TeamName,
+		TryoutID:    param.TryoutID,
+		RequestedBy: param.RequestedBy,
+
+		TeamRepository:       param.TeamRepository,
+		TryoutRepository:     param.TryoutRepository,
+		MembershipRepository: param.MembershipRepository,
+	})
+	if err != nil {
+		return handlerResult.GetTeamTryoutRankingsHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to get rankings of tryout %s of team '%s' from application service: %w", param.TryoutID, param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.GetTeamTryoutRankingsHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Tryout == nil {
+		return handlerResult.GetTeamTryoutRankingsHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	return handlerResult.GetTeamTryoutRankingsHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TryoutRankingEntitiesToTryoutRankings(result.Rankings),
+		},
+	}
+}
+
+// DecideTeamTryoutCandidateEchoHandlerV1 is the adapter from the Echo ecosystem to the DecideTeamTryoutCandidate handler.
+func DecideTeamTryoutCandidateEchoHandlerV1(param handlerParam.DecideTeamTryoutCandidateHandlerV1) echo.HandlerFunc {
+	return func(echoContext echo.Context) error {
+		requestContext := echoContext.Request().Context()
+		param.TeamName = echoContext.Param("name")
+		param.TryoutID = echoContext.Param("tryoutId")
+		param.CandidateUserName = echoContext.Param("username")
+
+		var input payload.TryoutDecisionInput
+		err := echoContext.Bind(&input)
+		if err != nil {
+			return DispatchEchoResponseFromString(echoContext, http.StatusBadRequest, "invalid payload format")
+		}
+		param.Payload = input
+
+		return DispatchEchoResponseFromHandlerResult(echoContext, DecideTeamTryoutCandidateHandlerV1(requestContext, param).HTTP)
+	}
+}
+
+// DecideTeamTryoutCandidateHandlerV1 is the entry point to the application's logic of selecting or rejecting a tryout
+// candidate. Selected candidates become members of the team, which minors can only become with the consents of a
+// guardian.
+func DecideTeamTryoutCandidateHandlerV1(
+	context context.Context,
+	param handlerParam.DecideTeamTryoutCandidateHandlerV1,
+) handlerResult.DecideTeamTryoutCandidateHandlerV1 {
+	invalidInput := payload.ValidateTryoutDecisionInput(&param.Payload)
+	if invalidInput != nil {
+		return handlerResult.DecideTeamTryoutCandidateHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: invalidInput,
+			},
+		}
+	}
+
+	if !helper.IsUUID(param.TryoutID) {
+		return handlerResult.DecideTeamTryoutCandidateHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	role := payload.TryoutDecisionInputRole(&param.Payload)
+	result, err := applicationService.DecideTeamTryoutCandidate(context, applicationParam.DecideTeamTryoutCandidate{
+		TeamName:            param.TeamName,
+		TryoutID:            param.TryoutID,
+		CandidateUserName:   param.CandidateUserName,
+		Decision:            entity.TryoutCandidateStatus(*param.Payload.Decision),
+		Role:                role,
+		MembershipStartDate: payload.TryoutDecisionInputMembershipStartDate(&param.Payload),
+		DecidedBy:           *param.Payload.DecidedBy,
+
+		TeamRepository:       param.TeamRepository,
+		TryoutRepository:     param.TryoutRepository,
+		PersonRepository:     param.PersonRepository,
+		MembershipRepository: param.MembershipRepository,
+		GuardianRepository:   param.GuardianRepository,
+		TransactionManager:   param.TransactionManager,
+	})
+	if err != nil {
+		return handlerResult.DecideTeamTryoutCandidateHandlerV1{
+			HTTP: handlerResult.HTTP{
+				Error: fmt.Errorf("failed to decide about '%s' in tryout %s of team '%s' in application service: %w", param.CandidateUserName, param.TryoutID, param.TeamName, err),
+			},
+		}
+	}
+
+	if result.Team == nil {
+		return handlerResult.DecideTeamTryoutCandidateHandlerV1{
+			HTTP: teamNotFoundHTTPResult(param.TeamName),
+		}
+	}
+
+	if result.Tryout == nil {
+		return handlerResult.DecideTeamTryoutCandidateHandlerV1{
+			HTTP: tryoutNotFoundHTTPResult(param.TeamName, param.TryoutID),
+		}
+	}
+
+	if result.Candidate == nil {
+		return handlerResult.DecideTeamTryoutCandidateHandlerV1{
+			HTTP: tryoutCandidateNotFoundHTTPResult(param.CandidateUserName, param.TryoutID),
+		}
+	}
+
+	return handlerResult.DecideTeamTryoutCandidateHandlerV1{
+		HTTP: handlerResult.HTTP{
+			StatusCode:   http.StatusOK,
+			ResponseType: handlerResult.ResponseBodyTypes.JSON,
+			JSONResponse: payload.TryoutDecisionEntitiesToTryoutDecision(result.Candidate, result.Membership),
+		},
+	}
+}
+
+func tryoutNotFoundHTTPResult(teamName, tryoutID string) handlerResult.HTTP {
+	return handlerResult.HTTP{
+		Error: failure.ErrTryoutNotFound.WithMessage(fmt.Sprintf("no tryout with ID '%s' was found for team '%s'", tryoutID, teamName)),
+	}
+}
+
+func tryoutCandidateNotFoundHTTPResult(candidateUserName, tryoutID string) handlerResult.HTTP {
+	return handlerResult.HTTP{
+		Error: failure.ErrCandidateNotFound.WithMessage(fmt.Sprintf("'%s' did not sign up for tryout %s", candidateUserName, tryoutID)),
+	}
+}
@@ -0,0 +1,69 @@
+package payload
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type ArchiveInput struct {
+	DeletedBy *string `json:"deletedBy"`
+}
+
+type RestoreInput struct {
+	UpdatedBy *string `json:"updatedBy"`
+}
+
+func ValidateArchiveInput(input *ArchiveInput, currentEntity string) *failure.Error {
+	var invalid invalidFields
+
+	if helper.IsNilOrEmpty(input.DeletedBy) {
+		invalid.add("deletedBy", helper.ErrorMessageInField(currentEntity, "DeletedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateRestoreInput(input *RestoreInput, currentEntity string) *failure.Error {
+	var invalid invalidFields
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+// ValidateIncludeArchived validates the optional 'includeArchived' query param of the listings, which leave the
+// archived records out by default.
+func ValidateIncludeArchived(includeArchived string) *failure.Error {
+	var invalid invalidFields
+
+	if includeArchived != "" {
+		if _, err := strconv.ParseBool(includeArchived); err != nil {
+			invalid.add("includeArchived", "the 'includeArchived' query param should be either true or false")
+		}
+	}
+
+	return invalid.err()
+}
+
+// ParseIncludeArchived parses an 'includeArchived' query param that was already checked by ValidateIncludeArchived.
+func ParseIncludeArchived(includeArchived string) bool {
+	parsed, _ := strconv.ParseBool(includeArchived)
+
+	return parsed
+}
+
+// archiveFields formats who archived a record and when, which are both null while the record is not archived.
+func archiveFields(deletedBy string, deletedAt time.Time) (*string, *string) {
+	if deletedAt.IsZero() {
+		return nil, nil
+	}
+
+	formattedDeletedAt := deletedAt.Format(helper.DefaultTimeLayout)
+
+	return &deletedBy, &formattedDeletedAt
+}
@@ -0,0 +1,49 @@
+package payload
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+)
+
+type Country struct {
+	Alpha2Code string `json:"alpha2Code"`
+	Alpha3Code string `json:"alpha3Code"`
+	Name       string `json:"name"`
+}
+
+func ValidateCountryLocale(locale string) *failure.Error {
+	var invalid invalidFields
+
+	if locale != "" && !entity.CountryLocale(locale).IsValid() {
+		invalid.add("locale", "the 'locale' should be one of en, de, es, fr or pt")
+	}
+
+	return invalid.err()
+}
+
+// CountryLocale is the locale of the country names, which defaults to English.
+func CountryLocale(locale string) entity.CountryLocale {
+	if locale == "" {
+		return entity.CountryLocales.English
+	}
+
+	return entity.CountryLocale(locale)
+}
+
+func CountryEntityToCountry(countryEntity *entity.Country, locale entity.CountryLocale) Country {
+	return Country{
+		Alpha2Code: countryEntity.Alpha2Code,
+		Alpha3Code: countryEntity.Alpha3Code,
+		Name:       countryEntity.LocalizedName(locale),
+	}
+}
+
+func CountryEntitiesToCountries(countryEntities []*entity.Country, locale entity.CountryLocale) []Country {
+	countries := make([]Country, 0, len(countryEntities))
+
+	for _, countryEntity := range countryEntities {
+		countries = append(countries, CountryEntityToCountry(countryEntity, locale))
+	}
+
+	return countries
+}
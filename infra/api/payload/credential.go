@@ -0,0 +1,282 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type WFDFAccreditation struct {
+	PersonUserName string `json:"personUserName"`
+	Level          string `json:"level"`
+	IssueDate      string `json:"issueDate"`
+	ExpiryDate     string `json:"expiryDate"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedBy string `json:"updatedBy"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type FederationMembership struct {
+	PersonUserName string `json:"personUserName"`
+	FederationSlug string `json:"federationSlug"`
+	FederationName string `json:"federationName"`
+	Season         string `json:"season"`
+	ValidFrom      string `json:"validFrom"`
+	ValidUntil     string `json:"validUntil"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedBy string `json:"updatedBy"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type CoachCertification struct {
+	PersonUserName string  `json:"personUserName"`
+	Name           string  `json:"name"`
+	IssuedBy       string  `json:"issuedBy"`
+	IssueDate      string  `json:"issueDate"`
+	ExpiryDate     *string `json:"expiryDate"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedBy string `json:"updatedBy"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type PersonCredentials struct {
+	PersonUserName        string                 `json:"personUserName"`
+	WFDFAccreditations    []WFDFAccreditation    `json:"wfdfAccreditations"`
+	FederationMemberships []FederationMembership `json:"federationMemberships"`
+	CoachCertifications   []CoachCertification   `json:"coachCertifications"`
+}
+
+// WFDFAccreditationStatus shows the most lasting accreditation of a player, whose fields are null when the player
+// has never been accredited.
+type WFDFAccreditationStatus struct {
+	PersonUserName string  `json:"personUserName"`
+	Level          *string `json:"level"`
+	IssueDate      *string `json:"issueDate"`
+	ExpiryDate     *string `json:"expiryDate"`
+}
+
+type WFDFAccreditationInput struct {
+	Level      *string `json:"level"`
+	IssueDate  *string `json:"issueDate"`
+	ExpiryDate *string `json:"expiryDate"`
+	CreatedBy  *string `json:"createdBy"`
+}
+
+type FederationMembershipInput struct {
+	FederationSlug *string `json:"federationSlug"`
+	Season         *string `json:"season"`
+	ValidFrom      *string `json:"validFrom"`
+	ValidUntil     *string `json:"validUntil"`
+	CreatedBy      *string `json:"createdBy"`
+}
+
+type CoachCertificationInput struct {
+	Name       *string `json:"name"`
+	IssuedBy   *string `json:"issuedBy"`
+	IssueDate  *string `json:"issueDate"`
+	ExpiryDate *string `json:"expiryDate"`
+	CreatedBy  *string `json:"createdBy"`
+}
+
+func ValidateWFDFAccreditationInput(input *WFDFAccreditationInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "WFDF Accreditation"
+
+	if helper.IsNilOrEmpty(input.Level) {
+		invalid.add("level", helper.ErrorMessageInField(currentEntity, "Level"))
+	} else if !entity.WFDFAccreditationLevel(*input.Level).IsValid() {
+		invalid.add("level", "the WFDF Accreditation's 'Level' should be one of Standard or Advanced")
+	}
+
+	validateDateRange(&invalid, currentEntity, "IssueDate", input.IssueDate, "ExpiryDate", input.ExpiryDate, true)
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateFederationMembershipInput(input *FederationMembershipInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Federation Membership"
+
+	if helper.IsNilOrEmpty(input.FederationSlug) {
+		invalid.add("federationSlug", helper.ErrorMessageInField(currentEntity, "FederationSlug"))
+	}
+
+	if helper.IsNilOrEmpty(input.Season) {
+		invalid.add("season", helper.ErrorMessageInField(currentEntity, "Season"))
+	}
+
+	validateDateRange(&invalid, currentEntity, "ValidFrom", input.ValidFrom, "ValidUntil", input.ValidUntil, true)
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateCoachCertificationInput(input *CoachCertificationInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Coach Certification"
+
+	if helper.IsNilOrEmpty(input.Name) {
+		invalid.add("name", helper.ErrorMessageInField(currentEntity, "Name"))
+	}
+
+	if helper.IsNilOrEmpty(input.IssuedBy) {
+		invalid.add("issuedBy", helper.ErrorMessageInField(currentEntity, "IssuedBy"))
+	}
+
+	validateDateRange(&invalid, currentEntity, "IssueDate", input.IssueDate, "ExpiryDate", input.ExpiryDate, false)
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+// ValidateExpiringWFDFAccreditationsDate validates the required 'date' query param, usually the first day of a
+// tournament, against which the accreditations are checked.
+func ValidateExpiringWFDFAccreditationsDate(date string) *failure.Error {
+	var invalid invalidFields
+
+	if date == "" {
+		invalid.add("date", "the 'date' query param should not be empty")
+	} else if _, err := time.Parse(helper.DefaultDateLayout, date); err != nil {
+		invalid.add("date", "the 'date' query param should follow the format "+helper.DefaultDateLayout)
+	}
+
+	return invalid.err()
+}
+
+// ValidateRosterParams validates the optional 'roster' query params, each one the username of a player of the roster.
+func ValidateRosterParams(roster []string) *failure.Error {
+	var invalid invalidFields
+
+	for _, userName := range roster {
+		if userName == "" {
+			invalid.add("roster", "the 'roster' query params should not be empty")
+
+			break
+		}
+	}
+
+	return invalid.err()
+}
+
+func WFDFAccreditationEntityToWFDFAccreditation(accreditationEntity *entity.WFDFAccreditation) WFDFAccreditation {
+	return WFDFAccreditation{
+		PersonUserName: accreditationEntity.Person.UserName,
+		Level:          string(accreditationEntity.Level),
+		IssueDate:      accreditationEntity.IssueDate.Format(helper.DefaultDateLayout),
+		ExpiryDate:     accreditationEntity.ExpiryDate.Format(helper.DefaultDateLayout),
+
+		CreatedBy: accreditationEntity.CreatedBy,
+		CreatedAt: accreditationEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+		UpdatedBy: accreditationEntity.UpdatedBy,
+		UpdatedAt: accreditationEntity.UpdatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func WFDFAccreditationEntitiesToWFDFAccreditations(accreditationEntities []*entity.WFDFAccreditation) []WFDFAccreditation {
+	accreditations := make([]WFDFAccreditation, 0, len(accreditationEntities))
+
+	for _, accreditationEntity := range accreditationEntities {
+		accreditations = append(accreditations, WFDFAccreditationEntityToWFDFAccreditation(accreditationEntity))
+	}
+
+	return accreditations
+}
+
+func FederationMembershipEntityToFederationMembership(membershipEntity *entity.FederationMembership) FederationMembership {
+	return FederationMembership{
+		PersonUserName: membershipEntity.Person.UserName,
+		FederationSlug: membershipEntity.Federation.Slug,
+		FederationName: membershipEntity.Federation.Name,
+		Season:         membershipEntity.Season,
+		ValidFrom:      membershipEntity.ValidFrom.Format(helper.DefaultDateLayout),
+		ValidUntil:     membershipEntity.ValidUntil.Format(helper.DefaultDateLayout),
+
+		CreatedBy: membershipEntity.CreatedBy,
+		CreatedAt: membershipEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+		UpdatedBy: membershipEntity.UpdatedBy,
+		UpdatedAt: membershipEntity.UpdatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func FederationMembershipEntitiesToFederationMemberships(
+	membershipEntities []*entity.FederationMembership,
+) []FederationMembership {
+	memberships := make([]FederationMembership, 0, len(membershipEntities))
+
+	for _, membershipEntity := range membershipEntities {
+		memberships = append(memberships, FederationMembershipEntityToFederationMembership(membershipEntity))
+	}
+
+	return memberships
+}
+
+func CoachCertificationEntityToCoachCertification(certificationEntity *entity.CoachCertification) CoachCertification {
+	// A certification that does not expire has no expiry date
+	var expiryDate *string
+	if !certificationEntity.ExpiryDate.IsZero() {
+		formattedExpiryDate := certificationEntity.ExpiryDate.Format(helper.DefaultDateLayout)
+		expiryDate = &formattedExpiryDate
+	}
+
+	return CoachCertification{
+		PersonUserName: certificationEntity.Person.UserName,
+		Name:           certificationEntity.Name,
+		IssuedBy:       certificationEntity.IssuedBy,
+		IssueDate:      certificationEntity.IssueDate.Format(helper.DefaultDateLayout),
+		ExpiryDate:     expiryDate,
+
+		CreatedBy: certificationEntity.CreatedBy,
+		CreatedAt: certificationEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+		UpdatedBy: certificationEntity.UpdatedBy,
+		UpdatedAt: certificationEntity.UpdatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func CoachCertificationEntitiesToCoachCertifications(certificationEntities []*entity.CoachCertification) []CoachCertification {
+	certifications := make([]CoachCertification, 0, len(certificationEntities))
+
+	for _, certificationEntity := range certificationEntities {
+		certifications = append(certifications, CoachCertificationEntityToCoachCertification(certificationEntity))
+	}
+
+	return certifications
+}
+
+func WFDFAccreditationStatusEntitiesToWFDFAccreditationStatuses(
+	statusEntities []*entity.WFDFAccreditationStatus,
+) []WFDFAccreditationStatus {
+	statuses := make([]WFDFAccreditationStatus, 0, len(statusEntities))
+
+	for _, statusEntity := range statusEntities {
+		status := WFDFAccreditationStatus{
+			PersonUserName: statusEntity.Person.UserName,
+		}
+		if statusEntity.Accreditation != nil {
+			accreditation := WFDFAccreditationEntityToWFDFAccreditation(statusEntity.Accreditation)
+			status.Level = &accreditation.Level
+			status.IssueDate = &accreditation.IssueDate
+			status.ExpiryDate = &accreditation.ExpiryDate
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
@@ -0,0 +1,188 @@
+package payload
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+// criteriaField is an attribute that a listing can be filtered and sorted by, named as in the JSON of the entity.
+type criteriaField struct {
+	attribute string
+	kind      entity.CriteriaAttributeKind
+}
+
+var filterOperatorNames = []string{
+	string(entity.FilterOperators.Equal),
+	string(entity.FilterOperators.NotEqual),
+	string(entity.FilterOperators.GreaterThan),
+	string(entity.FilterOperators.GreaterThanOrEqual),
+	string(entity.FilterOperators.LessThan),
+	string(entity.FilterOperators.LessThanOrEqual),
+	string(entity.FilterOperators.Contains),
+}
+
+// ValidateTeamCriteriaParams validates the optional 'filter' and 'sort' query params of the team listing.
+func ValidateTeamCriteriaParams(filters []string, sortParam string) *failure.Error {
+	_, invalidInput := parseCriteria(filters, sortParam, teamCriteriaFields())
+
+	return invalidInput
+}
+
+// ParseTeamCriteriaParams parses the 'filter' and 'sort' query params that were already checked by
+// ValidateTeamCriteriaParams.
+func ParseTeamCriteriaParams(filters []string, sortParam string) entity.Criteria {
+	criteria, _ := parseCriteria(filters, sortParam, teamCriteriaFields())
+
+	return criteria
+}
+
+// ValidatePersonCriteriaParams validates the optional 'filter' and 'sort' query params of the people listing.
+func ValidatePersonCriteriaParams(filters []string, sortParam string) *failure.Error {
+	_, invalidInput := parseCriteria(filters, sortParam, personCriteriaFields())
+
+	return invalidInput
+}
+
+// ParsePersonCriteriaParams parses the 'filter' and 'sort' query params that were already checked by
+// ValidatePersonCriteriaParams.
+func ParsePersonCriteriaParams(filters []string, sortParam string) entity.Criteria {
+	criteria, _ := parseCriteria(filters, sortParam, personCriteriaFields())
+
+	return criteria
+}
+
+func teamCriteriaFields() map[string]criteriaField {
+	fields := map[string]criteriaField{}
+	for attribute, kind := range entity.TeamCriteriaAttributes {
+		fields[criteriaFieldName(string(attribute))] = criteriaField{attribute: string(attribute), kind: kind}
+	}
+
+	return fields
+}
+
+func personCriteriaFields() map[string]criteriaField {
+	fields := map[string]criteriaField{}
+	for attribute, kind := range entity.PersonCriteriaAttributes {
+		fields[criteriaFieldName(string(attribute))] = criteriaField{attribute: string(attribute), kind: kind}
+	}
+
+	return fields
+}
+
+// criteriaFieldName names the attribute as the field of the JSON, such as "originCountry" for "OriginCountry".
+func criteriaFieldName(attribute string) string {
+	return strings.ToLower(attribute[:1]) + attribute[1:]
+}
+
+// parseCriteria parses each filter, as field:operator:value where the value may hold colons, and the sort, as a comma
+// separated list of fields each prefixed by '-' to sort from the largest value.
+func parseCriteria(filters []string, sortParam string, fields map[string]criteriaField) (entity.Criteria, *failure.Error) {
+	var invalid invalidFields
+	criteria := entity.Criteria{
+		Filters: []entity.Filter{},
+		Sorts:   []entity.Sort{},
+	}
+
+	for _, filter := range filters {
+		parts := strings.SplitN(filter, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			invalid.add("filter", fmt.Sprintf("the 'filter' query param '%s' should follow the format field:operator:value", filter))
+
+			continue
+		}
+		fieldName, operator, value := parts[0], entity.FilterOperator(parts[1]), parts[2]
+
+		field, ok := fields[fieldName]
+		if !ok {
+			invalid.add("filter", unknownCriteriaFieldMessage("filtered", fieldName, fields))
+
+			continue
+		}
+
+		if !entity.IsValidFilterOperator(operator) {
+			invalid.add("filter", fmt.Sprintf(
+				"unknown operator '%s' in the 'filter' query param, which should be one of [%s]",
+				operator,
+				strings.Join(filterOperatorNames, ", "),
+			))
+
+			continue
+		}
+
+		if !operator.AppliesTo(field.kind) {
+			invalid.add("filter", fmt.Sprintf("the '%s' operator cannot be applied to '%s'", operator, fieldName))
+
+			continue
+		}
+
+		if field.kind == entity.CriteriaAttributeKinds.Time && !isCriteriaTime(value) {
+			invalid.add("filter", fmt.Sprintf(
+				"the value of '%s' should follow the format %s or %s", fieldName, helper.DefaultDateLayout, helper.DefaultTimeLayout,
+			))
+
+			continue
+		}
+
+		criteria.Filters = append(criteria.Filters, entity.Filter{
+			Attribute: field.attribute,
+			Operator:  operator,
+			Value:     value,
+		})
+	}
+
+	if sortParam != "" {
+		for _, sortField := range strings.Split(sortParam, ",") {
+			descending := strings.HasPrefix(sortField, "-")
+			fieldName := strings.TrimPrefix(strings.TrimPrefix(sortField, "-"), "+")
+
+			field, ok := fields[fieldName]
+			if !ok {
+				invalid.add("sort", unknownCriteriaFieldMessage("sorted", fieldName, fields))
+
+				continue
+			}
+
+			criteria.Sorts = append(criteria.Sorts, entity.Sort{
+				Attribute:  field.attribute,
+				Descending: descending,
+			})
+		}
+	}
+
+	if invalidInput := invalid.err(); invalidInput != nil {
+		return entity.Criteria{}, invalidInput
+	}
+
+	return criteria, nil
+}
+
+func unknownCriteriaFieldMessage(action string, fieldName string, fields map[string]criteriaField) string {
+	fieldNames := make([]string, 0, len(fields))
+	for name := range fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+
+	return fmt.Sprintf(
+		"the listing cannot be %s by '%s', which should be one of [%s]",
+		action,
+		fieldName,
+		strings.Join(fieldNames, ", "),
+	)
+}
+
+func isCriteriaTime(value string) bool {
+	if _, err := time.Parse(helper.DefaultDateLayout, value); err == nil {
+		return true
+	}
+
+	_, err := time.Parse(helper.DefaultTimeLayout, value)
+
+	return err == nil
+}
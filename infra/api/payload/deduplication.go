@@ -0,0 +1,102 @@
+package payload
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/deduplication"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+// DuplicatePeople shows two people that are likely the same. The person is the one registered first, which is the
+// suggested survivor of a merge.
+type DuplicatePeople struct {
+	Person    Person           `json:"person"`
+	Duplicate Person           `json:"duplicate"`
+	Score     float64          `json:"score"`
+	Matches   []DuplicateMatch `json:"matches"`
+}
+
+type DuplicateMatch struct {
+	Signal string  `json:"signal"`
+	Weight float64 `json:"weight"`
+}
+
+type PersonMergeInput struct {
+	DuplicateUserName *string `json:"duplicateUserName"`
+	UpdatedBy         *string `json:"updatedBy"`
+}
+
+func ValidatePersonMergeInput(input *PersonMergeInput, userName string) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Merge"
+
+	if helper.IsNilOrEmpty(input.DuplicateUserName) {
+		invalid.add("duplicateUserName", helper.ErrorMessageInField(currentEntity, "DuplicateUserName"))
+	} else if *input.DuplicateUserName == userName {
+		invalid.add("duplicateUserName", "the Merge's 'DuplicateUserName' should not be the username of the surviving person")
+	}
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+// ValidateMinimumScore validates the optional 'minimumScore' query param of the duplicate detection, which goes from
+// 0 to 1.
+func ValidateMinimumScore(minimumScore string) *failure.Error {
+	var invalid invalidFields
+
+	if minimumScore != "" {
+		parsedMinimumScore, err := strconv.ParseFloat(minimumScore, 64)
+		if err != nil || parsedMinimumScore < 0 || parsedMinimumScore > 1 {
+			invalid.add("minimumScore", "the 'minimumScore' query param should be a number between 0 and 1")
+		}
+	}
+
+	return invalid.err()
+}
+
+// ParseMinimumScore parses a 'minimumScore' query param that was already checked by ValidateMinimumScore, which
+// defaults to deduplication.DefaultMinimumScore.
+func ParseMinimumScore(minimumScore string) float64 {
+	if minimumScore == "" {
+		return deduplication.DefaultMinimumScore
+	}
+
+	parsedMinimumScore, _ := strconv.ParseFloat(minimumScore, 64)
+
+	return parsedMinimumScore
+}
+
+func DuplicatePairsToDuplicatePeople(pairs []deduplication.Pair) []DuplicatePeople {
+	duplicatePeople := make([]DuplicatePeople, 0, len(pairs))
+
+	for _, pair := range pairs {
+		matches := make([]DuplicateMatch, 0, len(pair.Matches))
+		for _, match := range pair.Matches {
+			matches = append(matches, DuplicateMatch{
+				Signal: string(match.Signal),
+				Weight: roundScore(match.Weight),
+			})
+		}
+
+		duplicatePeople = append(duplicatePeople, DuplicatePeople{
+			Person:    PersonEntityToVisiblePerson(pair.Person, entity.Audiences.Public),
+			Duplicate: PersonEntityToVisiblePerson(pair.Duplicate, entity.Audiences.Public),
+			Score:     roundScore(pair.Score),
+			Matches:   matches,
+		})
+	}
+
+	return duplicatePeople
+}
+
+// roundScore keeps three decimal places, which is as precise as the weights of the signals are.
+func roundScore(score float64) float64 {
+	return math.Round(score*1000) / 1000
+}
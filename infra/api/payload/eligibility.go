@@ -0,0 +1,119 @@
+package payload
+
+import (
+	"strings"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/eligibility"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type RosterEligibility struct {
+	Eligible   bool                   `json:"eligible"`
+	Violations []EligibilityViolation `json:"violations"`
+}
+
+type EligibilityViolation struct {
+	PersonUserName string `json:"personUserName"`
+	Rule           string `json:"rule"`
+	Reason         string `json:"reason"`
+}
+
+// RosterEligibilityInput is the roster of a team for an event, along with the rules of the event. The MinimumAge is
+// only required by the MinimumAge rule.
+type RosterEligibilityInput struct {
+	Roster         []string `json:"roster"`
+	Rules          []string `json:"rules"`
+	MinimumAge     *int     `json:"minimumAge"`
+	EventStartDate *string  `json:"eventStartDate"`
+	EventEndDate   *string  `json:"eventEndDate"`
+}
+
+func ValidateRosterEligibilityInput(input *RosterEligibilityInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Roster Eligibility"
+
+	if len(input.Roster) == 0 {
+		invalid.add("roster", helper.ErrorMessageInField(currentEntity, "Roster"))
+	}
+
+	for _, userName := range input.Roster {
+		if userName == "" {
+			invalid.add("roster", "the "+currentEntity+"'s 'Roster' should not have empty usernames")
+
+			break
+		}
+	}
+
+	if len(input.Rules) == 0 {
+		invalid.add("rules", helper.ErrorMessageInField(currentEntity, "Rules"))
+	}
+
+	for _, rule := range input.Rules {
+		if !eligibility.IsRegistered(eligibility.RuleName(rule)) {
+			invalid.add("rules", "the "+currentEntity+"'s 'Rules' should only have the rules "+strings.Join(eligibilityRuleNames(), ", "))
+
+			break
+		}
+	}
+
+	for _, rule := range input.Rules {
+		if eligibility.RuleName(rule) == eligibility.RuleNames.MinimumAge && (input.MinimumAge == nil || *input.MinimumAge <= 0) {
+			invalid.add("minimumAge", "the "+currentEntity+"'s 'MinimumAge' should be a positive number when the MinimumAge rule is used")
+
+			break
+		}
+	}
+
+	validateDateRange(&invalid, currentEntity, "EventStartDate", input.EventStartDate, "EventEndDate", input.EventEndDate, true)
+
+	return invalid.err()
+}
+
+// ParseRosterEligibilityRules parses the rule names and settings of a RosterEligibilityInput that was validated. Each
+// rule name is kept once, in the order it was first given.
+func ParseRosterEligibilityRules(input *RosterEligibilityInput) ([]eligibility.RuleName, eligibility.Settings) {
+	ruleNames := make([]eligibility.RuleName, 0, len(input.Rules))
+	parsedRules := map[string]bool{}
+	for _, rule := range input.Rules {
+		if parsedRules[rule] {
+			continue
+		}
+		parsedRules[rule] = true
+		ruleNames = append(ruleNames, eligibility.RuleName(rule))
+	}
+
+	settings := eligibility.Settings{}
+	if input.MinimumAge != nil {
+		settings.MinimumAge = *input.MinimumAge
+	}
+
+	return ruleNames, settings
+}
+
+func EligibilityViolationsToRosterEligibility(eligible bool, violations []eligibility.Violation) RosterEligibility {
+	rosterEligibility := RosterEligibility{
+		Eligible:   eligible,
+		Violations: make([]EligibilityViolation, 0, len(violations)),
+	}
+
+	for _, violation := range violations {
+		rosterEligibility.Violations = append(rosterEligibility.Violations, EligibilityViolation{
+			PersonUserName: violation.PersonUserName,
+			Rule:           string(violation.Rule),
+			Reason:         violation.Reason,
+		})
+	}
+
+	return rosterEligibility
+}
+
+func eligibilityRuleNames() []string {
+	return []string{
+		string(eligibility.RuleNames.ActiveMembership),
+		string(eligibility.RuleNames.WFDFNumber),
+		string(eligibility.RuleNames.WFDFAccreditation),
+		string(eligibility.RuleNames.GuardianConsent),
+		string(eligibility.RuleNames.MinimumAge),
+	}
+}
@@ -0,0 +1,148 @@
+package payload
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type Guardianship struct {
+	GuardianUserName  string `json:"guardianUserName"`
+	DependentUserName string `json:"dependentUserName"`
+	Relationship      string `json:"relationship"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type Consent struct {
+	PersonUserName string `json:"personUserName"`
+	Kind           string `json:"kind"`
+	GrantedBy      string `json:"grantedBy"`
+	GrantDate      string `json:"grantDate"`
+	ExpiryDate     string `json:"expiryDate"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// DependentTeamEvent shows an event of the team of a dependent in the schedule followed by their guardians.
+type DependentTeamEvent struct {
+	DependentUserName string    `json:"dependentUserName"`
+	Event             TeamEvent `json:"event"`
+}
+
+type GuardianInput struct {
+	GuardianUserName *string `json:"guardianUserName"`
+	Relationship     *string `json:"relationship"`
+	CreatedBy        *string `json:"createdBy"`
+}
+
+type ConsentInput struct {
+	Kind       *string `json:"kind"`
+	GrantedBy  *string `json:"grantedBy"`
+	GrantDate  *string `json:"grantDate"`
+	ExpiryDate *string `json:"expiryDate"`
+	CreatedBy  *string `json:"createdBy"`
+}
+
+func ValidateGuardianInput(input *GuardianInput, dependentUserName string) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Guardian"
+
+	if helper.IsNilOrEmpty(input.GuardianUserName) {
+		invalid.add("guardianUserName", helper.ErrorMessageInField(currentEntity, "GuardianUserName"))
+	} else if *input.GuardianUserName == dependentUserName {
+		invalid.add("guardianUserName", "the Guardian's 'GuardianUserName' should not be the username of the dependent")
+	}
+
+	if helper.IsNilOrEmpty(input.Relationship) {
+		invalid.add("relationship", helper.ErrorMessageInField(currentEntity, "Relationship"))
+	}
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateConsentInput(input *ConsentInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Consent"
+
+	if helper.IsNilOrEmpty(input.Kind) {
+		invalid.add("kind", helper.ErrorMessageInField(currentEntity, "Kind"))
+	} else if !entity.ConsentKind(*input.Kind).IsValid() {
+		invalid.add("kind", "the Consent's 'Kind' should be one of Media, Medical or Travel")
+	}
+
+	if helper.IsNilOrEmpty(input.GrantedBy) {
+		invalid.add("grantedBy", helper.ErrorMessageInField(currentEntity, "GrantedBy"))
+	}
+
+	validateDateRange(&invalid, currentEntity, "GrantDate", input.GrantDate, "ExpiryDate", input.ExpiryDate, true)
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func GuardianshipEntityToGuardianship(guardianshipEntity *entity.Guardianship) Guardianship {
+	return Guardianship{
+		GuardianUserName:  guardianshipEntity.Guardian.UserName,
+		DependentUserName: guardianshipEntity.Dependent.UserName,
+		Relationship:      guardianshipEntity.Relationship,
+
+		CreatedBy: guardianshipEntity.CreatedBy,
+		CreatedAt: guardianshipEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func GuardianshipEntitiesToGuardianships(guardianshipEntities []*entity.Guardianship) []Guardianship {
+	guardianships := make([]Guardianship, 0, len(guardianshipEntities))
+
+	for _, guardianshipEntity := range guardianshipEntities {
+		guardianships = append(guardianships, GuardianshipEntityToGuardianship(guardianshipEntity))
+	}
+
+	return guardianships
+}
+
+func ConsentEntityToConsent(consentEntity *entity.Consent) Consent {
+	return Consent{
+		PersonUserName: consentEntity.Person.UserName,
+		Kind:           string(consentEntity.Kind),
+		GrantedBy:      consentEntity.GrantedBy.UserName,
+		GrantDate:      consentEntity.GrantDate.Format(helper.DefaultDateLayout),
+		ExpiryDate:     consentEntity.ExpiryDate.Format(helper.DefaultDateLayout),
+
+		CreatedBy: consentEntity.CreatedBy,
+		CreatedAt: consentEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func ConsentEntitiesToConsents(consentEntities []*entity.Consent) []Consent {
+	consents := make([]Consent, 0, len(consentEntities))
+
+	for _, consentEntity := range consentEntities {
+		consents = append(consents, ConsentEntityToConsent(consentEntity))
+	}
+
+	return consents
+}
+
+func DependentTeamEventEntitiesToDependentTeamEvents(eventEntities []*entity.DependentTeamEvent) []DependentTeamEvent {
+	events := make([]DependentTeamEvent, 0, len(eventEntities))
+
+	for _, eventEntity := range eventEntities {
+		events = append(events, DependentTeamEvent{
+			DependentUserName: eventEntity.Dependent.UserName,
+			Event:             TeamEventEntityToTeamEvent(eventEntity.Event),
+		})
+	}
+
+	return events
+}
@@ -0,0 +1,202 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type LedgerTransaction struct {
+	ID            string        `json:"id"`
+	TeamSlug      string        `json:"teamSlug"`
+	Kind          string        `json:"kind"`
+	Description   string        `json:"description"`
+	EffectiveDate string        `json:"effectiveDate"`
+	Entries       []LedgerEntry `json:"entries"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type LedgerEntry struct {
+	Account        string  `json:"account"`
+	PersonUserName *string `json:"personUserName"`
+	AmountInCents  int64   `json:"amountInCents"`
+}
+
+type LedgerBalance struct {
+	PersonUserName string `json:"personUserName"`
+	BalanceInCents int64  `json:"balanceInCents"`
+}
+
+type LedgerBalanceReport struct {
+	TeamSlug                string          `json:"teamSlug"`
+	Balances                []LedgerBalance `json:"balances"`
+	TotalOutstandingInCents int64           `json:"totalOutstandingInCents"`
+}
+
+type ChargeDuesInput struct {
+	AmountInCents *int64  `json:"amountInCents"`
+	Description   *string `json:"description"`
+	EffectiveDate *string `json:"effectiveDate"`
+	CreatedBy     *string `json:"createdBy"`
+}
+
+type ChargeTournamentFeeInput struct {
+	PersonUserNames    []string `json:"personUserNames"`
+	TotalAmountInCents *int64   `json:"totalAmountInCents"`
+	Description        *string  `json:"description"`
+	EffectiveDate      *string  `json:"effectiveDate"`
+	CreatedBy          *string  `json:"createdBy"`
+}
+
+type RecordPaymentInput struct {
+	PersonUserName string  `json:"personUserName"`
+	AmountInCents  *int64  `json:"amountInCents"`
+	Description    *string `json:"description"`
+	EffectiveDate  *string `json:"effectiveDate"`
+	CreatedBy      *string `json:"createdBy"`
+}
+
+func ValidateChargeDuesInput(input *ChargeDuesInput) *failure.Error {
+	var invalid invalidFields
+	validateLedgerInput(&invalid, "Dues", "amountInCents", input.AmountInCents, input.EffectiveDate, input.CreatedBy)
+
+	return invalid.err()
+}
+
+func ValidateChargeTournamentFeeInput(input *ChargeTournamentFeeInput) *failure.Error {
+	var invalid invalidFields
+
+	if len(input.PersonUserNames) == 0 {
+		invalid.add("personUserNames", helper.ErrorMessageInField("Tournament Fee", "PersonUserNames"))
+	}
+
+	validateLedgerInput(&invalid, "Tournament Fee", "totalAmountInCents", input.TotalAmountInCents, input.EffectiveDate, input.CreatedBy)
+
+	return invalid.err()
+}
+
+func ValidateRecordPaymentInput(input *RecordPaymentInput) *failure.Error {
+	var invalid invalidFields
+
+	if helper.IsNilOrEmpty(&input.PersonUserName) {
+		invalid.add("personUserName", helper.ErrorMessageInField("Payment", "PersonUserName"))
+	}
+
+	validateLedgerInput(&invalid, "Payment", "amountInCents", input.AmountInCents, input.EffectiveDate, input.CreatedBy)
+
+	return invalid.err()
+}
+
+func validateLedgerInput(
+	invalid *invalidFields,
+	currentEntity string,
+	amountField string,
+	amountInCents *int64,
+	effectiveDate *string,
+	createdBy *string,
+) {
+	if amountInCents == nil || *amountInCents <= 0 {
+		invalid.add(amountField, "the "+currentEntity+"'s amount should be a positive number of cents")
+	}
+
+	if helper.IsNilOrEmpty(effectiveDate) {
+		invalid.add("effectiveDate", helper.ErrorMessageInField(currentEntity, "EffectiveDate"))
+	} else if _, err := time.Parse(helper.DefaultDateLayout, *effectiveDate); err != nil {
+		invalid.add("effectiveDate", "the "+currentEntity+"'s 'EffectiveDate' should follow the format "+helper.DefaultDateLayout)
+	}
+
+	if helper.IsNilOrEmpty(createdBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+}
+
+// ParseDate parses a date that was already checked by one of the input validators.
+func ParseDate(date *string) time.Time {
+	if date == nil {
+		return time.Time{}
+	}
+
+	parsedDate, err := time.Parse(helper.DefaultDateLayout, *date)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsedDate
+}
+
+// StringValue dereferences an optional string field, defaulting to the empty string.
+func StringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func LedgerTransactionEntityToLedgerTransaction(transactionEntity *entity.LedgerTransaction) LedgerTransaction {
+	entries := make([]LedgerEntry, 0, len(transactionEntity.Entries))
+	for _, entryEntity := range transactionEntity.Entries {
+		var personUserName *string
+		if entryEntity.Person != nil {
+			userName := entryEntity.Person.UserName
+			personUserName = &userName
+		}
+		entries = append(entries, LedgerEntry{
+			Account:        string(entryEntity.Account),
+			PersonUserName: personUserName,
+			AmountInCents:  entryEntity.AmountInCents,
+		})
+	}
+
+	var teamSlug string
+	if transactionEntity.Team != nil {
+		teamSlug = transactionEntity.Team.Slug
+	}
+
+	return LedgerTransaction{
+		ID:            transactionEntity.ID,
+		TeamSlug:      teamSlug,
+		Kind:          string(transactionEntity.Kind),
+		Description:   transactionEntity.Description,
+		EffectiveDate: transactionEntity.EffectiveDate.Format(helper.DefaultDateLayout),
+		Entries:       entries,
+
+		CreatedBy: transactionEntity.CreatedBy,
+		CreatedAt: transactionEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func LedgerTransactionEntitiesToLedgerTransactions(transactionEntities []*entity.LedgerTransaction) []LedgerTransaction {
+	transactions := make([]LedgerTransaction, 0)
+
+	for _, transactionEntity := range transactionEntities {
+		transactions = append(transactions, LedgerTransactionEntityToLedgerTransaction(transactionEntity))
+	}
+
+	return transactions
+}
+
+func LedgerBalanceEntitiesToLedgerBalanceReport(
+	teamSlug string,
+	balanceEntities []*entity.LedgerBalance,
+	totalOutstandingInCents int64,
+) LedgerBalanceReport {
+	balances := make([]LedgerBalance, 0)
+
+	for _, balanceEntity := range balanceEntities {
+		balances = append(balances, LedgerBalance{
+			PersonUserName: balanceEntity.Person.UserName,
+			BalanceInCents: balanceEntity.BalanceInCents,
+		})
+	}
+
+	return LedgerBalanceReport{
+		TeamSlug:                teamSlug,
+		Balances:                balances,
+		TotalOutstandingInCents: totalOutstandingInCents,
+	}
+}
@@ -0,0 +1,290 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type LegalEntity struct {
+	Slug               string  `json:"slug"`
+	Name               string  `json:"name"`
+	Kind               *string `json:"kind"`
+	RegistrationNumber *string `json:"registrationNumber"`
+	Country            *string `json:"country"`
+	ContactName        *string `json:"contactName"`
+	ContactEmail       *string `json:"contactEmail"`
+	ContactPhoneNumber *string `json:"contactPhoneNumber"`
+	Website            *string `json:"website"`
+
+	CreatedBy *string `json:"createdBy"`
+	CreatedAt *string `json:"createdAt"`
+	UpdatedBy *string `json:"updatedBy"`
+	UpdatedAt *string `json:"updatedAt"`
+}
+
+type LegalEntityAffiliation struct {
+	LegalEntitySlug string  `json:"legalEntitySlug"`
+	LegalEntityName string  `json:"legalEntityName"`
+	TeamSlug        *string `json:"teamSlug"`
+	TeamName        *string `json:"teamName"`
+	PersonUserName  *string `json:"personUserName"`
+	StartDate       string  `json:"startDate"`
+	EndDate         *string `json:"endDate"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedBy string `json:"updatedBy"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type LegalEntityAffiliationInput struct {
+	TeamName       *string `json:"teamName"`
+	PersonUserName *string `json:"personUserName"`
+	StartDate      *string `json:"startDate"`
+	EndDate        *string `json:"endDate"`
+	CreatedBy      *string `json:"createdBy"`
+}
+
+type EndLegalEntityAffiliationInput struct {
+	EndDate   *string `json:"endDate"`
+	UpdatedBy *string `json:"updatedBy"`
+}
+
+func ValidateCreateLegalEntityInput(legalEntity *LegalEntity) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Legal Entity"
+
+	if helper.IsNilOrEmpty(&legalEntity.Slug) {
+		invalid.add("slug", helper.ErrorMessageInField(currentEntity, "Slug"))
+	}
+
+	if helper.IsNilOrEmpty(&legalEntity.Name) {
+		invalid.add("name", helper.ErrorMessageInField(currentEntity, "Name"))
+	}
+
+	if helper.IsNilOrEmpty(legalEntity.Kind) {
+		invalid.add("kind", helper.ErrorMessageInField(currentEntity, "Kind"))
+	} else if !entity.LegalEntityKind(*legalEntity.Kind).IsValid() {
+		invalid.add("kind", "the Legal Entity's 'Kind' should be one of Club, Association or Federation")
+	}
+
+	if helper.IsNilOrEmpty(legalEntity.Country) {
+		invalid.add("country", helper.ErrorMessageInField(currentEntity, "Country"))
+	}
+
+	if helper.IsNilOrEmpty(legalEntity.ContactEmail) && helper.IsNilOrEmpty(legalEntity.ContactPhoneNumber) {
+		invalid.addEach(
+			"at least one of the following fields should not be empty: [ContactEmail, ContactPhoneNumber]",
+			"contactEmail",
+			"contactPhoneNumber",
+		)
+	}
+
+	if helper.IsNilOrEmpty(legalEntity.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateUpdateLegalEntityInput(legalEntity *LegalEntity, slug string) *failure.Error {
+	var invalid invalidFields
+
+	if slug == "" {
+		invalid.add("slug", "legal entity slug defined in the path variable is empty")
+	} else if legalEntity.Slug != "" && legalEntity.Slug != slug {
+		invalid.add("slug", "updating the legal entity slug is not allowed")
+	}
+
+	if legalEntity.Kind != nil && !entity.LegalEntityKind(*legalEntity.Kind).IsValid() {
+		invalid.add("kind", "the Legal Entity's 'Kind' should be one of Club, Association or Federation")
+	}
+
+	if legalEntity.Country != nil && *legalEntity.Country == "" {
+		invalid.add("country", helper.ErrorMessageInField("Legal Entity", "Country"))
+	}
+
+	if len(GetFilledLegalEntityAttributesForUpdate(legalEntity)) == 0 {
+		invalid.addEach(
+			"at least one of the following fields should not be empty: "+
+				"[Name, Kind, RegistrationNumber, Country, ContactName, ContactEmail, ContactPhoneNumber, Website, UpdatedBy]",
+			"name", "kind", "registrationNumber", "country", "contactName", "contactEmail", "contactPhoneNumber", "website", "updatedBy",
+		)
+	}
+
+	return invalid.err()
+}
+
+func ValidateLegalEntityAffiliationInput(input *LegalEntityAffiliationInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Affiliation"
+
+	if helper.IsNilOrEmpty(input.TeamName) == helper.IsNilOrEmpty(input.PersonUserName) {
+		invalid.addEach("exactly one of the following fields should be filled: [TeamName, PersonUserName]", "teamName", "personUserName")
+	}
+
+	validateDateRange(&invalid, currentEntity, "StartDate", input.StartDate, "EndDate", input.EndDate, false)
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateEndLegalEntityAffiliationInput(input *EndLegalEntityAffiliationInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Affiliation"
+
+	if helper.IsNilOrEmpty(input.EndDate) {
+		invalid.add("endDate", helper.ErrorMessageInField(currentEntity, "EndDate"))
+	} else if _, err := time.Parse(helper.DefaultDateLayout, *input.EndDate); err != nil {
+		invalid.add("endDate", "the Affiliation's 'EndDate' should follow the format "+helper.DefaultDateLayout)
+	}
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func GetFilledLegalEntityAttributesForUpdate(legalEntity *LegalEntity) []entity.LegalEntityAttribute {
+	var attributes []entity.LegalEntityAttribute
+
+	if legalEntity.Name != "" {
+		attributes = append(attributes, entity.LegalEntityAttributes.Name)
+	}
+
+	if legalEntity.Kind != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.Kind)
+	}
+
+	if legalEntity.RegistrationNumber != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.RegistrationNumber)
+	}
+
+	if legalEntity.Country != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.Country)
+	}
+
+	if legalEntity.ContactName != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.ContactName)
+	}
+
+	if legalEntity.ContactEmail != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.ContactEmail)
+	}
+
+	if legalEntity.ContactPhoneNumber != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.ContactPhoneNumber)
+	}
+
+	if legalEntity.Website != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.Website)
+	}
+
+	if legalEntity.UpdatedBy != nil {
+		attributes = append(attributes, entity.LegalEntityAttributes.UpdatedBy)
+	}
+
+	return attributes
+}
+
+func LegalEntityToLegalEntityEntity(legalEntity LegalEntity) *entity.LegalEntity {
+	return &entity.LegalEntity{
+		Slug:               legalEntity.Slug,
+		Name:               legalEntity.Name,
+		Kind:               entity.LegalEntityKind(StringValue(legalEntity.Kind)),
+		RegistrationNumber: StringValue(legalEntity.RegistrationNumber),
+		Country:            StringValue(legalEntity.Country),
+		ContactName:        StringValue(legalEntity.ContactName),
+		ContactEmail:       StringValue(legalEntity.ContactEmail),
+		ContactPhoneNumber: StringValue(legalEntity.ContactPhoneNumber),
+		Website:            StringValue(legalEntity.Website),
+		CreatedBy:          StringValue(legalEntity.CreatedBy),
+		UpdatedBy:          StringValue(legalEntity.UpdatedBy),
+	}
+}
+
+func LegalEntityEntityToLegalEntity(legalEntityEntity *entity.LegalEntity) LegalEntity {
+	kind := string(legalEntityEntity.Kind)
+	createdAt := legalEntityEntity.CreatedAt.Format(helper.DefaultTimeLayout)
+	updatedAt := legalEntityEntity.UpdatedAt.Format(helper.DefaultTimeLayout)
+	return LegalEntity{
+		Slug:               legalEntityEntity.Slug,
+		Name:               legalEntityEntity.Name,
+		Kind:               &kind,
+		RegistrationNumber: &legalEntityEntity.RegistrationNumber,
+		Country:            &legalEntityEntity.Country,
+		ContactName:        &legalEntityEntity.ContactName,
+		ContactEmail:       &legalEntityEntity.ContactEmail,
+		ContactPhoneNumber: &legalEntityEntity.ContactPhoneNumber,
+		Website:            &legalEntityEntity.Website,
+		CreatedBy:          &legalEntityEntity.CreatedBy,
+		CreatedAt:          &createdAt,
+		UpdatedBy:          &legalEntityEntity.UpdatedBy,
+		UpdatedAt:          &updatedAt,
+	}
+}
+
+func LegalEntityEntitiesToLegalEntities(legalEntityEntities []*entity.LegalEntity) []LegalEntity {
+	legalEntities := make([]LegalEntity, 0, len(legalEntityEntities))
+
+	for _, legalEntityEntity := range legalEntityEntities {
+		legalEntities = append(legalEntities, LegalEntityEntityToLegalEntity(legalEntityEntity))
+	}
+
+	return legalEntities
+}
+
+func LegalEntityAffiliationEntityToLegalEntityAffiliation(
+	affiliationEntity *entity.LegalEntityAffiliation,
+) LegalEntityAffiliation {
+	// Only one of the team and the person is filled
+	var teamSlug, teamName, personUserName *string
+	if affiliationEntity.Team != nil {
+		teamSlug = &affiliationEntity.Team.Slug
+		teamName = &affiliationEntity.Team.Name
+	}
+	if affiliationEntity.Person != nil {
+		personUserName = &affiliationEntity.Person.UserName
+	}
+
+	// An ongoing affiliation has no end date
+	var endDate *string
+	if !affiliationEntity.EndDate.IsZero() {
+		formattedEndDate := affiliationEntity.EndDate.Format(helper.DefaultDateLayout)
+		endDate = &formattedEndDate
+	}
+
+	return LegalEntityAffiliation{
+		LegalEntitySlug: affiliationEntity.LegalEntity.Slug,
+		LegalEntityName: affiliationEntity.LegalEntity.Name,
+		TeamSlug:        teamSlug,
+		TeamName:        teamName,
+		PersonUserName:  personUserName,
+		StartDate:       affiliationEntity.StartDate.Format(helper.DefaultDateLayout),
+		EndDate:         endDate,
+
+		CreatedBy: affiliationEntity.CreatedBy,
+		CreatedAt: affiliationEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+		UpdatedBy: affiliationEntity.UpdatedBy,
+		UpdatedAt: affiliationEntity.UpdatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func LegalEntityAffiliationEntitiesToLegalEntityAffiliations(
+	affiliationEntities []*entity.LegalEntityAffiliation,
+) []LegalEntityAffiliation {
+	affiliations := make([]LegalEntityAffiliation, 0, len(affiliationEntities))
+
+	for _, affiliationEntity := range affiliationEntities {
+		affiliations = append(affiliations, LegalEntityAffiliationEntityToLegalEntityAffiliation(affiliationEntity))
+	}
+
+	return affiliations
+}
@@ -0,0 +1,88 @@
+package payload
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+// MediaURLPrefix is the path under which the API serves the objects of the storage, followed by their keys.
+const MediaURLPrefix = "/v1/media/"
+
+// MediaUpload is an image sent as the raw body of a request, along with who sent it.
+type MediaUpload struct {
+	ContentType string
+	Content     []byte
+	UpdatedBy   string
+}
+
+// ValidateMediaUploadInput checks the upload before it reaches the domain, failing with failure.ErrContentTooLarge
+// when it is larger than maxUploadBytes and with failure.ErrUnsupportedMediaType when it is not an image.
+func ValidateMediaUploadInput(upload *MediaUpload, maxUploadBytes int64) *failure.Error {
+	var invalid invalidFields
+
+	if helper.IsNilOrEmpty(&upload.UpdatedBy) {
+		invalid.add("updatedBy", "the 'updatedBy' query parameter should not be empty")
+	}
+
+	if len(upload.Content) == 0 {
+		invalid.add("body", "the request body should hold the image")
+	}
+
+	if invalidInput := invalid.err(); invalidInput != nil {
+		return invalidInput
+	}
+
+	if int64(len(upload.Content)) > maxUploadBytes {
+		return failure.ErrContentTooLarge.WithMessage(fmt.Sprintf("the image should not be larger than %d bytes", maxUploadBytes))
+	}
+
+	return validateMediaUploadContentType(upload)
+}
+
+// validateMediaUploadContentType checks that the declared content type is one of the ImageContentTypes and matches
+// the one sniffed from the content, which rejects files that are merely renamed.
+func validateMediaUploadContentType(upload *MediaUpload) *failure.Error {
+	if !entity.IsImageContentType(upload.ContentType) {
+		return failure.ErrUnsupportedMediaType.WithMessage(fmt.Sprintf(
+			"unsupported content type '%s', which should be one of [%s]",
+			upload.ContentType,
+			strings.Join(entity.ImageContentTypes, ", "),
+		))
+	}
+
+	detectedContentType := http.DetectContentType(upload.Content)
+	if detectedContentType != upload.ContentType {
+		return failure.ErrUnsupportedMediaType.WithMessage(
+			fmt.Sprintf("the content is '%s' instead of '%s'", detectedContentType, upload.ContentType),
+		)
+	}
+
+	return nil
+}
+
+// ParseMediaContentType drops the parameters of the Content-Type header, leaving only the media type.
+func ParseMediaContentType(header string) string {
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return header
+	}
+
+	return mediaType
+}
+
+// mediaURL returns where the API serves the object under the key, which is null when there is no object.
+func mediaURL(key string) *string {
+	if key == "" {
+		return nil
+	}
+
+	url := MediaURLPrefix + key
+
+	return &url
+}
@@ -0,0 +1,201 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+// Membership shows the team by its current slug and by the name it used when the membership started.
+type Membership struct {
+	TeamSlug       string  `json:"teamSlug"`
+	TeamName       string  `json:"teamName"`
+	PersonUserName string  `json:"personUserName"`
+	Role           string  `json:"role"`
+	JerseyNumber   *string `json:"jerseyNumber"`
+	StartDate      string  `json:"startDate"`
+	EndDate        *string `json:"endDate"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedBy string `json:"updatedBy"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// MembershipTransfer shows the membership ended by a transfer and the membership started by it.
+type MembershipTransfer struct {
+	EndedMembership Membership `json:"endedMembership"`
+	Membership      Membership `json:"membership"`
+}
+
+// TeamMember shows who wore a jersey number on a team, along with the membership in which they wore it.
+type TeamMember struct {
+	Person     Person     `json:"person"`
+	Membership Membership `json:"membership"`
+}
+
+type JerseyNumberInput struct {
+	// JerseyNumber is cleared when empty
+	JerseyNumber  *string `json:"jerseyNumber"`
+	EffectiveDate *string `json:"effectiveDate"`
+	UpdatedBy     *string `json:"updatedBy"`
+}
+
+type MembershipTransferInput struct {
+	FromTeamName *string `json:"fromTeamName"`
+	ToTeamName   *string `json:"toTeamName"`
+	Role         *string `json:"role"`
+	TransferDate *string `json:"transferDate"`
+	UpdatedBy    *string `json:"updatedBy"`
+}
+
+func ValidateMembershipTransferInput(input *MembershipTransferInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Transfer"
+
+	if helper.IsNilOrEmpty(input.FromTeamName) {
+		invalid.add("fromTeamName", helper.ErrorMessageInField(currentEntity, "FromTeamName"))
+	}
+
+	if helper.IsNilOrEmpty(input.ToTeamName) {
+		invalid.add("toTeamName", helper.ErrorMessageInField(currentEntity, "ToTeamName"))
+	} else if input.FromTeamName != nil && *input.FromTeamName == *input.ToTeamName {
+		invalid.add("toTeamName", "the Transfer's 'ToTeamName' should not be its 'FromTeamName'")
+	}
+
+	// The role is optional, the new membership keeps the role of the ended one when it is empty
+	if !helper.IsNilOrEmpty(input.Role) && !entity.IsPlayingRole(*input.Role) {
+		invalid.add("role", "the Transfer's 'Role' should be one of Player, Game Captain or Captain")
+	}
+
+	if helper.IsNilOrEmpty(input.TransferDate) {
+		invalid.add("transferDate", helper.ErrorMessageInField(currentEntity, "TransferDate"))
+	} else if _, err := time.Parse(helper.DefaultDateLayout, *input.TransferDate); err != nil {
+		invalid.add("transferDate", "the Transfer's 'TransferDate' should follow the format "+helper.DefaultDateLayout)
+	}
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateJerseyNumberInput(input *JerseyNumberInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Jersey Number"
+
+	if input.JerseyNumber == nil {
+		invalid.add("jerseyNumber", "the Jersey Number's 'JerseyNumber' should be present, even if empty to clear it")
+	} else if *input.JerseyNumber != "" && !entity.IsValidJerseyNumber(*input.JerseyNumber) {
+		invalid.add("jerseyNumber", "the Jersey Number's 'JerseyNumber' should have one or two digits, such as '7' or '00'")
+	}
+
+	if !helper.IsNilOrEmpty(input.EffectiveDate) {
+		if _, err := time.Parse(helper.DefaultDateLayout, *input.EffectiveDate); err != nil {
+			invalid.add("effectiveDate", "the Jersey Number's 'EffectiveDate' should follow the format "+helper.DefaultDateLayout)
+		}
+	}
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+// JerseyNumberInputEffectiveDate is the date from which the memberships that have not ended yet wear the number,
+// which defaults to the current day.
+func JerseyNumberInputEffectiveDate(input *JerseyNumberInput) time.Time {
+	if helper.IsNilOrEmpty(input.EffectiveDate) {
+		return currentDay()
+	}
+
+	return ParseDate(input.EffectiveDate)
+}
+
+// ValidateJerseyNumber validates the jersey number defined in the path variable.
+func ValidateJerseyNumber(jerseyNumber string) *failure.Error {
+	var invalid invalidFields
+
+	if !entity.IsValidJerseyNumber(jerseyNumber) {
+		invalid.add("jerseyNumber", "the jersey number should have one or two digits, such as '7' or '00'")
+	}
+
+	return invalid.err()
+}
+
+// MembershipsAsOfDate is the date of the optional 'asOf' query param, which defaults to the current day.
+func MembershipsAsOfDate(asOf string) time.Time {
+	if asOf == "" {
+		return currentDay()
+	}
+
+	return ParseDate(&asOf)
+}
+
+// ValidateMembershipsAsOf validates the optional 'asOf' query param, which restricts the memberships to the ones in
+// effect on that date.
+func ValidateMembershipsAsOf(asOf string) *failure.Error {
+	var invalid invalidFields
+
+	if asOf != "" {
+		if _, err := time.Parse(helper.DefaultDateLayout, asOf); err != nil {
+			invalid.add("asOf", "the 'asOf' query param should follow the format "+helper.DefaultDateLayout)
+		}
+	}
+
+	return invalid.err()
+}
+
+func MembershipEntityToMembership(membershipEntity *entity.Membership) Membership {
+	// An ongoing membership has no end date
+	var endDate *string
+	if !membershipEntity.EndDate.IsZero() {
+		formattedEndDate := membershipEntity.EndDate.Format(helper.DefaultDateLayout)
+		endDate = &formattedEndDate
+	}
+
+	// A membership without a number shows it as null
+	var jerseyNumber *string
+	if membershipEntity.JerseyNumber != "" {
+		jerseyNumber = &membershipEntity.JerseyNumber
+	}
+
+	return Membership{
+		TeamSlug:       membershipEntity.Team.Slug,
+		TeamName:       membershipEntity.Team.Name,
+		PersonUserName: membershipEntity.Person.UserName,
+		Role:           membershipEntity.Role,
+		JerseyNumber:   jerseyNumber,
+		StartDate:      membershipEntity.StartDate.Format(helper.DefaultDateLayout),
+		EndDate:        endDate,
+
+		CreatedBy: membershipEntity.CreatedBy,
+		CreatedAt: membershipEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+		UpdatedBy: membershipEntity.UpdatedBy,
+		UpdatedAt: membershipEntity.UpdatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func MembershipEntitiesToMemberships(membershipEntities []entity.Membership) []Membership {
+	memberships := make([]Membership, 0, len(membershipEntities))
+
+	for index := range membershipEntities {
+		memberships = append(memberships, MembershipEntityToMembership(&membershipEntities[index]))
+	}
+
+	return memberships
+}
+
+func MembershipEntityPointersToMemberships(membershipEntities []*entity.Membership) []Membership {
+	memberships := make([]Membership, 0, len(membershipEntities))
+
+	for _, membershipEntity := range membershipEntities {
+		memberships = append(memberships, MembershipEntityToMembership(membershipEntity))
+	}
+
+	return memberships
+}
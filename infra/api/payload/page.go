@@ -0,0 +1,99 @@
+package payload
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+)
+
+// cursor is what the opaque 'cursor' query param of the listings holds, which the clients only pass back as given.
+type cursor struct {
+	After string `json:"after"`
+}
+
+type TeamPage struct {
+	Items      []Team  `json:"items"`
+	NextCursor *string `json:"nextCursor"`
+}
+
+type PersonPage struct {
+	Items      []Person `json:"items"`
+	NextCursor *string  `json:"nextCursor"`
+}
+
+type LegalEntityPage struct {
+	Items      []LegalEntity `json:"items"`
+	NextCursor *string       `json:"nextCursor"`
+}
+
+// ValidatePageParams validates the optional 'limit' and 'cursor' query params of the listings.
+func ValidatePageParams(limit string, encodedCursor string, maxLimit int) *failure.Error {
+	var invalid invalidFields
+
+	if limit != "" {
+		parsedLimit, err := strconv.Atoi(limit)
+		if err != nil || parsedLimit < 1 || parsedLimit > maxLimit {
+			invalid.add("limit", fmt.Sprintf("the 'limit' query param should be a number from 1 to %d", maxLimit))
+		}
+	}
+
+	if encodedCursor != "" {
+		if _, err := decodeCursor(encodedCursor); err != nil {
+			invalid.add("cursor", "the 'cursor' query param should be the 'nextCursor' of a previous page")
+		}
+	}
+
+	return invalid.err()
+}
+
+// ParsePageParams parses the 'limit' and 'cursor' query params that were already checked by ValidatePageParams.
+func ParsePageParams(limit string, encodedCursor string, defaultLimit int) entity.PageRequest {
+	page := entity.PageRequest{
+		Limit: defaultLimit,
+	}
+
+	if limit != "" {
+		page.Limit, _ = strconv.Atoi(limit)
+	}
+
+	if encodedCursor != "" {
+		decodedCursor, _ := decodeCursor(encodedCursor)
+		page.After = decodedCursor.After
+	}
+
+	return page
+}
+
+// NextCursor returns the cursor of the page that follows the key, which is null on the last page.
+func NextCursor(nextAfter string) *string {
+	if nextAfter == "" {
+		return nil
+	}
+
+	encoded, _ := json.Marshal(cursor{After: nextAfter})
+	encodedCursor := base64.RawURLEncoding.EncodeToString(encoded)
+
+	return &encodedCursor
+}
+
+func decodeCursor(encodedCursor string) (cursor, error) {
+	encoded, err := base64.RawURLEncoding.DecodeString(encodedCursor)
+	if err != nil {
+		return cursor{}, err
+	}
+
+	var decodedCursor cursor
+	if err := json.Unmarshal(encoded, &decodedCursor); err != nil {
+		return cursor{}, err
+	}
+
+	if decodedCursor.After == "" {
+		return cursor{}, fmt.Errorf("the cursor has no key")
+	}
+
+	return decodedCursor, nil
+}
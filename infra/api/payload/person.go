@@ -1,9 +1,12 @@
 package payload
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
 	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
 )
 
@@ -14,54 +17,71 @@ type Person struct {
 	PhoneNumber   *string `json:"phoneNumber"`
 	WFDFNumber    *string `json:"wfdfNumber"`
 	OriginCountry *string `json:"originCountry"`
+	BirthDate     *string `json:"birthDate"`
+
+	EmailVisibility       *string `json:"emailVisibility"`
+	PhoneNumberVisibility *string `json:"phoneNumberVisibility"`
+
+	AvatarURL          *string `json:"avatarUrl"`
+	AvatarThumbnailURL *string `json:"avatarThumbnailUrl"`
 
 	CreatedBy *string `json:"createdBy"`
 	CreatedAt *string `json:"createdAt"`
 	UpdatedBy *string `json:"updatedBy"`
 	UpdatedAt *string `json:"updatedAt"`
+	DeletedBy *string `json:"deletedBy"`
+	DeletedAt *string `json:"deletedAt"`
 }
 
-func ValidateCreatePersonInput(person *Person) (bool, string) {
+func ValidateCreatePersonInput(person *Person) *failure.Error {
+	var invalid invalidFields
 	currentEntity := "Person"
 
 	if helper.IsNilOrEmpty(&person.UserName) {
-		return false, helper.ErrorMessageInField(currentEntity, "UserName")
+		invalid.add("userName", helper.ErrorMessageInField(currentEntity, "UserName"))
+	} else if strings.HasPrefix(person.UserName, entity.AnonymizedUserNamePrefix) {
+		// The prefix is kept for the people whose personal data was erased
+		invalid.add("userName", fmt.Sprintf("the Person's 'UserName' should not start with '%s'", entity.AnonymizedUserNamePrefix))
 	}
 
 	if helper.IsNilOrEmpty(&person.Name) {
-		return false, helper.ErrorMessageInField(currentEntity, "Name")
+		invalid.add("name", helper.ErrorMessageInField(currentEntity, "Name"))
 	}
 
 	if helper.IsNilOrEmpty(person.Email) {
-		return false, helper.ErrorMessageInField(currentEntity, "Email")
+		invalid.add("email", helper.ErrorMessageInField(currentEntity, "Email"))
 	}
 
 	if helper.IsNilOrEmpty(person.PhoneNumber) {
-		return false, helper.ErrorMessageInField(currentEntity, "PhoneNumber")
+		invalid.add("phoneNumber", helper.ErrorMessageInField(currentEntity, "PhoneNumber"))
 	}
 
 	if helper.IsNilOrEmpty(person.WFDFNumber) {
-		return false, helper.ErrorMessageInField(currentEntity, "WFDFNumber")
+		invalid.add("wfdfNumber", helper.ErrorMessageInField(currentEntity, "WFDFNumber"))
 	}
 
 	if helper.IsNilOrEmpty(person.OriginCountry) {
-		return false, helper.ErrorMessageInField(currentEntity, "OriginCountry")
+		invalid.add("originCountry", helper.ErrorMessageInField(currentEntity, "OriginCountry"))
+	}
+
+	if !helper.IsNilOrEmpty(person.BirthDate) {
+		validateBirthDate(&invalid, currentEntity, *person.BirthDate)
 	}
 
 	if helper.IsNilOrEmpty(person.CreatedBy) {
-		return false, helper.ErrorMessageInField(currentEntity, "CreatedBy")
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
 	}
 
-	return true, ""
+	return invalid.err()
 }
 
-func ValidateUpdatePersonInput(person *Person, userName string) (bool, string) {
-	if userName == "" {
-		return false, "user name defined in the path variable is empty"
-	}
+func ValidateUpdatePersonInput(person *Person, userName string) *failure.Error {
+	var invalid invalidFields
 
-	if person.UserName != "" && person.UserName != userName {
-		return false, "updating the user name is not allowed"
+	if userName == "" {
+		invalid.add("username", "user name defined in the path variable is empty")
+	} else if person.UserName != "" && person.UserName != userName {
+		invalid.add("userName", "updating the user name is not allowed")
 	}
 
 	if (person.Email == nil || *person.Email == "") &&
@@ -69,10 +89,113 @@ func ValidateUpdatePersonInput(person *Person, userName string) (bool, string) {
 		(person.WFDFNumber == nil || *person.WFDFNumber == "") &&
 		(person.OriginCountry == nil || *person.OriginCountry == "") &&
 		(person.UpdatedBy == nil || *person.UpdatedBy == "") {
-		return false, "at least one of the following fields should not be empty: [Email, PhoneNumber, WFDFNumber, OriginCountry, UpdatedBy]"
+		invalid.addEach(
+			"at least one of the following fields should not be empty: [Email, PhoneNumber, WFDFNumber, OriginCountry, UpdatedBy]",
+			"email", "phoneNumber", "wfdfNumber", "originCountry", "updatedBy",
+		)
+	}
+
+	return invalid.err()
+}
+
+// PersonBirthDateInput sets the birth date of a person, which tells whether they are a minor that needs the consent
+// of a guardian.
+type PersonBirthDateInput struct {
+	BirthDate *string `json:"birthDate"`
+	UpdatedBy *string `json:"updatedBy"`
+}
+
+func ValidatePersonBirthDateInput(input *PersonBirthDateInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Person"
+
+	if helper.IsNilOrEmpty(input.BirthDate) {
+		invalid.add("birthDate", helper.ErrorMessageInField(currentEntity, "BirthDate"))
+	} else {
+		validateBirthDate(&invalid, currentEntity, *input.BirthDate)
+	}
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+// PersonVisibilityInput restricts who can see the contact details of a person. A visibility that is left out goes back
+// to the default audience.
+type PersonVisibilityInput struct {
+	EmailVisibility       *string `json:"emailVisibility"`
+	PhoneNumberVisibility *string `json:"phoneNumberVisibility"`
+	UpdatedBy             *string `json:"updatedBy"`
+}
+
+func ValidatePersonVisibilityInput(input *PersonVisibilityInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Person"
+
+	validateVisibility(&invalid, currentEntity, "EmailVisibility", input.EmailVisibility, entity.PersonAttributes.Email)
+	validateVisibility(&invalid, currentEntity, "PhoneNumberVisibility", input.PhoneNumberVisibility, entity.PersonAttributes.PhoneNumber)
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+// validateVisibility checks that the optional visibility of an attribute is an audience that is at least as trusted as
+// the default one, since the visibilities can only restrict who sees the attribute.
+func validateVisibility(
+	invalid *invalidFields,
+	currentEntity string,
+	field string,
+	visibility *string,
+	attribute entity.PersonAttribute,
+) {
+	if helper.IsNilOrEmpty(visibility) {
+		return
+	}
+
+	defaultAudience := (&entity.Person{}).AudienceOf(attribute)
+	allowedAudiences := []string{}
+	for _, audience := range []entity.Audience{entity.Audiences.Public, entity.Audiences.Teammate, entity.Audiences.Staff, entity.Audiences.Self} {
+		if audience.Includes(defaultAudience) {
+			allowedAudiences = append(allowedAudiences, string(audience))
+		}
+	}
+
+	audience := entity.Audience(*visibility)
+	if !audience.IsValid() || !audience.Includes(defaultAudience) {
+		invalid.add(criteriaFieldName(field), fmt.Sprintf(
+			"the %s's '%s' should be one of %s",
+			currentEntity,
+			field,
+			strings.Join(allowedAudiences, ", "),
+		))
+	}
+}
+
+// ParseVisibility parses an optional visibility that was already checked by ValidatePersonVisibilityInput.
+func ParseVisibility(visibility *string) entity.Audience {
+	if helper.IsNilOrEmpty(visibility) {
+		return ""
+	}
+
+	return entity.Audience(*visibility)
+}
+
+func validateBirthDate(invalid *invalidFields, currentEntity string, birthDate string) {
+	parsedBirthDate, err := time.Parse(helper.DefaultDateLayout, birthDate)
+	if err != nil {
+		invalid.add("birthDate", "the "+currentEntity+"'s 'BirthDate' should follow the format "+helper.DefaultDateLayout)
+
+		return
 	}
 
-	return true, ""
+	if parsedBirthDate.After(currentDay()) {
+		invalid.add("birthDate", "the "+currentEntity+"'s 'BirthDate' should not be in the future")
+	}
 }
 
 func GetFilledPersonAttributesForUpdate(person *Person) []entity.PersonAttribute {
@@ -122,6 +245,8 @@ func PersonToPersonEntity(person Person) *entity.Person {
 		originCountry = *person.OriginCountry
 	}
 
+	birthDate := ParseDate(person.BirthDate)
+
 	var createdBy string
 	if person.CreatedBy != nil {
 		createdBy = *person.CreatedBy
@@ -157,6 +282,7 @@ func PersonToPersonEntity(person Person) *entity.Person {
 		PhoneNumber:   phoneNumber,
 		WFDFNumber:    wfdfNumber,
 		OriginCountry: originCountry,
+		BirthDate:     birthDate,
 
 		CreatedBy: createdBy,
 		CreatedAt: createdAt,
@@ -165,9 +291,20 @@ func PersonToPersonEntity(person Person) *entity.Person {
 	}
 }
 
-func PersonEntityToPerson(personEntity *entity.Person) Person {
+// personEntityToPerson formats every detail of the person, so it is only reached through PersonEntityToVisiblePerson,
+// which leaves out the ones that the audience is not allowed to see.
+func personEntityToPerson(personEntity *entity.Person) Person {
 	createdAt := personEntity.CreatedAt.Format(helper.DefaultTimeLayout)
 	updatedAt := personEntity.UpdatedAt.Format(helper.DefaultTimeLayout)
+	deletedBy, deletedAt := archiveFields(personEntity.DeletedBy, personEntity.DeletedAt)
+
+	// The birth date is null while it is unknown
+	var birthDate *string
+	if !personEntity.BirthDate.IsZero() {
+		formattedBirthDate := personEntity.BirthDate.Format(helper.DefaultDateLayout)
+		birthDate = &formattedBirthDate
+	}
+
 	return Person{
 		UserName:      personEntity.UserName,
 		Name:          personEntity.Name,
@@ -175,24 +312,63 @@ func PersonEntityToPerson(personEntity *entity.Person) Person {
 		PhoneNumber:   &personEntity.PhoneNumber,
 		WFDFNumber:    &personEntity.WFDFNumber,
 		OriginCountry: &personEntity.OriginCountry,
+		BirthDate:     birthDate,
+
+		EmailVisibility:       audienceOf(personEntity, entity.PersonAttributes.Email),
+		PhoneNumberVisibility: audienceOf(personEntity, entity.PersonAttributes.PhoneNumber),
+
+		AvatarURL:          mediaURL(personEntity.AvatarKey),
+		AvatarThumbnailURL: mediaURL(personEntity.AvatarThumbnailKey),
 
 		CreatedBy: &personEntity.CreatedBy,
 		CreatedAt: &createdAt,
 		UpdatedBy: &personEntity.UpdatedBy,
 		UpdatedAt: &updatedAt,
+		DeletedBy: deletedBy,
+		DeletedAt: deletedAt,
 	}
 }
 
-func PersonEntitiesToPeople(personEntities []*entity.Person) []Person {
-	People := make([]Person, 0)
+// PersonEntityToVisiblePerson formats the person as seen by the audience, which leaves null every personal detail
+// that the audience is not allowed to see.
+func PersonEntityToVisiblePerson(personEntity *entity.Person, audience entity.Audience) Person {
+	person := personEntityToPerson(personEntity)
 
-	for _, personEntity := range personEntities {
-		People = append(People, PersonEntityToPerson(personEntity))
+	if !personEntity.IsVisibleTo(entity.PersonAttributes.Email, audience) {
+		person.Email = nil
 	}
+	if !personEntity.IsVisibleTo(entity.PersonAttributes.PhoneNumber, audience) {
+		person.PhoneNumber = nil
+	}
+	if !personEntity.IsVisibleTo(entity.PersonAttributes.WFDFNumber, audience) {
+		person.WFDFNumber = nil
+	}
+	if !personEntity.IsVisibleTo(entity.PersonAttributes.BirthDate, audience) {
+		person.BirthDate = nil
+	}
+
+	return person
+}
+
+// PersonEntitiesToVisiblePeople formats each person as seen by the audience that the requester belongs to for them,
+// which is Public for the people that are left out of the audiences.
+func PersonEntitiesToVisiblePeople(personEntities []*entity.Person, audiences map[string]entity.Audience) []Person {
+	people := make([]Person, 0, len(personEntities))
+
+	for _, personEntity := range personEntities {
+		audience, isKnown := audiences[personEntity.UserName]
+		if !isKnown {
+			audience = entity.Audiences.Public
+		}
 
-	if People == nil {
-		return []Person{}
+		people = append(people, PersonEntityToVisiblePerson(personEntity, audience))
 	}
 
-	return People
+	return people
+}
+
+func audienceOf(personEntity *entity.Person, attribute entity.PersonAttribute) *string {
+	audience := string(personEntity.AudienceOf(attribute))
+
+	return &audience
 }
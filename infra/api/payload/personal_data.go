@@ -0,0 +1,82 @@
+package payload
+
+import (
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+// PersonalData is the archive handed to a person that asks for everything that is known about them.
+type PersonalData struct {
+	Person       Person               `json:"person"`
+	Records      []PersonalDataRecord `json:"records"`
+	AuditEntries []AuditEntry         `json:"auditEntries"`
+
+	ExportedAt string `json:"exportedAt"`
+}
+
+type PersonalDataRecord struct {
+	Table  string                   `json:"table"`
+	Column string                   `json:"column"`
+	Rows   []map[string]interface{} `json:"rows"`
+}
+
+type AuditEntry struct {
+	Table  string `json:"table"`
+	Action string `json:"action"`
+	At     string `json:"at"`
+}
+
+type ErasureInput struct {
+	ErasedBy *string `json:"erasedBy"`
+}
+
+func ValidateErasureInput(input *ErasureInput) *failure.Error {
+	var invalid invalidFields
+
+	if helper.IsNilOrEmpty(input.ErasedBy) {
+		invalid.add("erasedBy", helper.ErrorMessageInField("Erasure", "ErasedBy"))
+	}
+
+	return invalid.err()
+}
+
+// ValidatePersonalDataRequestedBy validates the required 'requestedBy' query param, the username of the person asking
+// for the export of personal data.
+func ValidatePersonalDataRequestedBy(requestedBy string) *failure.Error {
+	var invalid invalidFields
+
+	if requestedBy == "" {
+		invalid.add("requestedBy", "the 'requestedBy' query param should not be empty")
+	}
+
+	return invalid.err()
+}
+
+func PersonalDataEntityToPersonalData(personalDataEntity *entity.PersonalData) PersonalData {
+	records := make([]PersonalDataRecord, 0, len(personalDataEntity.Records))
+	for _, recordEntity := range personalDataEntity.Records {
+		records = append(records, PersonalDataRecord{
+			Table:  recordEntity.Table,
+			Column: recordEntity.Column,
+			Rows:   recordEntity.Rows,
+		})
+	}
+
+	auditEntries := make([]AuditEntry, 0, len(personalDataEntity.AuditEntries))
+	for _, auditEntryEntity := range personalDataEntity.AuditEntries {
+		auditEntries = append(auditEntries, AuditEntry{
+			Table:  auditEntryEntity.Table,
+			Action: string(auditEntryEntity.Action),
+			At:     auditEntryEntity.At.Format(helper.DefaultTimeLayout),
+		})
+	}
+
+	return PersonalData{
+		Person:       PersonEntityToVisiblePerson(personalDataEntity.Person, entity.Audiences.Self),
+		Records:      records,
+		AuditEntries: auditEntries,
+
+		ExportedAt: personalDataEntity.ExportedAt.Format(helper.DefaultTimeLayout),
+	}
+}
@@ -0,0 +1,80 @@
+package payload
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+)
+
+// ProblemContentType is the media type of the problems, as described by RFC 7807.
+const ProblemContentType = "application/problem+json"
+
+// internalProblemDetail replaces the detail of the server errors, which may tell the internals of the API.
+const internalProblemDetail = "an unexpected error occurred, which can be traced by the correlation ID"
+
+// Problem is the body of every error response, as described by RFC 7807, extended with the Code of the failure, the
+// errors of the fields of the input and the ID that correlates the response with the logs of the request.
+type Problem struct {
+	Type          string              `json:"type"`
+	Title         string              `json:"title"`
+	Status        int                 `json:"status"`
+	Detail        string              `json:"detail,omitempty"`
+	Instance      string              `json:"instance,omitempty"`
+	Code          string              `json:"code"`
+	CorrelationID string              `json:"correlationId,omitempty"`
+	Errors        []ProblemFieldError `json:"errors,omitempty"`
+}
+
+type ProblemFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// NewProblem describes the failure behind an error response, if known, along with the message of the response. The
+// code is the one of the failure or, when there is none, the one of the status code, such as not_found. The message
+// of a server error is left out.
+func NewProblem(statusCode int, failureError *failure.Error, message string) Problem {
+	problem := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(statusCode),
+		Status: statusCode,
+		Detail: message,
+		Code:   statusProblemCode(statusCode),
+		Errors: []ProblemFieldError{},
+	}
+
+	if statusCode >= http.StatusInternalServerError {
+		problem.Detail = internalProblemDetail
+	}
+
+	if failureError != nil {
+		problem.Code = failureError.Code
+		if problem.Detail == "" {
+			problem.Detail = failureError.Message
+		}
+		for _, fieldError := range failureError.Fields {
+			problem.Errors = append(problem.Errors, ProblemFieldError{
+				Field:   fieldError.Field,
+				Message: fieldError.Message,
+			})
+		}
+	}
+
+	return problem
+}
+
+// statusProblemCode is the code of the problems that are not caused by a known failure, which follows the status
+// code, such as not_found for 404.
+func statusProblemCode(statusCode int) string {
+	switch {
+	case statusCode == http.StatusBadRequest:
+		return "invalid_input"
+	case statusCode == http.StatusInternalServerError:
+		return "internal_error"
+	case http.StatusText(statusCode) == "":
+		return "unknown_error"
+	}
+
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(statusCode)), " ", "_")
+}
@@ -0,0 +1,119 @@
+package payload
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/search"
+)
+
+// SearchResultTypes are the kinds of entities that a search finds.
+var SearchResultTypes = struct {
+	Team   string
+	Person string
+}{
+	Team:   "team",
+	Person: "person",
+}
+
+type SearchResult struct {
+	Type   string  `json:"type"`
+	Rank   float64 `json:"rank"`
+	Team   *Team   `json:"team,omitempty"`
+	Person *Person `json:"person,omitempty"`
+	// Highlights has the matching fields of the team or person, HTML escaped, with the matching words within <mark>
+	Highlights map[string]string `json:"highlights"`
+}
+
+type SearchResults struct {
+	Items []SearchResult `json:"items"`
+}
+
+// ValidateSearchParams validates the 'q' and the optional 'limit' query params of the search.
+func ValidateSearchParams(query string, limit string, maxLimit int) *failure.Error {
+	var invalid invalidFields
+
+	if utf8.RuneCountInString(strings.TrimSpace(query)) < search.MinimumQueryLength {
+		invalid.add("q", fmt.Sprintf("the 'q' query param should have at least %d characters", search.MinimumQueryLength))
+	}
+
+	if limit != "" {
+		parsedLimit, err := strconv.Atoi(limit)
+		if err != nil || parsedLimit < 1 || parsedLimit > maxLimit {
+			invalid.add("limit", fmt.Sprintf("the 'limit' query param should be a number from 1 to %d", maxLimit))
+		}
+	}
+
+	return invalid.err()
+}
+
+// ParseSearchParams parses the 'q' and 'limit' query params that were already checked by ValidateSearchParams.
+func ParseSearchParams(query string, limit string, defaultLimit int) (string, int) {
+	parsedLimit := defaultLimit
+	if limit != "" {
+		parsedLimit, _ = strconv.Atoi(limit)
+	}
+
+	return strings.TrimSpace(query), parsedLimit
+}
+
+func SearchResultEntitiesToSearchResults(resultEntities []*entity.SearchResult) SearchResults {
+	results := make([]SearchResult, 0, len(resultEntities))
+
+	for _, resultEntity := range resultEntities {
+		results = append(results, SearchResultEntityToSearchResult(resultEntity))
+	}
+
+	return SearchResults{
+		Items: results,
+	}
+}
+
+// SearchResultEntityToSearchResult shows the person found by a search as anyone would see them.
+func SearchResultEntityToSearchResult(resultEntity *entity.SearchResult) SearchResult {
+	result := SearchResult{
+		Rank:       resultEntity.Rank,
+		Highlights: map[string]string{},
+	}
+
+	texts := map[string]string{}
+	if resultEntity.Team != nil {
+		team := TeamEntityToTeam(resultEntity.Team)
+		result.Type = SearchResultTypes.Team
+		result.Team = &team
+		texts[string(entity.TeamAttributes.Name)] = resultEntity.Team.Name
+		texts[string(entity.TeamAttributes.Description)] = resultEntity.Team.Description
+	} else {
+		person := PersonEntityToVisiblePerson(resultEntity.Person, entity.Audiences.Public)
+		result.Type = SearchResultTypes.Person
+		result.Person = &person
+		texts[string(entity.PersonAttributes.Name)] = resultEntity.Person.Name
+	}
+
+	for attribute, spans := range resultEntity.Highlights {
+		result.Highlights[criteriaFieldName(attribute)] = markSpans(texts[attribute], spans)
+	}
+
+	return result
+}
+
+// markSpans escapes the text as HTML and wraps each of the spans within <mark>.
+func markSpans(text string, spans []entity.TextSpan) string {
+	var builder strings.Builder
+	position := 0
+	for _, span := range spans {
+		builder.WriteString(html.EscapeString(text[position:span.Start]))
+		builder.WriteString("<mark>")
+		builder.WriteString(html.EscapeString(text[span.Start:span.End]))
+		builder.WriteString("</mark>")
+		position = span.End
+	}
+	builder.WriteString(html.EscapeString(text[position:]))
+
+	return builder.String()
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
 	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
 )
 
@@ -13,50 +14,59 @@ type Team struct {
 	Description   *string `json:"description"`
 	OriginCountry *string `json:"originCountry"`
 
+	LogoURL          *string `json:"logoUrl"`
+	LogoThumbnailURL *string `json:"logoThumbnailUrl"`
+
 	CreatedBy *string `json:"createdBy"`
 	CreatedAt *string `json:"createdAt"`
 	UpdatedBy *string `json:"updatedBy"`
 	UpdatedAt *string `json:"updatedAt"`
+	DeletedBy *string `json:"deletedBy"`
+	DeletedAt *string `json:"deletedAt"`
 }
 
-func ValidateCreateTeamInput(team *Team) (bool, string) {
+func ValidateCreateTeamInput(team *Team) *failure.Error {
+	var invalid invalidFields
 	currentEntity := "Team"
 
 	if helper.IsNilOrEmpty(&team.Name) {
-		return false, helper.ErrorMessageInField(currentEntity, "Name")
+		invalid.add("name", helper.ErrorMessageInField(currentEntity, "Name"))
 	}
 
 	if helper.IsNilOrEmpty(team.Description) {
-		return false, helper.ErrorMessageInField(currentEntity, "Description")
+		invalid.add("description", helper.ErrorMessageInField(currentEntity, "Description"))
 	}
 
 	if helper.IsNilOrEmpty(team.OriginCountry) {
-		return false, helper.ErrorMessageInField(currentEntity, "Origin Country")
+		invalid.add("originCountry", helper.ErrorMessageInField(currentEntity, "Origin Country"))
 	}
 
 	if helper.IsNilOrEmpty(team.CreatedBy) {
-		return false, helper.ErrorMessageInField(currentEntity, "Created By")
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "Created By"))
 	}
 
-	return true, ""
+	return invalid.err()
 }
 
-func ValidateUpdateTeamInput(team *Team, name string) (bool, string) {
-	if name == "" {
-		return false, "team name defined in the path variable is empty"
-	}
+func ValidateUpdateTeamInput(team *Team, name string) *failure.Error {
+	var invalid invalidFields
 
-	if team.Name != "" && team.Name != name {
-		return false, "updating the team name is not allowed"
+	if name == "" {
+		invalid.add("name", "team name defined in the path variable is empty")
+	} else if team.Name != "" && team.Name != name {
+		invalid.add("name", "updating the team name is not allowed")
 	}
 
 	if (team.Description == nil || *team.Description == "") &&
 		(team.OriginCountry == nil || *team.OriginCountry == "") &&
 		(team.UpdatedBy == nil || *team.UpdatedBy == "") {
-		return false, "at least one of the following fields should not be empty: [Description, OriginCountry, UpdatedBy]"
+		invalid.addEach(
+			"at least one of the following fields should not be empty: [Description, OriginCountry, UpdatedBy]",
+			"description", "originCountry", "updatedBy",
+		)
 	}
 
-	return true, ""
+	return invalid.err()
 }
 
 func GetFilledTeamAttributesForUpdate(team *Team) []entity.TeamAttribute {
@@ -131,6 +141,7 @@ func TeamToTeamEntity(team Team) *entity.Team {
 func TeamEntityToTeam(teamEntity *entity.Team) Team {
 	createdAt := teamEntity.CreatedAt.Format(helper.DefaultTimeLayout)
 	updatedAt := teamEntity.UpdatedAt.Format(helper.DefaultTimeLayout)
+	deletedBy, deletedAt := archiveFields(teamEntity.DeletedBy, teamEntity.DeletedAt)
 	return Team{
 		Slug:          teamEntity.Slug,
 		Name:          teamEntity.Name,
@@ -140,6 +151,11 @@ func TeamEntityToTeam(teamEntity *entity.Team) Team {
 		CreatedAt:     &createdAt,
 		UpdatedBy:     &teamEntity.UpdatedBy,
 		UpdatedAt:     &updatedAt,
+		DeletedBy:     deletedBy,
+		DeletedAt:     deletedAt,
+
+		LogoURL:          mediaURL(teamEntity.LogoKey),
+		LogoThumbnailURL: mediaURL(teamEntity.LogoThumbnailKey),
 	}
 }
 
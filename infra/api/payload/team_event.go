@@ -0,0 +1,334 @@
+package payload
+
+import (
+	"sort"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type TeamEvent struct {
+	ID          string `json:"id"`
+	TeamSlug    string `json:"teamSlug"`
+	Kind        string `json:"kind"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Location    string `json:"location"`
+	StartTime   string `json:"startTime"`
+	EndTime     string `json:"endTime"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedBy string `json:"updatedBy"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type TeamEventRSVP struct {
+	EventID        string `json:"eventId"`
+	PersonUserName string `json:"personUserName"`
+	Status         string `json:"status"`
+	RespondedAt    string `json:"respondedAt"`
+}
+
+type TeamEventAttendance struct {
+	EventID    string                      `json:"eventId"`
+	Records    []TeamEventAttendanceRecord `json:"records"`
+	RecordedBy string                      `json:"recordedBy"`
+}
+
+type TeamEventAttendanceRecord struct {
+	PersonUserName string `json:"personUserName"`
+	Status         string `json:"status"`
+}
+
+type TeamAttendanceRate struct {
+	PersonUserName string  `json:"personUserName"`
+	EventsCount    int     `json:"eventsCount"`
+	AttendedCount  int     `json:"attendedCount"`
+	AttendanceRate float64 `json:"attendanceRate"`
+}
+
+type TeamEventAvailability struct {
+	Event      TeamEvent `json:"event"`
+	Yes        []string  `json:"yes"`
+	No         []string  `json:"no"`
+	Maybe      []string  `json:"maybe"`
+	NoResponse []string  `json:"noResponse"`
+}
+
+type CreateTeamEventInput struct {
+	Kind        *string `json:"kind"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Location    *string `json:"location"`
+	StartTime   *string `json:"startTime"`
+	EndTime     *string `json:"endTime"`
+	CreatedBy   *string `json:"createdBy"`
+}
+
+type TeamEventRSVPInput struct {
+	Status *string `json:"status"`
+}
+
+type TeamEventAttendanceInput struct {
+	Records    []TeamEventAttendanceRecord `json:"records"`
+	RecordedBy *string                     `json:"recordedBy"`
+}
+
+func ValidateCreateTeamEventInput(input *CreateTeamEventInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Event"
+
+	if helper.IsNilOrEmpty(input.Kind) {
+		invalid.add("kind", helper.ErrorMessageInField(currentEntity, "Kind"))
+	} else if !entity.TeamEventKind(*input.Kind).IsValid() {
+		invalid.add("kind", "the Event's 'Kind' should be one of Practice, Scrimmage or Social")
+	}
+
+	if helper.IsNilOrEmpty(input.Title) {
+		invalid.add("title", helper.ErrorMessageInField(currentEntity, "Title"))
+	}
+
+	var startTime, endTime time.Time
+	if helper.IsNilOrEmpty(input.StartTime) {
+		invalid.add("startTime", helper.ErrorMessageInField(currentEntity, "StartTime"))
+	} else {
+		var err error
+		startTime, err = time.Parse(helper.DefaultTimeLayout, *input.StartTime)
+		if err != nil {
+			invalid.add("startTime", "the Event's 'StartTime' should follow the RFC 3339 format")
+		}
+	}
+
+	if helper.IsNilOrEmpty(input.EndTime) {
+		invalid.add("endTime", helper.ErrorMessageInField(currentEntity, "EndTime"))
+	} else {
+		var err error
+		endTime, err = time.Parse(helper.DefaultTimeLayout, *input.EndTime)
+		if err != nil {
+			invalid.add("endTime", "the Event's 'EndTime' should follow the RFC 3339 format")
+		}
+	}
+
+	if !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime) {
+		invalid.add("endTime", "the Event's 'EndTime' should be after its 'StartTime'")
+	}
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateTeamEventRSVPInput(input *TeamEventRSVPInput) *failure.Error {
+	var invalid invalidFields
+
+	if helper.IsNilOrEmpty(input.Status) {
+		invalid.add("status", helper.ErrorMessageInField("RSVP", "Status"))
+	} else if !entity.RSVPStatus(*input.Status).IsValid() {
+		invalid.add("status", "the RSVP's 'Status' should be one of Yes, No or Maybe")
+	}
+
+	return invalid.err()
+}
+
+func ValidateTeamEventAttendanceInput(input *TeamEventAttendanceInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Attendance"
+
+	if len(input.Records) == 0 {
+		invalid.add("records", helper.ErrorMessageInField(currentEntity, "Records"))
+	}
+
+	recordedUserNames := make(map[string]bool, len(input.Records))
+	for _, record := range input.Records {
+		if helper.IsNilOrEmpty(&record.PersonUserName) {
+			invalid.add("records", helper.ErrorMessageInField(currentEntity, "PersonUserName"))
+		}
+
+		status := entity.AttendanceStatus(record.Status)
+		if status != entity.AttendanceStatuses.Present && status != entity.AttendanceStatuses.Absent {
+			invalid.add("records", "the Attendance's 'Status' should be one of Present or Absent")
+		}
+
+		if record.PersonUserName != "" && recordedUserNames[record.PersonUserName] {
+			invalid.add("records", "the Attendance of '"+record.PersonUserName+"' was recorded more than once")
+		}
+		recordedUserNames[record.PersonUserName] = true
+	}
+
+	if helper.IsNilOrEmpty(input.RecordedBy) {
+		invalid.add("recordedBy", helper.ErrorMessageInField(currentEntity, "RecordedBy"))
+	}
+
+	return invalid.err()
+}
+
+// ValidateTeamEventPeriod checks the optional 'from' and 'to' query params used to filter team events by date.
+func ValidateTeamEventPeriod(from, to string) *failure.Error {
+	var invalid invalidFields
+
+	if from != "" {
+		if _, err := time.Parse(helper.DefaultDateLayout, from); err != nil {
+			invalid.add("from", "the 'from' query param should follow the format "+helper.DefaultDateLayout)
+		}
+	}
+
+	if to != "" {
+		if _, err := time.Parse(helper.DefaultDateLayout, to); err != nil {
+			invalid.add("to", "the 'to' query param should follow the format "+helper.DefaultDateLayout)
+		}
+	}
+
+	return invalid.err()
+}
+
+// ParseTeamEventPeriod converts the already validated 'from' and 'to' query params into the bounds of the period.
+// The 'to' date is inclusive, so the period ends at the start of the following day.
+func ParseTeamEventPeriod(from, to string) (time.Time, time.Time) {
+	fromTime := ParseDate(&from)
+
+	toTime := ParseDate(&to)
+	if !toTime.IsZero() {
+		toTime = toTime.AddDate(0, 0, 1)
+	}
+
+	return fromTime, toTime
+}
+
+func CreateTeamEventInputToTeamEventEntity(input *CreateTeamEventInput) *entity.TeamEvent {
+	startTime, _ := time.Parse(helper.DefaultTimeLayout, *input.StartTime)
+	endTime, _ := time.Parse(helper.DefaultTimeLayout, *input.EndTime)
+
+	return &entity.TeamEvent{
+		Kind:        entity.TeamEventKind(*input.Kind),
+		Title:       *input.Title,
+		Description: StringValue(input.Description),
+		Location:    StringValue(input.Location),
+		StartTime:   startTime,
+		EndTime:     endTime,
+		CreatedBy:   *input.CreatedBy,
+	}
+}
+
+func TeamEventAttendanceInputToAttendanceStatuses(input *TeamEventAttendanceInput) map[string]entity.AttendanceStatus {
+	attendance := make(map[string]entity.AttendanceStatus, len(input.Records))
+
+	for _, record := range input.Records {
+		attendance[record.PersonUserName] = entity.AttendanceStatus(record.Status)
+	}
+
+	return attendance
+}
+
+func TeamEventEntityToTeamEvent(eventEntity *entity.TeamEvent) TeamEvent {
+	var teamSlug string
+	if eventEntity.Team != nil {
+		teamSlug = eventEntity.Team.Slug
+	}
+
+	return TeamEvent{
+		ID:          eventEntity.ID,
+		TeamSlug:    teamSlug,
+		Kind:        string(eventEntity.Kind),
+		Title:       eventEntity.Title,
+		Description: eventEntity.Description,
+		Location:    eventEntity.Location,
+		StartTime:   eventEntity.StartTime.Format(helper.DefaultTimeLayout),
+		EndTime:     eventEntity.EndTime.Format(helper.DefaultTimeLayout),
+
+		CreatedBy: eventEntity.CreatedBy,
+		CreatedAt: eventEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+		UpdatedBy: eventEntity.UpdatedBy,
+		UpdatedAt: eventEntity.UpdatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func TeamEventEntitiesToTeamEvents(eventEntities []*entity.TeamEvent) []TeamEvent {
+	events := make([]TeamEvent, 0)
+
+	for _, eventEntity := range eventEntities {
+		events = append(events, TeamEventEntityToTeamEvent(eventEntity))
+	}
+
+	return events
+}
+
+func TeamEventParticipationEntityToTeamEventRSVP(participationEntity *entity.TeamEventParticipation) TeamEventRSVP {
+	return TeamEventRSVP{
+		EventID:        participationEntity.Event.ID,
+		PersonUserName: participationEntity.Person.UserName,
+		Status:         string(participationEntity.RSVP),
+		RespondedAt:    participationEntity.RespondedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func TeamEventParticipationEntitiesToTeamEventAttendance(
+	eventID string,
+	recordedBy string,
+	participationEntities []*entity.TeamEventParticipation,
+) TeamEventAttendance {
+	records := make([]TeamEventAttendanceRecord, 0, len(participationEntities))
+
+	for _, participationEntity := range participationEntities {
+		records = append(records, TeamEventAttendanceRecord{
+			PersonUserName: participationEntity.Person.UserName,
+			Status:         string(participationEntity.Attendance),
+		})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].PersonUserName < records[j].PersonUserName
+	})
+
+	return TeamEventAttendance{
+		EventID:    eventID,
+		Records:    records,
+		RecordedBy: recordedBy,
+	}
+}
+
+func TeamAttendanceRateEntitiesToTeamAttendanceRates(rateEntities []*entity.TeamAttendanceRate) []TeamAttendanceRate {
+	rates := make([]TeamAttendanceRate, 0)
+
+	for _, rateEntity := range rateEntities {
+		rates = append(rates, TeamAttendanceRate{
+			PersonUserName: rateEntity.Person.UserName,
+			EventsCount:    rateEntity.EventsCount,
+			AttendedCount:  rateEntity.AttendedCount,
+			AttendanceRate: rateEntity.AttendanceRate,
+		})
+	}
+
+	return rates
+}
+
+func TeamEventAvailabilityEntitiesToTeamEventAvailabilities(
+	availabilityEntities []*entity.TeamEventAvailability,
+) []TeamEventAvailability {
+	availabilities := make([]TeamEventAvailability, 0)
+
+	for _, availabilityEntity := range availabilityEntities {
+		availabilities = append(availabilities, TeamEventAvailability{
+			Event:      TeamEventEntityToTeamEvent(availabilityEntity.Event),
+			Yes:        personEntitiesToUserNames(availabilityEntity.Yes),
+			No:         personEntitiesToUserNames(availabilityEntity.No),
+			Maybe:      personEntitiesToUserNames(availabilityEntity.Maybe),
+			NoResponse: personEntitiesToUserNames(availabilityEntity.NoResponse),
+		})
+	}
+
+	return availabilities
+}
+
+func personEntitiesToUserNames(personEntities []*entity.Person) []string {
+	userNames := make([]string, 0, len(personEntities))
+
+	for _, personEntity := range personEntities {
+		userNames = append(userNames, personEntity.UserName)
+	}
+
+	return userNames
+}
@@ -0,0 +1,102 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+// TeamName shows a name that a team used before being renamed. The effective from date is null for the name the team
+// was created with.
+type TeamName struct {
+	Name           string  `json:"name"`
+	Slug           string  `json:"slug"`
+	EffectiveFrom  *string `json:"effectiveFrom"`
+	EffectiveUntil string  `json:"effectiveUntil"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type TeamRenameInput struct {
+	Name          *string `json:"name"`
+	Slug          *string `json:"slug"`
+	EffectiveDate *string `json:"effectiveDate"`
+	UpdatedBy     *string `json:"updatedBy"`
+}
+
+func ValidateTeamRenameInput(input *TeamRenameInput, currentName string) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Rename"
+
+	if helper.IsNilOrEmpty(input.Name) {
+		invalid.add("name", helper.ErrorMessageInField(currentEntity, "Name"))
+	} else if *input.Name == currentName {
+		invalid.add("name", "the Rename's 'Name' should not be the current name of the team")
+	}
+
+	if helper.IsNilOrEmpty(input.Slug) {
+		invalid.add("slug", helper.ErrorMessageInField(currentEntity, "Slug"))
+	}
+
+	if !helper.IsNilOrEmpty(input.EffectiveDate) {
+		effectiveDate, err := time.Parse(helper.DefaultDateLayout, *input.EffectiveDate)
+		if err != nil {
+			invalid.add("effectiveDate", "the Rename's 'EffectiveDate' should follow the format "+helper.DefaultDateLayout)
+		} else if effectiveDate.After(currentDay()) {
+			invalid.add("effectiveDate", "the Rename's 'EffectiveDate' should not be in the future")
+		}
+	}
+
+	if helper.IsNilOrEmpty(input.UpdatedBy) {
+		invalid.add("updatedBy", helper.ErrorMessageInField(currentEntity, "UpdatedBy"))
+	}
+
+	return invalid.err()
+}
+
+// TeamRenameInputEffectiveDate is the date in which the new name takes effect, which defaults to the current day.
+func TeamRenameInputEffectiveDate(input *TeamRenameInput) time.Time {
+	if helper.IsNilOrEmpty(input.EffectiveDate) {
+		return currentDay()
+	}
+
+	return ParseDate(input.EffectiveDate)
+}
+
+func currentDay() time.Time {
+	year, month, day := time.Now().UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TeamNameEntityToTeamName(teamNameEntity *entity.TeamName) TeamName {
+	// The name the team was created with has been in effect since the beginning
+	var effectiveFrom *string
+	if !teamNameEntity.EffectiveFrom.IsZero() {
+		formattedEffectiveFrom := teamNameEntity.EffectiveFrom.Format(helper.DefaultDateLayout)
+		effectiveFrom = &formattedEffectiveFrom
+	}
+
+	return TeamName{
+		Name:           teamNameEntity.Name,
+		Slug:           teamNameEntity.Slug,
+		EffectiveFrom:  effectiveFrom,
+		EffectiveUntil: teamNameEntity.EffectiveUntil.Format(helper.DefaultDateLayout),
+
+		CreatedBy: teamNameEntity.CreatedBy,
+		CreatedAt: teamNameEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func TeamNameEntitiesToTeamNames(teamNameEntities []*entity.TeamName) []TeamName {
+	teamNames := make([]TeamName, 0, len(teamNameEntities))
+
+	for _, teamNameEntity := range teamNameEntities {
+		teamNames = append(teamNames, TeamNameEntityToTeamName(teamNameEntity))
+	}
+
+	return teamNames
+}
@@ -0,0 +1,383 @@
+package payload
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+type Tryout struct {
+	ID        string            `json:"id"`
+	TeamSlug  string            `json:"teamSlug"`
+	Title     string            `json:"title"`
+	Location  string            `json:"location"`
+	StartDate string            `json:"startDate"`
+	EndDate   string            `json:"endDate"`
+	Criteria  []TryoutCriterion `json:"criteria"`
+
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedBy string `json:"updatedBy"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type TryoutCriterion struct {
+	Name   string   `json:"name"`
+	Weight *float64 `json:"weight"`
+}
+
+type TryoutCandidate struct {
+	TryoutID       string  `json:"tryoutId"`
+	PersonUserName string  `json:"personUserName"`
+	Status         string  `json:"status"`
+	RegisteredAt   string  `json:"registeredAt"`
+	DecidedAt      *string `json:"decidedAt"`
+	DecidedBy      *string `json:"decidedBy"`
+}
+
+type TryoutEvaluation struct {
+	TryoutID          string         `json:"tryoutId"`
+	CandidateUserName string         `json:"candidateUserName"`
+	EvaluatorUserName string         `json:"evaluatorUserName"`
+	Scores            map[string]int `json:"scores"`
+}
+
+type TryoutRanking struct {
+	Rank             int     `json:"rank"`
+	PersonUserName   string  `json:"personUserName"`
+	NormalizedScore  float64 `json:"normalizedScore"`
+	AverageScore     float64 `json:"averageScore"`
+	EvaluatorsCount  int     `json:"evaluatorsCount"`
+	EvaluationsCount int     `json:"evaluationsCount"`
+}
+
+type TryoutDecision struct {
+	Candidate  TryoutCandidate `json:"candidate"`
+	Membership *Membership     `json:"membership"`
+}
+
+type CreateTryoutInput struct {
+	Title     *string           `json:"title"`
+	Location  *string           `json:"location"`
+	StartDate *string           `json:"startDate"`
+	EndDate   *string           `json:"endDate"`
+	Criteria  []TryoutCriterion `json:"criteria"`
+	CreatedBy *string           `json:"createdBy"`
+}
+
+type TryoutSignUpInput struct {
+	PersonUserName *string `json:"personUserName"`
+	Person         *Person `json:"person"`
+}
+
+type TryoutEvaluationInput struct {
+	EvaluatorUserName *string        `json:"evaluatorUserName"`
+	Scores            map[string]int `json:"scores"`
+}
+
+type TryoutDecisionInput struct {
+	Decision            *string `json:"decision"`
+	Role                *string `json:"role"`
+	MembershipStartDate *string `json:"membershipStartDate"`
+	DecidedBy           *string `json:"decidedBy"`
+}
+
+func ValidateCreateTryoutInput(input *CreateTryoutInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Tryout"
+
+	if helper.IsNilOrEmpty(input.Title) {
+		invalid.add("title", helper.ErrorMessageInField(currentEntity, "Title"))
+	}
+
+	validateDateRange(&invalid, currentEntity, "StartDate", input.StartDate, "EndDate", input.EndDate, true)
+
+	if len(input.Criteria) == 0 {
+		invalid.add("criteria", helper.ErrorMessageInField(currentEntity, "Criteria"))
+	}
+
+	criteriaNames := make(map[string]bool, len(input.Criteria))
+	for _, criterion := range input.Criteria {
+		if helper.IsNilOrEmpty(&criterion.Name) {
+			invalid.add("criteria", helper.ErrorMessageInField("Criterion", "Name"))
+
+			continue
+		}
+
+		if criteriaNames[criterion.Name] {
+			invalid.add("criteria", "the Tryout's criterion '"+criterion.Name+"' was defined more than once")
+		}
+		criteriaNames[criterion.Name] = true
+
+		if criterion.Weight != nil && *criterion.Weight <= 0 {
+			invalid.add("criteria", "the weight of the criterion '"+criterion.Name+"' should be a positive number")
+		}
+	}
+
+	if helper.IsNilOrEmpty(input.CreatedBy) {
+		invalid.add("createdBy", helper.ErrorMessageInField(currentEntity, "CreatedBy"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateTryoutSignUpInput(input *TryoutSignUpInput) *failure.Error {
+	var invalid invalidFields
+
+	if (input.PersonUserName == nil) == (input.Person == nil) {
+		invalid.addEach("exactly one of the following fields should be filled: [PersonUserName, Person]", "personUserName", "person")
+	} else if input.Person != nil {
+		if invalidPerson := ValidateCreatePersonInput(input.Person); invalidPerson != nil {
+			for _, fieldError := range invalidPerson.Fields {
+				invalid.add("person."+fieldError.Field, fieldError.Message)
+			}
+		}
+	} else if helper.IsNilOrEmpty(input.PersonUserName) {
+		invalid.add("personUserName", helper.ErrorMessageInField("Sign Up", "PersonUserName"))
+	}
+
+	return invalid.err()
+}
+
+func ValidateTryoutEvaluationInput(input *TryoutEvaluationInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Evaluation"
+
+	if helper.IsNilOrEmpty(input.EvaluatorUserName) {
+		invalid.add("evaluatorUserName", helper.ErrorMessageInField(currentEntity, "EvaluatorUserName"))
+	}
+
+	if len(input.Scores) == 0 {
+		invalid.add("scores", helper.ErrorMessageInField(currentEntity, "Scores"))
+	}
+
+	criteria := make([]string, 0, len(input.Scores))
+	for criterion := range input.Scores {
+		criteria = append(criteria, criterion)
+	}
+	sort.Strings(criteria)
+
+	for _, criterion := range criteria {
+		score := input.Scores[criterion]
+		if score < entity.TryoutMinScore || score > entity.TryoutMaxScore {
+			invalid.add("scores", fmt.Sprintf(
+				"the score on '%s' should be between %d and %d",
+				criterion,
+				entity.TryoutMinScore,
+				entity.TryoutMaxScore,
+			))
+		}
+	}
+
+	return invalid.err()
+}
+
+func ValidateTryoutDecisionInput(input *TryoutDecisionInput) *failure.Error {
+	var invalid invalidFields
+	currentEntity := "Decision"
+
+	if helper.IsNilOrEmpty(input.Decision) {
+		invalid.add("decision", helper.ErrorMessageInField(currentEntity, "Decision"))
+	} else if !entity.TryoutCandidateStatus(*input.Decision).IsDecision() {
+		invalid.add("decision", "the Decision's 'Decision' should be one of Selected or Rejected")
+	}
+
+	if input.Role != nil && *input.Role == "" {
+		invalid.add("role", helper.ErrorMessageInField(currentEntity, "Role"))
+	}
+
+	if !helper.IsNilOrEmpty(input.MembershipStartDate) {
+		if _, err := time.Parse(helper.DefaultDateLayout, *input.MembershipStartDate); err != nil {
+			invalid.add("membershipStartDate", "the Decision's 'MembershipStartDate' should follow the format "+helper.DefaultDateLayout)
+		}
+	}
+
+	if helper.IsNilOrEmpty(input.DecidedBy) {
+		invalid.add("decidedBy", helper.ErrorMessageInField(currentEntity, "DecidedBy"))
+	}
+
+	return invalid.err()
+}
+
+// ValidateTryoutRankingsRequestedBy validates the required 'requestedBy' query param, the username of the staff member
+// asking for the rankings.
+func ValidateTryoutRankingsRequestedBy(requestedBy string) *failure.Error {
+	var invalid invalidFields
+
+	if requestedBy == "" {
+		invalid.add("requestedBy", "the 'requestedBy' query param should not be empty")
+	}
+
+	return invalid.err()
+}
+
+func CreateTryoutInputToTryoutEntity(input *CreateTryoutInput) *entity.Tryout {
+	criteria := make([]entity.TryoutCriterion, 0, len(input.Criteria))
+	for _, criterion := range input.Criteria {
+		// Criteria without an explicit weight count the same
+		weight := 1.0
+		if criterion.Weight != nil {
+			weight = *criterion.Weight
+		}
+		criteria = append(criteria, entity.TryoutCriterion{
+			Name:   criterion.Name,
+			Weight: weight,
+		})
+	}
+
+	return &entity.Tryout{
+		Title:     *input.Title,
+		Location:  StringValue(input.Location),
+		StartDate: ParseDate(input.StartDate),
+		EndDate:   ParseDate(input.EndDate),
+		Criteria:  criteria,
+		CreatedBy: *input.CreatedBy,
+	}
+}
+
+// TryoutDecisionInputRole is the role the selected candidate gets in the team, which defaults to Player.
+func TryoutDecisionInputRole(input *TryoutDecisionInput) string {
+	if input.Role == nil {
+		return entity.MembershipRoles.Player
+	}
+
+	return *input.Role
+}
+
+// TryoutDecisionInputMembershipStartDate is the date in which the membership of the selected candidate starts,
+// which defaults to the current day.
+func TryoutDecisionInputMembershipStartDate(input *TryoutDecisionInput) time.Time {
+	if helper.IsNilOrEmpty(input.MembershipStartDate) {
+		year, month, day := time.Now().UTC().Date()
+
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	return ParseDate(input.MembershipStartDate)
+}
+
+func TryoutEntityToTryout(tryoutEntity *entity.Tryout) Tryout {
+	criteria := make([]TryoutCriterion, 0, len(tryoutEntity.Criteria))
+	for _, criterionEntity := range tryoutEntity.Criteria {
+		weight := criterionEntity.Weight
+		criteria = append(criteria, TryoutCriterion{
+			Name:   criterionEntity.Name,
+			Weight: &weight,
+		})
+	}
+
+	var teamSlug string
+	if tryoutEntity.Team != nil {
+		teamSlug = tryoutEntity.Team.Slug
+	}
+
+	return Tryout{
+		ID:        tryoutEntity.ID,
+		TeamSlug:  teamSlug,
+		Title:     tryoutEntity.Title,
+		Location:  tryoutEntity.Location,
+		StartDate: tryoutEntity.StartDate.Format(helper.DefaultDateLayout),
+		EndDate:   tryoutEntity.EndDate.Format(helper.DefaultDateLayout),
+		Criteria:  criteria,
+
+		CreatedBy: tryoutEntity.CreatedBy,
+		CreatedAt: tryoutEntity.CreatedAt.Format(helper.DefaultTimeLayout),
+		UpdatedBy: tryoutEntity.UpdatedBy,
+		UpdatedAt: tryoutEntity.UpdatedAt.Format(helper.DefaultTimeLayout),
+	}
+}
+
+func TryoutEntitiesToTryouts(tryoutEntities []*entity.Tryout) []Tryout {
+	tryouts := make([]Tryout, 0)
+
+	for _, tryoutEntity := range tryoutEntities {
+		tryouts = append(tryouts, TryoutEntityToTryout(tryoutEntity))
+	}
+
+	return tryouts
+}
+
+func TryoutCandidateEntityToTryoutCandidate(candidateEntity *entity.TryoutCandidate) TryoutCandidate {
+	// Candidates waiting for a decision have no decision time nor author
+	var decidedAt, decidedBy *string
+	if !candidateEntity.DecidedAt.IsZero() {
+		formattedDecidedAt := candidateEntity.DecidedAt.Format(helper.DefaultTimeLayout)
+		decidedAt = &formattedDecidedAt
+		decidedBy = &candidateEntity.DecidedBy
+	}
+
+	return TryoutCandidate{
+		TryoutID:       candidateEntity.Tryout.ID,
+		PersonUserName: candidateEntity.Person.UserName,
+		Status:         string(candidateEntity.Status),
+		RegisteredAt:   candidateEntity.RegisteredAt.Format(helper.DefaultTimeLayout),
+		DecidedAt:      decidedAt,
+		DecidedBy:      decidedBy,
+	}
+}
+
+func TryoutCandidateEntitiesToTryoutCandidates(candidateEntities []*entity.TryoutCandidate) []TryoutCandidate {
+	candidates := make([]TryoutCandidate, 0)
+
+	for _, candidateEntity := range candidateEntities {
+		candidates = append(candidates, TryoutCandidateEntityToTryoutCandidate(candidateEntity))
+	}
+
+	return candidates
+}
+
+func TryoutEvaluationEntitiesToTryoutEvaluation(
+	tryoutID string,
+	candidateUserName string,
+	evaluatorUserName string,
+	evaluationEntities []*entity.TryoutEvaluation,
+) TryoutEvaluation {
+	scores := make(map[string]int, len(evaluationEntities))
+	for _, evaluationEntity := range evaluationEntities {
+		scores[evaluationEntity.Criterion] = evaluationEntity.Score
+	}
+
+	return TryoutEvaluation{
+		TryoutID:          tryoutID,
+		CandidateUserName: candidateUserName,
+		EvaluatorUserName: evaluatorUserName,
+		Scores:            scores,
+	}
+}
+
+func TryoutRankingEntitiesToTryoutRankings(rankingEntities []*entity.TryoutRanking) []TryoutRanking {
+	rankings := make([]TryoutRanking, 0)
+
+	for _, rankingEntity := range rankingEntities {
+		rankings = append(rankings, TryoutRanking{
+			Rank:             rankingEntity.Rank,
+			PersonUserName:   rankingEntity.Person.UserName,
+			NormalizedScore:  rankingEntity.NormalizedScore,
+			AverageScore:     rankingEntity.AverageScore,
+			EvaluatorsCount:  rankingEntity.EvaluatorsCount,
+			EvaluationsCount: rankingEntity.EvaluationsCount,
+		})
+	}
+
+	return rankings
+}
+
+func TryoutDecisionEntitiesToTryoutDecision(
+	candidateEntity *entity.TryoutCandidate,
+	membershipEntity *entity.Membership,
+) TryoutDecision {
+	var membership *Membership
+	if membershipEntity != nil {
+		createdMembership := MembershipEntityToMembership(membershipEntity)
+		membership = &createdMembership
+	}
+
+	return TryoutDecision{
+		Candidate:  TryoutCandidateEntityToTryoutCandidate(candidateEntity),
+		Membership: membership,
+	}
+}
@@ -0,0 +1,115 @@
+package payload
+
+import (
+	"strings"
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/failure"
+	"github.com/leeohaddad/ultimate-frisbee-api/infra/helper"
+)
+
+// invalidFields collects what is wrong with each field of an input, so that the clients learn about every invalid
+// field at once instead of one per request.
+type invalidFields []failure.FieldError
+
+// add tells what is wrong with the field, which is named as in the JSON or as the query or path param.
+func (fields *invalidFields) add(field string, message string) {
+	*fields = append(*fields, failure.FieldError{Field: field, Message: message})
+}
+
+// addEach tells the same thing about each of the fields, which are wrong together, such as when none of them was
+// filled.
+func (fields *invalidFields) addEach(message string, names ...string) {
+	for _, name := range names {
+		fields.add(name, message)
+	}
+}
+
+// addAll tells what is wrong with the fields of another validation of the same input, if any.
+func (fields *invalidFields) addAll(invalidInput *failure.Error) {
+	if invalidInput != nil {
+		*fields = append(*fields, invalidInput.Fields...)
+	}
+}
+
+// err is failure.ErrInvalidInput telling about each invalid field, or nil when every field is valid. Its message tells
+// each distinct message of the fields once.
+func (fields invalidFields) err() *failure.Error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(fields))
+	toldMessages := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if !toldMessages[field.Message] {
+			messages = append(messages, field.Message)
+			toldMessages[field.Message] = true
+		}
+	}
+
+	return failure.ErrInvalidInput.WithMessage(strings.Join(messages, "; ")).WithFields(fields...)
+}
+
+// JoinInvalidInputs tells about the invalid fields of every validation of an input at once, or is nil when all of them
+// passed.
+func JoinInvalidInputs(invalidInputs ...*failure.Error) *failure.Error {
+	var fields invalidFields
+	for _, invalidInput := range invalidInputs {
+		fields.addAll(invalidInput)
+	}
+
+	return fields.err()
+}
+
+// validateDateRange checks that the start date is filled, that both dates follow the default date layout and that
+// the range does not end before it starts, adding what is wrong to invalid. The end date may be empty unless
+// endIsRequired is set.
+func validateDateRange(
+	invalid *invalidFields,
+	currentEntity string,
+	startField string,
+	start *string,
+	endField string,
+	end *string,
+	endIsRequired bool,
+) {
+	var startDate time.Time
+	if helper.IsNilOrEmpty(start) {
+		invalid.add(criteriaFieldName(startField), helper.ErrorMessageInField(currentEntity, startField))
+	} else {
+		var err error
+		startDate, err = time.Parse(helper.DefaultDateLayout, *start)
+		if err != nil {
+			invalid.add(
+				criteriaFieldName(startField),
+				"the "+currentEntity+"'s '"+startField+"' should follow the format "+helper.DefaultDateLayout,
+			)
+		}
+	}
+
+	if helper.IsNilOrEmpty(end) {
+		if endIsRequired {
+			invalid.add(criteriaFieldName(endField), helper.ErrorMessageInField(currentEntity, endField))
+		}
+
+		return
+	}
+
+	endDate, err := time.Parse(helper.DefaultDateLayout, *end)
+	if err != nil {
+		invalid.add(
+			criteriaFieldName(endField),
+			"the "+currentEntity+"'s '"+endField+"' should follow the format "+helper.DefaultDateLayout,
+		)
+
+		return
+	}
+
+	if !startDate.IsZero() && endDate.Before(startDate) {
+		invalid.add(
+			criteriaFieldName(endField),
+			"the "+currentEntity+"'s '"+endField+"' should not be before its '"+startField+"'",
+		)
+	}
+}
@@ -12,11 +12,27 @@ func (app *App) configureRoutes() {
 func (app *App) configureRoutesV1() {
 	v1RouterGroup := app.router.Group("/v1")
 
+	// Teams addressed by a name they used before being renamed are redirected to their current name
+	v1RouterGroup.Use(handler.RedirectPreviousTeamNamesEchoMiddlewareV1(
+		param.RedirectPreviousTeamNamesMiddlewareV1{
+			Repository: app.repositories.Team,
+		},
+	))
+	// People addressed by the username of a duplicate merged into them are redirected to their own username
+	v1RouterGroup.Use(handler.RedirectPersonAliasesEchoMiddlewareV1(
+		param.RedirectPersonAliasesMiddlewareV1{
+			Repository: app.repositories.Person,
+		},
+	))
+
 	v1RouterGroup.GET("/health/", handler.HealthCheckHandlerV1())
 
 	// Teams
 	v1RouterGroup.GET("/teams/", handler.GetAllTeamsEchoHandlerV1(
 		param.GetAllTeamsHandlerV1{
+			DefaultPageLimit: app.config.Pagination.DefaultLimit,
+			MaxPageLimit:     app.config.Pagination.MaxLimit,
+
 			Repository: app.repositories.Team,
 		},
 	))
@@ -27,19 +43,459 @@ func (app *App) configureRoutesV1() {
 	))
 	v1RouterGroup.POST("/teams/", handler.CreateTeamEchoHandlerV1(
 		param.CreateTeamHandlerV1{
-			Repository: app.repositories.Team,
+			Repository:        app.repositories.Team,
+			CountryRepository: app.repositories.Country,
 		},
 	))
 	v1RouterGroup.PUT("/teams/:name/", handler.UpdateTeamEchoHandlerV1(
 		param.UpdateTeamHandlerV1{
+			Repository:        app.repositories.Team,
+			CountryRepository: app.repositories.Country,
+		},
+	))
+	v1RouterGroup.DELETE("/teams/:name/", handler.ArchiveTeamEchoHandlerV1(
+		param.ArchiveTeamHandlerV1{
+			Repository: app.repositories.Team,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/restore/", handler.RestoreTeamEchoHandlerV1(
+		param.RestoreTeamHandlerV1{
+			Repository: app.repositories.Team,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/rename/", handler.RenameTeamEchoHandlerV1(
+		param.RenameTeamHandlerV1{
+			Repository: app.repositories.Team,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/previous-names/", handler.GetPreviousTeamNamesEchoHandlerV1(
+		param.GetPreviousTeamNamesHandlerV1{
+			Repository: app.repositories.Team,
+		},
+	))
+
+	v1RouterGroup.GET("/teams/:name/legal-entity-affiliations/", handler.GetTeamLegalEntityAffiliationsEchoHandlerV1(
+		param.GetTeamLegalEntityAffiliationsHandlerV1{
+			TeamRepository:        app.repositories.Team,
+			LegalEntityRepository: app.repositories.LegalEntity,
+		},
+	))
+
+	// Media
+	v1RouterGroup.PUT("/teams/:name/logo/", handler.UploadTeamLogoEchoHandlerV1(
+		param.UploadTeamLogoHandlerV1{
+			MaxUploadBytes: app.config.Storage.MaxUploadBytes,
+
 			Repository: app.repositories.Team,
+			Storage:    app.storage,
+		},
+	))
+	v1RouterGroup.PUT("/people/:username/avatar/", handler.UploadPersonAvatarEchoHandlerV1(
+		param.UploadPersonAvatarHandlerV1{
+			MaxUploadBytes: app.config.Storage.MaxUploadBytes,
+
+			Repository: app.repositories.Person,
+			Storage:    app.storage,
+		},
+	))
+	v1RouterGroup.GET("/media/*", handler.GetMediaObjectEchoHandlerV1(
+		param.GetMediaObjectHandlerV1{
+			Storage: app.storage,
+		},
+	))
+
+	// Team Ledger
+	v1RouterGroup.GET("/teams/:name/ledger/", handler.GetTeamLedgerEchoHandlerV1(
+		param.GetTeamLedgerHandlerV1{
+			TeamRepository:   app.repositories.Team,
+			LedgerRepository: app.repositories.Ledger,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/ledger/balances/", handler.GetTeamLedgerBalancesEchoHandlerV1(
+		param.GetTeamLedgerBalancesHandlerV1{
+			TeamRepository:   app.repositories.Team,
+			LedgerRepository: app.repositories.Ledger,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/ledger/dues/", handler.ChargeTeamDuesEchoHandlerV1(
+		param.ChargeTeamDuesHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			MembershipRepository: app.repositories.Membership,
+			LedgerRepository:     app.repositories.Ledger,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/ledger/tournament-fees/", handler.ChargeTeamTournamentFeeEchoHandlerV1(
+		param.ChargeTeamTournamentFeeHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			MembershipRepository: app.repositories.Membership,
+			LedgerRepository:     app.repositories.Ledger,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/ledger/payments/", handler.RecordTeamPaymentEchoHandlerV1(
+		param.RecordTeamPaymentHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			MembershipRepository: app.repositories.Membership,
+			LedgerRepository:     app.repositories.Ledger,
+		},
+	))
+
+	// Team Events
+	v1RouterGroup.GET("/teams/:name/events/", handler.GetTeamEventsEchoHandlerV1(
+		param.GetTeamEventsHandlerV1{
+			TeamRepository:      app.repositories.Team,
+			TeamEventRepository: app.repositories.TeamEvent,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/events/", handler.CreateTeamEventEchoHandlerV1(
+		param.CreateTeamEventHandlerV1{
+			TeamRepository:      app.repositories.Team,
+			TeamEventRepository: app.repositories.TeamEvent,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/events/attendance-report/", handler.GetTeamAttendanceReportEchoHandlerV1(
+		param.GetTeamAttendanceReportHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			TeamEventRepository:  app.repositories.TeamEvent,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/events/availability/", handler.GetTeamAvailabilityEchoHandlerV1(
+		param.GetTeamAvailabilityHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			TeamEventRepository:  app.repositories.TeamEvent,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.PUT("/teams/:name/events/:eventId/rsvps/:username/", handler.RespondToTeamEventEchoHandlerV1(
+		param.RespondToTeamEventHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			TeamEventRepository:  app.repositories.TeamEvent,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.PUT("/teams/:name/events/:eventId/attendance/", handler.RecordTeamEventAttendanceEchoHandlerV1(
+		param.RecordTeamEventAttendanceHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			TeamEventRepository:  app.repositories.TeamEvent,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+
+	// Tryouts
+	v1RouterGroup.GET("/teams/:name/tryouts/", handler.GetTeamTryoutsEchoHandlerV1(
+		param.GetTeamTryoutsHandlerV1{
+			TeamRepository:   app.repositories.Team,
+			TryoutRepository: app.repositories.Tryout,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/tryouts/", handler.CreateTeamTryoutEchoHandlerV1(
+		param.CreateTeamTryoutHandlerV1{
+			TeamRepository:   app.repositories.Team,
+			TryoutRepository: app.repositories.Tryout,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/tryouts/:tryoutId/candidates/", handler.GetTeamTryoutCandidatesEchoHandlerV1(
+		param.GetTeamTryoutCandidatesHandlerV1{
+			TeamRepository:   app.repositories.Team,
+			TryoutRepository: app.repositories.Tryout,
+		},
+	))
+	v1RouterGroup.POST("/teams/:name/tryouts/:tryoutId/candidates/", handler.SignUpForTeamTryoutEchoHandlerV1(
+		param.SignUpForTeamTryoutHandlerV1{
+			TeamRepository:     app.repositories.Team,
+			PersonRepository:   app.repositories.Person,
+			TryoutRepository:   app.repositories.Tryout,
+			CountryRepository:  app.repositories.Country,
+			TransactionManager: app.repositories.TransactionManager,
+		},
+	))
+	v1RouterGroup.PUT("/teams/:name/tryouts/:tryoutId/candidates/:username/evaluations/", handler.EvaluateTeamTryoutCandidateEchoHandlerV1(
+		param.EvaluateTeamTryoutCandidateHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			TryoutRepository:     app.repositories.Tryout,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.PUT("/teams/:name/tryouts/:tryoutId/candidates/:username/decision/", handler.DecideTeamTryoutCandidateEchoHandlerV1(
+		param.DecideTeamTryoutCandidateHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			TryoutRepository:     app.repositories.Tryout,
+			PersonRepository:     app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+			GuardianRepository:   app.repositories.Guardian,
+			TransactionManager:   app.repositories.TransactionManager,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/tryouts/:tryoutId/rankings/", handler.GetTeamTryoutRankingsEchoHandlerV1(
+		param.GetTeamTryoutRankingsHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			TryoutRepository:     app.repositories.Tryout,
+			MembershipRepository: app.repositories.Membership,
 		},
 	))
 
 	// People
 	v1RouterGroup.GET("/people/", handler.GetAllPeopleEchoHandlerV1(
 		param.GetAllPeopleHandlerV1{
+			DefaultPageLimit: app.config.Pagination.DefaultLimit,
+			MaxPageLimit:     app.config.Pagination.MaxLimit,
+
+			Repository:           app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.GET("/people/:username/", handler.GetPersonByUserNameEchoHandlerV1(
+		param.GetPersonByUserNameHandlerV1{
+			Repository:           app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.DELETE("/people/:username/", handler.ArchivePersonEchoHandlerV1(
+		param.ArchivePersonHandlerV1{
+			Repository: app.repositories.Person,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/restore/", handler.RestorePersonEchoHandlerV1(
+		param.RestorePersonHandlerV1{
+			Repository: app.repositories.Person,
+		},
+	))
+	v1RouterGroup.PUT("/people/:username/birth-date/", handler.UpdatePersonBirthDateEchoHandlerV1(
+		param.UpdatePersonBirthDateHandlerV1{
 			Repository: app.repositories.Person,
 		},
 	))
+	v1RouterGroup.PUT("/people/:username/visibility/", handler.UpdatePersonVisibilityEchoHandlerV1(
+		param.UpdatePersonVisibilityHandlerV1{
+			Repository: app.repositories.Person,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/merge/", handler.MergePeopleEchoHandlerV1(
+		param.MergePeopleHandlerV1{
+			Repository: app.repositories.Person,
+		},
+	))
+	v1RouterGroup.GET("/people/:username/export/", handler.ExportPersonalDataEchoHandlerV1(
+		param.ExportPersonalDataHandlerV1{
+			Admins: app.config.Privacy.Admins,
+
+			Repository: app.repositories.Person,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/erase/", handler.ErasePersonEchoHandlerV1(
+		param.ErasePersonHandlerV1{
+			Admins: app.config.Privacy.Admins,
+
+			Repository: app.repositories.Person,
+			Storage:    app.storage,
+		},
+	))
+	v1RouterGroup.GET("/duplicate-people/", handler.FindDuplicatePeopleEchoHandlerV1(
+		param.FindDuplicatePeopleHandlerV1{
+			Repository: app.repositories.Person,
+		},
+	))
+	v1RouterGroup.GET("/people/:username/legal-entity-affiliations/", handler.GetPersonLegalEntityAffiliationsEchoHandlerV1(
+		param.GetPersonLegalEntityAffiliationsHandlerV1{
+			PersonRepository:      app.repositories.Person,
+			LegalEntityRepository: app.repositories.LegalEntity,
+		},
+	))
+
+	// Legal Entities
+	v1RouterGroup.GET("/legal-entities/", handler.GetAllLegalEntitiesEchoHandlerV1(
+		param.GetAllLegalEntitiesHandlerV1{
+			DefaultPageLimit: app.config.Pagination.DefaultLimit,
+			MaxPageLimit:     app.config.Pagination.MaxLimit,
+
+			Repository: app.repositories.LegalEntity,
+		},
+	))
+	v1RouterGroup.GET("/legal-entities/:slug/", handler.GetLegalEntityBySlugEchoHandlerV1(
+		param.GetLegalEntityBySlugHandlerV1{
+			Repository: app.repositories.LegalEntity,
+		},
+	))
+	v1RouterGroup.POST("/legal-entities/", handler.CreateLegalEntityEchoHandlerV1(
+		param.CreateLegalEntityHandlerV1{
+			Repository:        app.repositories.LegalEntity,
+			CountryRepository: app.repositories.Country,
+		},
+	))
+	v1RouterGroup.PUT("/legal-entities/:slug/", handler.UpdateLegalEntityEchoHandlerV1(
+		param.UpdateLegalEntityHandlerV1{
+			Repository:        app.repositories.LegalEntity,
+			CountryRepository: app.repositories.Country,
+		},
+	))
+	v1RouterGroup.GET("/legal-entities/:slug/affiliations/", handler.GetLegalEntityAffiliationsEchoHandlerV1(
+		param.GetLegalEntityAffiliationsHandlerV1{
+			LegalEntityRepository: app.repositories.LegalEntity,
+		},
+	))
+	v1RouterGroup.POST("/legal-entities/:slug/affiliations/", handler.AffiliateToLegalEntityEchoHandlerV1(
+		param.AffiliateToLegalEntityHandlerV1{
+			TeamRepository:        app.repositories.Team,
+			PersonRepository:      app.repositories.Person,
+			LegalEntityRepository: app.repositories.LegalEntity,
+		},
+	))
+	v1RouterGroup.PUT("/legal-entities/:slug/affiliations/teams/:name/", handler.EndTeamLegalEntityAffiliationEchoHandlerV1(
+		param.EndLegalEntityAffiliationHandlerV1{
+			TeamRepository:        app.repositories.Team,
+			PersonRepository:      app.repositories.Person,
+			LegalEntityRepository: app.repositories.LegalEntity,
+		},
+	))
+	v1RouterGroup.PUT("/legal-entities/:slug/affiliations/people/:username/", handler.EndPersonLegalEntityAffiliationEchoHandlerV1(
+		param.EndLegalEntityAffiliationHandlerV1{
+			TeamRepository:        app.repositories.Team,
+			PersonRepository:      app.repositories.Person,
+			LegalEntityRepository: app.repositories.LegalEntity,
+		},
+	))
+
+	// Memberships
+	v1RouterGroup.GET("/teams/:name/memberships/", handler.GetTeamMembershipsEchoHandlerV1(
+		param.GetTeamMembershipsHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.GET("/people/:username/memberships/", handler.GetPersonMembershipsEchoHandlerV1(
+		param.GetPersonMembershipsHandlerV1{
+			PersonRepository:     app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/memberships/transfers/", handler.TransferMembershipEchoHandlerV1(
+		param.TransferMembershipHandlerV1{
+			TransferWindows: app.config.Memberships.TransferWindows,
+
+			TeamRepository:       app.repositories.Team,
+			PersonRepository:     app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+			GuardianRepository:   app.repositories.Guardian,
+			TransactionManager:   app.repositories.TransactionManager,
+		},
+	))
+	v1RouterGroup.PUT("/teams/:name/memberships/:username/jersey-number/", handler.AssignJerseyNumberEchoHandlerV1(
+		param.AssignJerseyNumberHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			PersonRepository:     app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+			TransactionManager:   app.repositories.TransactionManager,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/jersey-numbers/:jerseyNumber/", handler.GetTeamMemberByJerseyNumberEchoHandlerV1(
+		param.GetTeamMemberByJerseyNumberHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			PersonRepository:     app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+		},
+	))
+
+	// Credentials
+	v1RouterGroup.GET("/people/:username/credentials/", handler.GetPersonCredentialsEchoHandlerV1(
+		param.GetPersonCredentialsHandlerV1{
+			PersonRepository:     app.repositories.Person,
+			CredentialRepository: app.repositories.Credential,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/wfdf-accreditations/", handler.CreateWFDFAccreditationEchoHandlerV1(
+		param.CreateWFDFAccreditationHandlerV1{
+			PersonRepository:     app.repositories.Person,
+			CredentialRepository: app.repositories.Credential,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/federation-memberships/", handler.CreateFederationMembershipEchoHandlerV1(
+		param.CreateFederationMembershipHandlerV1{
+			PersonRepository:      app.repositories.Person,
+			LegalEntityRepository: app.repositories.LegalEntity,
+			CredentialRepository:  app.repositories.Credential,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/coach-certifications/", handler.CreateCoachCertificationEchoHandlerV1(
+		param.CreateCoachCertificationHandlerV1{
+			PersonRepository:     app.repositories.Person,
+			CredentialRepository: app.repositories.Credential,
+		},
+	))
+	v1RouterGroup.GET("/teams/:name/wfdf-accreditations/expiring/", handler.GetTeamExpiringWFDFAccreditationsEchoHandlerV1(
+		param.GetTeamExpiringWFDFAccreditationsHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			PersonRepository:     app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+			CredentialRepository: app.repositories.Credential,
+		},
+	))
+
+	// Eligibility
+	v1RouterGroup.POST("/teams/:name/eligibility/", handler.CheckRosterEligibilityEchoHandlerV1(
+		param.CheckRosterEligibilityHandlerV1{
+			TeamRepository:       app.repositories.Team,
+			PersonRepository:     app.repositories.Person,
+			MembershipRepository: app.repositories.Membership,
+			CredentialRepository: app.repositories.Credential,
+			GuardianRepository:   app.repositories.Guardian,
+		},
+	))
+
+	// Guardians
+	v1RouterGroup.GET("/people/:username/guardians/", handler.GetGuardiansEchoHandlerV1(
+		param.GetGuardiansHandlerV1{
+			PersonRepository:   app.repositories.Person,
+			GuardianRepository: app.repositories.Guardian,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/guardians/", handler.AddGuardianEchoHandlerV1(
+		param.AddGuardianHandlerV1{
+			PersonRepository:   app.repositories.Person,
+			GuardianRepository: app.repositories.Guardian,
+		},
+	))
+	v1RouterGroup.DELETE("/people/:username/guardians/:guardianUsername/", handler.RemoveGuardianEchoHandlerV1(
+		param.RemoveGuardianHandlerV1{
+			PersonRepository:   app.repositories.Person,
+			GuardianRepository: app.repositories.Guardian,
+		},
+	))
+	v1RouterGroup.GET("/people/:username/consents/", handler.GetConsentsEchoHandlerV1(
+		param.GetConsentsHandlerV1{
+			PersonRepository:   app.repositories.Person,
+			GuardianRepository: app.repositories.Guardian,
+		},
+	))
+	v1RouterGroup.POST("/people/:username/consents/", handler.GrantConsentEchoHandlerV1(
+		param.GrantConsentHandlerV1{
+			PersonRepository:   app.repositories.Person,
+			GuardianRepository: app.repositories.Guardian,
+		},
+	))
+	v1RouterGroup.GET("/people/:username/dependents/events/", handler.GetDependentsScheduleEchoHandlerV1(
+		param.GetDependentsScheduleHandlerV1{
+			PersonRepository:     app.repositories.Person,
+			GuardianRepository:   app.repositories.Guardian,
+			MembershipRepository: app.repositories.Membership,
+			TeamEventRepository:  app.repositories.TeamEvent,
+		},
+	))
+
+	// Search
+	v1RouterGroup.GET("/search/", handler.SearchEchoHandlerV1(
+		param.SearchHandlerV1{
+			DefaultPageLimit: app.config.Pagination.DefaultLimit,
+			MaxPageLimit:     app.config.Pagination.MaxLimit,
+
+			TeamRepository:   app.repositories.Team,
+			PersonRepository: app.repositories.Person,
+		},
+	))
+
+	// Countries
+	v1RouterGroup.GET("/countries/", handler.GetAllCountriesEchoHandlerV1(
+		param.GetAllCountriesHandlerV1{
+			Repository: app.repositories.Country,
+		},
+	))
 }
@@ -0,0 +1,14 @@
+create table if not exists memberships (
+  team_slug varchar(30) not null references teams (slug),
+  person_username varchar(30) not null references people (username),
+  role varchar(30) not null,
+  start_date date not null,
+  end_date date,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50),
+
+  primary key (team_slug, person_username, role, start_date)
+);
@@ -0,0 +1,21 @@
+create table if not exists ledger_transactions (
+  id uuid not null primary key default uuid_generate_v4(),
+  team_slug varchar(30) not null references teams (slug),
+  kind varchar(30) not null,
+  description text,
+  effective_date date not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50)
+);
+
+create index if not exists ledger_transactions_team_slug_idx on ledger_transactions (team_slug);
+
+create table if not exists ledger_entries (
+  transaction_id uuid not null references ledger_transactions (id) on delete cascade,
+  account varchar(30) not null,
+  person_username varchar(30) references people (username),
+  amount_in_cents bigint not null
+);
+
+create index if not exists ledger_entries_transaction_id_idx on ledger_entries (transaction_id);
@@ -0,0 +1,2 @@
+drop table if exists team_event_participations;
+drop table if exists team_events;
@@ -0,0 +1,29 @@
+create table if not exists team_events (
+  id uuid not null primary key default uuid_generate_v4(),
+  team_slug varchar(30) not null references teams (slug),
+  kind varchar(30) not null,
+  title varchar(100) not null,
+  description text,
+  location text,
+  start_time timestamp not null,
+  end_time timestamp not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50)
+);
+
+create index if not exists team_events_team_slug_start_time_idx on team_events (team_slug, start_time);
+
+create table if not exists team_event_participations (
+  event_id uuid not null references team_events (id) on delete cascade,
+  person_username varchar(30) not null references people (username),
+  rsvp varchar(10),
+  responded_at timestamp,
+  attendance varchar(10),
+  attendance_recorded_at timestamp,
+  attendance_recorded_by varchar(50),
+
+  primary key (event_id, person_username)
+);
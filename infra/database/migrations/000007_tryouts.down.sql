@@ -0,0 +1,4 @@
+drop table if exists tryout_evaluations;
+drop table if exists tryout_candidates;
+drop table if exists tryout_criteria;
+drop table if exists tryouts;
@@ -0,0 +1,50 @@
+create table if not exists tryouts (
+  id uuid not null primary key default uuid_generate_v4(),
+  team_slug varchar(30) not null references teams (slug),
+  title varchar(100) not null,
+  location text,
+  start_date date not null,
+  end_date date not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50)
+);
+
+create index if not exists tryouts_team_slug_start_date_idx on tryouts (team_slug, start_date);
+
+create table if not exists tryout_criteria (
+  tryout_id uuid not null references tryouts (id) on delete cascade,
+  name varchar(50) not null,
+  weight numeric(6, 3) not null check (weight > 0),
+  position smallint not null,
+
+  primary key (tryout_id, name)
+);
+
+create table if not exists tryout_candidates (
+  tryout_id uuid not null references tryouts (id) on delete cascade,
+  person_username varchar(30) not null references people (username),
+  status varchar(20) not null,
+  registered_at timestamp not null default now(),
+  decided_at timestamp,
+  decided_by varchar(50),
+
+  primary key (tryout_id, person_username)
+);
+
+create table if not exists tryout_evaluations (
+  tryout_id uuid not null,
+  candidate_username varchar(30) not null,
+  evaluator_username varchar(30) not null references people (username),
+  criterion varchar(50) not null,
+  score smallint not null check (score between 1 and 10),
+
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now(),
+
+  primary key (tryout_id, candidate_username, evaluator_username, criterion),
+  foreign key (tryout_id, candidate_username) references tryout_candidates (tryout_id, person_username) on delete cascade,
+  foreign key (tryout_id, criterion) references tryout_criteria (tryout_id, name) on delete cascade
+);
@@ -0,0 +1,3 @@
+drop table if exists person_legal_entity_affiliations;
+drop table if exists team_legal_entity_affiliations;
+drop table if exists legal_entities;
@@ -0,0 +1,52 @@
+create table if not exists legal_entities (
+  slug varchar(30) not null primary key,
+  name varchar(100) not null unique,
+  kind varchar(20) not null,
+  registration_number varchar(50),
+  country varchar(30) not null,
+  contact_name varchar(100),
+  contact_email varchar(100),
+  contact_phone_number varchar(30),
+  website varchar(200),
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50),
+
+  unique (country, registration_number)
+);
+
+create table if not exists team_legal_entity_affiliations (
+  legal_entity_slug varchar(30) not null references legal_entities (slug),
+  team_slug varchar(30) not null references teams (slug),
+  start_date date not null,
+  end_date date,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50),
+
+  primary key (legal_entity_slug, team_slug, start_date),
+  check (end_date is null or end_date >= start_date)
+);
+
+create index if not exists team_legal_entity_affiliations_team_slug_idx on team_legal_entity_affiliations (team_slug);
+
+create table if not exists person_legal_entity_affiliations (
+  legal_entity_slug varchar(30) not null references legal_entities (slug),
+  person_username varchar(30) not null references people (username),
+  start_date date not null,
+  end_date date,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50),
+
+  primary key (legal_entity_slug, person_username, start_date),
+  check (end_date is null or end_date >= start_date)
+);
+
+create index if not exists person_legal_entity_affiliations_person_username_idx on person_legal_entity_affiliations (person_username);
@@ -0,0 +1,16 @@
+-- The countries stay written as alpha-2 codes, since the original spellings are not kept.
+alter table legal_entities
+  drop constraint if exists legal_entities_country_fkey,
+  alter column country type varchar(30);
+
+alter table people
+  drop constraint if exists people_origin_country_fkey,
+  alter column origin_country type varchar(30);
+
+alter table teams
+  drop constraint if exists teams_origin_country_fkey,
+  alter column origin_country type varchar(30);
+
+drop table if exists country_aliases;
+drop table if exists country_names;
+drop table if exists countries;
@@ -0,0 +1,1619 @@
+create table if not exists countries (
+  alpha2_code char(2) not null primary key,
+  alpha3_code char(3) not null unique,
+  name varchar(100) not null
+);
+
+create table if not exists country_names (
+  country_code char(2) not null references countries (alpha2_code),
+  locale varchar(5) not null,
+  name varchar(100) not null,
+
+  primary key (country_code, locale)
+);
+
+insert into countries (alpha2_code, alpha3_code, name) values
+  ('AD', 'AND', 'Andorra'),
+  ('AE', 'ARE', 'United Arab Emirates'),
+  ('AF', 'AFG', 'Afghanistan'),
+  ('AG', 'ATG', 'Antigua and Barbuda'),
+  ('AI', 'AIA', 'Anguilla'),
+  ('AL', 'ALB', 'Albania'),
+  ('AM', 'ARM', 'Armenia'),
+  ('AO', 'AGO', 'Angola'),
+  ('AQ', 'ATA', 'Antarctica'),
+  ('AR', 'ARG', 'Argentina'),
+  ('AS', 'ASM', 'American Samoa'),
+  ('AT', 'AUT', 'Austria'),
+  ('AU', 'AUS', 'Australia'),
+  ('AW', 'ABW', 'Aruba'),
+  ('AX', 'ALA', 'Åland Islands'),
+  ('AZ', 'AZE', 'Azerbaijan'),
+  ('BA', 'BIH', 'Bosnia and Herzegovina'),
+  ('BB', 'BRB', 'Barbados'),
+  ('BD', 'BGD', 'Bangladesh'),
+  ('BE', 'BEL', 'Belgium'),
+  ('BF', 'BFA', 'Burkina Faso'),
+  ('BG', 'BGR', 'Bulgaria'),
+  ('BH', 'BHR', 'Bahrain'),
+  ('BI', 'BDI', 'Burundi'),
+  ('BJ', 'BEN', 'Benin'),
+  ('BL', 'BLM', 'Saint Barthélemy'),
+  ('BM', 'BMU', 'Bermuda'),
+  ('BN', 'BRN', 'Brunei Darussalam'),
+  ('BO', 'BOL', 'Bolivia'),
+  ('BQ', 'BES', 'Bonaire, Sint Eustatius and Saba'),
+  ('BR', 'BRA', 'Brazil'),
+  ('BS', 'BHS', 'Bahamas'),
+  ('BT', 'BTN', 'Bhutan'),
+  ('BV', 'BVT', 'Bouvet Island'),
+  ('BW', 'BWA', 'Botswana'),
+  ('BY', 'BLR', 'Belarus'),
+  ('BZ', 'BLZ', 'Belize'),
+  ('CA', 'CAN', 'Canada'),
+  ('CC', 'CCK', 'Cocos (Keeling) Islands'),
+  ('CD', 'COD', 'Congo, The Democratic Republic of the'),
+  ('CF', 'CAF', 'Central African Republic'),
+  ('CG', 'COG', 'Congo'),
+  ('CH', 'CHE', 'Switzerland'),
+  ('CI', 'CIV', 'Côte d''Ivoire'),
+  ('CK', 'COK', 'Cook Islands'),
+  ('CL', 'CHL', 'Chile'),
+  ('CM', 'CMR', 'Cameroon'),
+  ('CN', 'CHN', 'China'),
+  ('CO', 'COL', 'Colombia'),
+  ('CR', 'CRI', 'Costa Rica'),
+  ('CU', 'CUB', 'Cuba'),
+  ('CV', 'CPV', 'Cabo Verde'),
+  ('CW', 'CUW', 'Curaçao'),
+  ('CX', 'CXR', 'Christmas Island'),
+  ('CY', 'CYP', 'Cyprus'),
+  ('CZ', 'CZE', 'Czechia'),
+  ('DE', 'DEU', 'Germany'),
+  ('DJ', 'DJI', 'Djibouti'),
+  ('DK', 'DNK', 'Denmark'),
+  ('DM', 'DMA', 'Dominica'),
+  ('DO', 'DOM', 'Dominican Republic'),
+  ('DZ', 'DZA', 'Algeria'),
+  ('EC', 'ECU', 'Ecuador'),
+  ('EE', 'EST', 'Estonia'),
+  ('EG', 'EGY', 'Egypt'),
+  ('EH', 'ESH', 'Western Sahara'),
+  ('ER', 'ERI', 'Eritrea'),
+  ('ES', 'ESP', 'Spain'),
+  ('ET', 'ETH', 'Ethiopia'),
+  ('FI', 'FIN', 'Finland'),
+  ('FJ', 'FJI', 'Fiji'),
+  ('FK', 'FLK', 'Falkland Islands (Malvinas)'),
+  ('FM', 'FSM', 'Micronesia, Federated States of'),
+  ('FO', 'FRO', 'Faroe Islands'),
+  ('FR', 'FRA', 'France'),
+  ('GA', 'GAB', 'Gabon'),
+  ('GB', 'GBR', 'United Kingdom'),
+  ('GD', 'GRD', 'Grenada'),
+  ('GE', 'GEO', 'Georgia'),
+  ('GF', 'GUF', 'French Guiana'),
+  ('GG', 'GGY', 'Guernsey'),
+  ('GH', 'GHA', 'Ghana'),
+  ('GI', 'GIB', 'Gibraltar'),
+  ('GL', 'GRL', 'Greenland'),
+  ('GM', 'GMB', 'Gambia'),
+  ('GN', 'GIN', 'Guinea'),
+  ('GP', 'GLP', 'Guadeloupe'),
+  ('GQ', 'GNQ', 'Equatorial Guinea'),
+  ('GR', 'GRC', 'Greece'),
+  ('GS', 'SGS', 'South Georgia and the South Sandwich Islands'),
+  ('GT', 'GTM', 'Guatemala'),
+  ('GU', 'GUM', 'Guam'),
+  ('GW', 'GNB', 'Guinea-Bissau'),
+  ('GY', 'GUY', 'Guyana'),
+  ('HK', 'HKG', 'Hong Kong'),
+  ('HM', 'HMD', 'Heard Island and McDonald Islands'),
+  ('HN', 'HND', 'Honduras'),
+  ('HR', 'HRV', 'Croatia'),
+  ('HT', 'HTI', 'Haiti'),
+  ('HU', 'HUN', 'Hungary'),
+  ('ID', 'IDN', 'Indonesia'),
+  ('IE', 'IRL', 'Ireland'),
+  ('IL', 'ISR', 'Israel'),
+  ('IM', 'IMN', 'Isle of Man'),
+  ('IN', 'IND', 'India'),
+  ('IO', 'IOT', 'British Indian Ocean Territory'),
+  ('IQ', 'IRQ', 'Iraq'),
+  ('IR', 'IRN', 'Iran'),
+  ('IS', 'ISL', 'Iceland'),
+  ('IT', 'ITA', 'Italy'),
+  ('JE', 'JEY', 'Jersey'),
+  ('JM', 'JAM', 'Jamaica'),
+  ('JO', 'JOR', 'Jordan'),
+  ('JP', 'JPN', 'Japan'),
+  ('KE', 'KEN', 'Kenya'),
+  ('KG', 'KGZ', 'Kyrgyzstan'),
+  ('KH', 'KHM', 'Cambodia'),
+  ('KI', 'KIR', 'Kiribati'),
+  ('KM', 'COM', 'Comoros'),
+  ('KN', 'KNA', 'Saint Kitts and Nevis'),
+  ('KP', 'PRK', 'North Korea'),
+  ('KR', 'KOR', 'South Korea'),
+  ('KW', 'KWT', 'Kuwait'),
+  ('KY', 'CYM', 'Cayman Islands'),
+  ('KZ', 'KAZ', 'Kazakhstan'),
+  ('LA', 'LAO', 'Laos'),
+  ('LB', 'LBN', 'Lebanon'),
+  ('LC', 'LCA', 'Saint Lucia'),
+  ('LI', 'LIE', 'Liechtenstein'),
+  ('LK', 'LKA', 'Sri Lanka'),
+  ('LR', 'LBR', 'Liberia'),
+  ('LS', 'LSO', 'Lesotho'),
+  ('LT', 'LTU', 'Lithuania'),
+  ('LU', 'LUX', 'Luxembourg'),
+  ('LV', 'LVA', 'Latvia'),
+  ('LY', 'LBY', 'Libya'),
+  ('MA', 'MAR', 'Morocco'),
+  ('MC', 'MCO', 'Monaco'),
+  ('MD', 'MDA', 'Moldova'),
+  ('ME', 'MNE', 'Montenegro'),
+  ('MF', 'MAF', 'Saint Martin (French part)'),
+  ('MG', 'MDG', 'Madagascar'),
+  ('MH', 'MHL', 'Marshall Islands'),
+  ('MK', 'MKD', 'North Macedonia'),
+  ('ML', 'MLI', 'Mali'),
+  ('MM', 'MMR', 'Myanmar'),
+  ('MN', 'MNG', 'Mongolia'),
+  ('MO', 'MAC', 'Macao'),
+  ('MP', 'MNP', 'Northern Mariana Islands'),
+  ('MQ', 'MTQ', 'Martinique'),
+  ('MR', 'MRT', 'Mauritania'),
+  ('MS', 'MSR', 'Montserrat'),
+  ('MT', 'MLT', 'Malta'),
+  ('MU', 'MUS', 'Mauritius'),
+  ('MV', 'MDV', 'Maldives'),
+  ('MW', 'MWI', 'Malawi'),
+  ('MX', 'MEX', 'Mexico'),
+  ('MY', 'MYS', 'Malaysia'),
+  ('MZ', 'MOZ', 'Mozambique'),
+  ('NA', 'NAM', 'Namibia'),
+  ('NC', 'NCL', 'New Caledonia'),
+  ('NE', 'NER', 'Niger'),
+  ('NF', 'NFK', 'Norfolk Island'),
+  ('NG', 'NGA', 'Nigeria'),
+  ('NI', 'NIC', 'Nicaragua'),
+  ('NL', 'NLD', 'Netherlands'),
+  ('NO', 'NOR', 'Norway'),
+  ('NP', 'NPL', 'Nepal'),
+  ('NR', 'NRU', 'Nauru'),
+  ('NU', 'NIU', 'Niue'),
+  ('NZ', 'NZL', 'New Zealand'),
+  ('OM', 'OMN', 'Oman'),
+  ('PA', 'PAN', 'Panama'),
+  ('PE', 'PER', 'Peru'),
+  ('PF', 'PYF', 'French Polynesia'),
+  ('PG', 'PNG', 'Papua New Guinea'),
+  ('PH', 'PHL', 'Philippines'),
+  ('PK', 'PAK', 'Pakistan'),
+  ('PL', 'POL', 'Poland'),
+  ('PM', 'SPM', 'Saint Pierre and Miquelon'),
+  ('PN', 'PCN', 'Pitcairn'),
+  ('PR', 'PRI', 'Puerto Rico'),
+  ('PS', 'PSE', 'Palestine, State of'),
+  ('PT', 'PRT', 'Portugal'),
+  ('PW', 'PLW', 'Palau'),
+  ('PY', 'PRY', 'Paraguay'),
+  ('QA', 'QAT', 'Qatar'),
+  ('RE', 'REU', 'Réunion'),
+  ('RO', 'ROU', 'Romania'),
+  ('RS', 'SRB', 'Serbia'),
+  ('RU', 'RUS', 'Russian Federation'),
+  ('RW', 'RWA', 'Rwanda'),
+  ('SA', 'SAU', 'Saudi Arabia'),
+  ('SB', 'SLB', 'Solomon Islands'),
+  ('SC', 'SYC', 'Seychelles'),
+  ('SD', 'SDN', 'Sudan'),
+  ('SE', 'SWE', 'Sweden'),
+  ('SG', 'SGP', 'Singapore'),
+  ('SH', 'SHN', 'Saint Helena, Ascension and Tristan da Cunha'),
+  ('SI', 'SVN', 'Slovenia'),
+  ('SJ', 'SJM', 'Svalbard and Jan Mayen'),
+  ('SK', 'SVK', 'Slovakia'),
+  ('SL', 'SLE', 'Sierra Leone'),
+  ('SM', 'SMR', 'San Marino'),
+  ('SN', 'SEN', 'Senegal'),
+  ('SO', 'SOM', 'Somalia'),
+  ('SR', 'SUR', 'Suriname'),
+  ('SS', 'SSD', 'South Sudan'),
+  ('ST', 'STP', 'Sao Tome and Principe'),
+  ('SV', 'SLV', 'El Salvador'),
+  ('SX', 'SXM', 'Sint Maarten (Dutch part)'),
+  ('SY', 'SYR', 'Syria'),
+  ('SZ', 'SWZ', 'Eswatini'),
+  ('TC', 'TCA', 'Turks and Caicos Islands'),
+  ('TD', 'TCD', 'Chad'),
+  ('TF', 'ATF', 'French Southern Territories'),
+  ('TG', 'TGO', 'Togo'),
+  ('TH', 'THA', 'Thailand'),
+  ('TJ', 'TJK', 'Tajikistan'),
+  ('TK', 'TKL', 'Tokelau'),
+  ('TL', 'TLS', 'Timor-Leste'),
+  ('TM', 'TKM', 'Turkmenistan'),
+  ('TN', 'TUN', 'Tunisia'),
+  ('TO', 'TON', 'Tonga'),
+  ('TR', 'TUR', 'Türkiye'),
+  ('TT', 'TTO', 'Trinidad and Tobago'),
+  ('TV', 'TUV', 'Tuvalu'),
+  ('TW', 'TWN', 'Taiwan'),
+  ('TZ', 'TZA', 'Tanzania'),
+  ('UA', 'UKR', 'Ukraine'),
+  ('UG', 'UGA', 'Uganda'),
+  ('UM', 'UMI', 'United States Minor Outlying Islands'),
+  ('US', 'USA', 'United States'),
+  ('UY', 'URY', 'Uruguay'),
+  ('UZ', 'UZB', 'Uzbekistan'),
+  ('VA', 'VAT', 'Holy See (Vatican City State)'),
+  ('VC', 'VCT', 'Saint Vincent and the Grenadines'),
+  ('VE', 'VEN', 'Venezuela'),
+  ('VG', 'VGB', 'Virgin Islands, British'),
+  ('VI', 'VIR', 'Virgin Islands, U.S.'),
+  ('VN', 'VNM', 'Vietnam'),
+  ('VU', 'VUT', 'Vanuatu'),
+  ('WF', 'WLF', 'Wallis and Futuna'),
+  ('WS', 'WSM', 'Samoa'),
+  ('YE', 'YEM', 'Yemen'),
+  ('YT', 'MYT', 'Mayotte'),
+  ('ZA', 'ZAF', 'South Africa'),
+  ('ZM', 'ZMB', 'Zambia'),
+  ('ZW', 'ZWE', 'Zimbabwe');
+
+insert into country_names (country_code, locale, name) values
+  ('AD', 'en', 'Andorra'),
+  ('AD', 'de', 'Andorra'),
+  ('AD', 'es', 'Andorra'),
+  ('AD', 'fr', 'Andorre'),
+  ('AD', 'pt', 'Andorra'),
+  ('AE', 'en', 'United Arab Emirates'),
+  ('AE', 'de', 'Vereinigte Arabische Emirate'),
+  ('AE', 'es', 'Emiratos Árabes Unidos'),
+  ('AE', 'fr', 'Émirats arabes unis'),
+  ('AE', 'pt', 'Emirados Árabes Unidos'),
+  ('AF', 'en', 'Afghanistan'),
+  ('AF', 'de', 'Afghanistan'),
+  ('AF', 'es', 'Afganistán'),
+  ('AF', 'fr', 'Afghanistan'),
+  ('AF', 'pt', 'Afeganistão'),
+  ('AG', 'en', 'Antigua and Barbuda'),
+  ('AG', 'de', 'Antigua und Barbuda'),
+  ('AG', 'es', 'Antigua y Barbuda'),
+  ('AG', 'fr', 'Antigua-et-Barbuda'),
+  ('AG', 'pt', 'Antígua e Barbuda'),
+  ('AI', 'en', 'Anguilla'),
+  ('AI', 'de', 'Anguilla'),
+  ('AI', 'es', 'Anguila'),
+  ('AI', 'fr', 'Anguilla'),
+  ('AI', 'pt', 'Anguila'),
+  ('AL', 'en', 'Albania'),
+  ('AL', 'de', 'Albanien'),
+  ('AL', 'es', 'Albania'),
+  ('AL', 'fr', 'Albanie'),
+  ('AL', 'pt', 'Albânia'),
+  ('AM', 'en', 'Armenia'),
+  ('AM', 'de', 'Armenien'),
+  ('AM', 'es', 'Armenia'),
+  ('AM', 'fr', 'Arménie'),
+  ('AM', 'pt', 'Armênia'),
+  ('AO', 'en', 'Angola'),
+  ('AO', 'de', 'Angola'),
+  ('AO', 'es', 'Angola'),
+  ('AO', 'fr', 'Angola'),
+  ('AO', 'pt', 'Angola'),
+  ('AQ', 'en', 'Antarctica'),
+  ('AQ', 'de', 'Antarktis'),
+  ('AQ', 'es', 'Antártida'),
+  ('AQ', 'fr', 'Antarctique'),
+  ('AQ', 'pt', 'Antártida'),
+  ('AR', 'en', 'Argentina'),
+  ('AR', 'de', 'Argentinien'),
+  ('AR', 'es', 'Argentina'),
+  ('AR', 'fr', 'Argentine'),
+  ('AR', 'pt', 'Argentina'),
+  ('AS', 'en', 'American Samoa'),
+  ('AS', 'de', 'Amerikanisch-Samoa'),
+  ('AS', 'es', 'Samoa Estadounidense'),
+  ('AS', 'fr', 'Samoa américaines'),
+  ('AS', 'pt', 'Samoa Americana'),
+  ('AT', 'en', 'Austria'),
+  ('AT', 'de', 'Österreich'),
+  ('AT', 'es', 'Austria'),
+  ('AT', 'fr', 'Autriche'),
+  ('AT', 'pt', 'Áustria'),
+  ('AU', 'en', 'Australia'),
+  ('AU', 'de', 'Australien'),
+  ('AU', 'es', 'Australia'),
+  ('AU', 'fr', 'Australie'),
+  ('AU', 'pt', 'Austrália'),
+  ('AW', 'en', 'Aruba'),
+  ('AW', 'de', 'Aruba'),
+  ('AW', 'es', 'Aruba'),
+  ('AW', 'fr', 'Aruba'),
+  ('AW', 'pt', 'Aruba'),
+  ('AX', 'en', 'Åland Islands'),
+  ('AX', 'de', 'Åland-Inseln'),
+  ('AX', 'es', 'Islas Äland'),
+  ('AX', 'fr', 'Åland, Îles'),
+  ('AX', 'pt', 'Ilhas Åland'),
+  ('AZ', 'en', 'Azerbaijan'),
+  ('AZ', 'de', 'Aserbaidschan'),
+  ('AZ', 'es', 'Azerbaiyán'),
+  ('AZ', 'fr', 'Azerbaïdjan'),
+  ('AZ', 'pt', 'Azerbaidjão'),
+  ('BA', 'en', 'Bosnia and Herzegovina'),
+  ('BA', 'de', 'Bosnien und Herzegowina'),
+  ('BA', 'es', 'Bosnia y Herzegovina'),
+  ('BA', 'fr', 'Bosnie-Herzégovine'),
+  ('BA', 'pt', 'Bósnia-Herzegóvina'),
+  ('BB', 'en', 'Barbados'),
+  ('BB', 'de', 'Barbados'),
+  ('BB', 'es', 'Barbados'),
+  ('BB', 'fr', 'Barbade'),
+  ('BB', 'pt', 'Barbados'),
+  ('BD', 'en', 'Bangladesh'),
+  ('BD', 'de', 'Bangladesch'),
+  ('BD', 'es', 'Bangladés'),
+  ('BD', 'fr', 'Bangladesh'),
+  ('BD', 'pt', 'Bangladesh'),
+  ('BE', 'en', 'Belgium'),
+  ('BE', 'de', 'Belgien'),
+  ('BE', 'es', 'Bélgica'),
+  ('BE', 'fr', 'Belgique'),
+  ('BE', 'pt', 'Bélgica'),
+  ('BF', 'en', 'Burkina Faso'),
+  ('BF', 'de', 'Burkina Faso'),
+  ('BF', 'es', 'Burquina Faso'),
+  ('BF', 'fr', 'Burkina Faso'),
+  ('BF', 'pt', 'Burquina'),
+  ('BG', 'en', 'Bulgaria'),
+  ('BG', 'de', 'Bulgarien'),
+  ('BG', 'es', 'Bulgaria'),
+  ('BG', 'fr', 'Bulgarie'),
+  ('BG', 'pt', 'Bulgária'),
+  ('BH', 'en', 'Bahrain'),
+  ('BH', 'de', 'Bahrain'),
+  ('BH', 'es', 'Baréin'),
+  ('BH', 'fr', 'Bahreïn'),
+  ('BH', 'pt', 'Barein'),
+  ('BI', 'en', 'Burundi'),
+  ('BI', 'de', 'Burundi'),
+  ('BI', 'es', 'Burundi'),
+  ('BI', 'fr', 'Burundi'),
+  ('BI', 'pt', 'Burundi'),
+  ('BJ', 'en', 'Benin'),
+  ('BJ', 'de', 'Benin'),
+  ('BJ', 'es', 'Benín'),
+  ('BJ', 'fr', 'Bénin'),
+  ('BJ', 'pt', 'Benin'),
+  ('BL', 'en', 'Saint Barthélemy'),
+  ('BL', 'de', 'Saint-Barthélemy'),
+  ('BL', 'es', 'San Bartolomé'),
+  ('BL', 'fr', 'Saint-Barthélemy'),
+  ('BL', 'pt', 'São Bartolomeu'),
+  ('BM', 'en', 'Bermuda'),
+  ('BM', 'de', 'Bermuda'),
+  ('BM', 'es', 'Islas Bermudas'),
+  ('BM', 'fr', 'Bermudes'),
+  ('BM', 'pt', 'Bermuda'),
+  ('BN', 'en', 'Brunei Darussalam'),
+  ('BN', 'de', 'Brunei Darussalam'),
+  ('BN', 'es', 'Brunei Darussalam'),
+  ('BN', 'fr', 'Brunéi Darussalam'),
+  ('BN', 'pt', 'Brunei'),
+  ('BO', 'en', 'Bolivia'),
+  ('BO', 'de', 'Bolivien'),
+  ('BO', 'es', 'Bolivia, Estado plurinacional de'),
+  ('BO', 'fr', 'Bolivie'),
+  ('BO', 'pt', 'Bolívia'),
+  ('BQ', 'en', 'Bonaire, Sint Eustatius and Saba'),
+  ('BQ', 'de', 'Bonaire, Sint Eustatius und Saba'),
+  ('BQ', 'es', 'Islas BES (Caribe Neerlandés)'),
+  ('BQ', 'fr', 'Bonaire, Saint-Eustache et Saba'),
+  ('BQ', 'pt', 'Bonaire, Saba e Santo Eustáquio'),
+  ('BR', 'en', 'Brazil'),
+  ('BR', 'de', 'Brasilien'),
+  ('BR', 'es', 'Brasil'),
+  ('BR', 'fr', 'Brésil'),
+  ('BR', 'pt', 'Brasil'),
+  ('BS', 'en', 'Bahamas'),
+  ('BS', 'de', 'Bahamas'),
+  ('BS', 'es', 'Bahamas'),
+  ('BS', 'fr', 'Bahamas'),
+  ('BS', 'pt', 'Bahamas'),
+  ('BT', 'en', 'Bhutan'),
+  ('BT', 'de', 'Bhutan'),
+  ('BT', 'es', 'Bután'),
+  ('BT', 'fr', 'Bhoutan'),
+  ('BT', 'pt', 'Butão'),
+  ('BV', 'en', 'Bouvet Island'),
+  ('BV', 'de', 'Bouvet-Insel'),
+  ('BV', 'es', 'Isla Bouvet'),
+  ('BV', 'fr', 'île Bouvet'),
+  ('BV', 'pt', 'Ilha Bouvet'),
+  ('BW', 'en', 'Botswana'),
+  ('BW', 'de', 'Botsuana'),
+  ('BW', 'es', 'Botsuana'),
+  ('BW', 'fr', 'Botswana'),
+  ('BW', 'pt', 'Botsuana'),
+  ('BY', 'en', 'Belarus'),
+  ('BY', 'de', 'Belarus'),
+  ('BY', 'es', 'Bielorrusia'),
+  ('BY', 'fr', 'Bélarus'),
+  ('BY', 'pt', 'Bielo-Rússia'),
+  ('BZ', 'en', 'Belize'),
+  ('BZ', 'de', 'Belize'),
+  ('BZ', 'es', 'Belice'),
+  ('BZ', 'fr', 'Belize'),
+  ('BZ', 'pt', 'Belize'),
+  ('CA', 'en', 'Canada'),
+  ('CA', 'de', 'Kanada'),
+  ('CA', 'es', 'Canadá'),
+  ('CA', 'fr', 'Canada'),
+  ('CA', 'pt', 'Canadá'),
+  ('CC', 'en', 'Cocos (Keeling) Islands'),
+  ('CC', 'de', 'Kokos-(Keeling-)Inseln'),
+  ('CC', 'es', 'Islas Cocos (Keeling)'),
+  ('CC', 'fr', 'Cocos (Keeling), Îles'),
+  ('CC', 'pt', 'Ilhas Cocos'),
+  ('CD', 'en', 'Congo, The Democratic Republic of the'),
+  ('CD', 'de', 'Demokratische Republik Kongo'),
+  ('CD', 'es', 'Congo, República Democrática del'),
+  ('CD', 'fr', 'République démocratique du Congo'),
+  ('CD', 'pt', 'Congo, República Democrática do'),
+  ('CF', 'en', 'Central African Republic'),
+  ('CF', 'de', 'Zentralafrikanische Republik'),
+  ('CF', 'es', 'República Centroafricana'),
+  ('CF', 'fr', 'République centrafricaine'),
+  ('CF', 'pt', 'República Centro-Africana'),
+  ('CG', 'en', 'Congo'),
+  ('CG', 'de', 'Kongo'),
+  ('CG', 'es', 'Congo'),
+  ('CG', 'fr', 'République du Congo'),
+  ('CG', 'pt', 'Congo'),
+  ('CH', 'en', 'Switzerland'),
+  ('CH', 'de', 'Schweiz'),
+  ('CH', 'es', 'Suiza'),
+  ('CH', 'fr', 'Suisse'),
+  ('CH', 'pt', 'Suíça'),
+  ('CI', 'en', 'Côte d''Ivoire'),
+  ('CI', 'de', 'Côte d''Ivoire'),
+  ('CI', 'es', 'Costa de Marfíl'),
+  ('CI', 'fr', 'Côte d''Ivoire'),
+  ('CI', 'pt', 'Costa do Marfim'),
+  ('CK', 'en', 'Cook Islands'),
+  ('CK', 'de', 'Cookinseln'),
+  ('CK', 'es', 'Islas Cook'),
+  ('CK', 'fr', 'îles Cook'),
+  ('CK', 'pt', 'Ilhas Cook'),
+  ('CL', 'en', 'Chile'),
+  ('CL', 'de', 'Chile'),
+  ('CL', 'es', 'Chile'),
+  ('CL', 'fr', 'Chili'),
+  ('CL', 'pt', 'Chile'),
+  ('CM', 'en', 'Cameroon'),
+  ('CM', 'de', 'Kamerun'),
+  ('CM', 'es', 'Camerún'),
+  ('CM', 'fr', 'Cameroun'),
+  ('CM', 'pt', 'Camarões'),
+  ('CN', 'en', 'China'),
+  ('CN', 'de', 'China'),
+  ('CN', 'es', 'China'),
+  ('CN', 'fr', 'Chine'),
+  ('CN', 'pt', 'China'),
+  ('CO', 'en', 'Colombia'),
+  ('CO', 'de', 'Kolumbien'),
+  ('CO', 'es', 'Colombia'),
+  ('CO', 'fr', 'Colombie'),
+  ('CO', 'pt', 'Colômbia'),
+  ('CR', 'en', 'Costa Rica'),
+  ('CR', 'de', 'Costa Rica'),
+  ('CR', 'es', 'Costa Rica'),
+  ('CR', 'fr', 'Costa Rica'),
+  ('CR', 'pt', 'Costa Rica'),
+  ('CU', 'en', 'Cuba'),
+  ('CU', 'de', 'Kuba'),
+  ('CU', 'es', 'Cuba'),
+  ('CU', 'fr', 'Cuba'),
+  ('CU', 'pt', 'Cuba'),
+  ('CV', 'en', 'Cabo Verde'),
+  ('CV', 'de', 'Kap Verde'),
+  ('CV', 'es', 'Cabo Verde'),
+  ('CV', 'fr', 'Cap-Vert'),
+  ('CV', 'pt', 'Cabo Verde'),
+  ('CW', 'en', 'Curaçao'),
+  ('CW', 'de', 'Curaçao'),
+  ('CW', 'es', 'Curazao'),
+  ('CW', 'fr', 'Curaçao'),
+  ('CW', 'pt', 'Curaçao'),
+  ('CX', 'en', 'Christmas Island'),
+  ('CX', 'de', 'Weihnachtsinseln'),
+  ('CX', 'es', 'Isla de Navidad'),
+  ('CX', 'fr', 'Christmas, Île'),
+  ('CX', 'pt', 'Ilha Christmas'),
+  ('CY', 'en', 'Cyprus'),
+  ('CY', 'de', 'Zypern'),
+  ('CY', 'es', 'Chipre'),
+  ('CY', 'fr', 'Chypre'),
+  ('CY', 'pt', 'Chipre'),
+  ('CZ', 'en', 'Czechia'),
+  ('CZ', 'de', 'Tschechien'),
+  ('CZ', 'es', 'Chequia'),
+  ('CZ', 'fr', 'Tchéquie'),
+  ('CZ', 'pt', 'Chéquia'),
+  ('DE', 'en', 'Germany'),
+  ('DE', 'de', 'Deutschland'),
+  ('DE', 'es', 'Alemania'),
+  ('DE', 'fr', 'Allemagne'),
+  ('DE', 'pt', 'Alemanha'),
+  ('DJ', 'en', 'Djibouti'),
+  ('DJ', 'de', 'Dschibuti'),
+  ('DJ', 'es', 'Yibuti'),
+  ('DJ', 'fr', 'Djibouti'),
+  ('DJ', 'pt', 'Djibuti'),
+  ('DK', 'en', 'Denmark'),
+  ('DK', 'de', 'Dänemark'),
+  ('DK', 'es', 'Dinamarca'),
+  ('DK', 'fr', 'Danemark'),
+  ('DK', 'pt', 'Dinamarca'),
+  ('DM', 'en', 'Dominica'),
+  ('DM', 'de', 'Dominica'),
+  ('DM', 'es', 'Dominica'),
+  ('DM', 'fr', 'Dominique'),
+  ('DM', 'pt', 'Domínica'),
+  ('DO', 'en', 'Dominican Republic'),
+  ('DO', 'de', 'Dominikanische Republik'),
+  ('DO', 'es', 'República Dominicana'),
+  ('DO', 'fr', 'République dominicaine'),
+  ('DO', 'pt', 'República Dominicana'),
+  ('DZ', 'en', 'Algeria'),
+  ('DZ', 'de', 'Algerien'),
+  ('DZ', 'es', 'Algeria'),
+  ('DZ', 'fr', 'Algérie'),
+  ('DZ', 'pt', 'Argélia'),
+  ('EC', 'en', 'Ecuador'),
+  ('EC', 'de', 'Ecuador'),
+  ('EC', 'es', 'Ecuador'),
+  ('EC', 'fr', 'Équateur'),
+  ('EC', 'pt', 'Equador'),
+  ('EE', 'en', 'Estonia'),
+  ('EE', 'de', 'Estland'),
+  ('EE', 'es', 'Estonia'),
+  ('EE', 'fr', 'Estonie'),
+  ('EE', 'pt', 'Estônia'),
+  ('EG', 'en', 'Egypt'),
+  ('EG', 'de', 'Ägypten'),
+  ('EG', 'es', 'Egipto'),
+  ('EG', 'fr', 'Égypte'),
+  ('EG', 'pt', 'Egito'),
+  ('EH', 'en', 'Western Sahara'),
+  ('EH', 'de', 'Westsahara'),
+  ('EH', 'es', 'Sahara Occidental'),
+  ('EH', 'fr', 'Sahara occidental'),
+  ('EH', 'pt', 'Saara Ocidental'),
+  ('ER', 'en', 'Eritrea'),
+  ('ER', 'de', 'Eritrea'),
+  ('ER', 'es', 'Eritrea'),
+  ('ER', 'fr', 'Érythrée'),
+  ('ER', 'pt', 'Eritréia'),
+  ('ES', 'en', 'Spain'),
+  ('ES', 'de', 'Spanien'),
+  ('ES', 'es', 'España'),
+  ('ES', 'fr', 'Espagne'),
+  ('ES', 'pt', 'Espanha'),
+  ('ET', 'en', 'Ethiopia'),
+  ('ET', 'de', 'Äthiopien'),
+  ('ET', 'es', 'Etiopía'),
+  ('ET', 'fr', 'Éthiopie'),
+  ('ET', 'pt', 'Etiópia'),
+  ('FI', 'en', 'Finland'),
+  ('FI', 'de', 'Finnland'),
+  ('FI', 'es', 'Finlandia'),
+  ('FI', 'fr', 'Finlande'),
+  ('FI', 'pt', 'Finlândia'),
+  ('FJ', 'en', 'Fiji'),
+  ('FJ', 'de', 'Fidschi'),
+  ('FJ', 'es', 'Fiyi'),
+  ('FJ', 'fr', 'Fidji'),
+  ('FJ', 'pt', 'Fiji'),
+  ('FK', 'en', 'Falkland Islands (Malvinas)'),
+  ('FK', 'de', 'Falklandinseln (Malwinen)'),
+  ('FK', 'es', 'Islas Falkland (Malvinas)'),
+  ('FK', 'fr', 'Malouines, Îles (Falkland)'),
+  ('FK', 'pt', 'Ilhas Malvinas (Falkland)'),
+  ('FM', 'en', 'Micronesia, Federated States of'),
+  ('FM', 'de', 'Mikronesien, Föderierte Staaten von'),
+  ('FM', 'es', 'Micronesia, Estados Federados de'),
+  ('FM', 'fr', 'Micronésie, États fédérés de'),
+  ('FM', 'pt', 'Micronésia, Estados Federados da'),
+  ('FO', 'en', 'Faroe Islands'),
+  ('FO', 'de', 'Färöer-Inseln'),
+  ('FO', 'es', 'Islas Feroe'),
+  ('FO', 'fr', 'îles Féroé'),
+  ('FO', 'pt', 'Ilhas Faroe'),
+  ('FR', 'en', 'France'),
+  ('FR', 'de', 'Frankreich'),
+  ('FR', 'es', 'Francia'),
+  ('FR', 'fr', 'France'),
+  ('FR', 'pt', 'França'),
+  ('GA', 'en', 'Gabon'),
+  ('GA', 'de', 'Gabun'),
+  ('GA', 'es', 'Gabón'),
+  ('GA', 'fr', 'Gabon'),
+  ('GA', 'pt', 'Gabão'),
+  ('GB', 'en', 'United Kingdom'),
+  ('GB', 'de', 'Vereinigtes Königreich'),
+  ('GB', 'es', 'Reino Unido'),
+  ('GB', 'fr', 'Royaume-Uni'),
+  ('GB', 'pt', 'Reino Unido'),
+  ('GD', 'en', 'Grenada'),
+  ('GD', 'de', 'Grenada'),
+  ('GD', 'es', 'Granada'),
+  ('GD', 'fr', 'Grenade'),
+  ('GD', 'pt', 'Granada'),
+  ('GE', 'en', 'Georgia'),
+  ('GE', 'de', 'Georgien'),
+  ('GE', 'es', 'Georgia'),
+  ('GE', 'fr', 'Géorgie'),
+  ('GE', 'pt', 'Geórgia'),
+  ('GF', 'en', 'French Guiana'),
+  ('GF', 'de', 'Französisch-Guyana'),
+  ('GF', 'es', 'Guayana Francesa'),
+  ('GF', 'fr', 'Guyane française'),
+  ('GF', 'pt', 'Guiana Francesa'),
+  ('GG', 'en', 'Guernsey'),
+  ('GG', 'de', 'Guernsey'),
+  ('GG', 'es', 'Guernsey'),
+  ('GG', 'fr', 'Guernesey'),
+  ('GG', 'pt', 'Guernsey'),
+  ('GH', 'en', 'Ghana'),
+  ('GH', 'de', 'Ghana'),
+  ('GH', 'es', 'Ghana'),
+  ('GH', 'fr', 'Ghana'),
+  ('GH', 'pt', 'Gana'),
+  ('GI', 'en', 'Gibraltar'),
+  ('GI', 'de', 'Gibraltar'),
+  ('GI', 'es', 'Gibraltar'),
+  ('GI', 'fr', 'Gibraltar'),
+  ('GI', 'pt', 'Gibraltar'),
+  ('GL', 'en', 'Greenland'),
+  ('GL', 'de', 'Grönland'),
+  ('GL', 'es', 'Groenlandia'),
+  ('GL', 'fr', 'Groënland'),
+  ('GL', 'pt', 'Groenlândia'),
+  ('GM', 'en', 'Gambia'),
+  ('GM', 'de', 'Gambia'),
+  ('GM', 'es', 'Gambia'),
+  ('GM', 'fr', 'Gambie'),
+  ('GM', 'pt', 'Gâmbia'),
+  ('GN', 'en', 'Guinea'),
+  ('GN', 'de', 'Guinea'),
+  ('GN', 'es', 'Guinea'),
+  ('GN', 'fr', 'Guinée'),
+  ('GN', 'pt', 'Guiné'),
+  ('GP', 'en', 'Guadeloupe'),
+  ('GP', 'de', 'Guadeloupe'),
+  ('GP', 'es', 'Guadalupe'),
+  ('GP', 'fr', 'Guadeloupe'),
+  ('GP', 'pt', 'Guadalupe'),
+  ('GQ', 'en', 'Equatorial Guinea'),
+  ('GQ', 'de', 'Äquatorialguinea'),
+  ('GQ', 'es', 'Guinea Ecuatorial'),
+  ('GQ', 'fr', 'Guinée Équatoriale'),
+  ('GQ', 'pt', 'Guiné Equatorial'),
+  ('GR', 'en', 'Greece'),
+  ('GR', 'de', 'Griechenland'),
+  ('GR', 'es', 'Grecia'),
+  ('GR', 'fr', 'Grèce'),
+  ('GR', 'pt', 'Grécia'),
+  ('GS', 'en', 'South Georgia and the South Sandwich Islands'),
+  ('GS', 'de', 'South Georgia und die Südlichen Sandwichinseln'),
+  ('GS', 'es', 'Islas Georgias del Sur y Sándwich del Sur'),
+  ('GS', 'fr', 'Géorgie du Sud et les îles Sandwich du Sud'),
+  ('GS', 'pt', 'Geórgia do Sul e Ilhas Sandwich do Sul'),
+  ('GT', 'en', 'Guatemala'),
+  ('GT', 'de', 'Guatemala'),
+  ('GT', 'es', 'Guatemala'),
+  ('GT', 'fr', 'Guatemala'),
+  ('GT', 'pt', 'Guatemala'),
+  ('GU', 'en', 'Guam'),
+  ('GU', 'de', 'Guam'),
+  ('GU', 'es', 'Guam'),
+  ('GU', 'fr', 'Guam'),
+  ('GU', 'pt', 'Guam'),
+  ('GW', 'en', 'Guinea-Bissau'),
+  ('GW', 'de', 'Guinea-Bissau'),
+  ('GW', 'es', 'Guinea-Bisáu'),
+  ('GW', 'fr', 'Guinée-Bissau'),
+  ('GW', 'pt', 'Guiné-Bissau'),
+  ('GY', 'en', 'Guyana'),
+  ('GY', 'de', 'Guyana'),
+  ('GY', 'es', 'Guyana'),
+  ('GY', 'fr', 'Guyana'),
+  ('GY', 'pt', 'Guiana'),
+  ('HK', 'en', 'Hong Kong'),
+  ('HK', 'de', 'Hongkong'),
+  ('HK', 'es', 'Hong Kong'),
+  ('HK', 'fr', 'Hong Kong'),
+  ('HK', 'pt', 'Hong Kong'),
+  ('HM', 'en', 'Heard Island and McDonald Islands'),
+  ('HM', 'de', 'Heard und McDonaldinseln'),
+  ('HM', 'es', 'Islas Heard y McDonald'),
+  ('HM', 'fr', 'îles Heard-et-MacDonald'),
+  ('HM', 'pt', 'Ilha Heard e Ilhas McDonald'),
+  ('HN', 'en', 'Honduras'),
+  ('HN', 'de', 'Honduras'),
+  ('HN', 'es', 'Honduras'),
+  ('HN', 'fr', 'Honduras'),
+  ('HN', 'pt', 'Honduras'),
+  ('HR', 'en', 'Croatia'),
+  ('HR', 'de', 'Kroatien'),
+  ('HR', 'es', 'Croacia'),
+  ('HR', 'fr', 'Croatie'),
+  ('HR', 'pt', 'Croácia'),
+  ('HT', 'en', 'Haiti'),
+  ('HT', 'de', 'Haiti'),
+  ('HT', 'es', 'Haití'),
+  ('HT', 'fr', 'Haïti'),
+  ('HT', 'pt', 'Haiti'),
+  ('HU', 'en', 'Hungary'),
+  ('HU', 'de', 'Ungarn'),
+  ('HU', 'es', 'Hungría'),
+  ('HU', 'fr', 'Hongrie'),
+  ('HU', 'pt', 'Hungria'),
+  ('ID', 'en', 'Indonesia'),
+  ('ID', 'de', 'Indonesien'),
+  ('ID', 'es', 'Indonesia'),
+  ('ID', 'fr', 'Indonésie'),
+  ('ID', 'pt', 'Indonésia'),
+  ('IE', 'en', 'Ireland'),
+  ('IE', 'de', 'Irland'),
+  ('IE', 'es', 'Irlanda'),
+  ('IE', 'fr', 'Irlande'),
+  ('IE', 'pt', 'Irlanda'),
+  ('IL', 'en', 'Israel'),
+  ('IL', 'de', 'Israel'),
+  ('IL', 'es', 'Israel'),
+  ('IL', 'fr', 'Israël'),
+  ('IL', 'pt', 'Israel'),
+  ('IM', 'en', 'Isle of Man'),
+  ('IM', 'de', 'Insel Man'),
+  ('IM', 'es', 'Isla de Man'),
+  ('IM', 'fr', 'Île de Man'),
+  ('IM', 'pt', 'Ilha de Man'),
+  ('IN', 'en', 'India'),
+  ('IN', 'de', 'Indien'),
+  ('IN', 'es', 'India'),
+  ('IN', 'fr', 'Inde'),
+  ('IN', 'pt', 'Índia'),
+  ('IO', 'en', 'British Indian Ocean Territory'),
+  ('IO', 'de', 'Britisches Territorium im Indischen Ozean'),
+  ('IO', 'es', 'Territorio Británico del Océano Índico'),
+  ('IO', 'fr', 'Territoire britannique de l''océan Indien'),
+  ('IO', 'pt', 'Território Britânico do Oceano Índico'),
+  ('IQ', 'en', 'Iraq'),
+  ('IQ', 'de', 'Irak'),
+  ('IQ', 'es', 'Irak'),
+  ('IQ', 'fr', 'Irak'),
+  ('IQ', 'pt', 'Iraque'),
+  ('IR', 'en', 'Iran'),
+  ('IR', 'de', 'Iran, Islamische Republik'),
+  ('IR', 'es', 'Irán, República islámica de'),
+  ('IR', 'fr', 'Iran, République islamique d'''),
+  ('IR', 'pt', 'Irã, República Islâmica do'),
+  ('IS', 'en', 'Iceland'),
+  ('IS', 'de', 'Island'),
+  ('IS', 'es', 'Islandia'),
+  ('IS', 'fr', 'Islande'),
+  ('IS', 'pt', 'Islândia'),
+  ('IT', 'en', 'Italy'),
+  ('IT', 'de', 'Italien'),
+  ('IT', 'es', 'Italia'),
+  ('IT', 'fr', 'Italie'),
+  ('IT', 'pt', 'Itália'),
+  ('JE', 'en', 'Jersey'),
+  ('JE', 'de', 'Jersey'),
+  ('JE', 'es', 'Jersey'),
+  ('JE', 'fr', 'Jersey'),
+  ('JE', 'pt', 'Jersey'),
+  ('JM', 'en', 'Jamaica'),
+  ('JM', 'de', 'Jamaika'),
+  ('JM', 'es', 'Jamaica'),
+  ('JM', 'fr', 'Jamaïque'),
+  ('JM', 'pt', 'Jamaica'),
+  ('JO', 'en', 'Jordan'),
+  ('JO', 'de', 'Jordanien'),
+  ('JO', 'es', 'Jordania'),
+  ('JO', 'fr', 'Jordanie'),
+  ('JO', 'pt', 'Jordânia'),
+  ('JP', 'en', 'Japan'),
+  ('JP', 'de', 'Japan'),
+  ('JP', 'es', 'Japón'),
+  ('JP', 'fr', 'Japon'),
+  ('JP', 'pt', 'Japão'),
+  ('KE', 'en', 'Kenya'),
+  ('KE', 'de', 'Kenia'),
+  ('KE', 'es', 'Kenia'),
+  ('KE', 'fr', 'Kenya'),
+  ('KE', 'pt', 'Quênia'),
+  ('KG', 'en', 'Kyrgyzstan'),
+  ('KG', 'de', 'Kirgisistan'),
+  ('KG', 'es', 'Kirguistán'),
+  ('KG', 'fr', 'Kirghizistan'),
+  ('KG', 'pt', 'Quirguistão'),
+  ('KH', 'en', 'Cambodia'),
+  ('KH', 'de', 'Kambodscha'),
+  ('KH', 'es', 'Camboya'),
+  ('KH', 'fr', 'Cambodge'),
+  ('KH', 'pt', 'Camboja'),
+  ('KI', 'en', 'Kiribati'),
+  ('KI', 'de', 'Kiribati'),
+  ('KI', 'es', 'Kiribati'),
+  ('KI', 'fr', 'Kiribati'),
+  ('KI', 'pt', 'Kiribati'),
+  ('KM', 'en', 'Comoros'),
+  ('KM', 'de', 'Komoren'),
+  ('KM', 'es', 'Comores, Islas'),
+  ('KM', 'fr', 'Comores'),
+  ('KM', 'pt', 'Comores'),
+  ('KN', 'en', 'Saint Kitts and Nevis'),
+  ('KN', 'de', 'St. Kitts und Nevis'),
+  ('KN', 'es', 'San Cristóbal y Nieves'),
+  ('KN', 'fr', 'Saint-Christophe-et-Niévès'),
+  ('KN', 'pt', 'São Cristóvão e Névis'),
+  ('KP', 'en', 'North Korea'),
+  ('KP', 'de', 'Nordkorea'),
+  ('KP', 'es', 'Corea, República Democrática Popular de'),
+  ('KP', 'fr', 'Corée du Nord'),
+  ('KP', 'pt', 'Coreia do Norte'),
+  ('KR', 'en', 'South Korea'),
+  ('KR', 'de', 'Südkorea'),
+  ('KR', 'es', 'Corea, República de'),
+  ('KR', 'fr', 'Corée du Sud'),
+  ('KR', 'pt', 'Coreia do Sul'),
+  ('KW', 'en', 'Kuwait'),
+  ('KW', 'de', 'Kuwait'),
+  ('KW', 'es', 'Kuwait'),
+  ('KW', 'fr', 'Koweït'),
+  ('KW', 'pt', 'Kuwait'),
+  ('KY', 'en', 'Cayman Islands'),
+  ('KY', 'de', 'Cayman-Inseln'),
+  ('KY', 'es', 'Islas Caimán'),
+  ('KY', 'fr', 'îles Caïmans'),
+  ('KY', 'pt', 'Ilhas Cayman'),
+  ('KZ', 'en', 'Kazakhstan'),
+  ('KZ', 'de', 'Kasachstan'),
+  ('KZ', 'es', 'Kazajistán'),
+  ('KZ', 'fr', 'Kazakhstan'),
+  ('KZ', 'pt', 'Cazaquistão'),
+  ('LA', 'en', 'Laos'),
+  ('LA', 'de', 'Laos, Demokratische Volksrepublik'),
+  ('LA', 'es', 'República Democrática Popular de Lao'),
+  ('LA', 'fr', 'Lao, République démocratique populaire'),
+  ('LA', 'pt', 'República Popular Democrática do Laos'),
+  ('LB', 'en', 'Lebanon'),
+  ('LB', 'de', 'Libanon'),
+  ('LB', 'es', 'Líbano'),
+  ('LB', 'fr', 'Liban'),
+  ('LB', 'pt', 'Líbano'),
+  ('LC', 'en', 'Saint Lucia'),
+  ('LC', 'de', 'St. Lucia'),
+  ('LC', 'es', 'Santa Lucía'),
+  ('LC', 'fr', 'Sainte-Lucie'),
+  ('LC', 'pt', 'Santa Lúcia'),
+  ('LI', 'en', 'Liechtenstein'),
+  ('LI', 'de', 'Liechtenstein'),
+  ('LI', 'es', 'Liechtenstein'),
+  ('LI', 'fr', 'Liechtenstein'),
+  ('LI', 'pt', 'Liechtenstein'),
+  ('LK', 'en', 'Sri Lanka'),
+  ('LK', 'de', 'Sri Lanka'),
+  ('LK', 'es', 'Sri Lanka'),
+  ('LK', 'fr', 'Sri Lanka'),
+  ('LK', 'pt', 'Sri Lanka'),
+  ('LR', 'en', 'Liberia'),
+  ('LR', 'de', 'Liberia'),
+  ('LR', 'es', 'Liberia'),
+  ('LR', 'fr', 'Libéria'),
+  ('LR', 'pt', 'Libéria'),
+  ('LS', 'en', 'Lesotho'),
+  ('LS', 'de', 'Lesotho'),
+  ('LS', 'es', 'Lesoto'),
+  ('LS', 'fr', 'Lesotho'),
+  ('LS', 'pt', 'Lesoto'),
+  ('LT', 'en', 'Lithuania'),
+  ('LT', 'de', 'Litauen'),
+  ('LT', 'es', 'Lituania'),
+  ('LT', 'fr', 'Lituanie'),
+  ('LT', 'pt', 'Lituânia'),
+  ('LU', 'en', 'Luxembourg'),
+  ('LU', 'de', 'Luxemburg'),
+  ('LU', 'es', 'Luxemburgo'),
+  ('LU', 'fr', 'Luxembourg'),
+  ('LU', 'pt', 'Luxemburgo'),
+  ('LV', 'en', 'Latvia'),
+  ('LV', 'de', 'Lettland'),
+  ('LV', 'es', 'Letonia'),
+  ('LV', 'fr', 'Lettonie'),
+  ('LV', 'pt', 'Letônia'),
+  ('LY', 'en', 'Libya'),
+  ('LY', 'de', 'Libyen'),
+  ('LY', 'es', 'Libia'),
+  ('LY', 'fr', 'Libye'),
+  ('LY', 'pt', 'Líbia'),
+  ('MA', 'en', 'Morocco'),
+  ('MA', 'de', 'Marokko'),
+  ('MA', 'es', 'Marruecos'),
+  ('MA', 'fr', 'Maroc'),
+  ('MA', 'pt', 'Marrocos'),
+  ('MC', 'en', 'Monaco'),
+  ('MC', 'de', 'Monaco'),
+  ('MC', 'es', 'Mónaco'),
+  ('MC', 'fr', 'Monaco'),
+  ('MC', 'pt', 'Mônaco'),
+  ('MD', 'en', 'Moldova'),
+  ('MD', 'de', 'Moldau'),
+  ('MD', 'es', 'Moldavia'),
+  ('MD', 'fr', 'Moldavie'),
+  ('MD', 'pt', 'Moldávia'),
+  ('ME', 'en', 'Montenegro'),
+  ('ME', 'de', 'Montenegro'),
+  ('ME', 'es', 'Montenegro'),
+  ('ME', 'fr', 'Monténégro'),
+  ('ME', 'pt', 'Montenegro'),
+  ('MF', 'en', 'Saint Martin (French part)'),
+  ('MF', 'de', 'Saint Martin (Französischer Teil)'),
+  ('MF', 'es', 'San Martín (zona francesa)'),
+  ('MF', 'fr', 'Saint-Martin (partie française)'),
+  ('MF', 'pt', 'São Martim (parte francesa)'),
+  ('MG', 'en', 'Madagascar'),
+  ('MG', 'de', 'Madagaskar'),
+  ('MG', 'es', 'Madagascar'),
+  ('MG', 'fr', 'Madagascar'),
+  ('MG', 'pt', 'Madagascar'),
+  ('MH', 'en', 'Marshall Islands'),
+  ('MH', 'de', 'Marshallinseln'),
+  ('MH', 'es', 'Islas Marshall'),
+  ('MH', 'fr', 'Îles Marshall'),
+  ('MH', 'pt', 'Ilhas Marshall'),
+  ('MK', 'en', 'North Macedonia'),
+  ('MK', 'de', 'Nordmazedonien'),
+  ('MK', 'es', 'Macedonia del Norte'),
+  ('MK', 'fr', 'Macédoine du Nord'),
+  ('MK', 'pt', 'Macedônia do Norte'),
+  ('ML', 'en', 'Mali'),
+  ('ML', 'de', 'Mali'),
+  ('ML', 'es', 'Malí'),
+  ('ML', 'fr', 'Mali'),
+  ('ML', 'pt', 'Mali'),
+  ('MM', 'en', 'Myanmar'),
+  ('MM', 'de', 'Myanmar'),
+  ('MM', 'es', 'Birmania'),
+  ('MM', 'fr', 'Birmanie'),
+  ('MM', 'pt', 'Myanmar'),
+  ('MN', 'en', 'Mongolia'),
+  ('MN', 'de', 'Mongolei'),
+  ('MN', 'es', 'Mongolia'),
+  ('MN', 'fr', 'Mongolie'),
+  ('MN', 'pt', 'Mongólia'),
+  ('MO', 'en', 'Macao'),
+  ('MO', 'de', 'Macao'),
+  ('MO', 'es', 'Macao'),
+  ('MO', 'fr', 'Macau'),
+  ('MO', 'pt', 'Macau'),
+  ('MP', 'en', 'Northern Mariana Islands'),
+  ('MP', 'de', 'Nördliche Marianen'),
+  ('MP', 'es', 'Islas Marianas del Norte'),
+  ('MP', 'fr', 'Îles Mariannes du Nord'),
+  ('MP', 'pt', 'Ilhas Marianas do Norte'),
+  ('MQ', 'en', 'Martinique'),
+  ('MQ', 'de', 'Martinique'),
+  ('MQ', 'es', 'Martinica'),
+  ('MQ', 'fr', 'Martinique'),
+  ('MQ', 'pt', 'Martinica'),
+  ('MR', 'en', 'Mauritania'),
+  ('MR', 'de', 'Mauretanien'),
+  ('MR', 'es', 'Mauritania'),
+  ('MR', 'fr', 'Mauritanie'),
+  ('MR', 'pt', 'Mauritânia'),
+  ('MS', 'en', 'Montserrat'),
+  ('MS', 'de', 'Montserrat'),
+  ('MS', 'es', 'Montserrat'),
+  ('MS', 'fr', 'Montserrat'),
+  ('MS', 'pt', 'Montserrat'),
+  ('MT', 'en', 'Malta'),
+  ('MT', 'de', 'Malta'),
+  ('MT', 'es', 'Malta'),
+  ('MT', 'fr', 'Malte'),
+  ('MT', 'pt', 'Malta'),
+  ('MU', 'en', 'Mauritius'),
+  ('MU', 'de', 'Mauritius'),
+  ('MU', 'es', 'Mauricio'),
+  ('MU', 'fr', 'Maurice'),
+  ('MU', 'pt', 'Maurício'),
+  ('MV', 'en', 'Maldives'),
+  ('MV', 'de', 'Malediven'),
+  ('MV', 'es', 'Islas Maldivas'),
+  ('MV', 'fr', 'Maldives'),
+  ('MV', 'pt', 'Maldivas'),
+  ('MW', 'en', 'Malawi'),
+  ('MW', 'de', 'Malawi'),
+  ('MW', 'es', 'Malaui'),
+  ('MW', 'fr', 'Malawi'),
+  ('MW', 'pt', 'Malaui'),
+  ('MX', 'en', 'Mexico'),
+  ('MX', 'de', 'Mexiko'),
+  ('MX', 'es', 'México'),
+  ('MX', 'fr', 'Mexique'),
+  ('MX', 'pt', 'México'),
+  ('MY', 'en', 'Malaysia'),
+  ('MY', 'de', 'Malaysia'),
+  ('MY', 'es', 'Malasia'),
+  ('MY', 'fr', 'Malaisie'),
+  ('MY', 'pt', 'Malásia'),
+  ('MZ', 'en', 'Mozambique'),
+  ('MZ', 'de', 'Mosambik'),
+  ('MZ', 'es', 'Mozambique'),
+  ('MZ', 'fr', 'Mozambique'),
+  ('MZ', 'pt', 'Moçambique'),
+  ('NA', 'en', 'Namibia'),
+  ('NA', 'de', 'Namibia'),
+  ('NA', 'es', 'Namibia'),
+  ('NA', 'fr', 'Namibie'),
+  ('NA', 'pt', 'Namíbia'),
+  ('NC', 'en', 'New Caledonia'),
+  ('NC', 'de', 'Neukaledonien'),
+  ('NC', 'es', 'Nueva Caledonia'),
+  ('NC', 'fr', 'Nouvelle-Calédonie'),
+  ('NC', 'pt', 'Nova Caledônia'),
+  ('NE', 'en', 'Niger'),
+  ('NE', 'de', 'Niger'),
+  ('NE', 'es', 'Niger'),
+  ('NE', 'fr', 'Niger'),
+  ('NE', 'pt', 'Níger'),
+  ('NF', 'en', 'Norfolk Island'),
+  ('NF', 'de', 'Norfolkinsel'),
+  ('NF', 'es', 'Isla Norfolk'),
+  ('NF', 'fr', 'île Norfolk'),
+  ('NF', 'pt', 'Ilha Norfolk'),
+  ('NG', 'en', 'Nigeria'),
+  ('NG', 'de', 'Nigeria'),
+  ('NG', 'es', 'Nigeria'),
+  ('NG', 'fr', 'Nigeria'),
+  ('NG', 'pt', 'Nigéria'),
+  ('NI', 'en', 'Nicaragua'),
+  ('NI', 'de', 'Nicaragua'),
+  ('NI', 'es', 'Nicaragua'),
+  ('NI', 'fr', 'Nicaragua'),
+  ('NI', 'pt', 'Nicarágua'),
+  ('NL', 'en', 'Netherlands'),
+  ('NL', 'de', 'Niederlande'),
+  ('NL', 'es', 'Países Bajos'),
+  ('NL', 'fr', 'Pays-Bas'),
+  ('NL', 'pt', 'Países Baixos'),
+  ('NO', 'en', 'Norway'),
+  ('NO', 'de', 'Norwegen'),
+  ('NO', 'es', 'Noruega'),
+  ('NO', 'fr', 'Norvège'),
+  ('NO', 'pt', 'Noruega'),
+  ('NP', 'en', 'Nepal'),
+  ('NP', 'de', 'Nepal'),
+  ('NP', 'es', 'Nepal'),
+  ('NP', 'fr', 'Népal'),
+  ('NP', 'pt', 'Nepal'),
+  ('NR', 'en', 'Nauru'),
+  ('NR', 'de', 'Nauru'),
+  ('NR', 'es', 'Nauru'),
+  ('NR', 'fr', 'Nauru'),
+  ('NR', 'pt', 'Nauru'),
+  ('NU', 'en', 'Niue'),
+  ('NU', 'de', 'Niue'),
+  ('NU', 'es', 'Niue'),
+  ('NU', 'fr', 'Nioue'),
+  ('NU', 'pt', 'Niue'),
+  ('NZ', 'en', 'New Zealand'),
+  ('NZ', 'de', 'Neuseeland'),
+  ('NZ', 'es', 'Nueva Zelanda'),
+  ('NZ', 'fr', 'Nouvelle-Zélande'),
+  ('NZ', 'pt', 'Nova Zelândia'),
+  ('OM', 'en', 'Oman'),
+  ('OM', 'de', 'Oman'),
+  ('OM', 'es', 'Omán'),
+  ('OM', 'fr', 'Oman'),
+  ('OM', 'pt', 'Omã'),
+  ('PA', 'en', 'Panama'),
+  ('PA', 'de', 'Panama'),
+  ('PA', 'es', 'Panamá'),
+  ('PA', 'fr', 'Panama'),
+  ('PA', 'pt', 'Panamá'),
+  ('PE', 'en', 'Peru'),
+  ('PE', 'de', 'Peru'),
+  ('PE', 'es', 'Perú'),
+  ('PE', 'fr', 'Pérou'),
+  ('PE', 'pt', 'Peru'),
+  ('PF', 'en', 'French Polynesia'),
+  ('PF', 'de', 'Französisch-Polynesien'),
+  ('PF', 'es', 'Polinesia Francesa'),
+  ('PF', 'fr', 'Polynésie française'),
+  ('PF', 'pt', 'Polinésia Francesa'),
+  ('PG', 'en', 'Papua New Guinea'),
+  ('PG', 'de', 'Papua-Neuguinea'),
+  ('PG', 'es', 'Papúa Nueva Guinea'),
+  ('PG', 'fr', 'Papouasie-Nouvelle-Guinée'),
+  ('PG', 'pt', 'Papua-Nova Guiné'),
+  ('PH', 'en', 'Philippines'),
+  ('PH', 'de', 'Philippinen'),
+  ('PH', 'es', 'Filipinas'),
+  ('PH', 'fr', 'Philippines'),
+  ('PH', 'pt', 'Filipinas'),
+  ('PK', 'en', 'Pakistan'),
+  ('PK', 'de', 'Pakistan'),
+  ('PK', 'es', 'Pakistán'),
+  ('PK', 'fr', 'Pakistan'),
+  ('PK', 'pt', 'Paquistão'),
+  ('PL', 'en', 'Poland'),
+  ('PL', 'de', 'Polen'),
+  ('PL', 'es', 'Polonia'),
+  ('PL', 'fr', 'Pologne'),
+  ('PL', 'pt', 'Polônia'),
+  ('PM', 'en', 'Saint Pierre and Miquelon'),
+  ('PM', 'de', 'St. Pierre und Miquelon'),
+  ('PM', 'es', 'San Pedro y Miquelon'),
+  ('PM', 'fr', 'Saint-Pierre-et-Miquelon'),
+  ('PM', 'pt', 'São Pedro e Miquelon'),
+  ('PN', 'en', 'Pitcairn'),
+  ('PN', 'de', 'Pitcairn'),
+  ('PN', 'es', 'Pitcairn'),
+  ('PN', 'fr', 'Îles Pitcairn'),
+  ('PN', 'pt', 'Pitcairn'),
+  ('PR', 'en', 'Puerto Rico'),
+  ('PR', 'de', 'Puerto Rico'),
+  ('PR', 'es', 'Puerto Rico'),
+  ('PR', 'fr', 'Porto Rico'),
+  ('PR', 'pt', 'Porto Rico'),
+  ('PS', 'en', 'Palestine, State of'),
+  ('PS', 'de', 'Palästina, Staat'),
+  ('PS', 'es', 'Palestina, Estado de'),
+  ('PS', 'fr', 'Palestine, État de'),
+  ('PS', 'pt', 'Palestina, Estado da'),
+  ('PT', 'en', 'Portugal'),
+  ('PT', 'de', 'Portugal'),
+  ('PT', 'es', 'Portugal'),
+  ('PT', 'fr', 'Portugal'),
+  ('PT', 'pt', 'Portugal'),
+  ('PW', 'en', 'Palau'),
+  ('PW', 'de', 'Palau'),
+  ('PW', 'es', 'Palaos'),
+  ('PW', 'fr', 'Palaos'),
+  ('PW', 'pt', 'Palau'),
+  ('PY', 'en', 'Paraguay'),
+  ('PY', 'de', 'Paraguay'),
+  ('PY', 'es', 'Paraguay'),
+  ('PY', 'fr', 'Paraguay'),
+  ('PY', 'pt', 'Paraguai'),
+  ('QA', 'en', 'Qatar'),
+  ('QA', 'de', 'Katar'),
+  ('QA', 'es', 'Catar'),
+  ('QA', 'fr', 'Qatar'),
+  ('QA', 'pt', 'Catar'),
+  ('RE', 'en', 'Réunion'),
+  ('RE', 'de', 'Réunion'),
+  ('RE', 'es', 'Reunión'),
+  ('RE', 'fr', 'Réunion, Île de la'),
+  ('RE', 'pt', 'Reunião'),
+  ('RO', 'en', 'Romania'),
+  ('RO', 'de', 'Rumänien'),
+  ('RO', 'es', 'Rumanía'),
+  ('RO', 'fr', 'Roumanie'),
+  ('RO', 'pt', 'Romênia'),
+  ('RS', 'en', 'Serbia'),
+  ('RS', 'de', 'Serbien'),
+  ('RS', 'es', 'Serbia'),
+  ('RS', 'fr', 'Serbie'),
+  ('RS', 'pt', 'Sérvia'),
+  ('RU', 'en', 'Russian Federation'),
+  ('RU', 'de', 'Russische Föderation'),
+  ('RU', 'es', 'Federación Rusa'),
+  ('RU', 'fr', 'Russie, Fédération de'),
+  ('RU', 'pt', 'Federação Russa'),
+  ('RW', 'en', 'Rwanda'),
+  ('RW', 'de', 'Ruanda'),
+  ('RW', 'es', 'Ruanda'),
+  ('RW', 'fr', 'Rwanda'),
+  ('RW', 'pt', 'Ruanda'),
+  ('SA', 'en', 'Saudi Arabia'),
+  ('SA', 'de', 'Saudi-Arabien'),
+  ('SA', 'es', 'Arabia Saudí'),
+  ('SA', 'fr', 'Arabie saoudite'),
+  ('SA', 'pt', 'Arábia Saudita'),
+  ('SB', 'en', 'Solomon Islands'),
+  ('SB', 'de', 'Salomoninseln'),
+  ('SB', 'es', 'Islas Salomón'),
+  ('SB', 'fr', 'Salomon, Îles'),
+  ('SB', 'pt', 'Ilhas Salomão'),
+  ('SC', 'en', 'Seychelles'),
+  ('SC', 'de', 'Seychellen'),
+  ('SC', 'es', 'Seychelles'),
+  ('SC', 'fr', 'Seychelles'),
+  ('SC', 'pt', 'Seychelles'),
+  ('SD', 'en', 'Sudan'),
+  ('SD', 'de', 'Sudan'),
+  ('SD', 'es', 'Sudán'),
+  ('SD', 'fr', 'Soudan'),
+  ('SD', 'pt', 'Sudão'),
+  ('SE', 'en', 'Sweden'),
+  ('SE', 'de', 'Schweden'),
+  ('SE', 'es', 'Suecia'),
+  ('SE', 'fr', 'Suède'),
+  ('SE', 'pt', 'Suécia'),
+  ('SG', 'en', 'Singapore'),
+  ('SG', 'de', 'Singapur'),
+  ('SG', 'es', 'Singapur'),
+  ('SG', 'fr', 'Singapour'),
+  ('SG', 'pt', 'Cingapura'),
+  ('SH', 'en', 'Saint Helena, Ascension and Tristan da Cunha'),
+  ('SH', 'de', 'St. Helena, Ascension und Tristan da Cunha'),
+  ('SH', 'es', 'Santa Elena, Ascensión y Tristán de Acuña'),
+  ('SH', 'fr', 'Sainte-Hélène, Ascension et Tristan da Cunha'),
+  ('SH', 'pt', 'Santa Helena, Ascensão e Tristão da Cunha'),
+  ('SI', 'en', 'Slovenia'),
+  ('SI', 'de', 'Slowenien'),
+  ('SI', 'es', 'Eslovenia'),
+  ('SI', 'fr', 'Slovénie'),
+  ('SI', 'pt', 'Eslovênia'),
+  ('SJ', 'en', 'Svalbard and Jan Mayen'),
+  ('SJ', 'de', 'Svalbard und Jan Mayen'),
+  ('SJ', 'es', 'Svalbard y Jan Mayen'),
+  ('SJ', 'fr', 'Svalbard et île Jan Mayen'),
+  ('SJ', 'pt', 'Svalbard e a Ilha de Jan Mayen'),
+  ('SK', 'en', 'Slovakia'),
+  ('SK', 'de', 'Slowakei'),
+  ('SK', 'es', 'Eslovaquia'),
+  ('SK', 'fr', 'Slovaquie'),
+  ('SK', 'pt', 'Eslováquia'),
+  ('SL', 'en', 'Sierra Leone'),
+  ('SL', 'de', 'Sierra Leone'),
+  ('SL', 'es', 'Sierra Leona'),
+  ('SL', 'fr', 'Sierra Leone'),
+  ('SL', 'pt', 'Serra Leoa'),
+  ('SM', 'en', 'San Marino'),
+  ('SM', 'de', 'San Marino'),
+  ('SM', 'es', 'San Marino'),
+  ('SM', 'fr', 'Saint-Marin'),
+  ('SM', 'pt', 'São Marino'),
+  ('SN', 'en', 'Senegal'),
+  ('SN', 'de', 'Senegal'),
+  ('SN', 'es', 'Senegal'),
+  ('SN', 'fr', 'Sénégal'),
+  ('SN', 'pt', 'Senegal'),
+  ('SO', 'en', 'Somalia'),
+  ('SO', 'de', 'Somalia'),
+  ('SO', 'es', 'Somalia'),
+  ('SO', 'fr', 'Somalie'),
+  ('SO', 'pt', 'Somália'),
+  ('SR', 'en', 'Suriname'),
+  ('SR', 'de', 'Suriname'),
+  ('SR', 'es', 'Surinám'),
+  ('SR', 'fr', 'Surinam'),
+  ('SR', 'pt', 'Suriname'),
+  ('SS', 'en', 'South Sudan'),
+  ('SS', 'de', 'Südsudan'),
+  ('SS', 'es', 'Sudán del Sur'),
+  ('SS', 'fr', 'Soudan du Sud'),
+  ('SS', 'pt', 'Sudão do Sul'),
+  ('ST', 'en', 'Sao Tome and Principe'),
+  ('ST', 'de', 'São Tomé und Príncipe'),
+  ('ST', 'es', 'Santo Tomé y Príncipe'),
+  ('ST', 'fr', 'Sao Tomé-et-Principe'),
+  ('ST', 'pt', 'São Tomé e Príncipe'),
+  ('SV', 'en', 'El Salvador'),
+  ('SV', 'de', 'El Salvador'),
+  ('SV', 'es', 'El Salvador'),
+  ('SV', 'fr', 'Salvador'),
+  ('SV', 'pt', 'El Salvador'),
+  ('SX', 'en', 'Sint Maarten (Dutch part)'),
+  ('SX', 'de', 'Saint-Martin (Niederländischer Teil)'),
+  ('SX', 'es', 'Isla de San Martín (zona holandsea)'),
+  ('SX', 'fr', 'Saint-Martin (partie néerlandaise)'),
+  ('SX', 'pt', 'São Martim (parte holandesa)'),
+  ('SY', 'en', 'Syria'),
+  ('SY', 'de', 'Syrien'),
+  ('SY', 'es', 'República árabe de Siria'),
+  ('SY', 'fr', 'Syrienne, République arabe'),
+  ('SY', 'pt', 'República Árabe da Síria'),
+  ('SZ', 'en', 'Eswatini'),
+  ('SZ', 'de', 'Eswatini'),
+  ('SZ', 'es', 'Esuatini'),
+  ('SZ', 'fr', 'Eswatini'),
+  ('SZ', 'pt', 'Suazilândia'),
+  ('TC', 'en', 'Turks and Caicos Islands'),
+  ('TC', 'de', 'Turks- und Caicosinseln'),
+  ('TC', 'es', 'Islas Turcas y Caicos'),
+  ('TC', 'fr', 'îles Turques-et-Caïques'),
+  ('TC', 'pt', 'Ilhas Turks e Caicos'),
+  ('TD', 'en', 'Chad'),
+  ('TD', 'de', 'Tschad'),
+  ('TD', 'es', 'Chad'),
+  ('TD', 'fr', 'Tchad'),
+  ('TD', 'pt', 'Chade'),
+  ('TF', 'en', 'French Southern Territories'),
+  ('TF', 'de', 'Französische Süd- und Antarktisgebiete'),
+  ('TF', 'es', 'Territorios Franceses del Sur'),
+  ('TF', 'fr', 'Terres australes françaises'),
+  ('TF', 'pt', 'Territórios Franceses do Sul'),
+  ('TG', 'en', 'Togo'),
+  ('TG', 'de', 'Togo'),
+  ('TG', 'es', 'Togo'),
+  ('TG', 'fr', 'Togo'),
+  ('TG', 'pt', 'Togo'),
+  ('TH', 'en', 'Thailand'),
+  ('TH', 'de', 'Thailand'),
+  ('TH', 'es', 'Tailandia'),
+  ('TH', 'fr', 'Thaïlande'),
+  ('TH', 'pt', 'Tailândia'),
+  ('TJ', 'en', 'Tajikistan'),
+  ('TJ', 'de', 'Tadschikistan'),
+  ('TJ', 'es', 'Tayikistán'),
+  ('TJ', 'fr', 'Tadjikistan'),
+  ('TJ', 'pt', 'Tadjiquistão'),
+  ('TK', 'en', 'Tokelau'),
+  ('TK', 'de', 'Tokelau'),
+  ('TK', 'es', 'Tokelau'),
+  ('TK', 'fr', 'Tokelau'),
+  ('TK', 'pt', 'Toquelau'),
+  ('TL', 'en', 'Timor-Leste'),
+  ('TL', 'de', 'Timor-Leste'),
+  ('TL', 'es', 'Timor Oriental'),
+  ('TL', 'fr', 'Timor oriental'),
+  ('TL', 'pt', 'Timor Leste'),
+  ('TM', 'en', 'Turkmenistan'),
+  ('TM', 'de', 'Turkmenistan'),
+  ('TM', 'es', 'Turkmenistán'),
+  ('TM', 'fr', 'Turkménistan'),
+  ('TM', 'pt', 'Turcomenistão'),
+  ('TN', 'en', 'Tunisia'),
+  ('TN', 'de', 'Tunesien'),
+  ('TN', 'es', 'Tunez'),
+  ('TN', 'fr', 'Tunisie'),
+  ('TN', 'pt', 'Tunísia'),
+  ('TO', 'en', 'Tonga'),
+  ('TO', 'de', 'Tonga'),
+  ('TO', 'es', 'Tonga'),
+  ('TO', 'fr', 'Tonga'),
+  ('TO', 'pt', 'Tonga'),
+  ('TR', 'en', 'Türkiye'),
+  ('TR', 'de', 'Türkei'),
+  ('TR', 'es', 'Türkiye'),
+  ('TR', 'fr', 'Türkiye'),
+  ('TR', 'pt', 'Turquia'),
+  ('TT', 'en', 'Trinidad and Tobago'),
+  ('TT', 'de', 'Trinidad und Tobago'),
+  ('TT', 'es', 'Trinidad y Tobago'),
+  ('TT', 'fr', 'Trinité-et-Tobago'),
+  ('TT', 'pt', 'Trinidade e Tobago'),
+  ('TV', 'en', 'Tuvalu'),
+  ('TV', 'de', 'Tuvalu'),
+  ('TV', 'es', 'Tuvalu'),
+  ('TV', 'fr', 'Tuvalu'),
+  ('TV', 'pt', 'Tuvalu'),
+  ('TW', 'en', 'Taiwan'),
+  ('TW', 'de', 'Taiwan, Chinesische Provinz'),
+  ('TW', 'es', 'Taiwán'),
+  ('TW', 'fr', 'Taïwan'),
+  ('TW', 'pt', 'Taiwan, Província da China'),
+  ('TZ', 'en', 'Tanzania'),
+  ('TZ', 'de', 'Tansania'),
+  ('TZ', 'es', 'Tanzania, República unida de'),
+  ('TZ', 'fr', 'Tanzanie'),
+  ('TZ', 'pt', 'Tanzânia'),
+  ('UA', 'en', 'Ukraine'),
+  ('UA', 'de', 'Ukraine'),
+  ('UA', 'es', 'Ucrania'),
+  ('UA', 'fr', 'Ukraine'),
+  ('UA', 'pt', 'Ucrânia'),
+  ('UG', 'en', 'Uganda'),
+  ('UG', 'de', 'Uganda'),
+  ('UG', 'es', 'Uganda'),
+  ('UG', 'fr', 'Ouganda'),
+  ('UG', 'pt', 'Uganda'),
+  ('UM', 'en', 'United States Minor Outlying Islands'),
+  ('UM', 'de', 'United States Minor Outlying Islands'),
+  ('UM', 'es', 'Islas Ultramarinas Menores de Estados Unidos'),
+  ('UM', 'fr', 'Îles mineures éloignées des États-Unis'),
+  ('UM', 'pt', 'Ilhas Menores Distantes dos Estados Unidos'),
+  ('US', 'en', 'United States'),
+  ('US', 'de', 'Vereinigte Staaten'),
+  ('US', 'es', 'Estados Unidos'),
+  ('US', 'fr', 'États-Unis'),
+  ('US', 'pt', 'Estados Unidos'),
+  ('UY', 'en', 'Uruguay'),
+  ('UY', 'de', 'Uruguay'),
+  ('UY', 'es', 'Uruguay'),
+  ('UY', 'fr', 'Uruguay'),
+  ('UY', 'pt', 'Uruguai'),
+  ('UZ', 'en', 'Uzbekistan'),
+  ('UZ', 'de', 'Usbekistan'),
+  ('UZ', 'es', 'Uzbekistán'),
+  ('UZ', 'fr', 'Ouzbékistan'),
+  ('UZ', 'pt', 'Uzbequistão'),
+  ('VA', 'en', 'Holy See (Vatican City State)'),
+  ('VA', 'de', 'Heiliger Stuhl (Staat Vatikanstadt)'),
+  ('VA', 'es', 'Santa Sede (Ciudad Estado del Vaticano)'),
+  ('VA', 'fr', 'Saint-Siège (état de la cité du Vatican)'),
+  ('VA', 'pt', 'Santa Sé (Cidade-Estado do Vaticano)'),
+  ('VC', 'en', 'Saint Vincent and the Grenadines'),
+  ('VC', 'de', 'St. Vincent und die Grenadinen'),
+  ('VC', 'es', 'San Vicente y las Granadinas'),
+  ('VC', 'fr', 'Saint-Vincent-et-les-Grenadines'),
+  ('VC', 'pt', 'São Vicente e Granadinas'),
+  ('VE', 'en', 'Venezuela'),
+  ('VE', 'de', 'Venezuela, Bolivarische Republik'),
+  ('VE', 'es', 'Venezuela, República Bolivariana de'),
+  ('VE', 'fr', 'Vénézuela'),
+  ('VE', 'pt', 'Venezuela, República Bolivariana da'),
+  ('VG', 'en', 'Virgin Islands, British'),
+  ('VG', 'de', 'Britische Jungferninseln'),
+  ('VG', 'es', 'Islas Vírgenes, Británicas'),
+  ('VG', 'fr', 'Îles Vierges britanniques'),
+  ('VG', 'pt', 'Ilhas Virgens Britânicas'),
+  ('VI', 'en', 'Virgin Islands, U.S.'),
+  ('VI', 'de', 'Amerikanische Jungferninseln'),
+  ('VI', 'es', 'Islas Vírgenes, de EEUU'),
+  ('VI', 'fr', 'Îles Vierges, États-Unis'),
+  ('VI', 'pt', 'Ilhas Virgens dos Estados Unidos'),
+  ('VN', 'en', 'Vietnam'),
+  ('VN', 'de', 'Vietnam'),
+  ('VN', 'es', 'Vietnam'),
+  ('VN', 'fr', 'Viêt Nam'),
+  ('VN', 'pt', 'Vietnã'),
+  ('VU', 'en', 'Vanuatu'),
+  ('VU', 'de', 'Vanuatu'),
+  ('VU', 'es', 'Vanuatu'),
+  ('VU', 'fr', 'Vanuatu'),
+  ('VU', 'pt', 'Vanuatu'),
+  ('WF', 'en', 'Wallis and Futuna'),
+  ('WF', 'de', 'Wallis und Futuna'),
+  ('WF', 'es', 'Wallis y Futuna'),
+  ('WF', 'fr', 'Wallis et Futuna'),
+  ('WF', 'pt', 'Wallis e Futuna'),
+  ('WS', 'en', 'Samoa'),
+  ('WS', 'de', 'Samoa'),
+  ('WS', 'es', 'Samoa'),
+  ('WS', 'fr', 'Samoa'),
+  ('WS', 'pt', 'Samoa'),
+  ('YE', 'en', 'Yemen'),
+  ('YE', 'de', 'Jemen'),
+  ('YE', 'es', 'Yemen'),
+  ('YE', 'fr', 'Yémen'),
+  ('YE', 'pt', 'Iêmen'),
+  ('YT', 'en', 'Mayotte'),
+  ('YT', 'de', 'Mayotte'),
+  ('YT', 'es', 'Mayotte'),
+  ('YT', 'fr', 'Mayotte'),
+  ('YT', 'pt', 'Maiote'),
+  ('ZA', 'en', 'South Africa'),
+  ('ZA', 'de', 'Südafrika'),
+  ('ZA', 'es', 'Sudáfrica'),
+  ('ZA', 'fr', 'Afrique du Sud'),
+  ('ZA', 'pt', 'África do Sul'),
+  ('ZM', 'en', 'Zambia'),
+  ('ZM', 'de', 'Sambia'),
+  ('ZM', 'es', 'Zambia'),
+  ('ZM', 'fr', 'Zambie'),
+  ('ZM', 'pt', 'Zâmbia'),
+  ('ZW', 'en', 'Zimbabwe'),
+  ('ZW', 'de', 'Simbabwe'),
+  ('ZW', 'es', 'Zimbabue'),
+  ('ZW', 'fr', 'Zimbabwe'),
+  ('ZW', 'pt', 'Zimbábue');
+
+-- Other names commonly used for the countries, like the ones written before the countries were kept in this table
+create table if not exists country_aliases (
+  alias varchar(100) not null primary key,
+  country_code char(2) not null references countries (alpha2_code)
+);
+
+insert into country_aliases (alias, country_code) values
+  ('Bolivia, Plurinational State of', 'BO'),
+  ('Czech Republic', 'CZ'),
+  ('United Kingdom of Great Britain and Northern Ireland', 'GB'),
+  ('Iran, Islamic Republic of', 'IR'),
+  ('Korea, Democratic People''s Republic of', 'KP'),
+  ('Korea, Republic of', 'KR'),
+  ('Lao People''s Democratic Republic', 'LA'),
+  ('Moldova, Republic of', 'MD'),
+  ('Syrian Arab Republic', 'SY'),
+  ('Taiwan, Province of China', 'TW'),
+  ('Tanzania, United Republic of', 'TZ'),
+  ('United States of America', 'US'),
+  ('Venezuela, Bolivarian Republic of', 'VE'),
+  ('Viet Nam', 'VN'),
+  ('Russia', 'RU'),
+  ('UK', 'GB'),
+  ('Great Britain', 'GB'),
+  ('England', 'GB'),
+  ('Scotland', 'GB'),
+  ('Wales', 'GB'),
+  ('Northern Ireland', 'GB'),
+  ('Holland', 'NL'),
+  ('Turkey', 'TR'),
+  ('Ivory Coast', 'CI'),
+  ('Cape Verde', 'CV'),
+  ('Swaziland', 'SZ'),
+  ('Macedonia', 'MK'),
+  ('Vatican', 'VA'),
+  ('Vatican City', 'VA'),
+  ('DR Congo', 'CD'),
+  ('Democratic Republic of the Congo', 'CD'),
+  ('Burma', 'MM'),
+  ('East Timor', 'TL'),
+  ('Palestine', 'PS'),
+  ('Micronesia', 'FM');
+
+-- Existing countries were written as free text, so they are matched against the codes, the names in every locale
+-- and the aliases of the countries.
+create temporary table known_country_names (
+  name varchar(100) not null primary key,
+  country_code char(2) not null
+);
+
+insert into known_country_names (name, country_code)
+select distinct lower(name), country_code from (
+  select alpha2_code as country_code, alpha2_code as name from countries
+  union all
+  select alpha2_code, alpha3_code from countries
+  union all
+  select country_code, name from country_names
+) known_names
+on conflict do nothing;
+
+insert into known_country_names (name, country_code)
+select lower(alias), country_code from country_aliases
+on conflict do nothing;
+
+update teams set origin_country = known_country_names.country_code
+from known_country_names where known_country_names.name = lower(trim(teams.origin_country));
+
+update people set origin_country = null where trim(origin_country) = '';
+
+update people set origin_country = known_country_names.country_code
+from known_country_names where known_country_names.name = lower(trim(people.origin_country));
+
+update legal_entities set country = known_country_names.country_code
+from known_country_names where known_country_names.name = lower(trim(legal_entities.country));
+
+-- Stop the migration when a country could not be recognized, so it can be fixed by hand instead of being lost.
+do $$
+declare
+  unknown_countries text;
+begin
+  select string_agg(distinct country, ', ') into unknown_countries from (
+    select origin_country as country from teams
+    union all
+    select origin_country from people
+    union all
+    select country from legal_entities
+  ) countries_in_use
+  where country not in (select alpha2_code from countries);
+
+  if unknown_countries is not null then
+    raise exception 'unknown countries found while normalizing them to ISO 3166-1 codes: %', unknown_countries;
+  end if;
+end $$;
+
+drop table known_country_names;
+
+alter table teams
+  alter column origin_country type char(2),
+  add constraint teams_origin_country_fkey foreign key (origin_country) references countries (alpha2_code);
+
+alter table people
+  alter column origin_country type char(2),
+  add constraint people_origin_country_fkey foreign key (origin_country) references countries (alpha2_code);
+
+alter table legal_entities
+  alter column country type char(2),
+  add constraint legal_entities_country_fkey foreign key (country) references countries (alpha2_code);
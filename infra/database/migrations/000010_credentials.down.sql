@@ -0,0 +1,3 @@
+drop table if exists coach_certifications;
+drop table if exists federation_memberships;
+drop table if exists wfdf_accreditations;
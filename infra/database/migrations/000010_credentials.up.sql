@@ -0,0 +1,48 @@
+create table if not exists wfdf_accreditations (
+  person_username varchar(30) not null references people (username),
+  level varchar(20) not null,
+  issue_date date not null,
+  expiry_date date not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50),
+
+  primary key (person_username, level, issue_date),
+  check (expiry_date >= issue_date)
+);
+
+create index if not exists wfdf_accreditations_expiry_date_idx on wfdf_accreditations (expiry_date);
+
+create table if not exists federation_memberships (
+  person_username varchar(30) not null references people (username),
+  federation_slug varchar(30) not null references legal_entities (slug),
+  season varchar(20) not null,
+  valid_from date not null,
+  valid_until date not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50),
+
+  primary key (person_username, federation_slug, season),
+  check (valid_until >= valid_from)
+);
+
+create table if not exists coach_certifications (
+  person_username varchar(30) not null references people (username),
+  name varchar(100) not null,
+  issued_by varchar(100) not null,
+  issue_date date not null,
+  expiry_date date,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+  updated_at timestamp not null default now(),
+  updated_by varchar(50),
+
+  primary key (person_username, name, issue_date),
+  check (expiry_date is null or expiry_date >= issue_date)
+);
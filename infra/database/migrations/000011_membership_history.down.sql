@@ -0,0 +1 @@
+drop index if exists memberships_person_username_idx;
@@ -0,0 +1 @@
+create index if not exists memberships_person_username_idx on memberships (person_username, start_date);
@@ -0,0 +1,4 @@
+drop index if exists memberships_person_username_period_idx;
+drop index if exists memberships_team_slug_period_idx;
+
+create index if not exists memberships_person_username_idx on memberships (person_username, start_date);
@@ -0,0 +1,5 @@
+-- Point-in-time queries filter the memberships of a team or a person by their start and end dates
+drop index if exists memberships_person_username_idx;
+
+create index if not exists memberships_team_slug_period_idx on memberships (team_slug, start_date, end_date);
+create index if not exists memberships_person_username_period_idx on memberships (person_username, start_date, end_date);
@@ -0,0 +1,8 @@
+drop index if exists people_deleted_at_idx;
+drop index if exists teams_deleted_at_idx;
+
+alter table people drop column if exists deleted_by;
+alter table people drop column if exists deleted_at;
+
+alter table teams drop column if exists deleted_by;
+alter table teams drop column if exists deleted_at;
@@ -0,0 +1,10 @@
+-- Archived teams and people are soft deleted, so the records that reference them keep their history
+alter table teams add column if not exists deleted_at timestamp;
+alter table teams add column if not exists deleted_by varchar(50);
+
+alter table people add column if not exists deleted_at timestamp;
+alter table people add column if not exists deleted_by varchar(50);
+
+-- The purge job looks for the records archived before the retention period
+create index if not exists teams_deleted_at_idx on teams (deleted_at) where deleted_at is not null;
+create index if not exists people_deleted_at_idx on people (deleted_at) where deleted_at is not null;
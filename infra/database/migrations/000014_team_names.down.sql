@@ -0,0 +1,18 @@
+drop index if exists team_names_slug_idx;
+drop index if exists team_names_name_idx;
+drop table if exists team_names;
+
+alter table team_legal_entity_affiliations drop constraint if exists team_legal_entity_affiliations_team_slug_fkey;
+alter table team_legal_entity_affiliations add constraint team_legal_entity_affiliations_team_slug_fkey foreign key (team_slug) references teams (slug);
+
+alter table tryouts drop constraint if exists tryouts_team_slug_fkey;
+alter table tryouts add constraint tryouts_team_slug_fkey foreign key (team_slug) references teams (slug);
+
+alter table team_events drop constraint if exists team_events_team_slug_fkey;
+alter table team_events add constraint team_events_team_slug_fkey foreign key (team_slug) references teams (slug);
+
+alter table ledger_transactions drop constraint if exists ledger_transactions_team_slug_fkey;
+alter table ledger_transactions add constraint ledger_transactions_team_slug_fkey foreign key (team_slug) references teams (slug);
+
+alter table memberships drop constraint if exists memberships_team_slug_fkey;
+alter table memberships add constraint memberships_team_slug_fkey foreign key (team_slug) references teams (slug);
@@ -0,0 +1,33 @@
+-- Renaming a team changes its slug, so the records that reference it follow the new slug
+alter table memberships drop constraint if exists memberships_team_slug_fkey;
+alter table memberships add constraint memberships_team_slug_fkey foreign key (team_slug) references teams (slug) on update cascade;
+
+alter table ledger_transactions drop constraint if exists ledger_transactions_team_slug_fkey;
+alter table ledger_transactions add constraint ledger_transactions_team_slug_fkey foreign key (team_slug) references teams (slug) on update cascade;
+
+alter table team_events drop constraint if exists team_events_team_slug_fkey;
+alter table team_events add constraint team_events_team_slug_fkey foreign key (team_slug) references teams (slug) on update cascade;
+
+alter table tryouts drop constraint if exists tryouts_team_slug_fkey;
+alter table tryouts add constraint tryouts_team_slug_fkey foreign key (team_slug) references teams (slug) on update cascade;
+
+alter table team_legal_entity_affiliations drop constraint if exists team_legal_entity_affiliations_team_slug_fkey;
+alter table team_legal_entity_affiliations add constraint team_legal_entity_affiliations_team_slug_fkey foreign key (team_slug) references teams (slug) on update cascade;
+
+-- The names and slugs that a team used before being renamed, each one in effect until the next one took effect
+create table if not exists team_names (
+  team_slug varchar(30) not null references teams (slug) on update cascade on delete cascade,
+  name varchar(50) not null,
+  slug varchar(30) not null,
+  effective_from date,
+  effective_until date not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+
+  primary key (team_slug, effective_until)
+);
+
+-- Lookups by a previous name or slug are redirected to the current team
+create index if not exists team_names_name_idx on team_names (name);
+create index if not exists team_names_slug_idx on team_names (slug);
@@ -0,0 +1,6 @@
+drop index if exists person_aliases_person_username_idx;
+drop table if exists person_aliases;
+
+alter table tryout_evaluations drop constraint if exists tryout_evaluations_tryout_id_candidate_username_fkey;
+alter table tryout_evaluations add constraint tryout_evaluations_tryout_id_candidate_username_fkey
+  foreign key (tryout_id, candidate_username) references tryout_candidates (tryout_id, person_username) on delete cascade;
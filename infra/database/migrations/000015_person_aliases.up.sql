@@ -0,0 +1,15 @@
+-- Merging people moves the tryout candidacies of the duplicate to the survivor, so their evaluations follow them
+alter table tryout_evaluations drop constraint if exists tryout_evaluations_tryout_id_candidate_username_fkey;
+alter table tryout_evaluations add constraint tryout_evaluations_tryout_id_candidate_username_fkey
+  foreign key (tryout_id, candidate_username) references tryout_candidates (tryout_id, person_username) on update cascade on delete cascade;
+
+-- The usernames of the people merged into another one, which keep addressing the surviving person
+create table if not exists person_aliases (
+  alias_username varchar(30) not null primary key,
+  person_username varchar(30) not null references people (username) on delete cascade,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50)
+);
+
+create index if not exists person_aliases_person_username_idx on person_aliases (person_username);
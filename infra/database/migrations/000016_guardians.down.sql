@@ -0,0 +1,7 @@
+drop index if exists consents_expiry_date_idx;
+drop table if exists consents;
+
+drop index if exists guardianships_dependent_username_idx;
+drop table if exists guardianships;
+
+alter table people drop column if exists birth_date;
@@ -0,0 +1,33 @@
+alter table people add column if not exists birth_date date;
+
+-- The people that answer for a minor, usually their parents or legal guardians
+create table if not exists guardianships (
+  guardian_username varchar(30) not null references people (username) on update cascade on delete cascade,
+  dependent_username varchar(30) not null references people (username) on update cascade on delete cascade,
+  relationship varchar(50) not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+
+  primary key (guardian_username, dependent_username),
+  check (guardian_username <> dependent_username)
+);
+
+create index if not exists guardianships_dependent_username_idx on guardianships (dependent_username);
+
+-- The consents that a guardian granted on behalf of a minor, which replace the paper forms
+create table if not exists consents (
+  person_username varchar(30) not null references people (username) on update cascade on delete cascade,
+  kind varchar(20) not null,
+  granted_by varchar(30) not null references people (username) on update cascade,
+  grant_date date not null,
+  expiry_date date not null,
+
+  created_at timestamp not null default now(),
+  created_by varchar(50),
+
+  primary key (person_username, kind, grant_date),
+  check (expiry_date >= grant_date)
+);
+
+create index if not exists consents_expiry_date_idx on consents (expiry_date);
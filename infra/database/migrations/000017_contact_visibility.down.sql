@@ -0,0 +1,2 @@
+alter table people drop column if exists phone_number_visibility;
+alter table people drop column if exists email_visibility;
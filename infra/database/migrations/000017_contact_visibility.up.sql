@@ -0,0 +1,3 @@
+-- A null visibility keeps the default audience of the contact detail
+alter table people add column if not exists email_visibility varchar(20);
+alter table people add column if not exists phone_number_visibility varchar(20);
@@ -0,0 +1,5 @@
+alter table people drop column if exists avatar_thumbnail_key;
+alter table people drop column if exists avatar_key;
+
+alter table teams drop column if exists logo_thumbnail_key;
+alter table teams drop column if exists logo_key;
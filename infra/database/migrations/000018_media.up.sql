@@ -0,0 +1,6 @@
+-- Logos and avatars are kept in the object storage, under the keys saved here
+alter table teams add column if not exists logo_key varchar(200);
+alter table teams add column if not exists logo_thumbnail_key varchar(200);
+
+alter table people add column if not exists avatar_key varchar(200);
+alter table people add column if not exists avatar_thumbnail_key varchar(200);
@@ -0,0 +1,3 @@
+alter table memberships drop constraint if exists memberships_jersey_number_excl;
+
+alter table memberships drop column if exists jersey_number;
@@ -0,0 +1,12 @@
+-- The number that a member wears on the team, such as "7" or "00", which scorekeepers use to tell the players apart
+alter table memberships add column if not exists jersey_number varchar(2) check (jersey_number ~ '^[0-9]{1,2}$');
+
+-- Two different people may only wear the same number on a team when their memberships do not overlap
+create extension if not exists btree_gist;
+
+alter table memberships add constraint memberships_jersey_number_excl exclude using gist (
+  team_slug with =,
+  jersey_number with =,
+  person_username with <>,
+  daterange(start_date, end_date, '[]') with &&
+);
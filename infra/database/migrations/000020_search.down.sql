@@ -0,0 +1,10 @@
+drop index if exists people_name_trigram_idx;
+drop index if exists teams_name_trigram_idx;
+drop index if exists people_search_document_idx;
+drop index if exists teams_search_document_idx;
+
+drop function if exists immutable_unaccent(text);
+
+-- Note: This is usually not safe to run in production if other objects depend on these extensions
+drop extension if exists pg_trgm;
+drop extension if exists unaccent;
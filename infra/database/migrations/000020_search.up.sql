@@ -0,0 +1,18 @@
+-- like the uuid extension, these extensions may need to be created by a user with the proper permission in prod
+create extension if not exists unaccent;
+create extension if not exists pg_trgm;
+
+-- unaccent is only stable, since its dictionary may change, so it is wrapped to be usable by the search indexes
+create or replace function immutable_unaccent(text) returns text
+  language sql immutable parallel safe strict
+  as $$ select public.unaccent('public.unaccent'::regdictionary, $1) $$;
+
+-- Full-text search, which ignores accents and casing
+create index if not exists teams_search_document_idx on teams
+  using gin (to_tsvector('simple', immutable_unaccent(name || ' ' || coalesce(description, ''))));
+create index if not exists people_search_document_idx on people
+  using gin (to_tsvector('simple', immutable_unaccent(name)));
+
+-- Fuzzy search of the names by their trigrams, which tolerates typos
+create index if not exists teams_name_trigram_idx on teams using gin (immutable_unaccent(lower(name)) gin_trgm_ops);
+create index if not exists people_name_trigram_idx on people using gin (immutable_unaccent(lower(name)) gin_trgm_ops);
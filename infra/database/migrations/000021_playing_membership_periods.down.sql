@@ -0,0 +1 @@
+alter table memberships drop constraint if exists memberships_playing_period_excl;
@@ -0,0 +1,66 @@
+alter table memberships drop constraint if exists memberships_playing_period_excl;
+
+-- Playing memberships of a person in different teams saved before this constraint may overlap. The earlier one ends
+-- the day before the later one starts, as a transfer would have done.
+do $$
+declare
+  overlap record;
+begin
+  loop
+    select
+      earlier.team_slug,
+      earlier.person_username,
+      earlier.role,
+      earlier.start_date,
+      later.start_date as later_start_date
+    into overlap
+    from memberships earlier
+    join memberships later on later.person_username = earlier.person_username
+      and later.team_slug <> earlier.team_slug
+      and later.start_date > earlier.start_date
+      and later.role in ('Player', 'Game Captain', 'Captain')
+      and daterange(later.start_date, later.end_date, '[]') && daterange(earlier.start_date, earlier.end_date, '[]')
+    where earlier.role in ('Player', 'Game Captain', 'Captain')
+    order by earlier.start_date, later.start_date
+    limit 1;
+
+    exit when not found;
+
+    update memberships set end_date = overlap.later_start_date - 1, updated_at = now()
+    where team_slug = overlap.team_slug
+      and person_username = overlap.person_username
+      and role = overlap.role
+      and start_date = overlap.start_date;
+  end loop;
+end $$;
+
+-- Stop the migration when playing memberships in different teams start on the same day, since which one to end can
+-- only be decided by hand.
+do $$
+declare
+  overlapping_memberships text;
+begin
+  select string_agg(distinct format(
+    '%s in %s and %s since %s', one.person_username, one.team_slug, other.team_slug, one.start_date
+  ), ', ')
+  into overlapping_memberships
+  from memberships one
+  join memberships other on other.person_username = one.person_username
+    and other.team_slug > one.team_slug
+    and other.role in ('Player', 'Game Captain', 'Captain')
+    and daterange(other.start_date, other.end_date, '[]') && daterange(one.start_date, one.end_date, '[]')
+  where one.role in ('Player', 'Game Captain', 'Captain');
+
+  if overlapping_memberships is not null then
+    raise exception 'overlapping playing memberships in different teams found: %', overlapping_memberships;
+  end if;
+end $$;
+
+-- A person may only play for one team at a time, which the services check before saving a playing membership and
+-- which this constraint keeps when two of them are saved at once. A person may hold several playing roles in the same
+-- team, such as when they become its Captain.
+alter table memberships add constraint memberships_playing_period_excl exclude using gist (
+  person_username with =,
+  team_slug with <>,
+  daterange(start_date, end_date, '[]') with &&
+) where (role in ('Player', 'Game Captain', 'Captain'));
@@ -27,7 +27,7 @@ func SeedPeople(ctx context.Context, personRepo repository.Person, logger logger
 			email:         "doug@gmail.com",
 			phoneNumber:   "(11) 98765-4321",
 			wfdfNumber:    "12",
-			originCountry: "Brazil",
+			originCountry: "BR",
 			createdBy:     "admin",
 		},
 		{
@@ -36,7 +36,7 @@ func SeedPeople(ctx context.Context, personRepo repository.Person, logger logger
 			email:         "allan@gmail.com",
 			phoneNumber:   "(11) 98765-4321",
 			wfdfNumber:    "34",
-			originCountry: "Brazil",
+			originCountry: "BR",
 			createdBy:     "admin",
 		},
 		{
@@ -45,7 +45,7 @@ func SeedPeople(ctx context.Context, personRepo repository.Person, logger logger
 			email:         "bella@gmail.com",
 			phoneNumber:   "(11) 98765-4321",
 			wfdfNumber:    "56",
-			originCountry: "Brazil",
+			originCountry: "BR",
 			createdBy:     "admin",
 		},
 	}
@@ -23,21 +23,21 @@ func SeedTeams(ctx context.Context, teamRepo repository.Team, logger logger.Logg
 			slug:          "ultimate-warriors",
 			name:          "Ultimate Warriors",
 			description:   "A competitive ultimate frisbee team from California",
-			originCountry: "USA",
+			originCountry: "US",
 			createdBy:     "admin",
 		},
 		{
 			slug:          "disc-dynamos",
 			name:          "Disc Dynamos",
 			description:   "Professional ultimate frisbee team from New York",
-			originCountry: "USA",
+			originCountry: "US",
 			createdBy:     "admin",
 		},
 		{
 			slug:          "flying-circus",
 			name:          "Flying Circus",
 			description:   "European championship ultimate frisbee team",
-			originCountry: "Germany",
+			originCountry: "DE",
 			createdBy:     "admin",
 		},
 	}
@@ -1,6 +1,11 @@
 package helper
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 
 func IsNilOrEmpty(str *string) bool {
 	return str == nil || *str == ""
@@ -9,3 +14,8 @@ func IsNilOrEmpty(str *string) bool {
 func ErrorMessageInField(entity, field string) string {
 	return fmt.Sprintf("the %s's '%s' should not be empty", entity, field)
 }
+
+// IsUUID checks if the string is in the canonical textual representation of a UUID.
+func IsUUID(str string) bool {
+	return uuidPattern.MatchString(str)
+}
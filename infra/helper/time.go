@@ -3,4 +3,5 @@ package helper
 import "time"
 
 const DefaultTimeLayout = time.RFC3339
+const DefaultDateLayout = "2006-01-02"
 const UserFriendlyTimeLayout = "2006-01-02 15:04:05"
@@ -0,0 +1,43 @@
+package fixture
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+// FakeMembershipDefaultStartDate is the default start date for a fake membership.
+var FakeMembershipDefaultStartDate = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// GetFakeMembership builds an open-ended fake playing membership of the person in the team.
+func GetFakeMembership(team *entity.Team, person *entity.Person) *entity.Membership {
+	return &entity.Membership{
+		Team:      team,
+		Person:    person,
+		Role:      entity.MembershipRoles.Player,
+		StartDate: FakeMembershipDefaultStartDate,
+	}
+}
+
+// GenerateMembershipQueries inserts the memberships, leaving the end date empty for the ones with a zero end date.
+func GenerateMembershipQueries(memberships ...*entity.Membership) []Query {
+	queries := make([]Query, 0)
+
+	for _, membership := range memberships {
+		if membership == nil {
+			continue
+		}
+		var endDate *string
+		if !membership.EndDate.IsZero() {
+			formattedEndDate := membership.EndDate.Format(time.DateOnly)
+			endDate = &formattedEndDate
+		}
+		queries = append(queries, GenerateCustomQuery(
+			"insert into memberships(team_slug, person_username, role, start_date, end_date, created_by) values (?, ?, ?, ?, ?, ?)",
+			membership.Team.Slug, membership.Person.UserName, membership.Role,
+			membership.StartDate.Format(time.DateOnly), endDate, membership.CreatedBy,
+		))
+	}
+
+	return queries
+}
@@ -0,0 +1,41 @@
+package fixture
+
+import (
+	"fmt"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+const (
+	// FakePersonDefaultUserName is the default username for a fake person.
+	FakePersonDefaultUserName = "my.person"
+	// FakePersonDefaultOriginCountry is the default origin country for a fake person.
+	FakePersonDefaultOriginCountry = "BR"
+)
+
+// GetFakePerson builds a fake person whose name and email follow the username, so that fake people with different
+// usernames never clash on the unique columns.
+func GetFakePerson(userName string) *entity.Person {
+	return &entity.Person{
+		UserName:      userName,
+		Name:          fmt.Sprintf("Fake Person %s", userName),
+		Email:         fmt.Sprintf("%s@fake.person", userName),
+		OriginCountry: FakePersonDefaultOriginCountry,
+	}
+}
+
+func GeneratePersonQueries(people ...*entity.Person) []Query {
+	queries := make([]Query, 0)
+
+	for _, person := range people {
+		if person == nil {
+			continue
+		}
+		queries = append(queries, GenerateCustomQuery(
+			"insert into people(username, name, email, origin_country, created_by, updated_by) values (?, ?, ?, ?, ?, ?)",
+			person.UserName, person.Name, person.Email, person.OriginCountry, person.CreatedBy, person.UpdatedBy,
+		))
+	}
+
+	return queries
+}
@@ -12,7 +12,7 @@ const (
 	// FakeTeamDefaultDescription is the default description for a fake team.
 	FakeTeamDefaultDescription = "This is my team description."
 	// FakeTeamDefaultOriginCountry is the default origin country for a fake team.
-	FakeTeamDefaultOriginCountry = "BRA"
+	FakeTeamDefaultOriginCountry = "BR"
 
 	// FakeTeamAnotherSlug is another name for a fake slug.
 	FakeTeamAnotherSlug = "bra-sp-another-team-slug"
@@ -51,7 +51,7 @@ func GetDefaultFixtureTeam() *entity.Team {
 	team.Slug = FakeTeamDefaultSlug
 	team.Name = FakeTeamDefaultName
 	team.Description = "My Awesome Team"
-	team.OriginCountry = "Brazil"
+	team.OriginCountry = "BR"
 
 	return team
 }
@@ -59,9 +59,10 @@ func GetDefaultFixtureTeam() *entity.Team {
 func GetAnotherFixtureTeam() *entity.Team {
 	team := GetFakeTeam()
 
+	team.Slug = FakeTeamAnotherSlug
 	team.Name = FakeTeamAnotherName
 	team.Description = "Another Not So Awesome Team"
-	team.OriginCountry = "Brazil"
+	team.OriginCountry = "BR"
 
 	return team
 }
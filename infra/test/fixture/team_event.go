@@ -0,0 +1,42 @@
+package fixture
+
+import (
+	"time"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/entity"
+)
+
+const (
+	// FakeTeamEventDefaultTitle is the default title for a fake team event.
+	FakeTeamEventDefaultTitle = "My Team Practice"
+	// FakeTeamEventDefaultDuration is the default duration for a fake team event.
+	FakeTeamEventDefaultDuration = 2 * time.Hour
+)
+
+// GetFakeTeamEvent builds a fake practice of the team, which needs an explicit ID so that tests can refer to it.
+func GetFakeTeamEvent(id string, team *entity.Team, startTime time.Time) *entity.TeamEvent {
+	return &entity.TeamEvent{
+		ID:        id,
+		Team:      team,
+		Kind:      entity.TeamEventKinds.Practice,
+		Title:     FakeTeamEventDefaultTitle,
+		StartTime: startTime,
+		EndTime:   startTime.Add(FakeTeamEventDefaultDuration),
+	}
+}
+
+func GenerateTeamEventQueries(events ...*entity.TeamEvent) []Query {
+	queries := make([]Query, 0)
+
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		queries = append(queries, GenerateCustomQuery(
+			"insert into team_events(id, team_slug, kind, title, start_time, end_time, created_by) values (?, ?, ?, ?, ?, ?, ?)",
+			event.ID, event.Team.Slug, string(event.Kind), event.Title, event.StartTime, event.EndTime, event.CreatedBy,
+		))
+	}
+
+	return queries
+}
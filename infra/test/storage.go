@@ -0,0 +1,83 @@
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/config"
+)
+
+// s3Object is an object kept by the S3 stand-in.
+type s3Object struct {
+	contentType string
+	content     []byte
+}
+
+// GetS3StandIn starts an in-memory stand-in of an S3-compatible storage, such as the MinIO of docker-compose, and
+// returns the S3 configuration of the tests pointing to it. The stand-in keeps the objects of the configured bucket
+// and rejects the requests that are not signed with the configured access key or whose content hash does not match.
+func GetS3StandIn(t *testing.T) *config.S3StorageSection {
+	t.Helper()
+
+	section := *GetTestConfiguration(t).Storage.S3
+	objects := map[string]s3Object{}
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		content, err := io.ReadAll(request.Body)
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		contentHash := sha256.Sum256(content)
+		isSigned := strings.HasPrefix(
+			request.Header.Get("Authorization"),
+			"AWS4-HMAC-SHA256 Credential="+section.AccessKeyID+"/",
+		) && request.Header.Get("X-Amz-Date") != ""
+		if !isSigned || request.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(contentHash[:]) {
+			http.Error(writer, "SignatureDoesNotMatch", http.StatusForbidden)
+			return
+		}
+
+		key := strings.TrimPrefix(request.URL.Path, "/"+section.Bucket+"/")
+		if key == request.URL.Path {
+			http.Error(writer, "NoSuchBucket", http.StatusNotFound)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch request.Method {
+		case http.MethodPut:
+			objects[key] = s3Object{contentType: request.Header.Get("Content-Type"), content: content}
+		case http.MethodGet:
+			object, exists := objects[key]
+			if !exists {
+				http.Error(writer, "NoSuchKey", http.StatusNotFound)
+				return
+			}
+			writer.Header().Set("Content-Type", object.contentType)
+			_, _ = writer.Write(object.content)
+		case http.MethodDelete:
+			delete(objects, key)
+			writer.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(writer, "MethodNotAllowed", http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	// The tests may change the returned configuration without affecting the stand-in
+	standInSection := section
+	standInSection.Endpoint = server.URL
+
+	return &standInSection
+}
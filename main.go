@@ -11,6 +11,10 @@ import (
 	postgresRepositories "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/repository/postgres"
 	postgresDatabase "github.com/leeohaddad/ultimate-frisbee-api/infra/database/postgres"
 
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/storage"
+	localStorage "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/storage/local"
+	s3Storage "github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/storage/s3"
+
 	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/logger"
 	"github.com/leeohaddad/ultimate-frisbee-api/infra/adapter/logger/zap"
 
@@ -25,13 +29,15 @@ const (
 	EntrypointMigration = "migration"
 	// EntrypointSeed represents the entrypoint for the database seeding tool.
 	EntrypointSeed = "seed"
+	// EntrypointPurge represents the entrypoint for the job that deletes records archived past the retention period.
+	EntrypointPurge = "purge"
 )
 
 func main() {
 	var startEntrypoint string
 	var configFile string
 
-	flag.StringVar(&startEntrypoint, "e", EntrypointAPI, "Define which entrypoint will be called on Ultimate Frisbee API. Options: [api, migration, seed] Default: [api]")
+	flag.StringVar(&startEntrypoint, "e", EntrypointAPI, "Define which entrypoint will be called on Ultimate Frisbee API. Options: [api, migration, seed, purge] Default: [api]")
 	flag.StringVar(&configFile, "config", "./config/local.yaml", "Path to the configuration file to be used by the service.")
 	flag.Parse()
 
@@ -44,6 +50,8 @@ func main() {
 		runMigration(applicationConfig)
 	case EntrypointSeed:
 		runSeed(applicationConfig)
+	case EntrypointPurge:
+		runPurge(applicationConfig)
 	default:
 		panic(fmt.Errorf("unknown entrypoint: %s", startEntrypoint))
 	}
@@ -94,12 +102,43 @@ func getDatabase(applicationConfig *config.Application, logger logger.Logger) po
 
 func getRepositories(applicationConfig *config.Application, databaseClient postgresDatabase.Client) repository.Collection {
 	return repository.Collection{
-		Team:   postgresRepositories.NewTeamRepository(databaseClient),
-		Person: postgresRepositories.NewPersonRepository(databaseClient),
+		Team:        postgresRepositories.NewTeamRepository(databaseClient),
+		Person:      postgresRepositories.NewPersonRepository(databaseClient),
+		Membership:  postgresRepositories.NewMembershipRepository(databaseClient),
+		Ledger:      postgresRepositories.NewLedgerRepository(databaseClient),
+		TeamEvent:   postgresRepositories.NewTeamEventRepository(databaseClient),
+		Tryout:      postgresRepositories.NewTryoutRepository(databaseClient),
+		LegalEntity: postgresRepositories.NewLegalEntityRepository(databaseClient),
+		Credential:  postgresRepositories.NewCredentialRepository(databaseClient),
+		Guardian:    postgresRepositories.NewGuardianRepository(databaseClient),
+		Country:     postgresRepositories.NewCountryRepository(databaseClient),
+
+		TransactionManager: postgresRepositories.NewTransactionManager(databaseClient),
 		// Tournament: postgresRepositories.NewRepository(databaseClient),
 	}
 }
 
+// getStorage sets up the storage of the media, panicking when it cannot be set up, since the API would fail on the
+// first request that needs it otherwise.
+func getStorage(applicationConfig *config.Application, logger logger.Logger) storage.Storage {
+	switch applicationConfig.Storage.Driver {
+	case "local":
+		return localStorage.NewStorage(applicationConfig.Storage.Local.Directory)
+	case "s3":
+		s3, err := s3Storage.NewStorage(applicationConfig.Storage.S3)
+		if err != nil {
+			logger.WithError(err).Error("error while trying to set up S3 storage")
+			panic(err)
+		}
+
+		return s3
+	}
+
+	err := fmt.Errorf("unknown storage driver: %s", applicationConfig.Storage.Driver)
+	logger.WithError(err).Error("error while trying to set up storage")
+	panic(err)
+}
+
 func waitShutdown() {
 	channel := make(chan os.Signal, 1)
 	signal.Notify(channel, os.Interrupt, syscall.SIGTERM)
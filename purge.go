@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	applicationParam "github.com/leeohaddad/ultimate-frisbee-api/application/param"
+	applicationService "github.com/leeohaddad/ultimate-frisbee-api/application/service"
+
+	"github.com/leeohaddad/ultimate-frisbee-api/domain/port/config"
+)
+
+// runPurge permanently deletes the teams and the people that were archived longer than the retention period ago.
+// It is meant to be scheduled (eg. daily) outside of the API.
+func runPurge(applicationConfig *config.Application) {
+	applicationLogger := getLogger()
+
+	databaseClient := getDatabase(applicationConfig, applicationLogger)
+	if databaseClient == nil {
+		applicationLogger.Error("failed to connect to database")
+		return
+	}
+
+	repositories := getRepositories(applicationConfig, databaseClient)
+	archivedBefore := time.Now().AddDate(0, 0, -applicationConfig.Archive.RetentionDays)
+	applicationLogger.Infof("purging the teams and people archived before %s...", archivedBefore.Format(time.RFC3339))
+
+	result, err := applicationService.PurgeArchivedRecords(context.Background(), applicationParam.PurgeArchivedRecords{
+		ArchivedBefore: archivedBefore,
+
+		TeamRepository:   repositories.Team,
+		PersonRepository: repositories.Person,
+	})
+	if err != nil {
+		applicationLogger.WithError(err).Error("failed to purge archived records")
+		return
+	}
+
+	applicationLogger.Infof(
+		"purge completed: %d teams and %d people deleted, %d teams and %d people kept because they are still referenced",
+		len(result.PurgedTeams),
+		len(result.PurgedPeople),
+		len(result.KeptTeams),
+		len(result.KeptPeople),
+	)
+}